@@ -0,0 +1,17 @@
+package jobs
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/spf13/cobra"
+)
+
+func New() *cobra.Command {
+	return command.NewSubcommandGroup("jobs",
+		newPurgeAccounts(),
+		newPurgeAuditEvents(),
+		newPurgeDataExports(),
+		newPurgeFailedAttempts(),
+		newProcessPushOutbox(),
+		newPurgePushDeliveries(),
+	)
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newProcessPushOutbox() *cobra.Command {
+	return &cobra.Command{
+		Use:   "process-push-outbox",
+		Short: "Sends all queued push messages and broadcasts which are due.",
+		Long: `Sends all queued push messages and scheduled broadcasts which are due,
+retrying failed deliveries as configured by SERVER_PUSH_OUTBOX_*.
+Intended to be run periodically (e.g. as cronjob) if SERVER_PUSH_OUTBOX_WORKER_ENABLED is disabled.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			processPushOutboxCmdFunc()
+		},
+	}
+}
+
+func processPushOutboxCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), jobs.ProcessPushOutbox)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to process push outbox")
+	}
+}
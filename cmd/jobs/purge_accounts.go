@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newPurgeAccounts() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-accounts",
+		Short: "Purges user accounts whose deletion grace period has passed.",
+		Long: `Sends reminders about upcoming account deletions and deletes all
+accounts scheduled for deletion whose grace period has passed.
+Intended to be run periodically (e.g. as cronjob).`,
+		Run: func(_ *cobra.Command, _ []string) {
+			purgeAccountsCmdFunc()
+		},
+	}
+}
+
+func purgeAccountsCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), jobs.PurgeAccountDeletions)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to purge account deletions")
+	}
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newPurgeAuditEvents() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-audit-events",
+		Short: "Purges audit events older than the configured retention.",
+		Long: `Deletes all security audit events older than SERVER_AUDIT_RETENTION_SECONDS.
+Events are kept forever if the retention is set to 0.
+Intended to be run periodically (e.g. as cronjob).`,
+		Run: func(_ *cobra.Command, _ []string) {
+			purgeAuditEventsCmdFunc()
+		},
+	}
+}
+
+func purgeAuditEventsCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), jobs.PurgeAuditEvents)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to purge audit events")
+	}
+}
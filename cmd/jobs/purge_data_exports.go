@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newPurgeDataExports() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-data-exports",
+		Short: "Purges expired data exports.",
+		Long: `Deletes all data exports along with their archives once their download link has expired
+(see SERVER_AUTH_DATA_EXPORT_VALIDITY_SECONDS), as well as archives left behind by deleted exports.
+Intended to be run periodically (e.g. as cronjob).`,
+		Run: func(_ *cobra.Command, _ []string) {
+			purgeDataExportsCmdFunc()
+		},
+	}
+}
+
+func purgeDataExportsCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), jobs.PurgeDataExports)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to purge data exports")
+	}
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newPurgeFailedAttempts() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-failed-attempts",
+		Short: "Purges expired failed authentication attempts.",
+		Long: `Deletes all failed login, password reset and magic link attempt counters which are not locked
+and had no failed attempt within SERVER_AUTH_LOCKOUT_COOLDOWN_DURATION_SECONDS.
+Intended to be run periodically (e.g. as cronjob).`,
+		Run: func(_ *cobra.Command, _ []string) {
+			purgeFailedAttemptsCmdFunc()
+		},
+	}
+}
+
+func purgeFailedAttemptsCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), jobs.PurgeFailedAttempts)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to purge failed attempts")
+	}
+}
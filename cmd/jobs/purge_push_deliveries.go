@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newPurgePushDeliveries() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-push-deliveries",
+		Short: "Purges completed push deliveries older than the configured retention.",
+		Long: `Deletes all sent, failed or rejected push deliveries completed longer than SERVER_PUSH_OUTBOX_RETENTION_SECONDS ago.
+Deliveries are kept forever if the retention is set to 0.
+Intended to be run periodically (e.g. as cronjob).`,
+		Run: func(_ *cobra.Command, _ []string) {
+			purgePushDeliveriesCmdFunc()
+		},
+	}
+}
+
+func purgePushDeliveriesCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), jobs.PurgePushDeliveries)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to purge push deliveries")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/cmd/db"
 	"allaboutapps.dev/aw/go-starter/cmd/env"
+	"allaboutapps.dev/aw/go-starter/cmd/jobs"
 	"allaboutapps.dev/aw/go-starter/cmd/probe"
 	"allaboutapps.dev/aw/go-starter/cmd/server"
 	"allaboutapps.dev/aw/go-starter/internal/config"
@@ -33,6 +34,7 @@ func Execute() {
 	rootCmd.AddCommand(
 		db.New(),
 		env.New(),
+		jobs.New(),
 		probe.New(),
 		server.New(),
 	)
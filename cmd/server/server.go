@@ -83,6 +83,10 @@ func runServer(flags Flags) {
 			log.Fatal().Err(err).Msg("Failed to initialize router")
 		}
 
+		if s.Config.Push.EnableOutboxWorker {
+			s.Push.StartWorker(ctx)
+		}
+
 		go func() {
 			if err := s.Start(); err != nil {
 				if errors.Is(err, http.ErrServerClosed) {
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteAdminInviteRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.DELETE("/invites/:id", deleteAdminInviteHandler(s), middleware.RequirePermission(auth.PermissionInvitesWrite))
+}
+
+func deleteAdminInviteHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewDeleteAdminInviteRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if err := s.Auth.DeleteInvite(ctx, params.ID.String()); err != nil {
+			log.Debug().Err(err).Msg("Failed to delete invite")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteAdminUserAPIKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.DELETE("/users/:id/api-keys/:apiKeyId", deleteAdminUserAPIKeyHandler(s), middleware.RequirePermission(auth.PermissionUsersWrite))
+}
+
+func deleteAdminUserAPIKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewDeleteAdminUserAPIKeyRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if err := s.Auth.DeleteAPIKey(ctx, dto.DeleteAPIKeyRequest{
+			UserID:   params.ID.String(),
+			APIKeyID: params.APIKeyID.String(),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to delete API key of user")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminAuditEventsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/audit-events", getAdminAuditEventsHandler(s), middleware.RequirePermission(auth.PermissionAuditRead))
+}
+
+func getAdminAuditEventsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewGetAdminAuditEventsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		request := dto.GetAuditEventsRequest{
+			Limit:     *params.Limit,
+			Cursor:    null.StringFromPtr(params.Cursor),
+			EventType: null.StringFromPtr(params.EventType),
+		}
+
+		if params.ActorID != nil {
+			request.ActorID = null.StringFrom(params.ActorID.String())
+		}
+
+		if params.SubjectID != nil {
+			request.SubjectID = null.StringFrom(params.SubjectID.String())
+		}
+
+		if params.From != nil {
+			request.From = null.TimeFrom(time.Time(*params.From))
+		}
+
+		if params.To != nil {
+			request.To = null.TimeFrom(time.Time(*params.To))
+		}
+
+		result, err := s.Audit.GetEvents(ctx, request)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get audit events")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
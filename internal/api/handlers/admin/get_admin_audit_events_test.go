@@ -0,0 +1,176 @@
+package admin_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdminAuditEventsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		events := []audit.Event{
+			{Type: audit.EventTypeLogin, ActorID: null.StringFrom(fix.User1.ID), SubjectID: null.StringFrom(fix.User1.ID)},
+			{Type: audit.EventTypeLoginFailed, SubjectID: null.StringFrom(fix.User2.ID), Metadata: map[string]any{"method": "password"}},
+			{Type: audit.EventTypeLogin, ActorID: null.StringFrom(fix.User2.ID), SubjectID: null.StringFrom(fix.User2.ID)},
+			{Type: audit.EventTypeScopesChanged, ActorID: null.StringFrom(fix.User1.ID), SubjectID: null.StringFrom(fix.User2.ID)},
+		}
+		for i, event := range events {
+			test.SetMockClock(t, s, start.Add(time.Duration(i)*time.Minute))
+			err := s.Audit.Record(ctx, s.DB, event)
+			require.NoError(t, err)
+		}
+
+		tests := []struct {
+			name       string
+			query      url.Values
+			eventTypes []string
+		}{
+			{
+				name:       "Default",
+				query:      url.Values{},
+				eventTypes: []string{"scopes_changed", "login", "login_failed", "login"},
+			},
+			{
+				name:       "Actor",
+				query:      url.Values{"actorId": {fix.User1.ID}},
+				eventTypes: []string{"scopes_changed", "login"},
+			},
+			{
+				name:       "Subject",
+				query:      url.Values{"subjectId": {fix.User2.ID}},
+				eventTypes: []string{"scopes_changed", "login", "login_failed"},
+			},
+			{
+				name:       "EventType",
+				query:      url.Values{"eventType": {"login"}},
+				eventTypes: []string{"login", "login"},
+			},
+			{
+				name: "TimeRange",
+				query: url.Values{
+					"from": {start.Add(time.Minute).Format(time.RFC3339)},
+					"to":   {start.Add(3 * time.Minute).Format(time.RFC3339)},
+				},
+				eventTypes: []string{"login", "login_failed"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "GET", "/api/v1/admin/audit-events?"+tt.query.Encode(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+				var response types.GetAdminAuditEventsResponse
+				test.ParseResponseAndValidate(t, res, &response)
+
+				eventTypes := make([]string, 0, len(response.Data))
+				for _, event := range response.Data {
+					eventTypes = append(eventTypes, *event.EventType)
+				}
+
+				assert.Equal(t, tt.eventTypes, eventTypes)
+			})
+		}
+	})
+}
+
+func TestGetAdminAuditEventsPagination(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		// events recorded at the same time are ordered by their ID
+		for range 3 {
+			err := s.Audit.Record(ctx, s.DB, audit.Event{
+				Type:      audit.EventTypeTokenRefreshed,
+				ActorID:   null.StringFrom(fix.User2.ID),
+				SubjectID: null.StringFrom(fix.User2.ID),
+			})
+			require.NoError(t, err)
+		}
+
+		ids := make(map[string]struct{})
+		path := fmt.Sprintf("/api/v1/admin/audit-events?subjectId=%s&limit=2", fix.User2.ID)
+
+		res := test.PerformRequest(t, s, "GET", path, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetAdminAuditEventsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		require.Len(t, response.Data, 2)
+		require.NotEmpty(t, response.NextCursor)
+
+		for _, event := range response.Data {
+			ids[event.ID.String()] = struct{}{}
+		}
+
+		res = test.PerformRequest(t, s, "GET", path+"&cursor="+url.QueryEscape(response.NextCursor), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		response = types.GetAdminAuditEventsResponse{}
+		test.ParseResponseAndValidate(t, res, &response)
+		require.Len(t, response.Data, 1)
+		assert.Empty(t, response.NextCursor)
+
+		assert.NotContains(t, ids, response.Data[0].ID.String())
+	})
+}
+
+func TestGetAdminAuditEventsInvalidCursor(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/audit-events?cursor=invalid", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrBadRequestInvalidCursor)
+	})
+}
+
+func TestGetAdminAuditEventsMissingPermission(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		_, err = models.Permissions(models.PermissionWhere.Name.EQ(auth.PermissionAuditRead.String())).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/audit-events", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingPermission)
+	})
+}
@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminPushBroadcastsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/push/broadcasts", getAdminPushBroadcastsHandler(s), middleware.RequirePermission(auth.PermissionBroadcastsRead))
+}
+
+func getAdminPushBroadcastsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		broadcasts, err := s.Push.GetBroadcasts(ctx)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get push broadcasts")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, broadcasts.ToTypes())
+	}
+}
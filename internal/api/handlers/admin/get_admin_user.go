@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminUserRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/users/:id", getAdminUserHandler(s), middleware.RequirePermission(auth.PermissionUsersRead))
+}
+
+func getAdminUserHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewGetAdminUserRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := s.Auth.GetAdminUser(ctx, params.ID.String())
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get user")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, user.ToTypes())
+	}
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminUserAPIKeysRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/users/:id/api-keys", getAdminUserAPIKeysHandler(s), middleware.RequirePermission(auth.PermissionUsersRead))
+}
+
+func getAdminUserAPIKeysHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewGetAdminUserAPIKeysRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		// ensures a 404 for unknown users instead of an empty list
+		if _, err := s.Auth.GetAdminUser(ctx, params.ID.String()); err != nil {
+			log.Debug().Err(err).Msg("Failed to get user")
+			return err
+		}
+
+		apiKeys, err := s.Auth.GetAPIKeys(ctx, params.ID.String())
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get API keys of user")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, apiKeys.ToTypes())
+	}
+}
@@ -0,0 +1,96 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdminUserSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fix.UserRequiresConfirmation.ID, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, fix.UserRequiresConfirmation.ID, response.ID.String())
+		assert.Equal(t, fix.UserRequiresConfirmation.Username.String, response.Username)
+		assert.False(t, *response.IsActive)
+		assert.True(t, *response.RequiresConfirmation)
+		assert.False(t, *response.PasswordResetRequired)
+		assert.Equal(t, []string{auth.ScopeApp.String()}, response.Scopes)
+	})
+}
+
+func TestGetAdminUserLockout(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fix.User2.ID, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Zero(t, response.FailedLoginAttempts)
+		assert.Zero(t, response.LockedUntil)
+
+		lockedUntil := s.Clock.Now().Add(time.Hour).Truncate(time.Second)
+		lock := models.AuthFailedAttempt{
+			Scope:        models.AuthAttemptScopeLoginUsername,
+			Key:          fix.User2.Username.String,
+			FailedCount:  2,
+			LockoutCount: 1,
+			LastFailedAt: s.Clock.Now(),
+			LockedUntil:  null.TimeFrom(lockedUntil),
+		}
+
+		err = lock.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fix.User2.ID, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, int64(2), response.FailedLoginAttempts)
+		assert.True(t, lockedUntil.Equal(time.Time(response.LockedUntil)))
+	})
+}
+
+func TestGetAdminUserNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users/3b0b9a2e-5a3c-4f7e-8d1b-6c2e9f4a7d10", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundUserNotFound)
+	})
+}
@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminUsersRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/users", getAdminUsersHandler(s), middleware.RequirePermission(auth.PermissionUsersRead))
+}
+
+func getAdminUsersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewGetAdminUsersRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.GetAdminUsers(ctx, dto.GetAdminUsersRequest{
+			Offset:   *params.Offset,
+			Limit:    *params.Limit,
+			OrderBy:  dto.AdminUserOrderBy(*params.OrderBy),
+			OrderDir: types.OrderDir(*params.OrderDir),
+			Query:    null.StringFromPtr(params.Q),
+			IsActive: null.BoolFromPtr(params.IsActive),
+			Scope:    null.StringFromPtr(params.Scope),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get users")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
@@ -0,0 +1,113 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdminUsersSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeApp.String(), auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		tests := []struct {
+			name      string
+			query     string
+			usernames []string
+			total     int64
+		}{
+			{
+				name:      "Default",
+				query:     "",
+				usernames: []string{"user1@example.com", "user2@example.com", "userdeactivated@example.com", "userrequiresconfirmation@example.com"},
+				total:     4,
+			},
+			{
+				name:      "Paginated",
+				query:     "?offset=1&limit=2",
+				usernames: []string{"user2@example.com", "userdeactivated@example.com"},
+				total:     4,
+			},
+			{
+				name:      "OrderDesc",
+				query:     "?orderBy=username&orderDir=desc&limit=2",
+				usernames: []string{"userrequiresconfirmation@example.com", "userdeactivated@example.com"},
+				total:     4,
+			},
+			{
+				name:      "Search",
+				query:     "?q=USER%20example.com&isActive=false",
+				usernames: []string{"userdeactivated@example.com", "userrequiresconfirmation@example.com"},
+				total:     2,
+			},
+			{
+				name:      "SearchEscaped",
+				query:     "?q=user_",
+				usernames: []string{},
+				total:     0,
+			},
+			{
+				name:      "Scope",
+				query:     "?scope=cms",
+				usernames: []string{"user1@example.com"},
+				total:     1,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users"+tt.query, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+				var response types.GetAdminUsersResponse
+				test.ParseResponseAndValidate(t, res, &response)
+
+				usernames := make([]string, 0, len(response.Data))
+				for _, user := range response.Data {
+					usernames = append(usernames, user.Username)
+				}
+
+				assert.Equal(t, tt.usernames, usernames)
+				assert.Equal(t, tt.total, *response.Total)
+			})
+		}
+	})
+}
+
+func TestGetAdminUsersBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users?orderBy=password&limit=1000", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
+
+func TestGetAdminUsersMissingScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingScopes)
+	})
+}
@@ -0,0 +1,79 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRolesSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/roles", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetRolesResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		roles := make(map[string][]string, len(response.Data))
+		for _, role := range response.Data {
+			roles[*role.Name] = role.Permissions
+		}
+
+		require.Contains(t, roles, auth.ScopeApp.String())
+		require.Contains(t, roles, auth.ScopeCMS.String())
+		assert.Empty(t, roles[auth.ScopeApp.String()])
+		assert.Contains(t, roles[auth.ScopeCMS.String()], auth.PermissionRolesRead.String())
+		assert.Contains(t, roles[auth.ScopeCMS.String()], auth.PermissionRolesAssign.String())
+	})
+}
+
+func TestGetRolesMissingScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/roles", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingScopes)
+	})
+}
+
+func TestGetRolesMissingPermission(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		// revoke the permission from the cms role
+		_, err = models.Permissions(models.PermissionWhere.Name.EQ(auth.PermissionRolesRead.String())).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/roles", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingPermission)
+	})
+}
+
+func TestGetRolesUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/roles", nil, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminInviteRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/invites", postAdminInviteHandler(s), middleware.RequirePermission(auth.PermissionInvitesWrite))
+}
+
+func postAdminInviteHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostAdminInvitePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// pre-assigning roles would otherwise allow granting any role without being allowed to assign roles
+		if len(body.Scopes) > 0 && !auth.PermissionsFromEchoContext(c).Has(auth.PermissionRolesAssign) {
+			log.Debug().Strs("scopes", body.Scopes).Msg("User is not allowed to assign roles, rejecting invite with scopes")
+			return middleware.ErrForbiddenMissingPermission
+		}
+
+		validUntil := time.Time(body.ValidUntil)
+		result, err := s.Auth.CreateInvite(ctx, dto.CreateInviteRequest{
+			Email:      dto.NewUsername(body.Email.String()),
+			Scopes:     body.Scopes,
+			ValidUntil: null.NewTime(validUntil, !validUntil.IsZero()),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to create invite")
+			return err
+		}
+
+		inviteLink, err := url.InviteDeeplinkURL(s.Config, result.Token)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate invite link")
+			return err
+		}
+
+		if err := s.Mailer.SendInvite(ctx, result.Invite.Email, dto.InviteNotificationPayload{
+			Link:       inviteLink.String(),
+			ValidUntil: result.Invite.ValidUntil,
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to send invite email")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, result.Invite.ToTypes())
+	}
+}
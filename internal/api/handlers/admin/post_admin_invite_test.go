@@ -0,0 +1,215 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminInviteSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"email":  " Invited@Example.com ",
+			"scopes": []string{auth.ScopeApp.String(), auth.ScopeCMS.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.Invite
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, "invited@example.com", response.Email.String())
+		assert.Equal(t, []string{auth.ScopeApp.String(), auth.ScopeCMS.String()}, response.Scopes)
+		assert.Equal(t, fix.User1.ID, response.CreatedByID.String())
+		assert.True(t, now.Add(s.Config.Auth.InviteValidity).Equal(time.Time(*response.ValidUntil)))
+
+		invite, err := models.FindInvite(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+
+		expectedLink, err := url.InviteDeeplinkURL(s.Config, invite.Token)
+		require.NoError(t, err)
+
+		mail := test.GetLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, "invited@example.com", mail.To[0])
+		assert.Contains(t, string(mail.HTML), expectedLink.String())
+
+		event, err := models.AuditEvents(models.AuditEventWhere.EventType.EQ(audit.EventTypeInviteCreated.String())).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(fix.User1.ID), event.ActorID)
+
+		// inviting the same address again replaces the pending invite
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{"email": "invited@example.com"}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var replacedResponse types.Invite
+		test.ParseResponseAndValidate(t, res, &replacedResponse)
+		assert.Equal(t, s.Config.Auth.DefaultUserScopes, replacedResponse.Scopes)
+
+		exists, err := models.InviteExists(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/invites", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var listResponse types.GetAdminInvitesResponse
+		test.ParseResponseAndValidate(t, res, &listResponse)
+
+		require.Len(t, listResponse.Data, 1)
+		assert.Equal(t, *replacedResponse.ID, *listResponse.Data[0].ID)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/admin/invites/"+replacedResponse.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/admin/invites/"+replacedResponse.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundInviteNotFound)
+	})
+}
+
+func TestPostAdminInviteExpiredNotListed(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"email":      "invited@example.com",
+			"validUntil": now.Add(time.Hour).Format(time.RFC3339),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		test.SetMockClock(t, s, now.Add(2*time.Hour))
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/invites", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var listResponse types.GetAdminInvitesResponse
+		test.ParseResponseAndValidate(t, res, &listResponse)
+		assert.Empty(t, listResponse.Data)
+	})
+}
+
+func TestPostAdminInviteBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{
+			"email":  "invited@example.com",
+			"scopes": []string{"does-not-exist"},
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrBadRequestRoleNotFound)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{
+			"email":      "invited@example.com",
+			"validUntil": now.Add(-time.Minute).Format(time.RFC3339),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrBadRequestInvalidInviteExpiry)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{
+			"email": fix.User2.Username.String,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictUserAlreadyExists)
+
+		count, err := models.Invites().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestPostAdminInviteMissingPermission(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		// revoke the permission from the cms role
+		_, err = models.Permissions(models.PermissionWhere.Name.EQ(auth.PermissionInvitesWrite.String())).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{"email": "invited@example.com"}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingPermission)
+
+		// listing requires the separate read permission
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/invites", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostAdminInviteScopesRequireRolesAssign(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		// revoke the permission from the cms role
+		_, err = models.Permissions(models.PermissionWhere.Name.EQ(auth.PermissionRolesAssign.String())).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{
+			"email":  "invited@example.com",
+			"scopes": []string{auth.ScopeCMS.String()},
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingPermission)
+
+		count, err := models.Invites().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+
+		// invites with the default scopes do not assign roles
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/invites", test.GenericPayload{"email": "invited@example.com"}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.Invite
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, s.Config.Auth.DefaultUserScopes, response.Scopes)
+	})
+}
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminPushBroadcastRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/push/broadcasts", postAdminPushBroadcastHandler(s), middleware.RequirePermission(auth.PermissionBroadcastsWrite))
+}
+
+func postAdminPushBroadcastHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		var body types.PostAdminPushBroadcastPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		scheduledAt := time.Time(body.ScheduledAt)
+		broadcast, err := s.Push.CreateBroadcast(ctx, dto.CreatePushBroadcastRequest{
+			Title:    swag.StringValue(body.Title),
+			Body:     swag.StringValue(body.Body),
+			DeepLink: db.NullStringIfEmpty(body.DeepLink),
+			ImageURL: db.NullStringIfEmpty(body.ImageURL.String()),
+			Data:     body.Data,
+			Category: notification.Category(swag.StringValue(body.Category)),
+			Segment: dto.PushSegment{
+				Topic:  db.NullStringIfEmpty(body.Topic),
+				Scope:  db.NullStringIfEmpty(body.Scope),
+				Locale: db.NullStringIfEmpty(body.Locale),
+			},
+			ScheduledAt: null.NewTime(scheduledAt, !scheduledAt.IsZero()),
+			CreatedByID: null.StringFrom(user.ID),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to create push broadcast")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, broadcast.ToTypes())
+	}
+}
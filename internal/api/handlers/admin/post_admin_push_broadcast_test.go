@@ -0,0 +1,164 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminPushBroadcastSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"title":       "Hello",
+			"body":        "World",
+			"deepLink":    "gostarter://news/42",
+			"data":        map[string]string{"newsId": "42"},
+			"topic":       "news",
+			"locale":      "de",
+			"scheduledAt": now.Add(time.Hour).Format(time.RFC3339),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/broadcasts", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PushBroadcast
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, "Hello", swag.StringValue(response.Title))
+		assert.Equal(t, "gostarter://news/42", response.DeepLink)
+		assert.Equal(t, map[string]string{"newsId": "42"}, response.Data)
+		assert.Equal(t, "news", response.Topic)
+		assert.Equal(t, "de", response.Locale)
+		assert.Empty(t, response.Scope)
+		assert.Equal(t, models.PushBroadcastStatusScheduled, swag.StringValue(response.Status))
+		assert.True(t, now.Add(time.Hour).Equal(time.Time(*response.ScheduledAt)))
+		assert.Equal(t, fix.User1.ID, response.CreatedByID.String())
+
+		// broadcasts are only sent once due
+		processed, err := s.Push.ProcessBroadcasts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+
+		// broadcasts without schedule are sent right away
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/push/broadcasts", test.GenericPayload{
+			"title": "Now",
+			"body":  "Everyone",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var immediateResponse types.PushBroadcast
+		test.ParseResponseAndValidate(t, res, &immediateResponse)
+		assert.True(t, now.Equal(time.Time(*immediateResponse.ScheduledAt)))
+
+		processed, err = s.Push.ProcessBroadcasts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/push/broadcasts", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var listResponse types.GetAdminPushBroadcastsResponse
+		test.ParseResponseAndValidate(t, res, &listResponse)
+
+		require.Len(t, listResponse.Data, 2)
+		assert.Equal(t, *response.ID, *listResponse.Data[0].ID)
+		assert.Equal(t, *immediateResponse.ID, *listResponse.Data[1].ID)
+		assert.Equal(t, models.PushBroadcastStatusSent, swag.StringValue(listResponse.Data[1].Status))
+		assert.True(t, now.Equal(time.Time(listResponse.Data[1].SentAt)))
+		// user1 has a valid FCM token, the APN token is not sent to as only the FCM mock provider is registered
+		assert.Equal(t, int64(1), swag.Int64Value(listResponse.Data[1].SentCount))
+
+		// sent broadcasts can no longer be cancelled
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/admin/push/broadcasts/"+immediateResponse.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundPushBroadcastNotFound)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/admin/push/broadcasts/"+response.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		exists, err := models.PushBroadcastExists(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPostAdminPushBroadcastBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/broadcasts", test.GenericPayload{
+			"title":       "Hello",
+			"body":        "World",
+			"scheduledAt": now.Add(-time.Minute).Format(time.RFC3339),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrBadRequestInvalidPushBroadcastSchedule)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/push/broadcasts", test.GenericPayload{
+			"title": "Hello",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/push/broadcasts", test.GenericPayload{
+			"title":  "Hello",
+			"body":   "World",
+			"locale": "not a locale",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		count, err := models.PushBroadcasts().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestPostAdminPushBroadcastMissingPermission(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		// revoke the permission from the cms role
+		_, err = models.Permissions(models.PermissionWhere.Name.EQ(auth.PermissionBroadcastsWrite.String())).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/broadcasts", test.GenericPayload{"title": "Hello", "body": "World"}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingPermission)
+
+		// listing requires the separate read permission
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/push/broadcasts", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminUserActivateRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/activate", postAdminUserActivateHandler(s), middleware.RequirePermission(auth.PermissionUsersWrite))
+}
+
+func postAdminUserActivateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewPostAdminUserActivateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := s.Auth.SetUserActive(ctx, dto.SetUserActiveRequest{
+			UserID:   params.ID.String(),
+			IsActive: true,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to activate user")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, user.ToTypes())
+	}
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminUserAPIKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/api-keys", postAdminUserAPIKeyHandler(s), middleware.RequirePermission(auth.PermissionUsersWrite))
+}
+
+func postAdminUserAPIKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewPostAdminUserAPIKeyRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostAPIKeyPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		validUntil := time.Time(body.ValidUntil)
+
+		result, err := s.Auth.CreateAPIKey(ctx, dto.CreateAPIKeyRequest{
+			UserID:     params.ID.String(),
+			Name:       *body.Name,
+			Scopes:     body.Scopes,
+			ValidUntil: null.NewTime(validUntil, !validUntil.IsZero()),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to create API key for user")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, result.ToTypes())
+	}
+}
@@ -0,0 +1,110 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminUserAPIKeySuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"name":   "Integration",
+			"scopes": []string{auth.ScopeApp.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User2.ID+"/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		apiKey, err := models.FindAPIKey(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, fix.User2.ID, apiKey.UserID)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fix.User2.ID+"/api-keys", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var listResponse types.GetAPIKeysResponse
+		test.ParseResponseAndValidate(t, res, &listResponse)
+
+		require.Len(t, listResponse.Data, 1)
+		assert.Equal(t, *response.ID, *listResponse.Data[0].ID)
+
+		// keys of other users are not found
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/admin/users/"+fix.User1.ID+"/api-keys/"+response.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundAPIKeyNotFound)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/admin/users/"+fix.User2.ID+"/api-keys/"+response.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		exists, err := models.APIKeyExists(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPostAdminUserAPIKeyCMSAccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"name":   "CMS integration",
+			"scopes": []string{auth.ScopeCMS.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User1.ID+"/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fix.User2.ID, nil, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostAdminUserAPIKeyNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"name":   "Integration",
+			"scopes": []string{auth.ScopeApp.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/3b0b9a2e-5a3c-4f7e-8d1b-6c2e9f4a7d10/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundUserNotFound)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users/3b0b9a2e-5a3c-4f7e-8d1b-6c2e9f4a7d10/api-keys", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundUserNotFound)
+	})
+}
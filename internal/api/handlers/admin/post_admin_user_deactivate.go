@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminUserDeactivateRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/deactivate", postAdminUserDeactivateHandler(s), middleware.RequirePermission(auth.PermissionUsersWrite))
+}
+
+func postAdminUserDeactivateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewPostAdminUserDeactivateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := s.Auth.SetUserActive(ctx, dto.SetUserActiveRequest{
+			UserID:   params.ID.String(),
+			IsActive: false,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to deactivate user")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, user.ToTypes())
+	}
+}
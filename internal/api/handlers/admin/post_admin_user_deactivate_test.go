@@ -0,0 +1,81 @@
+package admin_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminUserDeactivateSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User2.ID+"/deactivate", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.False(t, *response.IsActive)
+
+		err = fix.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fix.User2.IsActive)
+
+		err = fix.User2AccessToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		err = fix.User2RefreshToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		payload := test.GenericPayload{
+			"username": fix.User2.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenUserDeactivated)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User2.ID+"/activate", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.True(t, *response.IsActive)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostAdminUserDeactivateNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		for _, action := range []string{"activate", "deactivate"} {
+			t.Run(action, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/3b0b9a2e-5a3c-4f7e-8d1b-6c2e9f4a7d10/"+action, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, httperrors.ErrNotFoundUserNotFound)
+			})
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminUserImpersonateRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/impersonate", postAdminUserImpersonateHandler(s), middleware.RequirePermission(auth.PermissionUsersImpersonate))
+}
+
+func postAdminUserImpersonateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewPostAdminUserImpersonateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.ImpersonateUser(ctx, params.ID.String())
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to impersonate user")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
@@ -0,0 +1,202 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// impersonate impersonates the user as fix.User1, who is granted the cms scope, returning the impersonation token.
+// fix.User1 logs in first, so the access token used matches the configured access token format.
+func impersonate(t *testing.T, s *api.Server, userID string) string {
+	t.Helper()
+
+	fix := fixtures.Fixtures()
+
+	fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+	_, err := fix.User1.Update(t.Context(), s.DB, boil.Whitelist(models.UserColumns.Scopes))
+	require.NoError(t, err)
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+		"username": fix.User1.Username,
+		"password": fixtures.PlainTestUserPassword,
+	}, nil)
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var login types.PostLoginResponse
+	test.ParseResponseAndValidate(t, res, &login)
+
+	res = test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+userID+"/impersonate", nil, test.HeadersWithAuth(t, *login.AccessToken))
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostAdminUserImpersonateResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	assert.Equal(t, int64(s.Config.Auth.ImpersonationTokenValidity.Seconds()), *response.ExpiresIn)
+	assert.Equal(t, auth.TokenTypeBearer, *response.TokenType)
+
+	return *response.AccessToken
+}
+
+func TestPostAdminUserImpersonateSuccess(t *testing.T) {
+	for name, cfg := range map[string]config.Server{
+		"Opaque": config.DefaultServiceConfigFromEnv(),
+		"JWT":    test.DefaultServiceConfigWithJWTAccessTokens(test.JWTTestKeyIDEd25519),
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+				ctx := t.Context()
+				fix := fixtures.Fixtures()
+
+				token := impersonate(t, s, fix.User2.ID)
+
+				res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, token))
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+				var userInfo types.GetUserInfoResponse
+				test.ParseResponseAndValidate(t, res, &userInfo)
+				assert.Equal(t, fix.User2.ID, *userInfo.Sub)
+
+				accessToken, err := models.AccessTokens(
+					models.AccessTokenWhere.ImpersonatorID.EQ(null.StringFrom(fix.User1.ID)),
+				).One(ctx, s.DB)
+				require.NoError(t, err)
+				assert.Equal(t, fix.User2.ID, accessToken.UserID)
+				assert.False(t, accessToken.SessionID.Valid)
+
+				event, err := models.AuditEvents(
+					models.AuditEventWhere.EventType.EQ(audit.EventTypeUserImpersonated.String()),
+				).One(ctx, s.DB)
+				require.NoError(t, err)
+				assert.Equal(t, null.StringFrom(fix.User1.ID), event.ActorID)
+				assert.Equal(t, null.StringFrom(fix.User2.ID), event.SubjectID)
+
+				// sensitive routes are not available to impersonators
+				res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-password", test.GenericPayload{
+					"currentPassword": fixtures.PlainTestUserPassword,
+					"newPassword":     "correct horse battery staple",
+				}, test.HeadersWithAuth(t, token))
+				test.RequireHTTPError(t, res, httperrors.ErrForbiddenImpersonation)
+
+				res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", test.GenericPayload{
+					"currentPassword": fixtures.PlainTestUserPassword,
+				}, test.HeadersWithAuth(t, token))
+				test.RequireHTTPError(t, res, httperrors.ErrForbiddenImpersonation)
+
+				res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+					"currentPassword": fixtures.PlainTestUserPassword,
+					"newEmail":        "impersonated@example.com",
+				}, test.HeadersWithAuth(t, token))
+				test.RequireHTTPError(t, res, httperrors.ErrForbiddenImpersonation)
+
+				exists, err := models.UserExists(ctx, s.DB, fix.User2.ID)
+				require.NoError(t, err)
+				assert.True(t, exists)
+
+				// logging out the impersonator revokes the impersonation token
+				err = s.Auth.ForceLogout(ctx, fix.User1.ID)
+				require.NoError(t, err)
+
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, token))
+				require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+			})
+		})
+	}
+}
+
+func TestPostAdminUserImpersonateExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		token := impersonate(t, s, fix.User2.ID)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.ImpersonationTokenValidity+1))
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, token))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostAdminUserImpersonateNotAllowed(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		fix.User2.Scopes = []string{auth.ScopeApp.String(), auth.ScopeCMS.String()}
+		_, err = fix.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		tests := []struct {
+			name   string
+			userID string
+			err    *httperrors.HTTPError
+		}{
+			{
+				name:   "Self",
+				userID: fix.User1.ID,
+				err:    httperrors.ErrForbiddenNotImpersonatable,
+			},
+			{
+				name:   "CMSUser",
+				userID: fix.User2.ID,
+				err:    httperrors.ErrForbiddenNotImpersonatable,
+			},
+			{
+				name:   "Deactivated",
+				userID: fix.UserDeactivated.ID,
+				err:    httperrors.ErrForbiddenUserDeactivated,
+			},
+			{
+				name:   "NotFound",
+				userID: "dd1dcc8b-5c31-4b66-8d4c-5d9d1f8f5e6f",
+				err:    httperrors.ErrNotFoundUserNotFound,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+tt.userID+"/impersonate", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, tt.err)
+			})
+		}
+
+		count, err := models.AccessTokens(models.AccessTokenWhere.ImpersonatorID.IsNotNull()).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
+
+func TestPostAdminUserImpersonateMissingPermission(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		_, err = models.Permissions(models.PermissionWhere.Name.EQ(auth.PermissionUsersImpersonate.String())).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User2.ID+"/impersonate", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingPermission)
+	})
+}
@@ -0,0 +1,48 @@
+package admin_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminUserLogoutSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User2.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User1.ID+"/logout", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		err = fix.User1RefreshToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		pushTokens, err := fix.User1.PushTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Zero(t, pushTokens)
+
+		// the user stays active and can log in again
+		err = fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fix.User1.IsActive)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminUserPasswordResetRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/password-reset", postAdminUserPasswordResetHandler(s), middleware.RequirePermission(auth.PermissionUsersWrite))
+}
+
+func postAdminUserPasswordResetHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewPostAdminUserPasswordResetRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.ForcePasswordReset(ctx, params.ID.String())
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to force password reset of user")
+			return err
+		}
+
+		resetLink, err := url.PasswordResetDeeplinkURL(s.Config, result.ResetToken.String)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate password reset link")
+			return err
+		}
+
+		if err := s.Mailer.SendPasswordReset(ctx, result.Username, resetLink.String()); err != nil {
+			log.Debug().Err(err).Msg("Failed to send password reset email")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,100 @@
+package admin_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminUserPasswordResetSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User2.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User1.ID+"/password-reset", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fix.User1.PasswordResetRequired)
+
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		passwordResetToken, err := fix.User1.PasswordResetTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+
+		mail := test.GetLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, fix.User1.Username.String, mail.To[0])
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/set-new-password?token=%s", passwordResetToken.Token))
+
+		loginPayload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", loginPayload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasswordResetRequired)
+
+		newPassword := "correct horse battery staple"
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password/complete", test.GenericPayload{
+			"token":    passwordResetToken.Token,
+			"password": newPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		err = fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fix.User1.PasswordResetRequired)
+
+		loginPayload["password"] = newPassword
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", loginPayload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostAdminUserPasswordResetNotLocalUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User2.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		fix.User1.Password = null.String{}
+		_, err = fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Password))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fix.User1.ID+"/password-reset", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenNotLocalUser)
+
+		err = fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fix.User1.PasswordResetRequired)
+
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PutUserRolesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.PUT("/users/:id/roles", putUserRolesHandler(s), middleware.RequirePermission(auth.PermissionRolesAssign))
+}
+
+func putUserRolesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := admin.NewPutUserRolesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutUserRolesPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.UpdateUserRoles(ctx, dto.UpdateUserRolesRequest{
+			UserID: params.ID.String(),
+			Roles:  body.Roles,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to update user roles")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
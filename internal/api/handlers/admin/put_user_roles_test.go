@@ -0,0 +1,127 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutUserRolesSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"roles": []string{auth.ScopeApp.String(), auth.ScopeCMS.String()},
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/admin/users/"+fix.User2.ID+"/roles", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.UserRolesResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, []string{auth.ScopeApp.String(), auth.ScopeCMS.String()}, response.Roles)
+		assert.Equal(t, []string{auth.PermissionRolesAssign.String(), auth.PermissionRolesRead.String()}, response.Permissions)
+
+		err = fix.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, []string{auth.ScopeApp.String(), auth.ScopeCMS.String()}, []string(fix.User2.Scopes))
+
+		// role changes apply to existing opaque access tokens immediately
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/roles", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPutUserRolesRemoveAll(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"roles": []string{},
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/admin/users/"+fix.User2.ID+"/roles", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.UserRolesResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Empty(t, response.Roles)
+		assert.Empty(t, response.Permissions)
+
+		err = fix.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Empty(t, fix.User2.Scopes)
+	})
+}
+
+func TestPutUserRolesErrors(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		tests := []struct {
+			name        string
+			userID      string
+			roles       []string
+			expectedErr *httperrors.HTTPError
+		}{
+			{name: "UnknownRole", userID: fix.User2.ID, roles: []string{auth.ScopeApp.String(), "superuser"}, expectedErr: httperrors.ErrBadRequestRoleNotFound},
+			{name: "UnknownUser", userID: "3b0b9a2e-5a3c-4f7e-8d1b-6c2e9f4a7d10", roles: []string{auth.ScopeApp.String()}, expectedErr: httperrors.ErrNotFoundUserNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				payload := test.GenericPayload{
+					"roles": tt.roles,
+				}
+
+				res := test.PerformRequest(t, s, "PUT", "/api/v1/admin/users/"+tt.userID+"/roles", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, tt.expectedErr)
+			})
+		}
+
+		err = fix.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, []string{auth.ScopeApp.String()}, []string(fix.User2.Scopes))
+	})
+}
+
+func TestPutUserRolesMissingScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"roles": []string{auth.ScopeCMS.String()},
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/admin/users/"+fix.User1.ID+"/roles", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrForbiddenMissingScopes)
+	})
+}
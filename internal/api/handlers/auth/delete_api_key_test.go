@@ -0,0 +1,54 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteAPIKeySuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"name":   "Integration",
+			"scopes": []string{auth.ScopeApp.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// API keys of other users cannot be deleted
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/api-keys/"+response.ID.String(), nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundAPIKeyNotFound)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/api-keys/"+response.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		exists, err := models.APIKeyExists(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": models.ProviderTypeFCM,
+		}, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/api-keys/"+response.ID.String(), nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundAPIKeyNotFound)
+	})
+}
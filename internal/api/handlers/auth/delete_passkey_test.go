@@ -0,0 +1,51 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletePasskey(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/passkeys", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetPasskeysResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, "Test device", response.Data[0].Name)
+
+		passkeyID := response.Data[0].ID.String()
+
+		// passkeys of other users cannot be deleted
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/passkeys/"+passkeyID, nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundPasskeyNotFound)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/passkeys/"+passkeyID, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		// deleted passkeys can no longer be used to log in
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/passkeys", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Empty(t, response.Data)
+	})
+}
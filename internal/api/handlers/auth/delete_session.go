@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	authTypes "allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteSessionRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/sessions/:id", deleteSessionHandler(s))
+}
+
+func deleteSessionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		params := authTypes.NewDeleteSessionRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if err := s.Auth.RevokeSession(ctx, dto.RevokeSessionRequest{
+			User:      *user,
+			SessionID: params.ID.String(),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to revoke session")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
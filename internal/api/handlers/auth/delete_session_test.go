@@ -0,0 +1,92 @@
+package auth_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSessionSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var loginResponse types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &loginResponse)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/"+fix.User1RefreshToken1.SessionID, nil, test.HeadersWithAuth(t, *loginResponse.AccessToken))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err := fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		err = fix.User1RefreshToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		err = fix.User1PushToken.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		// push tokens without session are kept
+		err = fix.User1PushTokenAPN.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, test.HeadersWithAuth(t, *loginResponse.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetSessionsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Data, 1)
+		assert.True(t, *response.Data[0].Current)
+	})
+}
+
+func TestDeleteSessionNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		tests := []struct {
+			name      string
+			sessionID string
+		}{
+			{name: "Unknown", sessionID: "0d1c3b0e-7f3a-4c1e-9b5d-2a8e6f4c1d37"},
+			{name: "OtherUser", sessionID: fix.User2RefreshToken1.SessionID},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/"+tt.sessionID, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, httperrors.ErrNotFoundSessionNotFound)
+			})
+		}
+
+		err := fix.User2RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+	})
+}
+
+func TestDeleteSessionBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/not-a-uuid", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
@@ -4,16 +4,18 @@ import (
 	"net/http"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
 	"allaboutapps.dev/aw/go-starter/internal/data/dto"
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
 )
 
 func DeleteUserAccountRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Auth.DELETE("/account", deleteUserAccountHandler(s))
+	return s.Router.APIV1Auth.DELETE("/account", deleteUserAccountHandler(s), middleware.DenyImpersonation())
 }
 
 func deleteUserAccountHandler(s *api.Server) echo.HandlerFunc {
@@ -27,7 +29,7 @@ func deleteUserAccountHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
-		err := s.Auth.DeleteUserAccount(ctx, dto.DeleteUserAccountRequest{
+		result, err := s.Auth.DeleteUserAccount(ctx, dto.DeleteUserAccountRequest{
 			User:            *user,
 			CurrentPassword: swag.StringValue(body.CurrentPassword),
 		})
@@ -36,6 +38,22 @@ func deleteUserAccountHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
+		if result.Deletion != nil && result.Deletion.Email.Valid {
+			cancelLink, err := url.AccountDeletionCancelDeeplinkURL(s.Config, result.Deletion.CancelToken)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to generate account deletion cancel link")
+				return err
+			}
+
+			if err := s.Mailer.SendAccountDeletionScheduled(ctx, result.Deletion.Email.String, dto.AccountDeletionNotificationPayload{
+				Link:        cancelLink.String(),
+				ScheduledAt: result.Deletion.ScheduledAt,
+			}); err != nil {
+				// the deletion has already been scheduled, the user is reminded again before the purge
+				log.Warn().Err(err).Msg("Failed to send account deletion scheduled email")
+			}
+		}
+
 		return c.NoContent(http.StatusNoContent)
 	}
 }
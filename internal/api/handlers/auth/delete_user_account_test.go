@@ -3,7 +3,10 @@ package auth_test
 import (
 	"context"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
@@ -13,6 +16,7 @@ import (
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,13 +55,17 @@ func assertUserAndRelatedData(ctx context.Context, t *testing.T, s *api.Server,
 }
 
 func TestDeleteUserAccount(t *testing.T) {
-	test.WithTestServer(t, func(s *api.Server) {
+	cfg := dataExportConfig(t)
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
 		ctx := t.Context()
 		fix := fixtures.Fixtures()
 
 		// expect the user to have a app user profile and different kinds of tokens (access, refresh, push, password reset)
 		assertUserAndRelatedData(ctx, t, s, fix.User1.ID, true)
 
+		requestDataExport(t, s, fix.User1, fix.User1AccessToken1.Token)
+
 		payload := test.GenericPayload{
 			"currentPassword": fixtures.PlainTestUserPassword,
 		}
@@ -67,6 +75,51 @@ func TestDeleteUserAccount(t *testing.T) {
 
 		// expect the user and all related data to be deleted
 		assertUserAndRelatedData(ctx, t, s, fix.User1.ID, false)
+
+		archives, err := os.ReadDir(filepath.Join(s.Config.Paths.MntBaseDirAbs, "exports"))
+		require.NoError(t, err)
+		assert.Empty(t, archives)
+	})
+}
+
+func TestDeleteUserAccountScheduled(t *testing.T) {
+	test.WithTestServerConfigurable(t, accountDeletionConfig(t), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		// expect the user to be deactivated and scheduled for deletion after the grace period
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, user.IsActive)
+		require.True(t, user.DeletionScheduledAt.Valid)
+		assert.WithinDuration(t, s.Clock.Now().Add(s.Config.Auth.AccountDeletionGracePeriod), user.DeletionScheduledAt.Time, time.Second)
+		require.True(t, user.DeletionCancelToken.Valid)
+		assert.False(t, user.DeletionReminderSentAt.Valid)
+
+		// expect all tokens to be revoked
+		accessTokenExists, err := models.AccessTokens(models.AccessTokenWhere.UserID.EQ(fix.User1.ID)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, accessTokenExists)
+
+		refreshTokenExists, err := models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(fix.User1.ID)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, refreshTokenExists)
+
+		mail := test.GetLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, fix.User1.Username.String, mail.To[0])
+		assert.Equal(t, "Your account is scheduled for deletion", mail.Subject)
+		assert.Contains(t, string(mail.HTML), user.DeletionCancelToken.String)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
 	})
 }
 
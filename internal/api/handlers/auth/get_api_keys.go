@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAPIKeysRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/api-keys", getAPIKeysHandler(s))
+}
+
+func getAPIKeysHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		apiKeys, err := s.Auth.GetAPIKeys(ctx, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get API keys")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, apiKeys.ToTypes())
+	}
+}
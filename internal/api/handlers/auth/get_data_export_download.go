@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+const mimeTypeZIP = "application/zip"
+
+func GetDataExportDownloadRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/account/export/download", getDataExportDownloadHandler(s))
+}
+
+func getDataExportDownloadHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := auth.NewGetDataExportDownloadRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		file, err := s.Export.GetExport(ctx, params.Token.String())
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get data export")
+			return err
+		}
+
+		return util.StreamFile(c, http.StatusOK, mimeTypeZIP, file.FileName, file.Reader)
+	}
+}
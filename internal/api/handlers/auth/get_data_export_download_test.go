@@ -0,0 +1,52 @@
+package auth_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDataExportDownloadExpired(t *testing.T) {
+	cfg := dataExportConfig(t)
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := requestDataExport(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(cfg.Auth.DataExportValidity+time.Second))
+
+		res := test.PerformRequest(t, s, "GET", fmt.Sprintf("/api/v1/auth/account/export/download?token=%s", token), nil, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+
+		// expired exports are removed when requesting a new one
+		newToken := requestDataExport(t, s, fix.User1, fix.User1AccessToken1.Token)
+		assert.NotEqual(t, token, newToken)
+
+		exists, err := models.DataExports(
+			models.DataExportWhere.Token.EQ(token),
+		).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestGetDataExportDownloadNotFound(t *testing.T) {
+	test.WithTestServerConfigurable(t, dataExportConfig(t), func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/account/export/download?token=0f4ee3d5-4ab6-4a61-a3a4-4be1a4e8b4b9", nil, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/account/export/download", nil, nil)
+		require.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetOIDCAuthorizeRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/oidc/:provider/authorize", getOIDCAuthorizeHandler(s))
+}
+
+func getOIDCAuthorizeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := auth.NewGetOIDCAuthorizeRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.InitOIDCLogin(ctx, dto.InitOIDCLoginRequest{
+			Provider: params.Provider,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to init OIDC login")
+			return err
+		}
+
+		return c.Redirect(http.StatusFound, result.AuthorizationURL)
+	}
+}
@@ -0,0 +1,53 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOIDCAuthorize(t *testing.T) {
+	provider := test.NewTestOIDCProvider(t)
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.OIDCProviders = []oauth2.OIDCProviderConfig{provider.Config()}
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/oidc/fake/authorize", nil, nil)
+		require.Equal(t, http.StatusFound, res.Result().StatusCode)
+
+		location, err := url.Parse(res.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, provider.Server.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+
+		query := location.Query()
+		assert.Equal(t, "code", query.Get("response_type"))
+		assert.Equal(t, test.TestOIDCProviderClientID, query.Get("client_id"))
+		assert.Equal(t, test.TestOIDCProviderRedirectURL, query.Get("redirect_uri"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+		state, err := models.FindOidcAuthState(ctx, s.DB, query.Get("state"))
+		require.NoError(t, err)
+		assert.Equal(t, test.TestOIDCProviderName, state.Provider)
+		assert.Equal(t, state.Nonce, query.Get("nonce"))
+		assert.Equal(t, oauth2.GetPKCECodeChallengeS256(state.CodeVerifier), query.Get("code_challenge"))
+		assert.True(t, state.ValidUntil.After(s.Clock.Now()))
+	})
+}
+
+func TestGetOIDCAuthorizeProviderNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/oidc/unknown/authorize", nil, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundOIDCProviderNotFound)
+	})
+}
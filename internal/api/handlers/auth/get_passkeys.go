@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPasskeysRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/passkeys", getPasskeysHandler(s))
+}
+
+func getPasskeysHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		passkeys, err := s.Auth.GetPasskeys(ctx, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get passkeys")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, passkeys.ToTypes())
+	}
+}
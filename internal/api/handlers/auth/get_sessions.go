@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetSessionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/sessions", getSessionsHandler(s))
+}
+
+func getSessionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		sessions, err := s.Auth.GetSessions(ctx, dto.GetSessionsRequest{
+			User:        *user,
+			AccessToken: *auth.AccessTokenFromEchoContext(c),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get sessions")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, sessions.ToTypes())
+	}
+}
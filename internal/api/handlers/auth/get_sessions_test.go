@@ -0,0 +1,91 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSessionsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"username":    fix.User1.Username,
+			"password":    fixtures.PlainTestUserPassword,
+			"device_name": "iPhone 15 Pro",
+		}
+
+		headers := http.Header{}
+		headers.Set("User-Agent", "go-starter/1.0 (iOS 18.0)")
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var loginResponse types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &loginResponse)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, loginResponse.RefreshToken.String())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, test.HeadersWithAuth(t, *loginResponse.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetSessionsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Data, 2)
+
+		// the new session was used most recently
+		assert.Equal(t, refreshToken.SessionID, response.Data[0].ID.String())
+		assert.Equal(t, "iPhone 15 Pro", response.Data[0].DeviceName)
+		assert.Equal(t, "go-starter/1.0 (iOS 18.0)", response.Data[0].UserAgent)
+		assert.NotEmpty(t, response.Data[0].IPAddress)
+		assert.True(t, *response.Data[0].Current)
+
+		assert.Equal(t, fix.User1RefreshToken1.SessionID, response.Data[1].ID.String())
+		assert.Empty(t, response.Data[1].DeviceName)
+		assert.False(t, *response.Data[1].Current)
+	})
+}
+
+func TestGetSessionsKeptOnRefresh(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"refresh_token": fix.User1RefreshToken1.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var refreshResponse types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &refreshResponse)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, test.HeadersWithAuth(t, *refreshResponse.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetSessionsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, fix.User1RefreshToken1.SessionID, response.Data[0].ID.String())
+		assert.True(t, *response.Data[0].Current)
+	})
+}
+
+func TestGetSessionsUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
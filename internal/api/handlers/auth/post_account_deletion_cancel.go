@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAccountDeletionCancelRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/account/deletion/cancel", postAccountDeletionCancelHandler(s))
+}
+
+func postAccountDeletionCancelHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostAccountDeletionCancelPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		if err := s.Auth.CancelAccountDeletion(ctx, body.Token.String()); err != nil {
+			log.Debug().Err(err).Msg("Failed to cancel account deletion")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
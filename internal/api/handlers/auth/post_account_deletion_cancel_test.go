@@ -0,0 +1,120 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountDeletionConfig returns a config which schedules account deletions after a grace period.
+func accountDeletionConfig(t *testing.T) config.Server {
+	t.Helper()
+
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.AccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+	return cfg
+}
+
+// scheduleAccountDeletion deletes the account of User1 using the configured grace period, returning the cancel token.
+func scheduleAccountDeletion(t *testing.T, s *api.Server) string {
+	t.Helper()
+
+	fix := fixtures.Fixtures()
+
+	payload := test.GenericPayload{
+		"currentPassword": fixtures.PlainTestUserPassword,
+	}
+
+	res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+	require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+	user, err := models.FindUser(t.Context(), s.DB, fix.User1.ID)
+	require.NoError(t, err)
+	require.True(t, user.DeletionCancelToken.Valid)
+
+	return user.DeletionCancelToken.String
+}
+
+func TestPostAccountDeletionCancel(t *testing.T) {
+	test.WithTestServerConfigurable(t, accountDeletionConfig(t), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := scheduleAccountDeletion(t, s)
+
+		payload := test.GenericPayload{
+			"token": token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/deletion/cancel", payload, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+		assert.False(t, user.DeletionScheduledAt.Valid)
+		assert.False(t, user.DeletionCancelToken.Valid)
+
+		// the user is able to log in again
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username.String,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// the token can only be used once
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/account/deletion/cancel", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostAccountDeletionCancelExpired(t *testing.T) {
+	test.WithTestServerConfigurable(t, accountDeletionConfig(t), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := scheduleAccountDeletion(t, s)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.AccountDeletionGracePeriod+time.Second))
+
+		payload := test.GenericPayload{
+			"token": token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/deletion/cancel", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, user.IsActive)
+		assert.True(t, user.DeletionScheduledAt.Valid)
+	})
+}
+
+func TestPostAccountDeletionCancelTokenNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"token": "2e9a0d4c-5b6f-4e1a-9c3d-8f7b6a5e4d3c",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/deletion/cancel", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostAccountDeletionCancelBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/deletion/cancel", test.GenericPayload{"token": "invalid"}, nil)
+		require.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
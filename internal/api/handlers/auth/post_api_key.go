@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAPIKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/api-keys", postAPIKeyHandler(s), middleware.DenyImpersonation())
+}
+
+func postAPIKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		var body types.PostAPIKeyPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		validUntil := time.Time(body.ValidUntil)
+
+		result, err := s.Auth.CreateAPIKey(ctx, dto.CreateAPIKeyRequest{
+			UserID:     user.ID,
+			Name:       *body.Name,
+			Scopes:     body.Scopes,
+			ValidUntil: null.NewTime(validUntil, !validUntil.IsZero()),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to create API key")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, result.ToTypes())
+	}
+}
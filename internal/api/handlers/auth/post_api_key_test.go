@@ -0,0 +1,240 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAPIKeySuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"name":   "CI deployment",
+			"scopes": []string{auth.ScopeApp.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, "CI deployment", *response.Name)
+		assert.Equal(t, []string{auth.ScopeApp.String()}, response.Scopes)
+		assert.True(t, time.Time(response.ValidUntil).IsZero())
+		require.Contains(t, *response.Key, *response.Prefix+".")
+
+		apiKey, err := models.FindAPIKey(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.ID, apiKey.UserID)
+		assert.NotContains(t, apiKey.KeyHash, (*response.Key)[len(*response.Prefix)+1:])
+		// the SHA-256 digest of the secret is stored, API keys are not hashed using argon2 like passwords
+		assert.Len(t, apiKey.KeyHash, 64)
+		assert.False(t, apiKey.LastUsedAt.Valid)
+
+		// the key authenticates requests to endpoints accepting API keys
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": models.ProviderTypeFCM,
+		}, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		err = apiKey.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, apiKey.LastUsedAt.Valid)
+
+		// account management requires an access token
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/api-keys", nil, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/api-keys", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var listResponse types.GetAPIKeysResponse
+		test.ParseResponseAndValidate(t, res, &listResponse)
+
+		require.Len(t, listResponse.Data, 1)
+		assert.Equal(t, *response.ID, *listResponse.Data[0].ID)
+		assert.Equal(t, *response.Prefix, *listResponse.Data[0].Prefix)
+	})
+}
+
+func TestPostAPIKeyScopesRestrictedToUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"name":   "Integration",
+			"scopes": []string{auth.ScopeApp.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// removing the scope from the user removes it from the key as well
+		fix.User1.Scopes = []string{auth.ScopeCMS.String()}
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": models.ProviderTypeFCM,
+		}, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// the key was not granted the cms scope of the user
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users", nil, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+	})
+}
+
+func TestPostAPIKeyInvalid(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		tests := []struct {
+			name        string
+			payload     test.GenericPayload
+			expectedErr *httperrors.HTTPError
+		}{
+			{
+				name: "ScopeNotAssigned",
+				payload: test.GenericPayload{
+					"name":   "Integration",
+					"scopes": []string{auth.ScopeCMS.String()},
+				},
+				expectedErr: httperrors.ErrBadRequestInvalidAPIKeyScopes,
+			},
+			{
+				name: "ExpiryInPast",
+				payload: test.GenericPayload{
+					"name":        "Integration",
+					"scopes":      []string{auth.ScopeApp.String()},
+					"valid_until": s.Clock.Now().Add(-time.Minute),
+				},
+				expectedErr: httperrors.ErrBadRequestInvalidAPIKeyExpiry,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", tt.payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, tt.expectedErr)
+			})
+		}
+
+		count, err := fix.User1.APIKeys().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
+
+func TestPostAPIKeyExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"name":        "Integration",
+			"scopes":      []string{auth.ScopeApp.String()},
+			"valid_until": s.Clock.Now().Add(time.Hour),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		apiKey, err := models.FindAPIKey(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+
+		apiKey.ValidUntil.SetValid(s.Clock.Now().Add(-time.Minute))
+		_, err = apiKey.Update(ctx, s.DB, boil.Whitelist(models.APIKeyColumns.ValidUntil))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": models.ProviderTypeFCM,
+		}, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		// a wrong secret for a known prefix is rejected
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": models.ProviderTypeFCM,
+		}, test.HeadersWithAPIKeyAuth(t, *response.Prefix+".invalid"))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostAPIKeyRevokedWithSessions(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"name":   "Integration",
+			"scopes": []string{auth.ScopeApp.String()},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		pushTokenPayload := test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": models.ProviderTypeFCM,
+		}
+
+		// API keys are rejected while a password reset is required
+		fix.User1.PasswordResetRequired = true
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.PasswordResetRequired))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", pushTokenPayload, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasswordResetRequired)
+
+		fix.User1.PasswordResetRequired = false
+		_, err = fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.PasswordResetRequired))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", pushTokenPayload, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// changing the password signs the user out everywhere, including API keys
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-password", test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newPassword":     newPassword,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		exists, err := models.APIKeyExists(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", pushTokenPayload, test.HeadersWithAPIKeyAuth(t, *response.Key))
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
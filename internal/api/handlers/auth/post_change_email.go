@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostChangeEmailRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/change-email", postChangeEmailHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{
+		S:    s,
+		Mode: middleware.AuthModeSecure,
+	}))
+}
+
+func postChangeEmailHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		var body types.PostChangeEmailPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.InitEmailChange(ctx, dto.InitEmailChangeRequest{
+			User:            *user,
+			CurrentPassword: swag.StringValue(body.CurrentPassword),
+			NewEmail:        dto.NewUsername(body.NewEmail.String()),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to initiate email change")
+			return err
+		}
+
+		confirmationLink, err := url.EmailChangeConfirmationDeeplinkURL(s.Config, result.ConfirmationToken)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate email change confirmation link")
+			return err
+		}
+
+		if err := s.Mailer.SendEmailChangeConfirmation(ctx, dto.EmailChangeNotificationPayload{
+			OldEmail: result.OldEmail,
+			NewEmail: result.NewEmail,
+			Link:     confirmationLink.String(),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to send email change confirmation email")
+			return err
+		}
+
+		revertLink, err := url.EmailChangeRevertDeeplinkURL(s.Config, result.RevertToken)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate email change revert link")
+			return err
+		}
+
+		if err := s.Mailer.SendEmailChangeNotification(ctx, dto.EmailChangeNotificationPayload{
+			OldEmail: result.OldEmail,
+			NewEmail: result.NewEmail,
+			Link:     revertLink.String(),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to send email change notification email")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostChangeEmailConfirmRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/change-email/confirm", postChangeEmailConfirmHandler(s))
+}
+
+func postChangeEmailConfirmHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		var body types.PostChangeEmailConfirmPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.ConfirmEmailChange(ctx, dto.ConfirmEmailChangeRequest{
+			User:              *user,
+			ConfirmationToken: body.Token.String(),
+			Session:           sessionInfoFromEchoContext(c, ""),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to confirm email change")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
@@ -0,0 +1,130 @@
+package auth_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostChangeEmailConfirmSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEqual(t, fix.User1AccessToken1.Token, *response.AccessToken)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(newEmail), user.Username)
+
+		err = emailChangeRequest.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, emailChangeRequest.ConfirmedAt.Valid)
+
+		exists, err := models.AuditEvents(
+			models.AuditEventWhere.SubjectID.EQ(null.StringFrom(fix.User1.ID)),
+			models.AuditEventWhere.EventType.EQ(audit.EventTypeEmailChanged.String()),
+		).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		// all other sessions are invalidated
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		err = fix.User1RefreshToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		cnt, err := fix.User1.AccessTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		// the new address is used to login from now on
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": newEmail,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// tokens can only be used once
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, *response.AccessToken))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostChangeEmailConfirmExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		test.SetMockClock(t, s, emailChangeRequest.ValidUntil.Add(1))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.Username, user.Username)
+	})
+}
+
+func TestPostChangeEmailConfirmOtherUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostChangeEmailConfirmAddressTakenInMeantime(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		fix.User2.Username = null.StringFrom(newEmail)
+		_, err := fix.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Username))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictUserAlreadyExists)
+
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+	})
+}
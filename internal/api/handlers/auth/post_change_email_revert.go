@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostChangeEmailRevertRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/change-email/revert", postChangeEmailRevertHandler(s))
+}
+
+func postChangeEmailRevertHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostChangeEmailRevertPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		if err := s.Auth.RevertEmailChange(ctx, dto.RevertEmailChangeRequest{
+			RevertToken: body.Token.String(),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to revert email change")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,139 @@
+package auth_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostChangeEmailRevertConfirmed(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// further changes requested in the meantime are discarded by the revert as well
+		pendingRequest := models.EmailChangeRequest{
+			UserID:           fix.User1.ID,
+			OldEmail:         emailChangeRequest.NewEmail,
+			NewEmail:         "attacker@example.com",
+			ValidUntil:       s.Clock.Now().Add(time.Hour),
+			RevertValidUntil: s.Clock.Now().Add(time.Hour),
+		}
+
+		err := pendingRequest.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", test.GenericPayload{
+			"token": emailChangeRequest.RevertToken,
+		}, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.Username, user.Username)
+		assert.True(t, user.PasswordResetRequired)
+
+		err = pendingRequest.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		// whoever confirmed the change might know the password
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username.String,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasswordResetRequired)
+
+		// the account might have been taken over, all sessions are revoked
+		cnt, err := fix.User1.AccessTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		cnt, err = fix.User1.RefreshTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		err = emailChangeRequest.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		auditEvent, err := models.AuditEvents(
+			models.AuditEventWhere.SubjectID.EQ(null.StringFrom(fix.User1.ID)),
+			models.AuditEventWhere.EventType.EQ(audit.EventTypeEmailChangeReverted.String()),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"confirmed": true}`, string(auditEvent.Metadata))
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", test.GenericPayload{
+			"token": emailChangeRequest.RevertToken,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostChangeEmailRevertPending(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", test.GenericPayload{
+			"token": emailChangeRequest.RevertToken,
+		}, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err := emailChangeRequest.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		// pending changes are discarded without affecting existing sessions
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", test.GenericPayload{
+			"token": emailChangeRequest.ConfirmationToken,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostChangeEmailRevertExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		emailChangeRequest := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		test.SetMockClock(t, s, emailChangeRequest.RevertValidUntil.Add(1))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", test.GenericPayload{
+			"token": emailChangeRequest.RevertToken,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+	})
+}
+
+func TestPostChangeEmailRevertNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", test.GenericPayload{
+			"token": "a1b2c3d4-e5f6-4a5b-8c7d-9e0f1a2b3c4d",
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
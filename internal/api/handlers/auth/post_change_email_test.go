@@ -0,0 +1,179 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const newEmail = "user1-new@example.com"
+
+// setRecentlyAuthenticated marks the user as having authenticated just now, as required by secure routes.
+func setRecentlyAuthenticated(t *testing.T, s *api.Server, user *models.User) {
+	t.Helper()
+
+	user.LastAuthenticatedAt = null.TimeFrom(time.Now())
+	_, err := user.Update(t.Context(), s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+	require.NoError(t, err)
+}
+
+func requestEmailChange(t *testing.T, s *api.Server, user *models.User, accessToken string) *models.EmailChangeRequest {
+	t.Helper()
+
+	setRecentlyAuthenticated(t, s, user)
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+		"currentPassword": fixtures.PlainTestUserPassword,
+		"newEmail":        newEmail,
+	}, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+	emailChangeRequest, err := user.EmailChangeRequests().One(t.Context(), s.DB)
+	require.NoError(t, err)
+
+	return emailChangeRequest
+}
+
+func TestPostChangeEmailSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		setRecentlyAuthenticated(t, s, fix.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newEmail":        "User1-New@Example.com",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		emailChangeRequest, err := fix.User1.EmailChangeRequests().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.Username.String, emailChangeRequest.OldEmail)
+		assert.Equal(t, newEmail, emailChangeRequest.NewEmail)
+		assert.False(t, emailChangeRequest.ConfirmedAt.Valid)
+
+		sentMails := test.GetSentMails(t, s.Mailer)
+		require.Len(t, sentMails, 2)
+
+		assert.Equal(t, []string{newEmail}, sentMails[0].To)
+		assert.Contains(t, string(sentMails[0].HTML), emailChangeRequest.ConfirmationToken)
+		assert.NotContains(t, string(sentMails[0].HTML), emailChangeRequest.RevertToken)
+
+		assert.Equal(t, []string{fix.User1.Username.String}, sentMails[1].To)
+		assert.Contains(t, string(sentMails[1].HTML), emailChangeRequest.RevertToken)
+		assert.NotContains(t, string(sentMails[1].HTML), emailChangeRequest.ConfirmationToken)
+
+		// the username only changes after confirmation
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.Username, user.Username)
+
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+	})
+}
+
+func TestPostChangeEmailReplacesPendingRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		first := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+		second := requestEmailChange(t, s, fix.User1, fix.User1AccessToken1.Token)
+		assert.NotEqual(t, first.ConfirmationToken, second.ConfirmationToken)
+
+		cnt, err := fix.User1.EmailChangeRequests().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+	})
+}
+
+func TestPostChangeEmailRequiresRecentAuthentication(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.LastAuthenticatedAt = null.TimeFrom(time.Now().Add(-s.Config.Auth.LastAuthenticatedAtThreshold - time.Minute))
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newEmail":        newEmail,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, middleware.ErrUnauthorizedLastAuthenticatedAtExceeded)
+
+		cnt, err := models.EmailChangeRequests().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+		assert.Nil(t, test.GetLastSentMail(t, s.Mailer))
+	})
+}
+
+func TestPostChangeEmailInvalidPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		setRecentlyAuthenticated(t, s, fix.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+			"currentPassword": "not my password",
+			"newEmail":        newEmail,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+
+		cnt, err := models.EmailChangeRequests().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+		assert.Nil(t, test.GetLastSentMail(t, s.Mailer))
+	})
+}
+
+func TestPostChangeEmailAlreadyInUse(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		setRecentlyAuthenticated(t, s, fix.User1)
+
+		for _, email := range []string{fix.User2.Username.String, fix.User1.Username.String} {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+				"currentPassword": fixtures.PlainTestUserPassword,
+				"newEmail":        email,
+			}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+			test.RequireHTTPError(t, res, httperrors.ErrConflictUserAlreadyExists)
+		}
+
+		cnt, err := models.EmailChangeRequests().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostChangeEmailBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		setRecentlyAuthenticated(t, s, fix.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newEmail":        "not an email",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
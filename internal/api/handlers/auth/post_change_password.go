@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
 	"allaboutapps.dev/aw/go-starter/internal/data/dto"
 	"allaboutapps.dev/aw/go-starter/internal/types"
@@ -13,7 +14,7 @@ import (
 )
 
 func PostChangePasswordRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Auth.POST("/change-password", postChangePasswordHandler(s))
+	return s.Router.APIV1Auth.POST("/change-password", postChangePasswordHandler(s), middleware.DenyImpersonation())
 }
 
 func postChangePasswordHandler(s *api.Server) echo.HandlerFunc {
@@ -31,6 +32,7 @@ func postChangePasswordHandler(s *api.Server) echo.HandlerFunc {
 			User:            *user,
 			CurrentPassword: swag.StringValue(body.CurrentPassword),
 			NewPassword:     swag.StringValue(body.NewPassword),
+			Session:         sessionInfoFromEchoContext(c, ""),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to update password")
@@ -3,12 +3,14 @@ package auth_test
 import (
 	"database/sql"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
 
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
 	"allaboutapps.dev/aw/go-starter/internal/types"
@@ -185,3 +187,110 @@ func TestPostChangePasswordBadRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestPostChangePasswordJWTAccessToken(t *testing.T) {
+	test.WithTestServerConfigurable(t, test.DefaultServiceConfigWithJWTAccessTokens(test.JWTTestKeyIDRSA), func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		accessToken := loginWithJWTAccessToken(t, s, fix.User1.Username.String)
+
+		payload := test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newPassword":     newPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-password", payload, test.HeadersWithAuth(t, accessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// all previously issued tokens of the user are revoked
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, *response.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostChangePasswordHistory(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.PasswordHistorySize = 3
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		accessToken := fix.User1AccessToken1.Token
+		currentPassword := fixtures.PlainTestUserPassword
+
+		changePassword := func(t *testing.T, password string) *httptest.ResponseRecorder {
+			t.Helper()
+
+			return test.PerformRequest(t, s, "POST", "/api/v1/auth/change-password", test.GenericPayload{
+				"currentPassword": currentPassword,
+				"newPassword":     password,
+			}, test.HeadersWithAuth(t, accessToken))
+		}
+
+		requireChanged := func(t *testing.T, password string) {
+			t.Helper()
+
+			res := changePassword(t, password)
+			require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+			var response types.PostLoginResponse
+			test.ParseResponseAndValidate(t, res, &response)
+
+			accessToken = *response.AccessToken
+			currentPassword = password
+		}
+
+		expectedErr := httperrors.NewHTTPValidationErrorInvalidPassword("newPassword", []string{"must not match any of the last 3 passwords"})
+
+		// the current password may not be kept
+		res := changePassword(t, fixtures.PlainTestUserPassword)
+		test.RequireHTTPValidationError(t, res, expectedErr)
+
+		requireChanged(t, "second password")
+		requireChanged(t, "third password")
+
+		res = changePassword(t, fixtures.PlainTestUserPassword)
+		test.RequireHTTPValidationError(t, res, expectedErr)
+
+		res = changePassword(t, "second password")
+		test.RequireHTTPValidationError(t, res, expectedErr)
+
+		// only the previous passwords still relevant are kept
+		requireChanged(t, "fourth password")
+
+		cnt, err := fix.User1.PasswordHistoryEntries().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), cnt)
+
+		requireChanged(t, fixtures.PlainTestUserPassword)
+	})
+}
+
+func TestPostChangePasswordPolicyViolation(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-password", test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newPassword":     "user1",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPValidationError(t, res, httperrors.NewHTTPValidationErrorInvalidPassword("newPassword", []string{
+			"must be at least 8 characters long",
+			"must not contain the username",
+		}))
+
+		err := fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		err = fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.HashedTestUserPassword, fix.User1.Password.String)
+	})
+}
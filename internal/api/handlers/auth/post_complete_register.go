@@ -29,6 +29,7 @@ func postCompleteRegisterHandler(s *api.Server) echo.HandlerFunc {
 
 		result, err := s.Auth.CompleteRegister(ctx, dto.CompleteRegisterRequest{
 			ConfirmationToken: params.RegistrationToken.String(),
+			Session:           sessionInfoFromEchoContext(c, ""),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to complete registration")
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostDataExportRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/account/export", postDataExportHandler(s))
+}
+
+func postDataExportHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		if err := s.Export.RequestExport(ctx, *user); err != nil {
+			log.Debug().Err(err).Msg("Failed to request data export")
+			return err
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+}
@@ -0,0 +1,215 @@
+package auth_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type notesExporter struct{}
+
+func (notesExporter) Name() string {
+	return "notes"
+}
+
+func (notesExporter) Export(_ context.Context, _ boil.ContextExecutor, userID string) (any, error) {
+	return []string{fmt.Sprintf("note of %s", userID)}, nil
+}
+
+func dataExportConfig(t *testing.T) config.Server {
+	t.Helper()
+
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Paths.MntBaseDirAbs = t.TempDir()
+
+	return cfg
+}
+
+// requestDataExport requests a data export for the user and waits for it to be built, returning its token.
+func requestDataExport(t *testing.T, s *api.Server, user *models.User, accessToken string) string {
+	t.Helper()
+
+	ctx := t.Context()
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/export", nil, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+	err := s.Export.Wait(ctx)
+	require.NoError(t, err)
+
+	export, err := models.DataExports(
+		models.DataExportWhere.UserID.EQ(user.ID),
+	).One(ctx, s.DB)
+	require.NoError(t, err)
+	require.True(t, export.CompletedAt.Valid)
+
+	return export.Token
+}
+
+func readDataExport(t *testing.T, body []byte) map[string][]byte {
+	t.Helper()
+
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(archive.File))
+	for _, file := range archive.File {
+		r, err := file.Open()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(r)
+		require.NoError(t, err)
+		r.Close()
+
+		files[file.Name] = buf.Bytes()
+	}
+
+	return files
+}
+
+func TestPostDataExport(t *testing.T) {
+	test.WithTestServerConfigurable(t, dataExportConfig(t), func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		token := requestDataExport(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		mail := test.GetLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, fix.User1.Username.String, mail.To[0])
+		assert.Equal(t, "Your data export is ready", mail.Subject)
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("/api/v1/auth/account/export/download?token=%s", token))
+
+		// the download link is public, the token being sent to the user's email address
+		res := test.PerformRequest(t, s, "GET", fmt.Sprintf("/api/v1/auth/account/export/download?token=%s", token), nil, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "application/zip", res.Header().Get(echo.HeaderContentType))
+		assert.Contains(t, res.Header().Get(echo.HeaderContentDisposition), "attachment; filename=data-export-")
+
+		files := readDataExport(t, res.Body.Bytes())
+		require.Len(t, files, 6)
+		require.Contains(t, files, "user.json")
+		assert.Contains(t, files, "app_user_profile.json")
+		assert.Contains(t, files, "sessions.json")
+		assert.Contains(t, files, "push_tokens.json")
+		assert.Contains(t, files, "push_topic_subscriptions.json")
+		assert.Contains(t, files, "notification_preferences.json")
+
+		var user map[string]any
+		err := json.Unmarshal(files["user.json"], &user)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.ID, user["id"])
+		assert.Equal(t, fix.User1.Username.String, user["username"])
+		assert.NotContains(t, user, "password")
+
+		var pushTokens []map[string]any
+		err = json.Unmarshal(files["push_tokens.json"], &pushTokens)
+		require.NoError(t, err)
+		assert.NotEmpty(t, pushTokens)
+
+		// time zone and quiet hours are exported with the notification preferences
+		var preferences map[string]any
+		err = json.Unmarshal(files["notification_preferences.json"], &preferences)
+		require.NoError(t, err)
+		assert.Contains(t, preferences, "time_zone")
+		assert.Contains(t, preferences, "quiet_hours_start")
+		assert.Contains(t, preferences, "preferences")
+
+		var profile map[string]any
+		err = json.Unmarshal(files["app_user_profile.json"], &profile)
+		require.NoError(t, err)
+		assert.NotContains(t, profile, "time_zone")
+	})
+}
+
+func TestPostDataExportRegisteredExporter(t *testing.T) {
+	test.WithTestServerConfigurable(t, dataExportConfig(t), func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		s.Export.RegisterExporter(notesExporter{})
+
+		token := requestDataExport(t, s, fix.User2, fix.User2AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "GET", fmt.Sprintf("/api/v1/auth/account/export/download?token=%s", token), nil, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		files := readDataExport(t, res.Body.Bytes())
+		require.Contains(t, files, "notes.json")
+		assert.JSONEq(t, fmt.Sprintf(`["note of %s"]`, fix.User2.ID), string(files["notes.json"]))
+	})
+}
+
+func TestPostDataExportDebounced(t *testing.T) {
+	test.WithTestServerConfigurable(t, dataExportConfig(t), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		requestDataExport(t, s, fix.User1, fix.User1AccessToken1.Token)
+
+		// further requests within the debounce duration are accepted without building another export
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/export", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		err := s.Export.Wait(ctx)
+		require.NoError(t, err)
+
+		cnt, err := models.DataExports(models.DataExportWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		// exports being built block further exports regardless of the debounce duration
+		pending := models.DataExport{
+			UserID:     fix.User2.ID,
+			ValidUntil: s.Clock.Now().Add(s.Config.Auth.DataExportValidity),
+		}
+
+		err = pending.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.DataExportDebounceDuration+time.Second))
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/account/export", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		err = s.Export.Wait(ctx)
+		require.NoError(t, err)
+
+		cnt, err = models.DataExports(models.DataExportWhere.UserID.EQ(fix.User2.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		// once past the debounce duration, completed exports no longer block new ones
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/account/export", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		err = s.Export.Wait(ctx)
+		require.NoError(t, err)
+
+		cnt, err = models.DataExports(models.DataExportWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), cnt)
+	})
+}
+
+func TestPostDataExportUnauthorized(t *testing.T) {
+	test.WithTestServerConfigurable(t, dataExportConfig(t), func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/account/export", nil, nil)
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+	})
+}
@@ -28,7 +28,8 @@ func postForgotPasswordHandler(s *api.Server) echo.HandlerFunc {
 		username := dto.NewUsername(body.Username.String())
 
 		result, err := s.Auth.InitPasswordReset(ctx, dto.InitPasswordResetRequest{
-			Username: username,
+			Username:  username,
+			IPAddress: c.RealIP(),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to initiate password reset")
@@ -28,12 +28,17 @@ func postForgotPasswordCompleteHandler(s *api.Server) echo.HandlerFunc {
 		result, err := s.Auth.ResetPassword(ctx, dto.ResetPasswordRequest{
 			ResetToken:  body.Token.String(),
 			NewPassword: swag.StringValue(body.Password),
+			Session:     sessionInfoFromEchoContext(c, ""),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to reset password")
 			return err
 		}
 
+		if result.RequiresTwoFactor() {
+			return util.ValidateAndReturn(c, http.StatusAccepted, result.TwoFactorChallenge.ToTypes())
+		}
+
 		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
 	}
 }
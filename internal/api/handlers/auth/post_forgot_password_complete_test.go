@@ -3,15 +3,18 @@ package auth_test
 import (
 	"database/sql"
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
 	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/stretchr/testify/assert"
@@ -278,3 +281,115 @@ func TestPostForgotPasswordCompleteBadRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestPostForgotPasswordCompleteTwoFactorChallenge(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+
+		passwordResetToken := models.PasswordResetToken{
+			UserID:     fix.User1.ID,
+			ValidUntil: s.Clock.Now().Add(s.Config.Auth.PasswordResetTokenValidity),
+		}
+
+		err := passwordResetToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token":    passwordResetToken.Token,
+			"password": newPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password/complete", payload, nil)
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var response types.PostLoginTwoFactorChallengeResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.ChallengeToken)
+
+		// existing tokens are invalidated, new ones are only issued after completing the challenge
+		cnt, err := fix.User1.AccessTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		cnt, err = fix.User1.RefreshTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostForgotPasswordCompleteUnlocksLogin(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		lock := models.AuthFailedAttempt{
+			Scope:        models.AuthAttemptScopeLoginUsername,
+			Key:          fix.User1.Username.String,
+			LockoutCount: 1,
+			LastFailedAt: s.Clock.Now(),
+			LockedUntil:  null.TimeFrom(s.Clock.Now().Add(time.Hour)),
+		}
+
+		err := lock.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		passwordResetToken := models.PasswordResetToken{
+			UserID:     fix.User1.ID,
+			ValidUntil: s.Clock.Now().Add(s.Config.Auth.PasswordResetTokenValidity),
+		}
+
+		err = passwordResetToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password/complete", test.GenericPayload{
+			"token":    passwordResetToken.Token,
+			"password": newPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": newPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostForgotPasswordCompleteBreachedPassword(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.PasswordBreachedListDirAbs = filepath.Join(util.GetProjectRootDir(), "test", "testdata", "breached-passwords")
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		passwordResetToken := models.PasswordResetToken{
+			UserID:     fix.User1.ID,
+			ValidUntil: s.Clock.Now().Add(s.Config.Auth.PasswordResetTokenValidity),
+		}
+
+		err := passwordResetToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password/complete", test.GenericPayload{
+			"token":    passwordResetToken.Token,
+			"password": "qwertyuiop",
+		}, nil)
+		test.RequireHTTPValidationError(t, res, httperrors.NewHTTPValidationErrorInvalidPassword("password", []string{
+			"has appeared in a data breach and must not be used",
+		}))
+
+		// the token may still be used with a valid password
+		err = passwordResetToken.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password/complete", test.GenericPayload{
+			"token":    passwordResetToken.Token,
+			"password": newPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"allaboutapps.dev/aw/go-starter/internal/util/db"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -254,3 +255,28 @@ func TestPostForgotPasswordBadRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestPostForgotPasswordLockoutPerIP(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.PasswordResetMaxAttemptsPerIP = 2
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fix.User1.Username,
+		}
+
+		for range cfg.Auth.PasswordResetMaxAttemptsPerIP {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password", payload, nil)
+			require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+			// skip debounce duration
+			test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.PasswordResetTokenDebounceDuration+time.Second))
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsTooManyAttempts)
+		assert.NotEmpty(t, res.Header().Get(echo.HeaderRetryAfter))
+	})
+}
@@ -28,12 +28,17 @@ func postLoginHandler(s *api.Server) echo.HandlerFunc {
 		result, err := s.Auth.Login(ctx, dto.LoginRequest{
 			Username: dto.NewUsername(body.Username.String()),
 			Password: swag.StringValue(body.Password),
+			Session:  sessionInfoFromEchoContext(c, body.DeviceName),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to authenticate user")
 			return err
 		}
 
+		if result.RequiresTwoFactor() {
+			return util.ValidateAndReturn(c, http.StatusAccepted, result.TwoFactorChallenge.ToTypes())
+		}
+
 		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
 	}
 }
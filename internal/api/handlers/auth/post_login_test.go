@@ -5,15 +5,21 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,6 +45,16 @@ func TestPostLoginSuccess(t *testing.T) {
 		assert.NotEqual(t, fix.User1RefreshToken1.Token, response.RefreshToken)
 		assert.Equal(t, int64(s.Config.Auth.AccessTokenValidity.Seconds()), *response.ExpiresIn)
 		assert.Equal(t, auth.TokenTypeBearer, *response.TokenType)
+
+		event, err := models.AuditEvents(
+			models.AuditEventWhere.SubjectID.EQ(null.StringFrom(fix.User1.ID)),
+			models.AuditEventWhere.EventType.EQ(audit.EventTypeLogin.String()),
+		).One(t.Context(), s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(fix.User1.ID), event.ActorID)
+		assert.True(t, event.IPAddress.Valid)
+		assert.True(t, event.RequestID.Valid)
+		assert.JSONEq(t, `{"method": "password"}`, string(event.Metadata))
 	})
 }
 
@@ -75,6 +91,13 @@ func TestPostLoginInvalidCredentials(t *testing.T) {
 
 		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
 		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+
+		event, err := models.AuditEvents(
+			models.AuditEventWhere.SubjectID.EQ(null.StringFrom(fix.User1.ID)),
+			models.AuditEventWhere.EventType.EQ(audit.EventTypeLoginFailed.String()),
+		).One(t.Context(), s.DB)
+		require.NoError(t, err)
+		assert.False(t, event.ActorID.Valid)
 	})
 }
 
@@ -177,3 +200,254 @@ func TestPostLoginBadRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestPostLoginTwoFactorChallenge(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+
+		accessTokensBefore, err := models.AccessTokens(models.AccessTokenWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var response types.PostLoginTwoFactorChallengeResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.ChallengeToken)
+		assert.Equal(t, int64(s.Config.Auth.TwoFactorChallengeTokenValidity.Seconds()), *response.ExpiresIn)
+
+		// no auth tokens are issued until the challenge is completed
+		accessTokensAfter, err := models.AccessTokens(models.AccessTokenWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, accessTokensBefore, accessTokensAfter)
+	})
+}
+
+func TestPostLoginLockout(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.LoginMaxFailedAttemptsPerUsername = 3
+	cfg.Auth.LockoutBaseDuration = time.Minute
+	cfg.Auth.LockoutMaxDuration = 10 * time.Minute
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		invalidPayload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": "not my password",
+		}
+		validPayload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		for range cfg.Auth.LoginMaxFailedAttemptsPerUsername {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", invalidPayload, nil)
+			test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+		}
+
+		// locked, even with the correct password
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", validPayload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsTooManyAttempts)
+		assert.Equal(t, "60", res.Header().Get(echo.HeaderRetryAfter))
+
+		// automatically unlocked after the lockout duration, locking again with doubled duration
+		test.SetMockClock(t, s, s.Clock.Now().Add(cfg.Auth.LockoutBaseDuration+time.Second))
+
+		for range cfg.Auth.LoginMaxFailedAttemptsPerUsername {
+			res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", invalidPayload, nil)
+			test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", validPayload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsTooManyAttempts)
+		assert.Equal(t, "120", res.Header().Get(echo.HeaderRetryAfter))
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(2*cfg.Auth.LockoutBaseDuration+time.Second))
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", validPayload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// successful login resets the failed attempts
+		exists, err := models.AuthFailedAttempts(
+			models.AuthFailedAttemptWhere.Scope.EQ(models.AuthAttemptScopeLoginUsername),
+			models.AuthFailedAttemptWhere.Key.EQ(fix.User1.Username.String),
+		).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPostLoginLockoutCooldown(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.LoginMaxFailedAttemptsPerUsername = 3
+	cfg.Auth.LockoutCooldownDuration = 15 * time.Minute
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		invalidPayload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": "not my password",
+		}
+
+		for range cfg.Auth.LoginMaxFailedAttemptsPerUsername - 1 {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", invalidPayload, nil)
+			test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+		}
+
+		// failed attempts are forgotten after the cooldown
+		test.SetMockClock(t, s, s.Clock.Now().Add(cfg.Auth.LockoutCooldownDuration+time.Second))
+
+		for range cfg.Auth.LoginMaxFailedAttemptsPerUsername - 1 {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", invalidPayload, nil)
+			test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginLockoutPerIP(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.LoginMaxFailedAttemptsPerIP = 2
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		// failed attempts for different (also unknown) usernames are counted per IP
+		for _, username := range []string{"unknown@example.com", fix.User2.Username.String} {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+				"username": username,
+				"password": "not my password",
+			}, nil)
+			test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsTooManyAttempts)
+		assert.NotEmpty(t, res.Header().Get(echo.HeaderRetryAfter))
+	})
+}
+
+func TestPostLoginRehashesPassword(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	params := *cfg.Auth.Argon2Params
+	params.Time = 2
+	cfg.Auth.Argon2Params = &params
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// fixtures are hashed using t=1, hence weaker than the configured params
+		err := fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.NotEqual(t, fixtures.HashedTestUserPassword, fix.User1.Password.String)
+		assert.Contains(t, fix.User1.Password.String, ",t=2,")
+
+		hash := fix.User1.Password.String
+
+		// the rehashed password can still be used, without being rehashed again
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		err = fix.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, hash, fix.User1.Password.String)
+	})
+}
+
+func TestPostLoginJWTAccessToken(t *testing.T) {
+	for _, keyID := range []string{test.JWTTestKeyIDEd25519, test.JWTTestKeyIDRSA} {
+		t.Run(keyID, func(t *testing.T) {
+			test.WithTestServerConfigurable(t, test.DefaultServiceConfigWithJWTAccessTokens(keyID), func(s *api.Server) {
+				ctx := t.Context()
+				fix := fixtures.Fixtures()
+
+				accessToken := loginWithJWTAccessToken(t, s, fix.User1.Username.String)
+
+				token, _, err := jwt.NewParser().ParseUnverified(accessToken, jwt.MapClaims{})
+				require.NoError(t, err)
+				assert.Equal(t, keyID, token.Header["kid"])
+
+				sub, err := token.Claims.GetSubject()
+				require.NoError(t, err)
+				assert.Equal(t, fix.User1.ID, sub)
+
+				// the jti is stored to allow revoking all tokens of a user
+				jti := token.Claims.(jwt.MapClaims)["jti"].(string)
+				exists, err := models.AccessTokenExists(ctx, s.DB, jti)
+				require.NoError(t, err)
+				assert.True(t, exists)
+
+				res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+				var response types.GetUserInfoResponse
+				test.ParseResponseAndValidate(t, res, &response)
+
+				assert.Equal(t, fix.User1.ID, *response.Sub)
+				assert.Equal(t, fix.User1.Username.String, response.Email.String())
+				assert.Equal(t, fix.User1.Scopes, response.Scopes)
+
+				// UUID access tokens are no longer accepted
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, middleware.ErrBadRequestMalformedToken)
+
+				// tampered tokens are rejected
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken[:len(accessToken)-2]+"AA"))
+				test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+
+				test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.AccessTokenValidity+time.Second))
+
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+				test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+			})
+		})
+	}
+}
+
+func loginWithJWTAccessToken(t *testing.T, s *api.Server, username string) string {
+	t.Helper()
+
+	payload := test.GenericPayload{
+		"username": username,
+		"password": fixtures.PlainTestUserPassword,
+	}
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostLoginResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	require.Equal(t, 2, strings.Count(*response.AccessToken, "."))
+
+	return *response.AccessToken
+}
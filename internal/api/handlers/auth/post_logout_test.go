@@ -8,6 +8,7 @@ import (
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
 	"github.com/labstack/echo/v4"
@@ -127,3 +128,22 @@ func TestPostLogoutError(t *testing.T) {
 		}
 	})
 }
+
+func TestPostLogoutJWTAccessToken(t *testing.T) {
+	test.WithTestServerConfigurable(t, test.DefaultServiceConfigWithJWTAccessTokens(test.JWTTestKeyIDEd25519), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		accessToken := loginWithJWTAccessToken(t, s, fix.User1.Username.String)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/logout", nil, test.HeadersWithAuth(t, accessToken))
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		cnt, err := models.AccessTokenDenylists().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+	})
+}
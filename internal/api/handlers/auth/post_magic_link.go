@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/labstack/echo/v4"
+)
+
+func PostMagicLinkRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/magic-link", postMagicLinkHandler(s))
+}
+
+func postMagicLinkHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostMagicLinkPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		username := dto.NewUsername(body.Username.String())
+
+		result, err := s.Auth.InitMagicLink(ctx, dto.InitMagicLinkRequest{
+			Username:  username,
+			IPAddress: c.RealIP(),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to initiate magic link")
+			return err
+		}
+
+		if result.MagicLinkToken.IsZero() {
+			log.Debug().Msg("Failed to initiate magic link, no token returned")
+			// Return success status to prevent user enumeration
+			return c.NoContent(http.StatusNoContent)
+		}
+
+		magicLink, err := url.MagicLinkDeeplinkURL(s.Config, result.MagicLinkToken.String)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate magic link")
+			return err
+		}
+
+		if err := s.Mailer.SendMagicLink(ctx, username.String(), dto.MagicLinkNotificationPayload{
+			MagicLink: magicLink.String(),
+			Validity:  s.Config.Auth.MagicLinkTokenValidity,
+			Language:  s.I18n.ParseAcceptLanguage(c.Request().Header.Get("Accept-Language")),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to send magic link email")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
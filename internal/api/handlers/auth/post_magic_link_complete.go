@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostMagicLinkCompleteRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/magic-link/complete", postMagicLinkCompleteHandler(s))
+}
+
+func postMagicLinkCompleteHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostMagicLinkCompletePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.CompleteMagicLink(ctx, dto.CompleteMagicLinkRequest{
+			MagicLinkToken: body.Token.String(),
+			Session:        sessionInfoFromEchoContext(c, body.DeviceName),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to complete magic link login")
+			return err
+		}
+
+		if result.RequiresTwoFactor() {
+			return util.ValidateAndReturn(c, http.StatusAccepted, result.TwoFactorChallenge.ToTypes())
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
@@ -0,0 +1,185 @@
+package auth_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requestMagicLinkToken(t *testing.T, s *api.Server, user *models.User) string {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", test.GenericPayload{
+		"username": user.Username,
+	}, nil)
+	require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+	magicLinkToken, err := user.MagicLinkTokens().One(t.Context(), s.DB)
+	require.NoError(t, err)
+
+	return magicLinkToken.Token
+}
+
+func TestPostMagicLinkComplete(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := requestMagicLinkToken(t, s, fix.User1)
+
+		now := time.Date(2025, 2, 5, 11, 42, 30, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token":       token,
+			"device_name": "iPhone",
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEmpty(t, response.RefreshToken)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, now, user.LastAuthenticatedAt.Time)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, *response.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// magic links may only be used once
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token": token,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostMagicLinkCompleteTwoFactor(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+		token := requestMagicLinkToken(t, s, fix.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token": token,
+		}, nil)
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var response types.PostLoginTwoFactorChallengeResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.ChallengeToken)
+
+		cnt, err := fix.User1.MagicLinkTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostMagicLinkCompleteConcurrently(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := requestMagicLinkToken(t, s, fix.User1)
+
+		cntBefore, err := models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+
+		statusCodes := make([]int, 2)
+		var wg sync.WaitGroup
+		for i := range statusCodes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+					"token": token,
+				}, nil)
+				statusCodes[i] = res.Result().StatusCode
+			}()
+		}
+		wg.Wait()
+
+		// the token may only be redeemed by one of the requests
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusNotFound}, statusCodes)
+
+		cntAfter, err := models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, cntBefore+1, cntAfter)
+	})
+}
+
+func TestPostMagicLinkCompleteTokenExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		token := requestMagicLinkToken(t, s, fix.User1)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.MagicLinkTokenValidity+time.Second))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token": token,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+	})
+}
+
+func TestPostMagicLinkCompleteTokenNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token": "a5c1e3e4-5b0c-4f36-a1c8-2b3f0f9cbe0e",
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostMagicLinkCompleteUserDeactivated(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := requestMagicLinkToken(t, s, fix.User1)
+
+		fix.User1.IsActive = false
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token": token,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenUserDeactivated)
+	})
+}
+
+func TestPostMagicLinkCompletePasswordResetRequired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token := requestMagicLinkToken(t, s, fix.User1)
+
+		fix.User1.PasswordResetRequired = true
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.PasswordResetRequired))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link/complete", test.GenericPayload{
+			"token": token,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasswordResetRequired)
+	})
+}
@@ -0,0 +1,175 @@
+package auth_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostMagicLinkSuccess(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.MagicLinkTokenReuseDuration = 120 * time.Second
+	cfg.Auth.MagicLinkTokenDebounceDuration = 60 * time.Second
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		payload := test.GenericPayload{
+			"username": fix.User1.Username,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", payload, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		magicLinkToken, err := fix.User1.MagicLinkTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, s.Clock.Now().Add(s.Config.Auth.MagicLinkTokenValidity), magicLinkToken.ValidUntil)
+
+		mail := test.GetLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, "Your login link", mail.Subject)
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/magic-link?token=%s", magicLinkToken.Token))
+
+		// retrying should not send a new mail because of the debounce time
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", payload, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		assert.Len(t, test.GetSentMails(t, s.Mailer), 1)
+
+		// after the debounce time, the same token is sent again as the reuse duration has not passed yet
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.MagicLinkTokenDebounceDuration+time.Second))
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", payload, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		sentMails := test.GetSentMails(t, s.Mailer)
+		require.Len(t, sentMails, 2)
+		assert.Contains(t, string(sentMails[1].HTML), magicLinkToken.Token)
+
+		cnt, err := fix.User1.MagicLinkTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		// after the reuse duration, a new token is issued
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.MagicLinkTokenReuseDuration))
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", payload, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		sentMails = test.GetSentMails(t, s.Mailer)
+		require.Len(t, sentMails, 3)
+		assert.NotContains(t, string(sentMails[2].HTML), magicLinkToken.Token)
+
+		cnt, err = fix.User1.MagicLinkTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), cnt)
+	})
+}
+
+func TestPostMagicLinkLocalized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		headers := http.Header{}
+		headers.Set("Accept-Language", "de-AT,de;q=0.9,en;q=0.8")
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", test.GenericPayload{
+			"username": fix.User1.Username,
+		}, headers)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		mail := test.GetLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, "Dein Login-Link", mail.Subject)
+		assert.Contains(t, string(mail.HTML), "läuft in 10 Minuten ab")
+	})
+}
+
+func TestPostMagicLinkUserWithoutPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// users without password (e.g. created via OpenID Connect) may use magic links as well
+		fix.User2.Password = null.String{}
+		_, err := fix.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Password))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", test.GenericPayload{
+			"username": fix.User2.Username,
+		}, nil)
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		cnt, err := fix.User2.MagicLinkTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+	})
+}
+
+func TestPostMagicLinkNoMail(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		tests := []struct {
+			name     string
+			username string
+		}{
+			{
+				name:     "UnknownUser",
+				username: "definitelydoesnotexist@example.com",
+			},
+			{
+				name:     "DeactivatedUser",
+				username: fix.UserDeactivated.Username.String,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", test.GenericPayload{
+					"username": tt.username,
+				}, nil)
+				require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+				cnt, err := models.MagicLinkTokens().Count(ctx, s.DB)
+				require.NoError(t, err)
+				assert.Equal(t, int64(0), cnt)
+
+				assert.Nil(t, test.GetLastSentMail(t, s.Mailer))
+			})
+		}
+	})
+}
+
+func TestPostMagicLinkLockoutPerIP(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.MagicLinkMaxAttemptsPerIP = 2
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"username": "definitelydoesnotexist@example.com",
+		}
+
+		for range cfg.Auth.MagicLinkMaxAttemptsPerIP {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", payload, nil)
+			require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/magic-link", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsTooManyAttempts)
+		assert.NotEmpty(t, res.Header().Get(echo.HeaderRetryAfter))
+	})
+}
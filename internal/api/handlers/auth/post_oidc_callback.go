@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostOIDCCallbackRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/oidc/:provider/callback", postOIDCCallbackHandler(s))
+}
+
+func postOIDCCallbackHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := auth.NewPostOIDCCallbackRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostOIDCCallbackPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.CompleteOIDCLogin(ctx, dto.CompleteOIDCLoginRequest{
+			Provider: params.Provider,
+			Code:     swag.StringValue(body.Code),
+			State:    body.State.String(),
+			Session:  sessionInfoFromEchoContext(c, body.DeviceName),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to complete OIDC login")
+			return err
+		}
+
+		if result.RequiresTwoFactor() {
+			return util.ValidateAndReturn(c, http.StatusAccepted, result.TwoFactorChallenge.ToTypes())
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
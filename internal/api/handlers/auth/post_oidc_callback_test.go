@@ -0,0 +1,321 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostOIDCCallbackNewUser(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		identity := test.TestOIDCIdentity{Subject: "new-user", Email: " New.User@Example.com ", EmailVerified: true}
+
+		response := performOIDCLogin(t, s, provider, identity)
+
+		userIdentity, err := models.UserIdentities(
+			models.UserIdentityWhere.Provider.EQ(test.TestOIDCProviderName),
+			models.UserIdentityWhere.Subject.EQ(identity.Subject),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "new.user@example.com", userIdentity.Email.String)
+
+		user, err := userIdentity.User().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "new.user@example.com", user.Username.String)
+		assert.False(t, user.Password.Valid)
+		assert.True(t, user.IsActive)
+		assert.Equal(t, s.Config.Auth.DefaultUserScopes, []string(user.Scopes))
+
+		exists, err := models.AppUserProfileExists(ctx, s.DB, user.ID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, *response.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// subsequent logins use the linked identity
+		performOIDCLogin(t, s, provider, identity)
+
+		cnt, err := models.Users(models.UserWhere.ID.EQ(user.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		cnt, err = models.UserIdentities().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		// local password login is not possible for users without password
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-password", test.GenericPayload{
+			"currentPassword": fixtures.PlainTestUserPassword,
+			"newPassword":     "correct horse battery staple",
+		}, test.HeadersWithAuth(t, *response.AccessToken))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenNotLocalUser)
+	})
+}
+
+func TestPostOIDCCallbackRegistrationRequiresInvite(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.RegistrationRequiresInvite = true
+
+	withTestOIDCServerConfigurable(t, cfg, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		code, state := authorizeOIDC(t, s, provider, test.TestOIDCIdentity{Subject: "new-user", Email: "new.user@example.com", EmailVerified: true})
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  code,
+			"state": state,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenInviteRequired)
+
+		exists, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom("new.user@example.com"))).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		cnt, err := models.UserIdentities().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		// existing users may still link their identity
+		performOIDCLogin(t, s, provider, test.TestOIDCIdentity{Subject: "existing-user", Email: fix.User1.Username.String, EmailVerified: true})
+	})
+}
+
+func TestPostOIDCCallbackLinkExistingUser(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		performOIDCLogin(t, s, provider, test.TestOIDCIdentity{Subject: "user1", Email: fix.User1.Username.String, EmailVerified: true})
+
+		userIdentity, err := models.UserIdentities(
+			models.UserIdentityWhere.Subject.EQ("user1"),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.ID, userIdentity.UserID)
+
+		// the local password stays valid
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostOIDCCallbackUnverifiedEmail(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		performOIDCLogin(t, s, provider, test.TestOIDCIdentity{Subject: "unverified", Email: fix.User1.Username.String, EmailVerified: false})
+
+		// unverified emails must not be used to take over existing users
+		userIdentity, err := models.UserIdentities(
+			models.UserIdentityWhere.Subject.EQ("unverified"),
+			models.UserIdentityWhere.Email.IsNull(),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.NotEqual(t, fix.User1.ID, userIdentity.UserID)
+
+		user, err := userIdentity.User().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, user.Username.Valid)
+	})
+}
+
+func TestPostOIDCCallbackConfirmsUser(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		performOIDCLogin(t, s, provider, test.TestOIDCIdentity{Subject: "unconfirmed", Email: fix.UserRequiresConfirmation.Username.String, EmailVerified: true})
+
+		user, err := models.FindUser(ctx, s.DB, fix.UserRequiresConfirmation.ID)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+		assert.False(t, user.RequiresConfirmation)
+
+		// the password of the unconfirmed registration might have been set by someone else
+		assert.False(t, user.Password.Valid)
+
+		exists, err := models.ConfirmationTokenExists(ctx, s.DB, fix.UserRequiresConfirmationConfirmationToken.Token)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.UserRequiresConfirmation.Username.String,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostOIDCCallbackUserDeactivated(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		code, state := authorizeOIDC(t, s, provider, test.TestOIDCIdentity{Subject: "deactivated", Email: fix.UserDeactivated.Username.String, EmailVerified: true})
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  code,
+			"state": state,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenUserDeactivated)
+
+		cnt, err := models.UserIdentities().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostOIDCCallbackPasswordResetRequired(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.PasswordResetRequired = true
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.PasswordResetRequired))
+		require.NoError(t, err)
+
+		code, state := authorizeOIDC(t, s, provider, test.TestOIDCIdentity{Subject: "reset", Email: fix.User1.Username.String, EmailVerified: true})
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  code,
+			"state": state,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasswordResetRequired)
+
+		cnt, err := models.UserIdentities().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostOIDCCallbackInvalidState(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		identity := test.TestOIDCIdentity{Subject: "state", Email: "state@example.com", EmailVerified: true}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  "code",
+			"state": "7a5b2b1c-3d4e-4f60-8a9b-0c1d2e3f4a5b",
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+
+		code, state := authorizeOIDC(t, s, provider, identity)
+		payload := test.GenericPayload{
+			"code":  code,
+			"state": state,
+		}
+
+		// states are bound to their provider
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/unknown/callback", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundOIDCProviderNotFound)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// states can only be used once
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+
+		code, state = authorizeOIDC(t, s, provider, identity)
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.OIDCStateValidity+time.Second))
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  code,
+			"state": state,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+	})
+}
+
+func TestPostOIDCCallbackAuthFailed(t *testing.T) {
+	withTestOIDCServer(t, func(s *api.Server, provider *test.TestOIDCProvider) {
+		identity := test.TestOIDCIdentity{Subject: "failed", Email: "failed@example.com", EmailVerified: true}
+
+		// invalid authorization code
+		_, state := authorizeOIDC(t, s, provider, identity)
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  "invalid",
+			"state": state,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedOIDCAuthFailed)
+
+		// ID token issued for another client
+		provider.ModifyIDTokenClaims = func(claims jwt.MapClaims) {
+			claims["aud"] = "other-client"
+		}
+
+		code, state := authorizeOIDC(t, s, provider, identity)
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+			"code":  code,
+			"state": state,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedOIDCAuthFailed)
+	})
+}
+
+func withTestOIDCServer(t *testing.T, closure func(s *api.Server, provider *test.TestOIDCProvider)) {
+	t.Helper()
+
+	withTestOIDCServerConfigurable(t, config.DefaultServiceConfigFromEnv(), closure)
+}
+
+func withTestOIDCServerConfigurable(t *testing.T, cfg config.Server, closure func(s *api.Server, provider *test.TestOIDCProvider)) {
+	t.Helper()
+
+	provider := test.NewTestOIDCProvider(t)
+	cfg.Auth.OIDCProviders = []oauth2.OIDCProviderConfig{provider.Config()}
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		provider.Clock = s.Clock
+		closure(s, provider)
+	})
+}
+
+func authorizeOIDC(t *testing.T, s *api.Server, provider *test.TestOIDCProvider, identity test.TestOIDCIdentity) (code string, state string) {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "GET", "/api/v1/auth/oidc/fake/authorize", nil, nil)
+	require.Equal(t, http.StatusFound, res.Result().StatusCode)
+
+	return provider.Authorize(t, res.Header().Get("Location"), identity)
+}
+
+func performOIDCLogin(t *testing.T, s *api.Server, provider *test.TestOIDCProvider, identity test.TestOIDCIdentity) types.PostLoginResponse {
+	t.Helper()
+
+	code, state := authorizeOIDC(t, s, provider, identity)
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oidc/fake/callback", test.GenericPayload{
+		"code":  code,
+		"state": state,
+	}, nil)
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostLoginResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	assert.NotEmpty(t, response.AccessToken)
+	assert.NotEmpty(t, response.RefreshToken)
+
+	return response
+}
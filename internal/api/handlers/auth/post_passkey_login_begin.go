@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostPasskeyLoginBeginRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/login/begin", postPasskeyLoginBeginHandler(s))
+}
+
+func postPasskeyLoginBeginHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		result, err := s.Auth.BeginPasskeyLogin(ctx)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to begin passkey login")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
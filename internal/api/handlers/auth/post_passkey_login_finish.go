@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostPasskeyLoginFinishRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/login/finish", postPasskeyLoginFinishHandler(s))
+}
+
+func postPasskeyLoginFinishHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostPasskeyLoginFinishPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.FinishPasskeyLogin(ctx, dto.FinishPasskeyLoginRequest{
+			CredentialID:      swag.StringValue(body.Credential.RawID),
+			ClientDataJSON:    swag.StringValue(body.Credential.Response.ClientDataJSON),
+			AuthenticatorData: swag.StringValue(body.Credential.Response.AuthenticatorData),
+			Signature:         swag.StringValue(body.Credential.Response.Signature),
+			UserHandle:        null.NewString(body.Credential.Response.UserHandle, len(body.Credential.Response.UserHandle) > 0),
+			Session:           sessionInfoFromEchoContext(c, body.DeviceName),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to finish passkey login")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
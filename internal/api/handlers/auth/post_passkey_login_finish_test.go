@@ -0,0 +1,222 @@
+package auth_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func beginPasskeyLogin(t *testing.T, s *api.Server) string {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/begin", nil, nil)
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostPasskeyLoginBeginResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	return *response.PublicKey.Challenge
+}
+
+func TestPostPasskeyLogin(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+
+		now := time.Date(2025, 2, 5, 11, 42, 30, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"device_name": "iPhone",
+			"credential":  authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEmpty(t, response.RefreshToken)
+
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, now, user.LastAuthenticatedAt.Time)
+
+		credential, err := models.WebauthnCredentials(
+			models.WebauthnCredentialWhere.CredentialID.EQ(authenticator.CredentialID),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, now, credential.LastUsedAt.Time)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, *response.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostPasskeyLoginSignCountRollback(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		authenticator := test.NewTestPasskeyAuthenticator(t)
+		authenticator.SignCount = 10
+
+		options := beginPasskeyRegistration(t, s, fix.User1AccessToken1.Token)
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+			"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// a cloned authenticator reports an outdated sign count
+		authenticator.SignCount = 5
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostPasskeyLoginUnknownCredential(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		authenticator := test.NewTestPasskeyAuthenticator(t)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostPasskeyLoginUserHandleMismatch(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+		authenticator.UserHandle = []byte(fix.User2.ID)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostPasskeyLoginChallengeReused(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+		challenge := beginPasskeyLogin(t, s)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, challenge),
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, challenge),
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostPasskeyLoginChallengeReusedConcurrently(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+		challenge := beginPasskeyLogin(t, s)
+
+		payloads := []test.GenericPayload{
+			{"credential": authenticator.GetAssertion(t, challenge)},
+			{"credential": authenticator.GetAssertion(t, challenge)},
+		}
+
+		statusCodes := make([]int, len(payloads))
+		var wg sync.WaitGroup
+		for i, payload := range payloads {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", payload, nil)
+				statusCodes[i] = res.Result().StatusCode
+			}()
+		}
+		wg.Wait()
+
+		// the challenge may only be consumed by one of the requests
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusNotFound}, statusCodes)
+	})
+}
+
+func TestPostPasskeyLoginChallengeExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+		challenge := beginPasskeyLogin(t, s)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.WebAuthnChallengeValidity+time.Second))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, challenge),
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+	})
+}
+
+func TestPostPasskeyLoginUserDeactivated(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+
+		user := *fix.User1
+		user.IsActive = false
+		_, err := user.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenUserDeactivated)
+	})
+}
+
+func TestPostPasskeyLoginPasswordResetRequired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		authenticator := registerPasskey(t, s, fix.User1AccessToken1.Token)
+
+		user := *fix.User1
+		user.PasswordResetRequired = true
+		_, err := user.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.PasswordResetRequired))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/login/finish", test.GenericPayload{
+			"credential": authenticator.GetAssertion(t, beginPasskeyLogin(t, s)),
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasswordResetRequired)
+	})
+}
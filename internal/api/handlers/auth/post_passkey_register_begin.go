@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostPasskeyRegisterBeginRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/register/begin", postPasskeyRegisterBeginHandler(s), middleware.DenyImpersonation())
+}
+
+func postPasskeyRegisterBeginHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		result, err := s.Auth.BeginPasskeyRegistration(ctx, dto.BeginPasskeyRegistrationRequest{
+			User: *user,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to begin passkey registration")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostPasskeyRegisterFinishRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/register/finish", postPasskeyRegisterFinishHandler(s), middleware.DenyImpersonation())
+}
+
+func postPasskeyRegisterFinishHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		var body types.PostPasskeyRegisterFinishPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.FinishPasskeyRegistration(ctx, dto.FinishPasskeyRegistrationRequest{
+			User:              *user,
+			Name:              null.NewString(body.Name, len(body.Name) > 0),
+			CredentialID:      swag.StringValue(body.Credential.RawID),
+			ClientDataJSON:    swag.StringValue(body.Credential.Response.ClientDataJSON),
+			AttestationObject: swag.StringValue(body.Credential.Response.AttestationObject),
+			Transports:        body.Credential.Response.Transports,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to finish passkey registration")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, result.ToTypes())
+	}
+}
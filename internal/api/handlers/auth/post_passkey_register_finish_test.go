@@ -0,0 +1,152 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func beginPasskeyRegistration(t *testing.T, s *api.Server, accessToken string) types.PostPasskeyRegisterBeginResponse {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/begin", nil, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostPasskeyRegisterBeginResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	return response
+}
+
+func registerPasskey(t *testing.T, s *api.Server, accessToken string) *test.TestPasskeyAuthenticator {
+	t.Helper()
+
+	authenticator := test.NewTestPasskeyAuthenticator(t)
+	options := beginPasskeyRegistration(t, s, accessToken)
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+		"name":       "Test device",
+		"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+	}, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+	return authenticator
+}
+
+func TestPostPasskeyRegister(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		options := beginPasskeyRegistration(t, s, fix.User1AccessToken1.Token)
+		assert.Equal(t, s.Config.Auth.WebAuthnRPID, *options.PublicKey.Rp.ID)
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString([]byte(fix.User1.ID)), *options.PublicKey.User.ID)
+		assert.Equal(t, fix.User1.Username.String, *options.PublicKey.User.Name)
+		assert.Equal(t, "required", *options.PublicKey.AuthenticatorSelection.UserVerification)
+		assert.Empty(t, options.PublicKey.ExcludeCredentials)
+
+		authenticator := test.NewTestPasskeyAuthenticator(t)
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+			"name":       "My phone",
+			"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.Passkey
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, "My phone", response.Name)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000000", response.Aaguid.String())
+		assert.Equal(t, []string{"internal", "hybrid"}, response.Transports)
+		assert.True(t, *response.BackedUp)
+
+		credential, err := models.FindWebauthnCredential(ctx, s.DB, response.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1.ID, credential.UserID)
+		assert.Equal(t, authenticator.CredentialID, credential.CredentialID)
+
+		// challenges may only be used once
+		cnt, err := models.WebauthnChallenges().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		// existing passkeys are excluded from subsequent registrations
+		options = beginPasskeyRegistration(t, s, fix.User1AccessToken1.Token)
+		require.Len(t, options.PublicKey.ExcludeCredentials, 1)
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(authenticator.CredentialID), *options.PublicKey.ExcludeCredentials[0].ID)
+
+		// registering the same credential again is rejected
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+			"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictPasskeyRegistered)
+	})
+}
+
+func TestPostPasskeyRegisterChallengeOfOtherUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		options := beginPasskeyRegistration(t, s, fix.User1AccessToken1.Token)
+
+		authenticator := test.NewTestPasskeyAuthenticator(t)
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+			"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+		}, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostPasskeyRegisterChallengeExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		options := beginPasskeyRegistration(t, s, fix.User1AccessToken1.Token)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.WebAuthnChallengeValidity+time.Second))
+
+		authenticator := test.NewTestPasskeyAuthenticator(t)
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+			"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+	})
+}
+
+func TestPostPasskeyRegisterInvalidCredential(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		options := beginPasskeyRegistration(t, s, fix.User1AccessToken1.Token)
+
+		// credentials created for other relying parties are rejected
+		authenticator := test.NewTestPasskeyAuthenticator(t)
+		authenticator.RPID = "evil.example.com"
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/finish", test.GenericPayload{
+			"credential": authenticator.CreateCredential(t, *options.PublicKey.Challenge, *options.PublicKey.User.ID),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrBadRequestInvalidPasskey)
+
+		cnt, err := models.WebauthnCredentials().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostPasskeyRegisterUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/passkeys/register/begin", nil, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -26,6 +26,7 @@ func postRefreshHandler(s *api.Server) echo.HandlerFunc {
 
 		result, err := s.Auth.Refresh(ctx, dto.RefreshRequest{
 			RefreshToken: body.RefreshToken.String(),
+			Session:      sessionInfoFromEchoContext(c, ""),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to refresh tokens")
@@ -4,10 +4,13 @@ import (
 	"database/sql"
 	"net/http"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
 	"allaboutapps.dev/aw/go-starter/internal/types"
@@ -37,8 +40,16 @@ func TestPostRefreshSuccess(t *testing.T) {
 		assert.Equal(t, int64(s.Config.Auth.AccessTokenValidity.Seconds()), *response.ExpiresIn)
 		assert.Equal(t, auth.TokenTypeBearer, *response.TokenType)
 
+		// the old refresh token is kept for reuse detection
 		err := fix.User1RefreshToken1.Reload(ctx, s.DB)
-		require.ErrorIs(t, err, sql.ErrNoRows)
+		require.NoError(t, err)
+		assert.True(t, fix.User1RefreshToken1.RotatedAt.Valid)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1RefreshToken1.SessionID, refreshToken.SessionID)
+		assert.WithinDuration(t, fix.User1RefreshToken1.SessionStartedAt, refreshToken.SessionStartedAt, time.Millisecond)
+		assert.False(t, refreshToken.RotatedAt.Valid)
 	})
 }
 
@@ -66,9 +77,97 @@ func TestPostRefreshDeactivatedUser(t *testing.T) {
 
 		err := fix.UserDeactivatedRefreshToken1.Reload(ctx, s.DB)
 		require.NoError(t, err)
+		assert.False(t, fix.UserDeactivatedRefreshToken1.RotatedAt.Valid)
+	})
+}
+
+func TestPostRefreshReuseDetection(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		payload := test.GenericPayload{
+			"refresh_token": fix.User1RefreshToken1.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// replaying the rotated refresh token revokes the whole session
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+
+		err := fix.User1RefreshToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		exists, err := models.RefreshTokenExists(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = models.AccessTokenExists(ctx, s.DB, *response.AccessToken)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		err = fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		event, err := models.RefreshTokenReuseEvents(
+			models.RefreshTokenReuseEventWhere.UserID.EQ(fix.User1.ID),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, fix.User1RefreshToken1.SessionID, event.SessionID)
+
+		// sessions of other users are not affected
+		err = fix.User2RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		payload = test.GenericPayload{
+			"refresh_token": response.RefreshToken,
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
 	})
 }
 
+func TestPostRefreshExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		lifetime    time.Duration
+		idleTimeout time.Duration
+	}{
+		{name: "IdleTimeout", lifetime: 0, idleTimeout: time.Hour},
+		{name: "Lifetime", lifetime: time.Hour, idleTimeout: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultServiceConfigFromEnv()
+			cfg.Auth.RefreshTokenLifetime = tt.lifetime
+			cfg.Auth.RefreshTokenIdleTimeout = tt.idleTimeout
+
+			test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+				ctx := t.Context()
+				fix := fixtures.Fixtures()
+
+				test.SetMockClock(t, s, s.Clock.Now().Add(time.Hour+time.Second))
+
+				payload := test.GenericPayload{
+					"refresh_token": fix.User1RefreshToken1.Token,
+				}
+
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+				test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrUnauthorized))
+
+				err := fix.User1RefreshToken1.Reload(ctx, s.DB)
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			})
+		})
+	}
+}
+
 func TestPostRefreshBadRequest(t *testing.T) {
 	test.WithTestServer(t, func(s *api.Server) {
 		tests := []struct {
@@ -8,6 +8,7 @@ import (
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/aarondl/null/v8"
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
 )
@@ -28,10 +29,16 @@ func postRegisterHandler(s *api.Server) echo.HandlerFunc {
 
 		username := dto.NewUsername(body.Username.String())
 
-		result, err := s.Auth.Register(ctx, dto.RegisterRequest{
+		request := dto.RegisterRequest{
 			Username: username,
 			Password: swag.StringValue(body.Password),
-		})
+		}
+
+		if len(body.InviteToken) > 0 {
+			request.InviteToken = null.StringFrom(body.InviteToken.String())
+		}
+
+		result, err := s.Auth.Register(ctx, request)
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to register user")
 			return err
@@ -41,6 +48,7 @@ func postRegisterHandler(s *api.Server) echo.HandlerFunc {
 			loginResult, err := s.Auth.Login(ctx, dto.LoginRequest{
 				Username: username,
 				Password: swag.StringValue(body.Password),
+				Session:  sessionInfoFromEchoContext(c, body.DeviceName),
 			})
 			if err != nil {
 				log.Debug().Err(err).Msg("Failed to authenticate user after registration")
@@ -2,20 +2,24 @@ package auth_test
 
 import (
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
 	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
 	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
 	"allaboutapps.dev/aw/go-starter/internal/util/db"
 	"allaboutapps.dev/aw/go-starter/internal/util/url"
 	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -64,7 +68,7 @@ func TestPostRegisterSuccess(t *testing.T) {
 		assert.False(t, user.R.AppUserProfile.LegalAcceptedAt.Valid)
 
 		assert.Len(t, user.R.AccessTokens, 1)
-		assert.Equal(t, strfmt.UUID4(user.R.AccessTokens[0].Token), *response.AccessToken)
+		assert.Equal(t, user.R.AccessTokens[0].Token, *response.AccessToken)
 		assert.Len(t, user.R.RefreshTokens, 1)
 		assert.Equal(t, strfmt.UUID4(user.R.RefreshTokens[0].Token), *response.RefreshToken)
 
@@ -229,7 +233,7 @@ func TestPostRegisterSuccessLowercaseTrimWhitespaces(t *testing.T) {
 		assert.False(t, user.R.AppUserProfile.LegalAcceptedAt.Valid)
 
 		assert.Len(t, user.R.AccessTokens, 1)
-		assert.Equal(t, strfmt.UUID4(user.R.AccessTokens[0].Token), *response.AccessToken)
+		assert.Equal(t, user.R.AccessTokens[0].Token, *response.AccessToken)
 		assert.Len(t, user.R.RefreshTokens, 1)
 		assert.Equal(t, strfmt.UUID4(user.R.RefreshTokens[0].Token), *response.RefreshToken)
 
@@ -332,3 +336,253 @@ func TestPostRegisterBadRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestPostRegisterPasswordPolicy(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.PasswordRequireUppercase = true
+	cfg.Auth.PasswordRequireDigit = true
+	cfg.Auth.PasswordBreachedListDirAbs = filepath.Join(util.GetProjectRootDir(), "test", "testdata", "breached-passwords")
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		username := "usernew@example.com"
+
+		tests := []struct {
+			name       string
+			password   string
+			violations []string
+		}{
+			{
+				name:     "Breached",
+				password: "password",
+				violations: []string{
+					"must contain an uppercase letter",
+					"must contain a digit",
+					"has appeared in a data breach and must not be used",
+				},
+			},
+			{
+				name:     "TooShort",
+				password: "Pass1",
+				violations: []string{
+					"must be at least 8 characters long",
+				},
+			},
+			{
+				name:     "Username",
+				password: "UserNew-2024",
+				violations: []string{
+					"must not contain the username",
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", test.GenericPayload{
+					"username": username,
+					"password": tt.password,
+				}, nil)
+				test.RequireHTTPValidationError(t, res, httperrors.NewHTTPValidationErrorInvalidPassword("password", tt.violations))
+
+				exists, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).Exists(ctx, s.DB)
+				require.NoError(t, err)
+				assert.False(t, exists)
+			})
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", test.GenericPayload{
+			"username": username,
+			"password": "Correct Horse Battery Staple 2",
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostRegisterWithInviteSuccess(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.RegistrationRequiresInvite = true
+	cfg.Auth.RegistrationRequiresConfirmation = true
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		username := "invited@example.com"
+		invite := models.Invite{
+			Email:      username,
+			Scopes:     []string{auth.ScopeApp.String(), auth.ScopeCMS.String()},
+			ValidUntil: now.Add(time.Hour),
+		}
+		require.NoError(t, invite.Insert(ctx, s.DB, boil.Infer()))
+
+		payload := test.GenericPayload{
+			"username":     username,
+			"password":     fixtures.PlainTestUserPassword,
+			"invite_token": invite.Token,
+		}
+
+		// the invite confirms the email address, the user is logged in right away
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+
+		user, err := models.Users(
+			models.UserWhere.Username.EQ(null.StringFrom(username)),
+			qm.Load(models.UserRels.AppUserProfile),
+			qm.Load(models.UserRels.ConfirmationTokens),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+		assert.False(t, user.RequiresConfirmation)
+		assert.EqualValues(t, invite.Scopes, user.Scopes)
+		assert.NotNil(t, user.R.AppUserProfile)
+		assert.Empty(t, user.R.ConfirmationTokens)
+
+		require.NoError(t, invite.Reload(ctx, s.DB))
+		assert.Equal(t, null.TimeFrom(now), invite.AcceptedAt)
+		assert.Equal(t, null.StringFrom(user.ID), invite.UserID)
+
+		event, err := models.AuditEvents(models.AuditEventWhere.EventType.EQ(audit.EventTypeInviteAccepted.String())).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(user.ID), event.SubjectID)
+
+		// accepted invites cannot be reused
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/register", payload, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
+
+func TestPostRegisterWithInvitePendingRegistration(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		invite := models.Invite{
+			Email:      fix.UserRequiresConfirmation.Username.String,
+			Scopes:     []string{auth.ScopeCMS.String()},
+			ValidUntil: now.Add(time.Hour),
+		}
+		require.NoError(t, invite.Insert(ctx, s.DB, boil.Infer()))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", test.GenericPayload{
+			"username":     fix.UserRequiresConfirmation.Username.String,
+			"password":     "Correct Horse Battery Staple 2",
+			"invite_token": invite.Token,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		user, err := models.FindUser(ctx, s.DB, fix.UserRequiresConfirmation.ID)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+		assert.False(t, user.RequiresConfirmation)
+		assert.EqualValues(t, invite.Scopes, user.Scopes)
+
+		exists, err := models.ConfirmationTokenExists(ctx, s.DB, fix.UserRequiresConfirmationConfirmationToken.Token)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPostRegisterInviteRequired(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.RegistrationRequiresInvite = true
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+
+		username := "usernew@example.com"
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", test.GenericPayload{
+			"username": username,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenInviteRequired)
+
+		exists, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPostRegisterWithInviteInvalid(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		test.SetMockClock(t, s, now)
+
+		username := "invited@example.com"
+		invite := models.Invite{
+			Email:      username,
+			Scopes:     []string{auth.ScopeApp.String()},
+			ValidUntil: now.Add(time.Hour),
+		}
+		require.NoError(t, invite.Insert(ctx, s.DB, boil.Infer()))
+
+		confirmedInvite := models.Invite{
+			Email:      fix.User1.Username.String,
+			Scopes:     []string{auth.ScopeApp.String()},
+			ValidUntil: now.Add(time.Hour),
+		}
+		require.NoError(t, confirmedInvite.Insert(ctx, s.DB, boil.Infer()))
+
+		tests := []struct {
+			name        string
+			username    string
+			inviteToken string
+			expectedErr *httperrors.HTTPError
+		}{
+			{
+				name:        "UnknownToken",
+				username:    username,
+				inviteToken: "0f2b8c3d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+				expectedErr: httperrors.ErrNotFoundTokenNotFound,
+			},
+			{
+				name:        "EmailMismatch",
+				username:    "other@example.com",
+				inviteToken: invite.Token,
+				expectedErr: httperrors.ErrForbiddenInviteEmailMismatch,
+			},
+			{
+				name:        "UserAlreadyExists",
+				username:    fix.User1.Username.String,
+				inviteToken: confirmedInvite.Token,
+				expectedErr: httperrors.ErrConflictUserAlreadyExists,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", test.GenericPayload{
+					"username":     tt.username,
+					"password":     fixtures.PlainTestUserPassword,
+					"invite_token": tt.inviteToken,
+				}, nil)
+				test.RequireHTTPError(t, res, tt.expectedErr)
+			})
+		}
+
+		test.SetMockClock(t, s, now.Add(2*time.Hour))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/register", test.GenericPayload{
+			"username":     username,
+			"password":     fixtures.PlainTestUserPassword,
+			"invite_token": invite.Token,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+
+		exists, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostRevokeOtherSessionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sessions/revoke-others", postRevokeOtherSessionsHandler(s))
+}
+
+func postRevokeOtherSessionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		if err := s.Auth.RevokeOtherSessions(ctx, dto.RevokeOtherSessionsRequest{
+			User:        *user,
+			AccessToken: *auth.AccessTokenFromEchoContext(c),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to revoke other sessions")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,63 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostRevokeOtherSessionsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var loginResponse types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &loginResponse)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/sessions/revoke-others", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		// the session of the request is kept
+		err := fix.User1AccessToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		err = fix.User1RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		err = fix.User1PushToken.Reload(ctx, s.DB)
+		require.NoError(t, err)
+
+		exists, err := models.RefreshTokenExists(ctx, s.DB, loginResponse.RefreshToken.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, *loginResponse.AccessToken))
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		// sessions of other users are not affected
+		err = fix.User2RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+	})
+}
+
+func TestPostRevokeOtherSessionsUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/sessions/revoke-others", nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
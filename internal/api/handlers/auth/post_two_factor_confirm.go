@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostTwoFactorConfirmRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/2fa/confirm", postTwoFactorConfirmHandler(s), middleware.DenyImpersonation())
+}
+
+func postTwoFactorConfirmHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		var body types.PostTwoFactorCodePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		if err := s.Auth.ConfirmTOTP(ctx, dto.TOTPCodeRequest{
+			User: *user,
+			Code: swag.StringValue(body.Code),
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to confirm two-factor authentication")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
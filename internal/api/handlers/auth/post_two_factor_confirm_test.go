@@ -0,0 +1,83 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTwoFactorConfirmSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostTwoFactorEnrollResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// recovery codes are not accepted for confirming the enrollment
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/confirm", test.GenericPayload{
+			"code": response.RecoveryCodes[0],
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedInvalidTOTPCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/confirm", test.GenericPayload{
+			"code": currentTOTPCode(t, s, *response.Secret),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		totpSecret, err := models.FindTotpSecret(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.True(t, totpSecret.ConfirmedAt.Valid)
+		assert.True(t, totpSecret.LastUsedTimeStep.Valid)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/confirm", test.GenericPayload{
+			"code": currentTOTPCode(t, s, *response.Secret),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTOTPAlreadyEnabled)
+	})
+}
+
+func TestPostTwoFactorConfirmInvalidCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostTwoFactorEnrollResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		code := currentTOTPCode(t, s, *response.Secret)
+		invalid := "000000"
+		if code == invalid {
+			invalid = "111111"
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/confirm", test.GenericPayload{
+			"code": invalid,
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedInvalidTOTPCode)
+	})
+}
+
+func TestPostTwoFactorConfirmNotEnrolled(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/confirm", test.GenericPayload{
+			"code": "123456",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTOTPNotEnabled)
+	})
+}
@@ -0,0 +1,79 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTwoFactorDisableSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		secret, _ := enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/disable", test.GenericPayload{
+			"code": currentTOTPCode(t, s, secret),
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		exists, err := models.TotpSecrets(models.TotpSecretWhere.UserID.EQ(fix.User1.ID)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = models.TotpRecoveryCodes(models.TotpRecoveryCodeWhere.UserID.EQ(fix.User1.ID)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostTwoFactorDisableWithRecoveryCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		_, recoveryCodes := enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/disable", test.GenericPayload{
+			"code": recoveryCodes[3],
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+	})
+}
+
+func TestPostTwoFactorDisableInvalidCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/disable", test.GenericPayload{
+			"code": "abcde-fghij",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedInvalidTOTPCode)
+	})
+}
+
+func TestPostTwoFactorDisableNotEnabled(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/disable", test.GenericPayload{
+			"code": "123456",
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTOTPNotEnabled)
+	})
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostTwoFactorEnrollRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/2fa/enroll", postTwoFactorEnrollHandler(s), middleware.DenyImpersonation())
+}
+
+func postTwoFactorEnrollHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		result, err := s.Auth.EnrollTOTP(ctx, dto.EnrollTOTPRequest{
+			User: *user,
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to enroll two-factor authentication")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
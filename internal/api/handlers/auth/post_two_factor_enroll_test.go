@@ -0,0 +1,133 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enableTwoFactor enrolls and confirms two-factor authentication for the user with the given access token,
+// returning the TOTP secret and recovery codes. The mock clock is advanced by one TOTP period afterwards,
+// so the next generated code is not rejected as replay.
+func enableTwoFactor(t *testing.T, s *api.Server, accessToken string) (string, []string) {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostTwoFactorEnrollResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/confirm", test.GenericPayload{
+		"code": currentTOTPCode(t, s, *response.Secret),
+	}, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+	test.SetMockClock(t, s, s.Clock.Now().Add(totp.DefaultPeriod*time.Second))
+
+	return *response.Secret, response.RecoveryCodes
+}
+
+func currentTOTPCode(t *testing.T, s *api.Server, secret string) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(secret, totp.TimeStep(s.Clock.Now()))
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestPostTwoFactorEnrollSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostTwoFactorEnrollResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, *response.Secret)
+		assert.Len(t, response.RecoveryCodes, 10)
+
+		uri, err := url.Parse(swag.StringValue(response.OtpauthURI))
+		require.NoError(t, err)
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, *response.Secret, uri.Query().Get("secret"))
+		assert.Equal(t, s.Config.Auth.TOTPIssuer, uri.Query().Get("issuer"))
+
+		totpSecret, err := models.FindTotpSecret(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, *response.Secret, totpSecret.Secret)
+		assert.False(t, totpSecret.ConfirmedAt.Valid)
+
+		cnt, err := models.TotpRecoveryCodes(models.TotpRecoveryCodeWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), cnt)
+
+		// plain recovery codes are never persisted
+		for _, code := range response.RecoveryCodes {
+			exists, err := models.TotpRecoveryCodes(models.TotpRecoveryCodeWhere.CodeHash.EQ(code)).Exists(ctx, s.DB)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		}
+
+		// an unconfirmed enrollment does not affect login
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fix.User1.Username,
+			"password": fixtures.PlainTestUserPassword,
+		}, nil)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// re-enrolling replaces the unconfirmed secret and recovery codes
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response2 types.PostTwoFactorEnrollResponse
+		test.ParseResponseAndValidate(t, res, &response2)
+		assert.NotEqual(t, *response.Secret, *response2.Secret)
+
+		cnt, err = models.TotpRecoveryCodes(models.TotpRecoveryCodeWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), cnt)
+	})
+}
+
+func TestPostTwoFactorEnrollAlreadyEnabled(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTOTPAlreadyEnabled)
+	})
+}
+
+func TestPostTwoFactorEnrollNotLocalUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		fix.User1.Password.Valid = false
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Password))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/enroll", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenNotLocalUser)
+	})
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostTwoFactorVerifyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/2fa/verify", postTwoFactorVerifyHandler(s))
+}
+
+func postTwoFactorVerifyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostTwoFactorVerifyPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.Auth.VerifyTwoFactorChallenge(ctx, dto.VerifyTwoFactorChallengeRequest{
+			ChallengeToken: body.ChallengeToken.String(),
+			Code:           swag.StringValue(body.Code),
+			Session:        sessionInfoFromEchoContext(c, body.DeviceName),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to verify two-factor challenge")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
+	}
+}
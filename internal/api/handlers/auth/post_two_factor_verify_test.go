@@ -0,0 +1,167 @@
+package auth_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loginWithTwoFactorChallenge(t *testing.T, s *api.Server, username string) string {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+		"username": username,
+		"password": fixtures.PlainTestUserPassword,
+	}, nil)
+	require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+	var response types.PostLoginTwoFactorChallengeResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	return response.ChallengeToken.String()
+}
+
+func TestPostTwoFactorVerifySuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		secret, _ := enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+		challengeToken := loginWithTwoFactorChallenge(t, s, fix.User1.Username.String)
+
+		code := currentTOTPCode(t, s, secret)
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+			"challenge_token": challengeToken,
+			"code":            code,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEmpty(t, response.RefreshToken)
+
+		exists, err := models.TwoFactorChallengeTokens(models.TwoFactorChallengeTokenWhere.Token.EQ(challengeToken)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// the same code must not be accepted twice
+		challengeToken = loginWithTwoFactorChallenge(t, s, fix.User1.Username.String)
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+			"challenge_token": challengeToken,
+			"code":            code,
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedInvalidTOTPCode)
+	})
+}
+
+func TestPostTwoFactorVerifyRecoveryCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		_, recoveryCodes := enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+		challengeToken := loginWithTwoFactorChallenge(t, s, fix.User1.Username.String)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+			"challenge_token": challengeToken,
+			"code":            recoveryCodes[0],
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		unused, err := models.TotpRecoveryCodes(
+			models.TotpRecoveryCodeWhere.UserID.EQ(fix.User1.ID),
+			models.TotpRecoveryCodeWhere.UsedAt.IsNull(),
+		).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(recoveryCodes)-1), unused)
+
+		// recovery codes may only be used once
+		challengeToken = loginWithTwoFactorChallenge(t, s, fix.User1.Username.String)
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+			"challenge_token": challengeToken,
+			"code":            recoveryCodes[0],
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrUnauthorizedInvalidTOTPCode)
+	})
+}
+
+func TestPostTwoFactorVerifyConcurrently(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		_, recoveryCodes := enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+		challengeToken := loginWithTwoFactorChallenge(t, s, fix.User1.Username.String)
+
+		cntBefore, err := models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+
+		statusCodes := make([]int, 2)
+		var wg sync.WaitGroup
+		for i := range statusCodes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+					"challenge_token": challengeToken,
+					"code":            recoveryCodes[i],
+				}, nil)
+				statusCodes[i] = res.Result().StatusCode
+			}()
+		}
+		wg.Wait()
+
+		// the challenge may only be completed by one of the requests, even using different codes
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusNotFound}, statusCodes)
+
+		cntAfter, err := models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(fix.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, cntBefore+1, cntAfter)
+
+		unused, err := models.TotpRecoveryCodes(
+			models.TotpRecoveryCodeWhere.UserID.EQ(fix.User1.ID),
+			models.TotpRecoveryCodeWhere.UsedAt.IsNull(),
+		).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(recoveryCodes)-1), unused)
+	})
+}
+
+func TestPostTwoFactorVerifyTokenExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		secret, _ := enableTwoFactor(t, s, fix.User1AccessToken1.Token)
+		challengeToken := loginWithTwoFactorChallenge(t, s, fix.User1.Username.String)
+
+		test.SetMockClock(t, s, s.Clock.Now().Add(s.Config.Auth.TwoFactorChallengeTokenValidity+time.Second))
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+			"challenge_token": challengeToken,
+			"code":            currentTOTPCode(t, s, secret),
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrConflictTokenExpired)
+	})
+}
+
+func TestPostTwoFactorVerifyTokenNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/2fa/verify", test.GenericPayload{
+			"challenge_token": "a5c1e3e4-5b0c-4f36-a1c8-2b3f0f9cbe0e",
+			"code":            "123456",
+		}, nil)
+		test.RequireHTTPError(t, res, httperrors.ErrNotFoundTokenNotFound)
+	})
+}
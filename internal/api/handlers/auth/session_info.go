@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+// sessionInfoFromEchoContext describes the requesting client for the session list, the device name
+// is optional and only provided by endpoints starting a new session.
+func sessionInfoFromEchoContext(c echo.Context, deviceName string) dto.SessionInfo {
+	return dto.SessionInfo{
+		DeviceName: null.NewString(deviceName, len(deviceName) > 0),
+		UserAgent:  c.Request().UserAgent(),
+		IPAddress:  c.RealIP(),
+	}
+}
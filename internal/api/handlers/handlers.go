@@ -3,8 +3,10 @@ package handlers
 
 import (
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/admin"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/auth"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/common"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/notifications"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/push"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/wellknown"
 	"github.com/labstack/echo/v4"
@@ -13,23 +15,74 @@ import (
 func AttachAllRoutes(s *api.Server) {
 	// attach our routes
 	s.Router.Routes = []*echo.Route{
+		admin.DeleteAdminInviteRoute(s),
+		admin.DeleteAdminPushBroadcastRoute(s),
+		admin.DeleteAdminUserAPIKeyRoute(s),
+		admin.GetAdminAuditEventsRoute(s),
+		admin.GetAdminInvitesRoute(s),
+		admin.GetAdminPushBroadcastsRoute(s),
+		admin.GetAdminUserAPIKeysRoute(s),
+		admin.GetAdminUserRoute(s),
+		admin.GetAdminUsersRoute(s),
+		admin.GetRolesRoute(s),
+		admin.PostAdminInviteRoute(s),
+		admin.PostAdminPushBroadcastRoute(s),
+		admin.PostAdminUserAPIKeyRoute(s),
+		admin.PostAdminUserActivateRoute(s),
+		admin.PostAdminUserDeactivateRoute(s),
+		admin.PostAdminUserImpersonateRoute(s),
+		admin.PostAdminUserLogoutRoute(s),
+		admin.PostAdminUserPasswordResetRoute(s),
+		admin.PutUserRolesRoute(s),
+		auth.DeleteAPIKeyRoute(s),
+		auth.DeletePasskeyRoute(s),
+		auth.DeleteSessionRoute(s),
 		auth.DeleteUserAccountRoute(s),
+		auth.GetAPIKeysRoute(s),
 		auth.GetCompleteRegisterRoute(s),
+		auth.GetDataExportDownloadRoute(s),
+		auth.GetOIDCAuthorizeRoute(s),
+		auth.GetPasskeysRoute(s),
+		auth.GetSessionsRoute(s),
 		auth.GetUserInfoRoute(s),
+		auth.PostAPIKeyRoute(s),
+		auth.PostAccountDeletionCancelRoute(s),
+		auth.PostChangeEmailConfirmRoute(s),
+		auth.PostChangeEmailRevertRoute(s),
+		auth.PostChangeEmailRoute(s),
 		auth.PostChangePasswordRoute(s),
 		auth.PostCompleteRegisterRoute(s),
+		auth.PostDataExportRoute(s),
 		auth.PostForgotPasswordCompleteRoute(s),
 		auth.PostForgotPasswordRoute(s),
 		auth.PostLoginRoute(s),
 		auth.PostLogoutRoute(s),
+		auth.PostMagicLinkCompleteRoute(s),
+		auth.PostMagicLinkRoute(s),
+		auth.PostOIDCCallbackRoute(s),
+		auth.PostPasskeyLoginBeginRoute(s),
+		auth.PostPasskeyLoginFinishRoute(s),
+		auth.PostPasskeyRegisterBeginRoute(s),
+		auth.PostPasskeyRegisterFinishRoute(s),
 		auth.PostRefreshRoute(s),
 		auth.PostRegisterRoute(s),
+		auth.PostRevokeOtherSessionsRoute(s),
+		auth.PostTwoFactorConfirmRoute(s),
+		auth.PostTwoFactorDisableRoute(s),
+		auth.PostTwoFactorEnrollRoute(s),
+		auth.PostTwoFactorVerifyRoute(s),
 		common.GetHealthyRoute(s),
 		common.GetReadyRoute(s),
 		common.GetSwaggerRoute(s),
 		common.GetVersionRoute(s),
+		notifications.GetNotificationPreferencesRoute(s),
+		notifications.PutNotificationPreferencesRoute(s),
+		push.DeletePushTopicRoute(s),
+		push.GetPushTopicsRoute(s),
+		push.PutPushTopicRoute(s),
 		push.PutUpdatePushTokenRoute(s),
 		wellknown.GetAndroidDigitalAssetLinksRoute(s),
 		wellknown.GetAppleAppSiteAssociationRoute(s),
+		wellknown.GetJWKSRoute(s),
 	}
 }
@@ -0,0 +1,30 @@
+package notifications
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetNotificationPreferencesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Notifications.GET("/preferences", getNotificationPreferencesHandler(s))
+}
+
+func getNotificationPreferencesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		preferences, err := s.Local.GetNotificationPreferences(ctx, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get notification preferences")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, preferences.ToTypes())
+	}
+}
@@ -0,0 +1,70 @@
+package notifications
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PutNotificationPreferencesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Notifications.PUT("/preferences", putNotificationPreferencesHandler(s))
+}
+
+func putNotificationPreferencesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		var body types.PutNotificationPreferencesPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		request := dto.UpdateNotificationPreferencesRequest{
+			UserID:     user.ID,
+			TimeZone:   swag.StringValue(body.TimeZone),
+			Categories: make([]dto.NotificationCategoryPreference, 0, len(body.Categories)),
+		}
+
+		if body.QuietHours != nil {
+			start, err := notification.ParseClock(swag.StringValue(body.QuietHours.Start))
+			if err != nil {
+				log.Debug().Err(err).Msg("Invalid start of quiet hours")
+				return httperrors.ErrBadRequestInvalidQuietHours
+			}
+
+			end, err := notification.ParseClock(swag.StringValue(body.QuietHours.End))
+			if err != nil {
+				log.Debug().Err(err).Msg("Invalid end of quiet hours")
+				return httperrors.ErrBadRequestInvalidQuietHours
+			}
+
+			request.QuietHours = &notification.QuietHours{Start: start, End: end}
+		}
+
+		for _, preference := range body.Categories {
+			request.Categories = append(request.Categories, dto.NotificationCategoryPreference{
+				Category: notification.Category(*preference.Category),
+				Push:     swag.BoolValue(preference.Push),
+				Email:    swag.BoolValue(preference.Email),
+			})
+		}
+
+		preferences, err := s.Local.UpdateNotificationPreferences(ctx, request)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to update notification preferences")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, preferences.ToTypes())
+	}
+}
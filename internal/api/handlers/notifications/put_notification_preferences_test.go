@@ -0,0 +1,183 @@
+package notifications_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func categoryPreferences(t *testing.T, preferences types.NotificationPreferences) map[types.NotificationCategory][2]bool {
+	t.Helper()
+
+	result := make(map[types.NotificationCategory][2]bool)
+	for _, preference := range preferences.Categories {
+		result[*preference.Category] = [2]bool{swag.BoolValue(preference.Push), swag.BoolValue(preference.Email)}
+	}
+
+	return result
+}
+
+func TestPutNotificationPreferencesSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/notifications/preferences", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// users without preferences get the category defaults
+		var response types.NotificationPreferences
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, "UTC", swag.StringValue(response.TimeZone))
+		assert.Nil(t, response.QuietHours)
+		assert.Equal(t, map[types.NotificationCategory][2]bool{
+			types.NotificationCategoryAccount:   {true, true},
+			types.NotificationCategoryMarketing: {false, false},
+			types.NotificationCategoryUpdates:   {true, true},
+		}, categoryPreferences(t, response))
+
+		payload := test.GenericPayload{
+			"timeZone": "Europe/Vienna",
+			"quietHours": test.GenericPayload{
+				"start": "22:00",
+				"end":   "07:00",
+			},
+			"categories": []test.GenericPayload{
+				{"category": "marketing", "push": true, "email": false},
+				{"category": "updates", "push": true, "email": false},
+			},
+		}
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/notifications/preferences", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, "Europe/Vienna", swag.StringValue(response.TimeZone))
+		require.NotNil(t, response.QuietHours)
+		assert.Equal(t, "22:00", swag.StringValue(response.QuietHours.Start))
+		assert.Equal(t, "07:00", swag.StringValue(response.QuietHours.End))
+		assert.Equal(t, map[types.NotificationCategory][2]bool{
+			types.NotificationCategoryAccount:   {true, true},
+			types.NotificationCategoryMarketing: {true, false},
+			types.NotificationCategoryUpdates:   {true, false},
+		}, categoryPreferences(t, response))
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Vienna", profile.TimeZone)
+		assert.Equal(t, null.IntFrom(22*60), profile.QuietHoursStart)
+		assert.Equal(t, null.IntFrom(7*60), profile.QuietHoursEnd)
+
+		// omitted quiet hours are disabled, omitted categories are kept
+		payload = test.GenericPayload{
+			"timeZone": "Europe/Vienna",
+			"categories": []test.GenericPayload{
+				{"category": "updates", "push": false, "email": true},
+			},
+		}
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/notifications/preferences", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/notifications/preferences", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		response = types.NotificationPreferences{}
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Nil(t, response.QuietHours)
+		assert.Equal(t, map[types.NotificationCategory][2]bool{
+			types.NotificationCategoryAccount:   {true, true},
+			types.NotificationCategoryMarketing: {true, false},
+			types.NotificationCategoryUpdates:   {false, true},
+		}, categoryPreferences(t, response))
+
+		// preferences are per user
+		count, err := models.NotificationPreferences(models.NotificationPreferenceWhere.UserID.EQ(fix.User2.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestPutNotificationPreferencesBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		tests := []struct {
+			name    string
+			payload test.GenericPayload
+			err     *httperrors.HTTPError
+		}{
+			{
+				name:    "unknown time zone",
+				payload: test.GenericPayload{"timeZone": "Europe/Atlantis"},
+				err:     httperrors.ErrBadRequestInvalidTimeZone,
+			},
+			{
+				name:    "server time zone",
+				payload: test.GenericPayload{"timeZone": "Local"},
+				err:     httperrors.ErrBadRequestInvalidTimeZone,
+			},
+			{
+				name: "empty quiet hours",
+				payload: test.GenericPayload{
+					"timeZone":   "UTC",
+					"quietHours": test.GenericPayload{"start": "22:00", "end": "22:00"},
+				},
+				err: httperrors.ErrBadRequestInvalidQuietHours,
+			},
+			{
+				name: "mandatory category",
+				payload: test.GenericPayload{
+					"timeZone": "UTC",
+					"categories": []test.GenericPayload{
+						{"category": "account", "push": false, "email": true},
+					},
+				},
+				err: httperrors.ErrBadRequestNotificationCategoryMandatory,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := test.PerformRequest(t, s, "PUT", "/api/v1/notifications/preferences", tt.payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, tt.err)
+			})
+		}
+
+		// invalid formats are rejected by the spec
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/notifications/preferences", test.GenericPayload{
+			"timeZone":   "UTC",
+			"quietHours": test.GenericPayload{"start": "10pm", "end": "07:00"},
+		}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", profile.TimeZone)
+
+		count, err := models.NotificationPreferences().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestPutNotificationPreferencesUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/notifications/preferences", nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/notifications/preferences", test.GenericPayload{"timeZone": "UTC"}, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,35 @@
+package push
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	pushTypes "allaboutapps.dev/aw/go-starter/internal/types/push"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeletePushTopicRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Push.DELETE("/topics/:topic", deletePushTopicHandler(s))
+}
+
+func deletePushTopicHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		params := pushTypes.NewDeletePushTopicRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if err := s.Local.UnsubscribePushTopic(ctx, user.ID, params.Topic); err != nil {
+			log.Debug().Err(err).Msg("Failed to unsubscribe from push topic")
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,30 @@
+package push
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPushTopicsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Push.GET("/topics", getPushTopicsHandler(s))
+}
+
+func getPushTopicsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromEchoContext(c)
+		log := util.LogFromContext(ctx)
+
+		topics, err := s.Local.GetPushTopics(ctx, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get push topics")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, topics.ToTypes())
+	}
+}
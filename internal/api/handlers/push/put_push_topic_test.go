@@ -0,0 +1,79 @@
+package push_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutPushTopicSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/push/topics", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetPushTopicsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Empty(t, response.Topics)
+
+		for _, topic := range []string{"offers", "news", "news"} {
+			res = test.PerformRequest(t, s, "PUT", "/api/v1/push/topics/"+topic, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+			require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		}
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/topics", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, []string{"news", "offers"}, response.Topics)
+
+		// subscriptions are per user
+		count, err := models.PushTopicSubscriptions(models.PushTopicSubscriptionWhere.UserID.EQ(fix.User2.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+
+		for range 2 {
+			res = test.PerformRequest(t, s, "DELETE", "/api/v1/push/topics/news", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+			require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		}
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/topics", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, []string{"offers"}, response.Topics)
+	})
+}
+
+func TestPutPushTopicBadRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		for _, topic := range []string{"no%20spaces", strings.Repeat("a", 101)} {
+			res := test.PerformRequest(t, s, "PUT", "/api/v1/push/topics/"+topic, nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+			assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+		}
+
+		count, err := models.PushTopicSubscriptions().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestPutPushTopicUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/push/topics/news", nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
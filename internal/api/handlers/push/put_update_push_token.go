@@ -30,9 +30,11 @@ func putUpdatePushTokenHandler(s *api.Server) echo.HandlerFunc {
 
 		err := s.Local.UpdatePushToken(ctx, dto.UpdatePushTokenRequest{
 			User:          *user,
+			AccessToken:   *auth.AccessTokenFromEchoContext(c),
 			Token:         swag.StringValue(body.NewToken),
 			Provider:      swag.StringValue(body.Provider),
 			ExistingToken: null.StringFromPtr(body.OldToken),
+			Locale:        null.StringFromPtr(body.Locale),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to update push token")
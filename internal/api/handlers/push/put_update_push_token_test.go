@@ -10,6 +10,7 @@ import (
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,7 @@ func TestPutUpdatePushTokenSuccess(t *testing.T) {
 		payload := test.GenericPayload{
 			"newToken": testToken,
 			"provider": testProvider,
+			"locale":   "de-AT",
 		}
 
 		res := test.PerformRequest(t, s, "PUT", "/api/v1/push/token", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
@@ -38,6 +40,7 @@ func TestPutUpdatePushTokenSuccess(t *testing.T) {
 		assert.Equal(t, testToken, newToken.Token)
 		assert.Equal(t, testProvider, newToken.Provider)
 		assert.Equal(t, fix.User1.ID, newToken.UserID)
+		assert.Equal(t, null.StringFrom("de-AT"), newToken.Locale)
 	})
 }
 
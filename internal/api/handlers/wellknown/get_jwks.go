@@ -0,0 +1,36 @@
+package wellknown
+
+import (
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetJWKSRoute(s *api.Server) *echo.Route {
+	return s.Router.WellKnown.GET("/jwks.json", getJWKSHandler(s))
+}
+
+func getJWKSHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		jwks, err := s.Auth.GetJSONWebKeySet(ctx)
+		if err != nil {
+			if errors.Is(err, auth.ErrNotFound) {
+				return echo.ErrNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to get JSON web key set")
+			return err
+		}
+
+		c.Response().Header().Set("Cache-Control", "public, max-age=0, must-revalidate")
+
+		return util.ValidateAndReturn(c, http.StatusOK, jwks.ToTypes())
+	}
+}
@@ -0,0 +1,49 @@
+package wellknown_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJWKS(t *testing.T) {
+	config := test.DefaultServiceConfigWithJWTAccessTokens(test.JWTTestKeyIDEd25519)
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/.well-known/jwks.json", nil, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetJWKSResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// all keys are published, not only the current signing key, so tokens stay verifiable during rotation
+		require.Len(t, response.Keys, 2)
+
+		assert.Equal(t, test.JWTTestKeyIDEd25519, *response.Keys[0].Kid)
+		assert.Equal(t, types.JSONWebKeyKtyOKP, *response.Keys[0].Kty)
+		assert.Equal(t, types.JSONWebKeyAlgEdDSA, *response.Keys[0].Alg)
+		assert.Equal(t, "sig", *response.Keys[0].Use)
+		assert.Equal(t, "Ed25519", response.Keys[0].Crv)
+		assert.NotEmpty(t, response.Keys[0].X)
+
+		assert.Equal(t, test.JWTTestKeyIDRSA, *response.Keys[1].Kid)
+		assert.Equal(t, types.JSONWebKeyKtyRSA, *response.Keys[1].Kty)
+		assert.Equal(t, types.JSONWebKeyAlgRS256, *response.Keys[1].Alg)
+		assert.NotEmpty(t, response.Keys[1].N)
+		assert.Equal(t, "AQAB", response.Keys[1].E)
+	})
+}
+
+func TestGetJWKSNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/.well-known/jwks.json", nil, nil)
+		test.RequireHTTPError(t, res, httperrors.NewFromEcho(echo.ErrNotFound))
+	})
+}
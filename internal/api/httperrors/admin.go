@@ -0,0 +1,16 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+)
+
+var (
+	ErrNotFoundUserNotFound          = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeUSERNOTFOUND, "User not found")
+	ErrBadRequestRoleNotFound        = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeROLENOTFOUND, "Role not found")
+	ErrBadRequestInvalidCursor       = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDCURSOR, "Invalid pagination cursor")
+	ErrForbiddenNotImpersonatable    = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeUSERNOTIMPERSONATABLE, "User cannot be impersonated")
+	ErrNotFoundInviteNotFound        = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeINVITENOTFOUND, "Invite not found")
+	ErrBadRequestInvalidInviteExpiry = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDINVITEEXPIRY, "Invite expiry has to be in the future")
+)
@@ -4,13 +4,47 @@ import (
 	"net/http"
 
 	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/go-openapi/swag"
 )
 
 var (
-	ErrForbiddenUserDeactivated  = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeUSERDEACTIVATED, "User account is deactivated")
-	ErrBadRequestInvalidPassword = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDPASSWORD, "The password provided was invalid", "Password was either too weak or did not match other criteria")
-	ErrForbiddenNotLocalUser     = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeNOTLOCALUSER, "User account is not valid for local authentication")
-	ErrNotFoundTokenNotFound     = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeTOKENNOTFOUND, "Provided token was not found")
-	ErrConflictTokenExpired      = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeTOKENEXPIRED, "Provided token has expired and is no longer valid")
-	ErrConflictUserAlreadyExists = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeUSERALREADYEXISTS, "User with given username already exists")
+	ErrForbiddenUserDeactivated       = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeUSERDEACTIVATED, "User account is deactivated")
+	ErrBadRequestInvalidPassword      = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDPASSWORD, "The password provided was invalid", "Password was either too weak or did not match other criteria")
+	ErrForbiddenNotLocalUser          = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeNOTLOCALUSER, "User account is not valid for local authentication")
+	ErrNotFoundTokenNotFound          = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeTOKENNOTFOUND, "Provided token was not found")
+	ErrConflictTokenExpired           = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeTOKENEXPIRED, "Provided token has expired and is no longer valid")
+	ErrConflictUserAlreadyExists      = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeUSERALREADYEXISTS, "User with given username already exists")
+	ErrConflictTOTPAlreadyEnabled     = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeTOTPALREADYENABLED, "Two-factor authentication is already enabled")
+	ErrConflictTOTPNotEnabled         = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeTOTPNOTENABLED, "Two-factor authentication is not enabled")
+	ErrUnauthorizedInvalidTOTPCode    = NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeINVALIDTOTPCODE, "The provided two-factor authentication code is invalid")
+	ErrTooManyRequestsTooManyAttempts = NewHTTPError(http.StatusTooManyRequests, types.PublicHTTPErrorTypeTOOMANYATTEMPTS, "Too many failed attempts, please try again later")
+	ErrNotFoundOIDCProviderNotFound   = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeOIDCPROVIDERNOTFOUND, "OpenID Connect provider not found")
+	ErrUnauthorizedOIDCAuthFailed     = NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeOIDCAUTHENTICATIONFAILED, "Authentication with OpenID Connect provider failed")
+	ErrNotFoundSessionNotFound        = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeSESSIONNOTFOUND, "Session not found")
+	ErrForbiddenPasswordResetRequired = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypePASSWORDRESETREQUIRED, "Password has to be reset before logging in")
+	ErrNotFoundAPIKeyNotFound         = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeAPIKEYNOTFOUND, "API key not found")
+	ErrBadRequestInvalidAPIKeyScopes  = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDAPIKEYSCOPES, "The scopes provided for the API key are invalid", "API keys can only be granted scopes assigned to the user")
+	ErrBadRequestInvalidAPIKeyExpiry  = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDAPIKEYEXPIRY, "API key expiry has to be in the future")
+	ErrNotFoundPasskeyNotFound        = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypePASSKEYNOTFOUND, "Passkey not found")
+	ErrConflictPasskeyRegistered      = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypePASSKEYALREADYREGISTERED, "Passkey is already registered")
+	ErrBadRequestInvalidPasskey       = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDPASSKEY, "The provided passkey credential is invalid")
+	ErrForbiddenUserMissingEmail      = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeUSERMISSINGEMAIL, "User account has no email address")
+	ErrForbiddenImpersonation         = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeIMPERSONATIONFORBIDDEN, "Action is not available while impersonating a user")
+	ErrForbiddenInviteRequired        = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeINVITEREQUIRED, "Registration requires an invite")
+	ErrForbiddenInviteEmailMismatch   = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeINVITEEMAILMISMATCH, "Username does not match the invited email address")
 )
+
+// NewHTTPValidationErrorInvalidPassword returns an INVALID_PASSWORD error listing the messages of all
+// password policy rules violated by the password provided in the body field key.
+func NewHTTPValidationErrorInvalidPassword(key string, violations []string) *HTTPValidationError {
+	valErrs := make([]*types.HTTPValidationErrorDetail, 0, len(violations))
+	for _, violation := range violations {
+		valErrs = append(valErrs, &types.HTTPValidationErrorDetail{
+			Key:   swag.String(key),
+			In:    swag.String("body"),
+			Error: swag.String(violation),
+		})
+	}
+
+	return NewHTTPValidationErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDPASSWORD, *ErrBadRequestInvalidPassword.Title, valErrs, ErrBadRequestInvalidPassword.Detail)
+}
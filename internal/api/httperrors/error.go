@@ -18,6 +18,7 @@ type HTTPError struct {
 	types.PublicHTTPError
 	Internal       error                  `json:"-"`
 	AdditionalData map[string]interface{} `json:"-"`
+	Header         http.Header            `json:"-"` // additional response headers, set by the HTTP error handler
 }
 
 type HTTPValidationError struct {
@@ -51,6 +52,19 @@ func NewFromEcho(e *echo.HTTPError) *HTTPError {
 	return NewHTTPError(e.Code, types.PublicHTTPErrorTypeGeneric, http.StatusText(e.Code))
 }
 
+// WithHeader returns a copy of the error with the response header set, leaving the (usually
+// package level) original error untouched.
+func (e *HTTPError) WithHeader(key string, value string) *HTTPError {
+	err := *e
+	err.Header = e.Header.Clone()
+	if err.Header == nil {
+		err.Header = http.Header{}
+	}
+	err.Header.Set(key, value)
+
+	return &err
+}
+
 func (e *HTTPError) Error() string {
 	var builder strings.Builder
 
@@ -78,3 +78,18 @@ func TestHTTPValidationErrorAdditionalData(t *testing.T) {
 
 	require.Equal(t, "HTTPValidationError 400 (generic): Bad Request. Additional: key1=value1, key2=value2 - Validation: test1 (in body.test1): ValidationError, test2 (in body.test2): Validation Error", err.Error())
 }
+
+func TestHTTPErrorWithHeader(t *testing.T) {
+	err := httperrors.NewHTTPError(http.StatusTooManyRequests, types.PublicHTTPErrorTypeTOOMANYATTEMPTS, http.StatusText(http.StatusTooManyRequests))
+
+	errWithHeader := err.WithHeader("Retry-After", "60")
+	require.Equal(t, "60", errWithHeader.Header.Get("Retry-After"))
+	require.Equal(t, *err.Code, *errWithHeader.Code)
+
+	// the original error is left untouched
+	require.Nil(t, err.Header)
+
+	errWithHeader2 := errWithHeader.WithHeader("Retry-After", "30")
+	require.Equal(t, "30", errWithHeader2.Header.Get("Retry-After"))
+	require.Equal(t, "60", errWithHeader.Header.Get("Retry-After"))
+}
@@ -0,0 +1,13 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+)
+
+var (
+	ErrBadRequestInvalidTimeZone               = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDTIMEZONE, "Unknown time zone")
+	ErrBadRequestInvalidQuietHours             = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDQUIETHOURS, "Quiet hours have to start and end at different times")
+	ErrBadRequestNotificationCategoryMandatory = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeNOTIFICATIONCATEGORYMANDATORY, "Mandatory notification categories cannot be disabled")
+)
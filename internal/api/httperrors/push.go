@@ -9,4 +9,7 @@ import (
 var (
 	ErrConflictPushToken    = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypePUSHTOKENALREADYEXISTS, "The given token already exists.")
 	ErrNotFoundOldPushToken = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeOLDPUSHTOKENNOTFOUND, "The old push token does not exists. The new token was saved.")
+
+	ErrNotFoundPushBroadcastNotFound          = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypePUSHBROADCASTNOTFOUND, "Push broadcast not found")
+	ErrBadRequestInvalidPushBroadcastSchedule = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDPUSHBROADCASTSCHEDULE, "Push broadcast has to be scheduled in the future")
 )
@@ -0,0 +1,11 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+)
+
+var (
+	ErrTooManyRequestsRateLimitExceeded = NewHTTPError(http.StatusTooManyRequests, types.PublicHTTPErrorTypeRATELIMITEXCEEDED, "Rate limit exceeded, please try again later")
+)
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
+	cfg "allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/data/dto"
 	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
 	"allaboutapps.dev/aw/go-starter/internal/models"
@@ -163,6 +165,7 @@ func DefaultAuthTokenValidator(c echo.Context, config AuthConfig, token string)
 	accessToken, err := models.AccessTokens(
 		models.AccessTokenWhere.Token.EQ(token),
 		qm.Load(qm.Rels(models.AccessTokenRels.User, models.UserRels.AppUserProfile)),
+		qm.Load(models.AccessTokenRels.Impersonator),
 	).One(c.Request().Context(), config.S.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -174,24 +177,76 @@ func DefaultAuthTokenValidator(c echo.Context, config AuthConfig, token string)
 		return auth.Result{}, echo.ErrInternalServerError
 	}
 
-	return auth.Result{
-		Token:      accessToken.Token,
-		User:       mapper.LocalUserToDTO(accessToken.R.User).Ptr(),
-		ValidUntil: accessToken.ValidUntil,
-	}, nil
+	permissions, err := config.S.Auth.GetPermissions(c.Request().Context(), accessToken.R.User.Scopes)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to query for permissions of user in database, aborting request")
+		return auth.Result{}, echo.ErrInternalServerError
+	}
+
+	res := auth.Result{
+		Token:       accessToken.Token,
+		User:        mapper.LocalUserToDTO(accessToken.R.User).Ptr(),
+		ValidUntil:  accessToken.ValidUntil,
+		Permissions: permissions,
+	}
+
+	if accessToken.R.Impersonator != nil {
+		res.Impersonator = mapper.LocalUserToDTO(accessToken.R.Impersonator).Ptr()
+	}
+
+	return res, nil
+}
+
+// JWTAuthTokenFormatValidator checks the token consists of the three parts of a JWS in compact serialization.
+func JWTAuthTokenFormatValidator(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// JWTAuthTokenValidator verifies a signed JWT access token without any database lookup.
+func JWTAuthTokenValidator(c echo.Context, config AuthConfig, token string) (auth.Result, error) {
+	res, err := config.S.Auth.ValidateJWTAccessToken(c.Request().Context(), token)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return auth.Result{}, ErrAuthTokenValidationFailed
+		}
+
+		log.Error().Err(err).Msg("Failed to validate JWT access token, aborting request")
+		return auth.Result{}, echo.ErrInternalServerError
+	}
+
+	return res, nil
+}
+
+// APIKeyAuthTokenFormatValidator checks the API key consists of its prefix and secret.
+func APIKeyAuthTokenFormatValidator(token string) bool {
+	return strings.Count(token, ".") == 1
+}
+
+// APIKeyAuthTokenValidator looks up the API key in the api_keys table and verifies its secret.
+func APIKeyAuthTokenValidator(c echo.Context, config AuthConfig, token string) (auth.Result, error) {
+	res, err := config.S.Auth.ValidateAPIKey(c.Request().Context(), token)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return auth.Result{}, ErrAuthTokenValidationFailed
+		}
+
+		log.Error().Err(err).Msg("Failed to validate API key, aborting request")
+		return auth.Result{}, echo.ErrInternalServerError
+	}
+
+	return res, nil
 }
 
 var (
 	DefaultAuthConfig = AuthConfig{
-		Mode:            AuthModeRequired,
-		FailureMode:     AuthFailureModeUnauthorized,
-		TokenSource:     AuthTokenSourceHeader,
-		TokenSourceKey:  echo.HeaderAuthorization,
-		Scheme:          "Bearer",
-		Skipper:         middleware.DefaultSkipper,
-		FormatValidator: DefaultAuthTokenFormatValidator,
-		TokenValidator:  DefaultAuthTokenValidator,
-		Scopes:          []string{auth.ScopeApp.String()},
+		Mode:           AuthModeRequired,
+		FailureMode:    AuthFailureModeUnauthorized,
+		TokenSource:    AuthTokenSourceHeader,
+		TokenSourceKey: echo.HeaderAuthorization,
+		Scheme:         "Bearer",
+		APIKeyScheme:   "APIKey",
+		Skipper:        middleware.DefaultSkipper,
+		Scopes:         []string{auth.ScopeApp.String()},
 	}
 )
 
@@ -202,9 +257,11 @@ type AuthConfig struct {
 	TokenSource     AuthTokenSource          // Sets source of auth token (default: AuthTokenSourceHeader)
 	TokenSourceKey  string                   // Sets key for auth token source lookup (default: "Authorization")
 	Scheme          string                   // Sets required token scheme (default: "Bearer")
+	APIKeyScheme    string                   // Sets token scheme of API keys accepted alongside tokens using Scheme, API keys are rejected if empty (default: "APIKey" for Auth, none for AuthWithConfig)
+	APIKeyValidator AuthTokenValidator       // Validates API key retrieved and returns associated user (default: performs lookup in api_keys table)
 	Skipper         middleware.Skipper       // Controls skipping of certain routes (default: no skipped routes)
-	FormatValidator AuthTokenFormatValidator // Validates the format of the token retrieved
-	TokenValidator  AuthTokenValidator       // Validates token retrieved and returns associated user (default: performs lookup in access_tokens table)
+	FormatValidator AuthTokenFormatValidator // Validates the format of the token retrieved (default: depends on SERVER_AUTH_ACCESS_TOKEN_FORMAT)
+	TokenValidator  AuthTokenValidator       // Validates token retrieved and returns associated user (default: performs lookup in access_tokens table or verifies JWT, depending on SERVER_AUTH_ACCESS_TOKEN_FORMAT)
 	Scopes          []string                 // List of scopes required to access endpoint (default: none required)
 }
 
@@ -220,6 +277,12 @@ func (c AuthConfig) CheckLastAuthenticatedAt(user *dto.User) bool {
 	return time.Since(user.LastAuthenticatedAt.Time).Seconds() <= c.S.Config.Auth.LastAuthenticatedAtThreshold.Seconds()
 }
 
+// CheckImpersonation rejects impersonated requests to routes requiring AuthModeSecure, as the impersonator
+// cannot re-confirm the authentication of the impersonated user.
+func (c AuthConfig) CheckImpersonation(impersonator *dto.User) bool {
+	return c.Mode != AuthModeSecure || impersonator == nil
+}
+
 func (c AuthConfig) CheckUserScopes(user *dto.User) bool {
 	if len(c.Scopes) == 0 {
 		return true
@@ -263,12 +326,26 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 		config.Skipper = DefaultAuthConfig.Skipper
 	}
 
+	jwtAccessTokens := config.S.Config.Auth.AccessTokenFormat == cfg.AccessTokenFormatJWT
+
 	if config.FormatValidator == nil {
-		config.FormatValidator = DefaultAuthConfig.FormatValidator
+		if jwtAccessTokens {
+			config.FormatValidator = JWTAuthTokenFormatValidator
+		} else {
+			config.FormatValidator = DefaultAuthTokenFormatValidator
+		}
 	}
 
 	if config.TokenValidator == nil {
-		config.TokenValidator = DefaultAuthConfig.TokenValidator
+		if jwtAccessTokens {
+			config.TokenValidator = JWTAuthTokenValidator
+		} else {
+			config.TokenValidator = DefaultAuthTokenValidator
+		}
+	}
+
+	if config.APIKeyValidator == nil {
+		config.APIKeyValidator = APIKeyAuthTokenValidator
 	}
 
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -287,6 +364,11 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 
 			user := auth.UserFromEchoContext(c)
 			if user != nil {
+				if !config.CheckImpersonation(auth.ImpersonatorFromEchoContext(c)) {
+					log.Trace().Msg("Authentication already performed, but user is being impersonated, rejecting request")
+					return httperrors.ErrForbiddenImpersonation
+				}
+
 				if !config.CheckLastAuthenticatedAt(user) {
 					log.Trace().
 						Time("last_authenticated_at", user.LastAuthenticatedAt.Time).
@@ -307,7 +389,21 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 				return next(c)
 			}
 
+			formatValidator := config.FormatValidator
+			tokenValidator := config.TokenValidator
+			usesAPIKey := false
+
 			token, exists := config.TokenSource.Extract(c, config.TokenSourceKey, config.Scheme)
+			if len(token) == 0 && exists && len(config.APIKeyScheme) > 0 {
+				if apiKey, _ := config.TokenSource.Extract(c, config.TokenSourceKey, config.APIKeyScheme); len(apiKey) > 0 {
+					log.Trace().Msg("Request uses API key instead of token")
+					token = apiKey
+					formatValidator = APIKeyAuthTokenFormatValidator
+					tokenValidator = config.APIKeyValidator
+					usesAPIKey = true
+				}
+			}
+
 			if len(token) == 0 {
 				if config.Mode == AuthModeRequired || config.Mode == AuthModeSecure || (exists && config.Mode == AuthModeOptional) {
 					log.Trace().Bool("token_exists", exists).Msg("Request has missing or malformed token, rejecting")
@@ -318,7 +414,7 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 				return next(c)
 			}
 
-			if !config.FormatValidator(token) {
+			if !formatValidator(token) {
 				if config.Mode == AuthModeRequired || config.Mode == AuthModeSecure || config.Mode == AuthModeOptional {
 					log.Trace().Msg("Request has malformed token, rejecting")
 					return ErrBadRequestMalformedToken
@@ -328,7 +424,7 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 				return next(c)
 			}
 
-			res, err := config.TokenValidator(c, config, token)
+			res, err := tokenValidator(c, config, token)
 			if err != nil {
 				if errors.Is(err, ErrAuthTokenValidationFailed) {
 					if config.Mode == AuthModeTry {
@@ -364,6 +460,17 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 				return httperrors.ErrForbiddenUserDeactivated
 			}
 
+			// sessions are revoked once a password reset is required, API keys are rejected until it has been reset
+			if usesAPIKey && user.PasswordResetRequired {
+				log.Trace().Str("user_id", user.ID).Msg("User has to reset password before using API keys, rejecting request")
+				return httperrors.ErrForbiddenPasswordResetRequired
+			}
+
+			if !config.CheckImpersonation(res.Impersonator) {
+				log.Trace().Str("user_id", user.ID).Str("impersonator_id", res.Impersonator.ID).Msg("User is being impersonated, rejecting request")
+				return httperrors.ErrForbiddenImpersonation
+			}
+
 			if !config.CheckLastAuthenticatedAt(user) {
 				log.Trace().
 					Time("last_authenticated_at", user.LastAuthenticatedAt.Time).
@@ -382,6 +489,14 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 
 			auth.EnrichEchoContextWithCredentials(c, res)
 
+			// impersonated requests are always logged, regardless of the request logger's configuration
+			if res.Impersonator != nil {
+				util.LogFromEchoContext(c).Info().
+					Str("method", c.Request().Method).
+					Str("path", c.Path()).
+					Msg("Processing impersonated request")
+			}
+
 			log.Trace().Str("user_id", user.ID).Msg("Auth token is valid, allowing request")
 
 			return next(c)
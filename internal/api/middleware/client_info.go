@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// ClientInfo stores the IP address and user agent of the requesting client in the request's context,
+// retrievable via util.ClientInfoFromContext (e.g. to attribute audit events).
+func ClientInfo() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			c.SetRequest(req.WithContext(util.WithClientInfo(req.Context(), util.ClientInfo{
+				IPAddress: c.RealIP(),
+				UserAgent: req.UserAgent(),
+			})))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// DenyImpersonation rejects requests authenticated by impersonation tokens, protecting sensitive routes (e.g. password
+// change or account deletion) from support staff impersonating users. Must be used after the auth middleware.
+func DenyImpersonation() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if impersonator := auth.ImpersonatorFromEchoContext(c); impersonator != nil {
+				util.LogFromEchoContext(c).Trace().
+					Str("middleware", "impersonation").
+					Str("impersonator_id", impersonator.ID).
+					Msg("User is being impersonated, rejecting request")
+				return httperrors.ErrForbiddenImpersonation
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrForbiddenMissingPermission = httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeMISSINGPERMISSION, "User is missing required permission")
+)
+
+// RequirePermission rejects requests of users not granted the given permission by their roles. Must be used
+// after the auth middleware, which stores the user's permissions in the request context.
+func RequirePermission(permission auth.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			log := util.LogFromEchoContext(c).With().Str("middleware", "permission").Str("permission", permission.String()).Logger()
+
+			if auth.UserFromEchoContext(c) == nil {
+				log.Trace().Msg("Request is not authenticated, rejecting")
+				return echo.ErrUnauthorized
+			}
+
+			permissions := auth.PermissionsFromEchoContext(c)
+			if !permissions.Has(permission) {
+				log.Trace().Strs("user_permissions", permissions).Msg("User does not have required permission, rejecting request")
+				return ErrForbiddenMissingPermission
+			}
+
+			return next(c)
+		}
+	}
+}
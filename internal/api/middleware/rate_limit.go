@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+)
+
+// RateLimitKeyFunc returns the key requests are rate limited by, e.g. the client IP.
+type RateLimitKeyFunc func(c echo.Context) string
+
+// RateLimitRate allows up to Requests requests per Period. Tokens are refilled continuously, so a
+// client may burst up to Requests requests after being idle for Period.
+type RateLimitRate struct {
+	Requests int
+	Period   time.Duration
+}
+
+// RateLimitResult describes the state of a token bucket after a request has been taken from it.
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // time until the bucket is completely refilled
+	RetryAfter time.Duration // time until the next request will be allowed, only set if not allowed
+}
+
+// RateLimitStore keeps the token buckets of all keys.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, rate RateLimitRate, now time.Time) (RateLimitResult, error)
+}
+
+// RateLimitConfig defines the config for the rate limit middleware.
+type RateLimitConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper middleware.Skipper
+	// Store keeps the token buckets, required.
+	Store RateLimitStore
+	// Rate defines the number of requests allowed per key, required.
+	Rate RateLimitRate
+	// KeyFunc returns the key to limit requests by, defaults to the client IP.
+	KeyFunc RateLimitKeyFunc
+	// KeyPrefix is prepended to all keys, allowing multiple groups to share a store without sharing limits.
+	KeyPrefix string
+	// Clock is used to refill the token buckets, defaults to the real time.
+	Clock time2.Clock
+}
+
+var (
+	DefaultRateLimitConfig = RateLimitConfig{
+		Skipper: middleware.DefaultSkipper,
+		KeyFunc: RateLimitKeyIP,
+		Clock:   time2.DefaultClock,
+	}
+)
+
+// RateLimitKeyIP limits requests by the client IP as determined by echo's IPExtractor, configured by
+// config.EchoServer.IPExtractor and TrustedProxies. Headers of untrusted peers are ignored, so clients cannot
+// evade the limit by spoofing X-Forwarded-For.
+func RateLimitKeyIP(c echo.Context) string {
+	return "ip:" + c.RealIP()
+}
+
+// RateLimitKeyUser limits requests by the ID of the authenticated user, falling back to the client IP for
+// unauthenticated requests. The auth middleware must be executed before the rate limit middleware.
+func RateLimitKeyUser(c echo.Context) string {
+	user := auth.UserFromEchoContext(c)
+	if user == nil {
+		return RateLimitKeyIP(c)
+	}
+
+	return "user:" + user.ID
+}
+
+// RateLimitKeyRoute limits requests by API route, shared between all clients.
+func RateLimitKeyRoute(c echo.Context) string {
+	return "route:" + c.Request().Method + " " + c.Path()
+}
+
+// RateLimitKeyFuncFromConfig returns the key func for the configured rate limit key.
+func RateLimitKeyFuncFromConfig(key config.RateLimitKey) RateLimitKeyFunc {
+	switch key {
+	case config.RateLimitKeyUser:
+		return RateLimitKeyUser
+	case config.RateLimitKeyRoute:
+		return RateLimitKeyRoute
+	default:
+		return RateLimitKeyIP
+	}
+}
+
+// RateLimitWithConfig returns a token bucket rate limit middleware with config, rejecting requests
+// exceeding the rate with httperrors.ErrTooManyRequestsRateLimitExceeded. All responses carry the
+// RateLimit-* headers (see https://datatracker.ietf.org/doc/draft-ietf-httpapi-ratelimit-headers/).
+func RateLimitWithConfig(config RateLimitConfig) echo.MiddlewareFunc {
+	if config.Store == nil {
+		panic("rate limit middleware requires a store")
+	}
+	if config.Rate.Requests <= 0 || config.Rate.Period <= 0 {
+		panic("rate limit middleware requires a positive rate")
+	}
+
+	// Defaults
+	if config.Skipper == nil {
+		config.Skipper = DefaultRateLimitConfig.Skipper
+	}
+	if config.KeyFunc == nil {
+		config.KeyFunc = DefaultRateLimitConfig.KeyFunc
+	}
+	if config.Clock == nil {
+		config.Clock = DefaultRateLimitConfig.Clock
+	}
+
+	policy := fmt.Sprintf("%d;w=%d", config.Rate.Requests, int64(config.Rate.Period.Seconds()))
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			log := util.LogFromContext(ctx)
+
+			key := config.KeyPrefix + config.KeyFunc(c)
+			result, err := config.Store.Take(ctx, key, config.Rate, config.Clock.Now())
+			if err != nil {
+				// Rather let requests pass than taking down the API if the store is unavailable
+				log.Err(err).Str("key", key).Msg("Failed to take from rate limit bucket, allowing request")
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(HeaderRateLimitLimit, strconv.Itoa(result.Limit))
+			header.Set(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+			header.Set(HeaderRateLimitReset, formatSeconds(result.Reset))
+			header.Set(HeaderRateLimitPolicy, policy)
+
+			if !result.Allowed {
+				log.Debug().Str("key", key).Dur("retryAfter", result.RetryAfter).Msg("Rate limit exceeded, rejecting request")
+				return httperrors.ErrTooManyRequestsRateLimitExceeded.WithHeader(echo.HeaderRetryAfter, formatSeconds(result.RetryAfter))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// take refills the bucket holding tokens since refilledAt and takes a single token if available.
+func (r RateLimitRate) take(tokens float64, refilledAt time.Time, now time.Time) (float64, RateLimitResult) {
+	capacity := float64(r.Requests)
+
+	if elapsed := now.Sub(refilledAt); elapsed > 0 {
+		tokens += float64(elapsed) / float64(r.Period) * capacity
+	}
+	tokens = min(tokens, capacity)
+
+	result := RateLimitResult{
+		Limit: r.Requests,
+	}
+
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = r.refillDuration(1 - tokens)
+	}
+
+	result.Remaining = int(math.Floor(tokens))
+	result.Reset = r.refillDuration(capacity - tokens)
+
+	return tokens, result
+}
+
+// refillDuration returns the time needed to refill the given amount of tokens.
+func (r RateLimitRate) refillDuration(tokens float64) time.Duration {
+	return time.Duration(math.Round(tokens / float64(r.Requests) * float64(r.Period)))
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
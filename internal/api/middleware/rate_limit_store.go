@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// rateLimitPruneInterval defines how often buckets which have been refilled completely are removed from the stores.
+const rateLimitPruneInterval = time.Minute
+
+type rateLimitMemoryBucket struct {
+	tokens     float64
+	refilledAt time.Time
+	expiresAt  time.Time
+}
+
+// RateLimitMemoryStore keeps token buckets in memory, limits are therefore not shared between multiple instances.
+type RateLimitMemoryStore struct {
+	mu           sync.Mutex
+	buckets      map[string]*rateLimitMemoryBucket
+	lastPrunedAt time.Time
+}
+
+func NewRateLimitMemoryStore() *RateLimitMemoryStore {
+	return &RateLimitMemoryStore{
+		buckets: make(map[string]*rateLimitMemoryBucket),
+	}
+}
+
+func (s *RateLimitMemoryStore) Take(_ context.Context, key string, rate RateLimitRate, now time.Time) (RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastPrunedAt) >= rateLimitPruneInterval {
+		for k, b := range s.buckets {
+			if !b.expiresAt.After(now) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastPrunedAt = now
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &rateLimitMemoryBucket{
+			tokens:     float64(rate.Requests),
+			refilledAt: now,
+		}
+		s.buckets[key] = bucket
+	}
+
+	tokens, result := rate.take(bucket.tokens, bucket.refilledAt, now)
+	bucket.tokens = tokens
+	bucket.refilledAt = now
+	bucket.expiresAt = now.Add(result.Reset)
+
+	return result, nil
+}
+
+// RateLimitPostgresStore keeps token buckets in the rate_limit_buckets table, sharing limits between all instances
+// using the same database.
+type RateLimitPostgresStore struct {
+	db *sql.DB
+
+	pruneMutex   sync.Mutex
+	lastPrunedAt time.Time
+}
+
+func NewRateLimitPostgresStore(db *sql.DB) *RateLimitPostgresStore {
+	return &RateLimitPostgresStore{
+		db: db,
+	}
+}
+
+func (s *RateLimitPostgresStore) Take(ctx context.Context, key string, rate RateLimitRate, now time.Time) (RateLimitResult, error) {
+	log := util.LogFromContext(ctx)
+
+	var result RateLimitResult
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		initial := models.RateLimitBucket{
+			Key:        key,
+			Tokens:     float64(rate.Requests),
+			RefilledAt: now,
+			ExpiresAt:  now,
+		}
+
+		if err := initial.Upsert(ctx, exec, false, []string{models.RateLimitBucketColumns.Key}, boil.None(), boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert rate limit bucket")
+			return err
+		}
+
+		bucket, err := models.RateLimitBuckets(
+			models.RateLimitBucketWhere.Key.EQ(key),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load rate limit bucket")
+			return err
+		}
+
+		bucket.Tokens, result = rate.take(bucket.Tokens, bucket.RefilledAt, now)
+		bucket.RefilledAt = now
+		bucket.ExpiresAt = now.Add(result.Reset)
+
+		if _, err := bucket.Update(ctx, exec, boil.Whitelist(
+			models.RateLimitBucketColumns.Tokens,
+			models.RateLimitBucketColumns.RefilledAt,
+			models.RateLimitBucketColumns.ExpiresAt,
+			models.RateLimitBucketColumns.UpdatedAt,
+		)); err != nil {
+			log.Debug().Err(err).Msg("Failed to update rate limit bucket")
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return RateLimitResult{}, err
+	}
+
+	s.prune(ctx, now)
+
+	return result, nil
+}
+
+// prune removes all buckets which have been refilled completely, at most once per rateLimitPruneInterval per instance.
+func (s *RateLimitPostgresStore) prune(ctx context.Context, now time.Time) {
+	s.pruneMutex.Lock()
+	if now.Sub(s.lastPrunedAt) < rateLimitPruneInterval {
+		s.pruneMutex.Unlock()
+		return
+	}
+	s.lastPrunedAt = now
+	s.pruneMutex.Unlock()
+
+	if _, err := models.RateLimitBuckets(
+		models.RateLimitBucketWhere.ExpiresAt.LTE(now),
+	).DeleteAll(ctx, s.db); err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to prune expired rate limit buckets")
+	}
+}
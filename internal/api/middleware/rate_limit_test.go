@@ -0,0 +1,190 @@
+package middleware_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitMemoryStore(t *testing.T) {
+	testRateLimitStore(t, middleware.NewRateLimitMemoryStore())
+}
+
+func TestRateLimitPostgresStore(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		testRateLimitStore(t, middleware.NewRateLimitPostgresStore(db))
+	})
+}
+
+func testRateLimitStore(t *testing.T, store middleware.RateLimitStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	rate := middleware.RateLimitRate{Requests: 3, Period: 30 * time.Second}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	for i := range rate.Requests {
+		res, err := store.Take(ctx, "key1", rate, now)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, rate.Requests, res.Limit)
+		assert.Equal(t, rate.Requests-i-1, res.Remaining)
+		assert.Equal(t, time.Duration(i+1)*10*time.Second, res.Reset)
+	}
+
+	res, err := store.Take(ctx, "key1", rate, now)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, 10*time.Second, res.RetryAfter)
+
+	// other keys are not affected
+	res, err = store.Take(ctx, "key2", rate, now)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// a single token is refilled every 10 seconds
+	res, err = store.Take(ctx, "key1", rate, now.Add(10*time.Second))
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	res, err = store.Take(ctx, "key1", rate, now.Add(15*time.Second))
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 5*time.Second, res.RetryAfter)
+
+	// the bucket never holds more than the configured requests
+	res, err = store.Take(ctx, "key1", rate, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, rate.Requests-1, res.Remaining)
+}
+
+func TestRateLimitPerGroup(t *testing.T) {
+	for _, store := range []config.RateLimitStore{config.RateLimitStoreMemory, config.RateLimitStorePostgres} {
+		t.Run(store.String(), func(t *testing.T) {
+			cfg := config.DefaultServiceConfigFromEnv()
+			cfg.Echo.EnableRateLimitMiddleware = true
+			cfg.Echo.RateLimitMiddleware.Store = store
+			cfg.Echo.RateLimitMiddleware.APIV1Auth = config.EchoServerRateLimitGroup{
+				Requests: 2,
+				Period:   time.Minute,
+				Key:      config.RateLimitKeyUser,
+			}
+
+			test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+				fix := fixtures.Fixtures()
+
+				for i := range 2 {
+					res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+					require.Equal(t, http.StatusOK, res.Result().StatusCode)
+					assert.Equal(t, "2", res.Header().Get(middleware.HeaderRateLimitLimit))
+					assert.Equal(t, strconv.Itoa(1-i), res.Header().Get(middleware.HeaderRateLimitRemaining))
+					assert.Equal(t, strconv.Itoa((i+1)*30), res.Header().Get(middleware.HeaderRateLimitReset))
+					assert.Equal(t, "2;w=60", res.Header().Get(middleware.HeaderRateLimitPolicy))
+				}
+
+				res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsRateLimitExceeded)
+				assert.Equal(t, "30", res.Header().Get("Retry-After"))
+				assert.Equal(t, "0", res.Header().Get(middleware.HeaderRateLimitRemaining))
+
+				// limits are tracked per user
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+				// other groups are not limited
+				res = test.PerformRequest(t, s, "GET", "/-/healthy", nil, nil)
+				assert.Empty(t, res.Header().Get(middleware.HeaderRateLimitLimit))
+
+				test.SetMockClock(t, s, s.Clock.Now().Add(30*time.Second))
+
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+			})
+		})
+	}
+}
+
+func TestRateLimitKeyIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		ipExtractor    config.IPExtractor
+		trustedProxies []string
+		// headerTrusted is true if X-Forwarded-For determines the rate limit key
+		headerTrusted bool
+	}{
+		{"direct", config.IPExtractorDirect, nil, false},
+		{"untrusted proxy", config.IPExtractorXFF, []string{"10.0.0.0/8"}, false},
+		{"trusted proxy", config.IPExtractorXFF, []string{"192.0.2.0/24"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultServiceConfigFromEnv()
+			cfg.Echo.EnableRateLimitMiddleware = true
+			cfg.Echo.IPExtractor = tt.ipExtractor
+			cfg.Echo.TrustedProxies = tt.trustedProxies
+			cfg.Echo.RateLimitMiddleware.Store = config.RateLimitStoreMemory
+			cfg.Echo.RateLimitMiddleware.APIV1Auth = config.EchoServerRateLimitGroup{
+				Requests: 1,
+				Period:   time.Minute,
+				Key:      config.RateLimitKeyIP,
+			}
+
+			test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+				fix := fixtures.Fixtures()
+
+				// httptest requests originate from 192.0.2.1
+				headers := test.HeadersWithAuth(t, fix.User1AccessToken1.Token)
+				headers.Set(echo.HeaderXForwardedFor, "203.0.113.1")
+				res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, headers)
+				require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+				headers.Set(echo.HeaderXForwardedFor, "203.0.113.2")
+				res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, headers)
+				if tt.headerTrusted {
+					require.Equal(t, http.StatusOK, res.Result().StatusCode)
+				} else {
+					test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsRateLimitExceeded)
+				}
+			})
+		})
+	}
+}
+
+func TestRateLimitKeyIPInvalidCredentials(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Echo.EnableRateLimitMiddleware = true
+	cfg.Echo.RateLimitMiddleware.Store = config.RateLimitStoreMemory
+	cfg.Echo.RateLimitMiddleware.APIV1Auth = config.EchoServerRateLimitGroup{
+		Requests: 1,
+		Period:   time.Minute,
+		Key:      config.RateLimitKeyIP,
+	}
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		headers := test.HeadersWithAuth(t, "25e8630e-9a41-4f38-8339-373f0c203cef")
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, headers)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		// rejected credentials count against the limit
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, headers)
+		test.RequireHTTPError(t, res, httperrors.ErrTooManyRequestsRateLimitExceeded)
+	})
+}
@@ -6,10 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/audit"
 	"allaboutapps.dev/aw/go-starter/internal/auth"
 	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/export"
 	"allaboutapps.dev/aw/go-starter/internal/i18n"
 	"allaboutapps.dev/aw/go-starter/internal/mailer"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
 	"allaboutapps.dev/aw/go-starter/internal/persistence"
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
@@ -22,8 +25,8 @@ import (
 // https://github.com/google/wire/blob/main/docs/guide.md#defining-providers
 
 // NewPush creates an instance of the push service and registers the configured push providers.
-func NewPush(cfg config.Server, db *sql.DB) (*push.Service, error) {
-	pusher := push.New(db)
+func NewPush(cfg config.Server, db *sql.DB, clock time2.Clock) (*push.Service, error) {
+	pusher := push.New(db, clock, cfg.Push.Outbox)
 
 	if cfg.Push.UseFCMProvider {
 		fcmProvider, err := provider.NewFCM(cfg.FCMConfig)
@@ -33,6 +36,14 @@ func NewPush(cfg config.Server, db *sql.DB) (*push.Service, error) {
 		pusher.RegisterProvider(fcmProvider)
 	}
 
+	if cfg.Push.UseAPNSProvider {
+		apnsProvider, err := provider.NewAPNS(cfg.APNSConfig, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create APNs provider: %w", err)
+		}
+		pusher.RegisterProvider(apnsProvider)
+	}
+
 	if cfg.Push.UseMockProvider {
 		log.Warn().Msg("Initializing mock push provider")
 		mockProvider := provider.NewMock(push.ProviderTypeFCM)
@@ -46,6 +57,15 @@ func NewPush(cfg config.Server, db *sql.DB) (*push.Service, error) {
 	return pusher, nil
 }
 
+// NewExport creates an instance of the data export service, register the exporters of your domain packages here.
+func NewExport(cfg config.Server, db *sql.DB, clock time2.Clock, mail *mailer.Mailer) *export.Service {
+	service := export.New(cfg, db, clock, mail)
+	service.RegisterExporter(push.TopicSubscriptionExporter{})
+	service.RegisterExporter(notification.PreferenceExporter{})
+
+	return service
+}
+
 func NewClock(t ...*testing.T) time2.Clock {
 	var clock time2.Clock
 
@@ -60,12 +80,16 @@ func NewClock(t ...*testing.T) time2.Clock {
 	return clock
 }
 
-func NewAuthService(config config.Server, db *sql.DB, clock time2.Clock) *auth.Service {
-	return auth.NewService(config, db, clock)
+func NewAuthService(config config.Server, db *sql.DB, clock time2.Clock, audit *audit.Service) (*auth.Service, error) {
+	return auth.NewService(config, db, clock, audit)
+}
+
+func NewAudit(config config.Server, db *sql.DB, clock time2.Clock) *audit.Service {
+	return audit.New(config, db, clock)
 }
 
-func NewMailer(config config.Server) (*mailer.Mailer, error) {
-	return mailer.NewWithConfig(config.Mailer, config.SMTP)
+func NewMailer(config config.Server, db *sql.DB, clock time2.Clock, i18n *i18n.Service) (*mailer.Mailer, error) {
+	return mailer.NewWithConfig(config.Mailer, config.SMTP, db, clock, i18n)
 }
 
 func NewDB(config config.Server) (*sql.DB, error) {
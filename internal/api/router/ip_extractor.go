@@ -0,0 +1,42 @@
+package router
+
+import (
+	"fmt"
+	"net"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"github.com/labstack/echo/v4"
+)
+
+// newIPExtractor returns the extractor determining the client IP of requests. Headers set by proxies are only trusted
+// if the request was received from one of the trusted proxies, echo's defaults trusting all private networks are
+// disabled.
+func newIPExtractor(cfg config.EchoServer) (echo.IPExtractor, error) {
+	if cfg.IPExtractor == config.IPExtractorDirect {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, proxy := range cfg.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trusted proxy range %q: %w", proxy, err)
+		}
+
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	switch cfg.IPExtractor {
+	case config.IPExtractorXFF:
+		return echo.ExtractIPFromXFFHeader(options...), nil
+	case config.IPExtractorXRealIP:
+		return echo.ExtractIPFromRealIPHeader(options...), nil
+	default:
+		return nil, fmt.Errorf("unsupported IP extractor: %s", cfg.IPExtractor)
+	}
+}
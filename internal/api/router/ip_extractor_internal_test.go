@@ -0,0 +1,49 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPExtractor(t *testing.T) {
+	newRequest := func(remoteAddr string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.7")
+		req.Header.Set(echo.HeaderXRealIP, "203.0.113.7")
+
+		return req
+	}
+
+	tests := []struct {
+		name       string
+		cfg        config.EchoServer
+		remoteAddr string
+		expected   string
+	}{
+		{"DirectIgnoresHeaders", config.EchoServer{IPExtractor: config.IPExtractorDirect}, "10.0.0.1:1234", "10.0.0.1"},
+		{"XFFTrustedProxy", config.EchoServer{IPExtractor: config.IPExtractorXFF, TrustedProxies: []string{"10.0.0.0/8"}}, "10.0.0.1:1234", "203.0.113.7"},
+		{"XFFUntrustedPeer", config.EchoServer{IPExtractor: config.IPExtractorXFF, TrustedProxies: []string{"10.0.0.0/8"}}, "198.51.100.1:1234", "198.51.100.1"},
+		// private networks are not trusted unless configured
+		{"XFFPrivateNetNotTrusted", config.EchoServer{IPExtractor: config.IPExtractorXFF}, "192.168.0.1:1234", "192.168.0.1"},
+		{"XRealIPTrustedProxy", config.EchoServer{IPExtractor: config.IPExtractorXRealIP, TrustedProxies: []string{"10.0.0.0/8"}}, "10.0.0.1:1234", "203.0.113.7"},
+		{"XRealIPUntrustedPeer", config.EchoServer{IPExtractor: config.IPExtractorXRealIP}, "198.51.100.1:1234", "198.51.100.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extractor, err := newIPExtractor(tt.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, extractor(newRequest(tt.remoteAddr)))
+		})
+	}
+
+	_, err := newIPExtractor(config.EchoServer{IPExtractor: config.IPExtractorXFF, TrustedProxies: []string{"10.0.0.1"}})
+	require.Error(t, err)
+}
@@ -15,6 +15,8 @@ import (
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/constants"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
 	"allaboutapps.dev/aw/go-starter/internal/api/router/templates"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
@@ -53,6 +55,13 @@ func Init(s *api.Server) error {
 	s.Echo.Renderer = viewsRenderer
 
 	s.Echo.Debug = s.Config.Echo.Debug
+
+	// the client IP keys rate limits and lockouts, so it must not be taken from headers clients can spoof
+	s.Echo.IPExtractor, err = newIPExtractor(s.Config.Echo)
+	if err != nil {
+		return err
+	}
+
 	s.Echo.HideBanner = true
 	s.Echo.Logger.SetOutput(&echoLogger{level: s.Config.Logger.RequestLevel, log: log.With().Str("component", "echo").Logger()})
 	echo.NotFoundHandler = NotFoundHandler(s.Config)
@@ -111,6 +120,8 @@ func Init(s *api.Server) error {
 		log.Warn().Msg("Disabling request ID middleware due to environment config")
 	}
 
+	s.Echo.Use(middleware.ClientInfo())
+
 	if s.Config.Echo.EnableLoggerMiddleware {
 		s.Echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 			Level:             s.Config.Logger.RequestLevel,
@@ -189,6 +200,18 @@ func Init(s *api.Server) error {
 		}
 	}
 
+	var rateLimitStore middleware.RateLimitStore
+	if s.Config.Echo.EnableRateLimitMiddleware {
+		switch s.Config.Echo.RateLimitMiddleware.Store {
+		case config.RateLimitStorePostgres:
+			rateLimitStore = middleware.NewRateLimitPostgresStore(s.DB)
+		default:
+			rateLimitStore = middleware.NewRateLimitMemoryStore()
+		}
+	} else {
+		log.Warn().Msg("Disabling rate limit middleware due to environment config")
+	}
+
 	// Add your custom / additional middlewares here.
 	// see https://echo.labstack.com/middleware
 
@@ -216,15 +239,33 @@ func Init(s *api.Server) error {
 			},
 		}), middleware.NoCache()),
 
+		// CMS endpoints, secured by bearer auth or API key requiring the cms scope and permissions checked per route, available at /api/v1/admin/**
+		APIV1Admin: s.Echo.Group("/api/v1/admin", authAndRateLimit(s, rateLimitStore, "apiv1admin", s.Config.Echo.RateLimitMiddleware.APIV1Admin, middleware.AuthWithConfig(middleware.AuthConfig{
+			S:            s,
+			Mode:         middleware.AuthModeRequired,
+			APIKeyScheme: middleware.DefaultAuthConfig.APIKeyScheme,
+			Scopes:       []string{auth.ScopeCMS.String()},
+		}))...),
+
 		// OAuth2, unsecured or secured by bearer auth, available at /api/v1/auth/**
-		APIV1Auth: s.Echo.Group("/api/v1/auth", middleware.AuthWithConfig(middleware.AuthConfig{
+		APIV1Auth: s.Echo.Group("/api/v1/auth", authAndRateLimit(s, rateLimitStore, "apiv1auth", s.Config.Echo.RateLimitMiddleware.APIV1Auth, middleware.AuthWithConfig(middleware.AuthConfig{
 			S:    s,
 			Mode: middleware.AuthModeRequired,
 			Skipper: func(c echo.Context) bool {
 				switch c.Path() {
-				case "/api/v1/auth/forgot-password",
+				case "/api/v1/auth/2fa/verify",
+					"/api/v1/auth/account/deletion/cancel",
+					"/api/v1/auth/account/export/download",
+					"/api/v1/auth/change-email/revert",
+					"/api/v1/auth/forgot-password",
 					"/api/v1/auth/forgot-password/complete",
 					"/api/v1/auth/login",
+					"/api/v1/auth/magic-link",
+					"/api/v1/auth/magic-link/complete",
+					"/api/v1/auth/oidc/:provider/authorize",
+					"/api/v1/auth/oidc/:provider/callback",
+					"/api/v1/auth/passkeys/login/begin",
+					"/api/v1/auth/passkeys/login/finish",
 					"/api/v1/auth/refresh",
 					"/api/v1/auth/register",
 					fmt.Sprintf("/api/v1/auth/register/:%s", constants.RegistrationTokenParam):
@@ -232,11 +273,12 @@ func Init(s *api.Server) error {
 				}
 				return false
 			},
-		})),
-		WellKnown: s.Echo.Group("/.well-known"),
+		}))...),
+		WellKnown: s.Echo.Group("/.well-known", rateLimit(s, rateLimitStore, "wellknown", s.Config.Echo.RateLimitMiddleware.WellKnown)),
 
-		// Your other endpoints, typically secured by bearer auth, available at /api/v1/**
-		APIV1Push: s.Echo.Group("/api/v1/push", middleware.Auth(s)),
+		// Your other endpoints, typically secured by bearer auth or API key, available at /api/v1/**
+		APIV1Push:          s.Echo.Group("/api/v1/push", authAndRateLimit(s, rateLimitStore, "apiv1push", s.Config.Echo.RateLimitMiddleware.APIV1Push, middleware.Auth(s))...),
+		APIV1Notifications: s.Echo.Group("/api/v1/notifications", authAndRateLimit(s, rateLimitStore, "apiv1notifications", s.Config.Echo.RateLimitMiddleware.APIV1Notifications, middleware.Auth(s))...),
 	}
 
 	// ---
@@ -250,3 +292,33 @@ func Init(s *api.Server) error {
 
 	return nil
 }
+
+// rateLimit returns the rate limit middleware for a group, prefixing its keys with the group name so all groups
+// can share the same store. Must be added after the auth middleware so requests can be limited per user.
+func rateLimit(s *api.Server, store middleware.RateLimitStore, group string, cfg config.EchoServerRateLimitGroup) echo.MiddlewareFunc {
+	if store == nil || cfg.Requests <= 0 {
+		return middleware.Noop()
+	}
+
+	return middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+		Store: store,
+		Rate: middleware.RateLimitRate{
+			Requests: cfg.Requests,
+			Period:   cfg.Period,
+		},
+		KeyFunc:   middleware.RateLimitKeyFuncFromConfig(cfg.Key),
+		KeyPrefix: group + ":",
+		Clock:     s.Clock,
+	})
+}
+
+// authAndRateLimit orders the auth and rate limit middleware of a group. Limits keyed by IP or route are
+// enforced before authenticating, so rejected credentials still count against the limit and cannot be
+// brute forced. Limits keyed by user require the authenticated user and are enforced afterwards.
+func authAndRateLimit(s *api.Server, store middleware.RateLimitStore, group string, cfg config.EchoServerRateLimitGroup, authMiddleware echo.MiddlewareFunc) []echo.MiddlewareFunc {
+	if cfg.Key == config.RateLimitKeyUser {
+		return []echo.MiddlewareFunc{authMiddleware, rateLimit(s, store, group, cfg)}
+	}
+
+	return []echo.MiddlewareFunc{rateLimit(s, store, group, cfg), authMiddleware}
+}
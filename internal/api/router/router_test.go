@@ -91,6 +91,9 @@ func TestMetricsEnabled(t *testing.T) {
 
 		assert.Contains(t, result, fmt.Sprintf("%s %d", users.MetricNameTotalUsers, expectedTotalUserCount))
 
+		// no account deletions are pending within the fixtures
+		assert.Contains(t, result, fmt.Sprintf("%s 0", users.MetricNamePendingUserDeletions))
+
 		// expect sqlstats metrics
 		assert.Contains(t, result, "go_sql_stats_connections")
 	})
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"net/http"
 
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
 	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/data/dto"
 	"allaboutapps.dev/aw/go-starter/internal/data/local"
+	"allaboutapps.dev/aw/go-starter/internal/export"
 	"allaboutapps.dev/aw/go-starter/internal/i18n"
 	"allaboutapps.dev/aw/go-starter/internal/mailer"
 	"allaboutapps.dev/aw/go-starter/internal/metrics"
@@ -24,12 +27,14 @@ import (
 )
 
 type Router struct {
-	Routes     []*echo.Route
-	Root       *echo.Group
-	Management *echo.Group
-	APIV1Auth  *echo.Group
-	APIV1Push  *echo.Group
-	WellKnown  *echo.Group
+	Routes             []*echo.Route
+	Root               *echo.Group
+	Management         *echo.Group
+	APIV1Admin         *echo.Group
+	APIV1Auth          *echo.Group
+	APIV1Notifications *echo.Group
+	APIV1Push          *echo.Group
+	WellKnown          *echo.Group
 }
 
 // Server is a central struct keeping all the dependencies.
@@ -56,6 +61,8 @@ type Server struct {
 	Auth    AuthService
 	Local   *local.Service
 	Metrics *metrics.Service
+	Export  *export.Service
+	Audit   *audit.Service
 }
 
 // newServerWithComponents is used by wire to initialize the server components.
@@ -71,6 +78,8 @@ func newServerWithComponents(
 	auth AuthService,
 	local *local.Service,
 	metrics *metrics.Service,
+	export *export.Service,
+	audit *audit.Service,
 ) *Server {
 	return &Server{
 		Config:  cfg,
@@ -82,6 +91,8 @@ func newServerWithComponents(
 		Auth:    auth,
 		Local:   local,
 		Metrics: metrics,
+		Export:  export,
+		Audit:   audit,
 	}
 }
 
@@ -90,12 +101,54 @@ type AuthService interface {
 	InitPasswordReset(ctx context.Context, request dto.InitPasswordResetRequest) (dto.InitPasswordResetResult, error)
 	Login(ctx context.Context, request dto.LoginRequest) (dto.LoginResult, error)
 	Logout(ctx context.Context, request dto.LogoutRequest) error
+	InitMagicLink(ctx context.Context, request dto.InitMagicLinkRequest) (dto.InitMagicLinkResult, error)
+	CompleteMagicLink(ctx context.Context, request dto.CompleteMagicLinkRequest) (dto.LoginResult, error)
 	Refresh(ctx context.Context, request dto.RefreshRequest) (dto.LoginResult, error)
 	Register(ctx context.Context, request dto.RegisterRequest) (dto.RegisterResult, error)
 	CompleteRegister(ctx context.Context, request dto.CompleteRegisterRequest) (dto.LoginResult, error)
-	DeleteUserAccount(ctx context.Context, request dto.DeleteUserAccountRequest) error
+	DeleteUserAccount(ctx context.Context, request dto.DeleteUserAccountRequest) (dto.DeleteUserAccountResult, error)
+	CancelAccountDeletion(ctx context.Context, token string) error
+	ClaimAccountDeletionReminders(ctx context.Context) ([]dto.AccountDeletion, error)
+	PurgeAccountDeletions(ctx context.Context) (int64, error)
+	PurgeFailedAttempts(ctx context.Context) (int64, error)
 	ResetPassword(ctx context.Context, request dto.ResetPasswordRequest) (dto.LoginResult, error)
 	UpdatePassword(ctx context.Context, request dto.UpdatePasswordRequest) (dto.LoginResult, error)
+	InitEmailChange(ctx context.Context, request dto.InitEmailChangeRequest) (dto.InitEmailChangeResult, error)
+	ConfirmEmailChange(ctx context.Context, request dto.ConfirmEmailChangeRequest) (dto.LoginResult, error)
+	RevertEmailChange(ctx context.Context, request dto.RevertEmailChangeRequest) error
+	EnrollTOTP(ctx context.Context, request dto.EnrollTOTPRequest) (dto.EnrollTOTPResult, error)
+	ConfirmTOTP(ctx context.Context, request dto.TOTPCodeRequest) error
+	DisableTOTP(ctx context.Context, request dto.TOTPCodeRequest) error
+	VerifyTwoFactorChallenge(ctx context.Context, request dto.VerifyTwoFactorChallengeRequest) (dto.LoginResult, error)
+	ValidateJWTAccessToken(ctx context.Context, token string) (auth.Result, error)
+	GetJSONWebKeySet(ctx context.Context) (dto.JSONWebKeySet, error)
+	InitOIDCLogin(ctx context.Context, request dto.InitOIDCLoginRequest) (dto.InitOIDCLoginResult, error)
+	CompleteOIDCLogin(ctx context.Context, request dto.CompleteOIDCLoginRequest) (dto.LoginResult, error)
+	GetSessions(ctx context.Context, request dto.GetSessionsRequest) (dto.Sessions, error)
+	RevokeSession(ctx context.Context, request dto.RevokeSessionRequest) error
+	RevokeOtherSessions(ctx context.Context, request dto.RevokeOtherSessionsRequest) error
+	GetPermissions(ctx context.Context, scopes []string) (auth.Permissions, error)
+	GetRoles(ctx context.Context) (dto.Roles, error)
+	UpdateUserRoles(ctx context.Context, request dto.UpdateUserRolesRequest) (dto.UserRoles, error)
+	GetAdminUsers(ctx context.Context, request dto.GetAdminUsersRequest) (dto.AdminUsers, error)
+	GetAdminUser(ctx context.Context, userID string) (dto.AdminUser, error)
+	SetUserActive(ctx context.Context, request dto.SetUserActiveRequest) (dto.AdminUser, error)
+	ForceLogout(ctx context.Context, userID string) error
+	ForcePasswordReset(ctx context.Context, userID string) (dto.ForcePasswordResetResult, error)
+	ImpersonateUser(ctx context.Context, userID string) (dto.ImpersonateUserResult, error)
+	CreateInvite(ctx context.Context, request dto.CreateInviteRequest) (dto.CreateInviteResult, error)
+	GetInvites(ctx context.Context) (dto.Invites, error)
+	DeleteInvite(ctx context.Context, inviteID string) error
+	GetAPIKeys(ctx context.Context, userID string) (dto.APIKeys, error)
+	CreateAPIKey(ctx context.Context, request dto.CreateAPIKeyRequest) (dto.CreateAPIKeyResult, error)
+	DeleteAPIKey(ctx context.Context, request dto.DeleteAPIKeyRequest) error
+	ValidateAPIKey(ctx context.Context, key string) (auth.Result, error)
+	GetPasskeys(ctx context.Context, userID string) (dto.Passkeys, error)
+	DeletePasskey(ctx context.Context, request dto.DeletePasskeyRequest) error
+	BeginPasskeyRegistration(ctx context.Context, request dto.BeginPasskeyRegistrationRequest) (dto.BeginPasskeyRegistrationResult, error)
+	FinishPasskeyRegistration(ctx context.Context, request dto.FinishPasskeyRegistrationRequest) (dto.Passkey, error)
+	BeginPasskeyLogin(ctx context.Context) (dto.BeginPasskeyLoginResult, error)
+	FinishPasskeyLogin(ctx context.Context, request dto.FinishPasskeyLoginRequest) (dto.LoginResult, error)
 }
 
 func NewServer(config config.Server) *Server {
@@ -132,6 +185,24 @@ func (s *Server) Shutdown(ctx context.Context) []error {
 
 	var errs []error
 
+	if s.Export != nil {
+		log.Debug().Msg("Waiting for data exports to complete")
+
+		if err := s.Export.Wait(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to wait for data exports")
+			errs = append(errs, err)
+		}
+	}
+
+	if s.Push != nil {
+		log.Debug().Msg("Stopping push outbox worker")
+
+		if err := s.Push.StopWorker(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to stop push outbox worker")
+			errs = append(errs, err)
+		}
+	}
+
 	if s.DB != nil {
 		log.Debug().Msg("Closing database connection")
 
@@ -21,6 +21,8 @@ var serviceSet = wire.NewSet(
 	NewPush,
 	NewMailer,
 	NewI18N,
+	NewExport,
+	NewAudit,
 	authServiceSet,
 	local.NewService,
 	metrics.New,
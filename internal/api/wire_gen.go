@@ -28,53 +28,63 @@ func InitNewServer(server config.Server) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	mailer, err := NewMailer(server)
+	i18nService, err := NewI18N(server)
 	if err != nil {
 		return nil, err
 	}
-	service, err := NewPush(server, db)
+	v := NoTest()
+	clock := NewClock(v...)
+	mailer, err := NewMailer(server, db, clock, i18nService)
 	if err != nil {
 		return nil, err
 	}
-	i18nService, err := NewI18N(server)
+	service, err := NewPush(server, db, clock)
+	if err != nil {
+		return nil, err
+	}
+	auditService := NewAudit(server, db, clock)
+	authService, err := NewAuthService(server, db, clock, auditService)
 	if err != nil {
 		return nil, err
 	}
-	v := NoTest()
-	clock := NewClock(v...)
-	authService := NewAuthService(server, db, clock)
 	localService := local.NewService(server, db, clock)
 	metricsService, err := metrics.New(server, db)
 	if err != nil {
 		return nil, err
 	}
-	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService)
+	exportService := NewExport(server, db, clock, mailer)
+	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, exportService, auditService)
 	return apiServer, nil
 }
 
 // InitNewServerWithDB returns a new Server instance with the given DB instance.
 // All the other components are initialized via go wire according to the configuration.
 func InitNewServerWithDB(server config.Server, db *sql.DB, t ...*testing.T) (*Server, error) {
-	mailer, err := NewMailer(server)
+	i18nService, err := NewI18N(server)
 	if err != nil {
 		return nil, err
 	}
-	service, err := NewPush(server, db)
+	clock := NewClock(t...)
+	mailer, err := NewMailer(server, db, clock, i18nService)
 	if err != nil {
 		return nil, err
 	}
-	i18nService, err := NewI18N(server)
+	service, err := NewPush(server, db, clock)
+	if err != nil {
+		return nil, err
+	}
+	auditService := NewAudit(server, db, clock)
+	authService, err := NewAuthService(server, db, clock, auditService)
 	if err != nil {
 		return nil, err
 	}
-	clock := NewClock(t...)
-	authService := NewAuthService(server, db, clock)
 	localService := local.NewService(server, db, clock)
 	metricsService, err := metrics.New(server, db)
 	if err != nil {
 		return nil, err
 	}
-	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService)
+	exportService := NewExport(server, db, clock, mailer)
+	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, exportService, auditService)
 	return apiServer, nil
 }
 
@@ -86,6 +96,8 @@ var serviceSet = wire.NewSet(
 	NewPush,
 	NewMailer,
 	NewI18N,
+	NewExport,
+	NewAudit,
 	authServiceSet, local.NewService, metrics.New, NewClock,
 )
 
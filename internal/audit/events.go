@@ -0,0 +1,26 @@
+package audit
+
+// EventType identifies the kind of security relevant event recorded.
+type EventType string
+
+const (
+	EventTypeLogin                    EventType = "login"
+	EventTypeLoginFailed              EventType = "login_failed"
+	EventTypeTokenRefreshed           EventType = "token_refreshed"
+	EventTypePasswordChanged          EventType = "password_changed"
+	EventTypePasswordResetRequested   EventType = "password_reset_requested"
+	EventTypePasswordReset            EventType = "password_reset"
+	EventTypeEmailChanged             EventType = "email_changed"
+	EventTypeEmailChangeReverted      EventType = "email_change_reverted"
+	EventTypeAccountDeletionScheduled EventType = "account_deletion_scheduled"
+	EventTypeAccountDeletionCancelled EventType = "account_deletion_cancelled"
+	EventTypeAccountDeleted           EventType = "account_deleted"
+	EventTypeScopesChanged            EventType = "scopes_changed"
+	EventTypeUserImpersonated         EventType = "user_impersonated"
+	EventTypeInviteCreated            EventType = "invite_created"
+	EventTypeInviteAccepted           EventType = "invite_accepted"
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
@@ -0,0 +1,225 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/google/uuid"
+)
+
+// Event is a security relevant event to be recorded, the client's IP address, user agent and the request ID
+// are taken from the context.
+type Event struct {
+	Type EventType
+	// ActorID is the user performing the action, unset if unknown (e.g. failed logins)
+	ActorID null.String
+	// SubjectID is the user affected by the action, equal to ActorID for users acting on their own account
+	SubjectID null.String
+	Metadata  map[string]any
+}
+
+// Service records security relevant events in an append-only log. Events are written using the executor
+// of the caller, so they are only persisted if the surrounding transaction is committed.
+type Service struct {
+	config config.Server
+	db     *sql.DB
+	clock  time2.Clock
+}
+
+func New(config config.Server, db *sql.DB, clock time2.Clock) *Service {
+	return &Service{
+		config: config,
+		db:     db,
+		clock:  clock,
+	}
+}
+
+// Record appends the event to the audit log.
+func (s *Service) Record(ctx context.Context, exec boil.ContextExecutor, event Event) error {
+	log := util.LogFromContext(ctx).With().Str("eventType", event.Type.String()).Logger()
+
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		log.Err(err).Msg("Failed to encode audit event metadata")
+		return fmt.Errorf("failed to encode audit event metadata: %w", err)
+	}
+
+	auditEvent := models.AuditEvent{
+		EventType: event.Type.String(),
+		ActorID:   event.ActorID,
+		SubjectID: event.SubjectID,
+		Metadata:  types.JSON(encoded),
+		CreatedAt: s.clock.Now(),
+	}
+
+	// events recorded outside of HTTP requests (e.g. jobs) lack the client info and request ID
+	if info, err := util.ClientInfoFromContext(ctx); err == nil {
+		auditEvent.IPAddress = null.NewString(info.IPAddress, len(info.IPAddress) > 0)
+		auditEvent.UserAgent = null.NewString(info.UserAgent, len(info.UserAgent) > 0)
+	}
+
+	if requestID, err := util.RequestIDFromContext(ctx); err == nil {
+		auditEvent.RequestID = null.NewString(requestID, len(requestID) > 0)
+	}
+
+	if err := auditEvent.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert audit event")
+		return err
+	}
+
+	return nil
+}
+
+// GetEvents returns the events matching the filters of the request, most recent first.
+func (s *Service) GetEvents(ctx context.Context, request dto.GetAuditEventsRequest) (dto.AuditEvents, error) {
+	log := util.LogFromContext(ctx)
+
+	mods := []qm.QueryMod{}
+	if request.ActorID.Valid {
+		mods = append(mods, models.AuditEventWhere.ActorID.EQ(request.ActorID))
+	}
+
+	if request.SubjectID.Valid {
+		mods = append(mods, models.AuditEventWhere.SubjectID.EQ(request.SubjectID))
+	}
+
+	if request.EventType.Valid {
+		mods = append(mods, models.AuditEventWhere.EventType.EQ(request.EventType.String))
+	}
+
+	if request.From.Valid {
+		mods = append(mods, models.AuditEventWhere.CreatedAt.GTE(request.From.Time))
+	}
+
+	if request.To.Valid {
+		mods = append(mods, models.AuditEventWhere.CreatedAt.LT(request.To.Time))
+	}
+
+	if request.Cursor.Valid {
+		createdAt, id, err := decodeCursor(request.Cursor.String)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to decode audit events cursor")
+			return dto.AuditEvents{}, httperrors.ErrBadRequestInvalidCursor
+		}
+
+		mods = append(mods, qm.Where(
+			fmt.Sprintf("(%s, %s) < (?, ?)", models.AuditEventColumns.CreatedAt, models.AuditEventColumns.ID),
+			createdAt, id,
+		))
+	}
+
+	// an additional event is loaded to determine whether another page is available
+	mods = append(mods,
+		qm.OrderBy(models.AuditEventColumns.CreatedAt+" DESC, "+models.AuditEventColumns.ID+" DESC"),
+		qm.Limit(int(request.Limit)+1),
+	)
+
+	events, err := models.AuditEvents(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load audit events")
+		return dto.AuditEvents{}, err
+	}
+
+	result := dto.AuditEvents{
+		Data: make([]dto.AuditEvent, 0, len(events)),
+	}
+
+	if int64(len(events)) > request.Limit {
+		events = events[:request.Limit]
+		last := events[len(events)-1]
+		result.NextCursor = null.StringFrom(encodeCursor(last.CreatedAt, last.ID))
+	}
+
+	for _, event := range events {
+		var metadata map[string]any
+		if err := event.Metadata.Unmarshal(&metadata); err != nil {
+			log.Err(err).Str("auditEventID", event.ID).Msg("Failed to decode audit event metadata")
+			return dto.AuditEvents{}, fmt.Errorf("failed to decode audit event metadata: %w", err)
+		}
+
+		result.Data = append(result.Data, dto.AuditEvent{
+			ID:        event.ID,
+			EventType: event.EventType,
+			ActorID:   event.ActorID,
+			SubjectID: event.SubjectID,
+			IPAddress: event.IPAddress,
+			UserAgent: event.UserAgent,
+			RequestID: event.RequestID,
+			Metadata:  metadata,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// Purge deletes all events older than the configured retention, returning the number of deleted events.
+func (s *Service) Purge(ctx context.Context) (int64, error) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Audit.Retention <= 0 {
+		log.Debug().Msg("Audit event retention is disabled, skipping purge")
+		return 0, nil
+	}
+
+	purged, err := models.AuditEvents(
+		models.AuditEventWhere.CreatedAt.LT(s.clock.Now().Add(-s.config.Audit.Retention)),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge audit events")
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// cursorSeparator separates the creation time and ID of the last event of a page within cursors
+const cursorSeparator = "|"
+
+func encodeCursor(createdAt time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.UTC().Format(time.RFC3339Nano) + cursorSeparator + id))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	rawCreatedAt, id, ok := strings.Cut(string(decoded), cursorSeparator)
+	if !ok {
+		return time.Time{}, "", errors.New("cursor is missing separator")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, rawCreatedAt)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("failed to parse cursor time: %w", err)
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return time.Time{}, "", fmt.Errorf("failed to parse cursor ID: %w", err)
+	}
+
+	return createdAt, id, nil
+}
@@ -10,16 +10,26 @@ import (
 )
 
 // EnrichContextWithCredentials stores the provided credentials in the form of user and access token used for authentication
-// in the give context and updates the logger associated with ctx to include the user's ID.
+// in the give context and updates the logger associated with ctx to include the user's ID (and the impersonator's ID if any).
 func EnrichContextWithCredentials(ctx context.Context, result Result) context.Context {
 	// Retrieve current logger associated with context and extend it ID of authenticated user
-	l := util.LogFromContext(ctx).With().Str("userID", result.User.ID).Logger()
+	lc := util.LogFromContext(ctx).With().Str("userID", result.User.ID)
+	if result.Impersonator != nil {
+		lc = lc.Str("impersonatorID", result.Impersonator.ID)
+	}
+	l := lc.Logger()
 	ctx = l.WithContext(ctx)
 
 	// Store authenticated user's instance in context
 	ctx = context.WithValue(ctx, util.CTXKeyUser, result.User)
 	// Store access token used for authentication in context
 	ctx = context.WithValue(ctx, util.CTXKeyAccessToken, result.Token)
+	// Store permissions of authenticated user in context
+	ctx = context.WithValue(ctx, util.CTXKeyPermissions, result.Permissions)
+	// Store impersonator acting on behalf of authenticated user in context
+	if result.Impersonator != nil {
+		ctx = context.WithValue(ctx, util.CTXKeyImpersonator, result.Impersonator)
+	}
 
 	return ctx
 }
@@ -80,3 +90,47 @@ func AccessTokenFromContext(ctx context.Context) *string {
 func AccessTokenFromEchoContext(c echo.Context) *string {
 	return AccessTokenFromContext(c.Request().Context())
 }
+
+// PermissionsFromContext returns the permissions of the currently authenticated user from a context. If no authentication was
+// provided or the current context does not carry any permission information, an empty set of permissions will be returned instead.
+func PermissionsFromContext(ctx context.Context) Permissions {
+	p := ctx.Value(util.CTXKeyPermissions)
+	if p == nil {
+		return nil
+	}
+
+	permissions, ok := p.(Permissions)
+	if !ok {
+		return nil
+	}
+
+	return permissions
+}
+
+// PermissionsFromEchoContext returns the permissions of the currently authenticated user from an echo context. If no authentication
+// was provided or the current context does not carry any permission information, an empty set of permissions will be returned instead.
+func PermissionsFromEchoContext(c echo.Context) Permissions {
+	return PermissionsFromContext(c.Request().Context())
+}
+
+// ImpersonatorFromContext returns the user impersonating the currently authenticated user from a context. If the request is not
+// authenticated by an impersonation token or the current context does not carry any user information, nil will be returned instead.
+func ImpersonatorFromContext(ctx context.Context) *dto.User {
+	u := ctx.Value(util.CTXKeyImpersonator)
+	if u == nil {
+		return nil
+	}
+
+	user, ok := u.(*dto.User)
+	if !ok {
+		return nil
+	}
+
+	return user
+}
+
+// ImpersonatorFromEchoContext returns the user impersonating the currently authenticated user from an echo context. If the request
+// is not authenticated by an impersonation token or the current echo context does not carry any user information, nil will be returned instead.
+func ImpersonatorFromEchoContext(c echo.Context) *dto.User {
+	return ImpersonatorFromContext(c.Request().Context())
+}
@@ -3,5 +3,6 @@ package auth
 import "errors"
 
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidToken = errors.New("invalid token")
 )
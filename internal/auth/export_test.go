@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// exposes unexported parts of the service to the external tests of package auth_test
+
+func (s *Service) WithTransaction(ctx context.Context, txHandler db.TxFn) error {
+	return s.withTransaction(ctx, txHandler)
+}
+
+func (s *Service) RevokeAccessTokens(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.revokeAccessTokens(ctx, exec, mods...)
+}
+
+func (s *Service) IsAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	return s.isAccessTokenDenied(ctx, jti)
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	jwtKeyFileExtension = ".pem"
+	jwtKeyUseSignature  = "sig"
+)
+
+// accessTokenClaims are the claims of JWT access tokens, carrying all user information required by the
+// auth middleware so no database lookup is needed to authenticate a request.
+type accessTokenClaims struct {
+	jwt.RegisteredClaims
+	Email       string   `json:"email,omitempty"`
+	Scopes      []string `json:"scopes"`
+	Permissions []string `json:"permissions,omitempty"`
+	AuthTime    int64    `json:"auth_time,omitempty"`
+	UpdatedAt   int64    `json:"updated_at,omitempty"`
+	// Actor identifies the impersonator of impersonation tokens (RFC 8693 actor claim)
+	Actor *actorClaim `json:"act,omitempty"`
+}
+
+type actorClaim struct {
+	Subject string `json:"sub"`
+}
+
+type jwtKey struct {
+	id         string
+	method     jwt.SigningMethod
+	privateKey crypto.Signer
+}
+
+func (k *jwtKey) toDTO() dto.JSONWebKey {
+	result := dto.JSONWebKey{
+		KeyID:     k.id,
+		Use:       jwtKeyUseSignature,
+		Algorithm: k.method.Alg(),
+	}
+
+	switch pub := k.privateKey.Public().(type) {
+	case ed25519.PublicKey:
+		result.KeyType = types.JSONWebKeyKtyOKP
+		result.Curve = "Ed25519"
+		result.X = base64.RawURLEncoding.EncodeToString(pub)
+	case *rsa.PublicKey:
+		result.KeyType = types.JSONWebKeyKtyRSA
+		result.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		result.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	}
+
+	return result
+}
+
+// jwtKeySet holds all keys accepted to verify JWT access tokens, while only signingKey is used to issue new ones.
+type jwtKeySet struct {
+	signingKey *jwtKey
+	keys       map[string]*jwtKey
+}
+
+// loadJWTKeySet loads all PEM encoded Ed25519 or RSA private keys (PKCS #8 or PKCS #1) of dirAbs, using
+// their file name without extension as key ID.
+func loadJWTKeySet(dirAbs string, signingKeyID string) (*jwtKeySet, error) {
+	files, err := filepath.Glob(filepath.Join(dirAbs, "*"+jwtKeyFileExtension))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list JWT keys: %w", err)
+	}
+
+	set := &jwtKeySet{
+		keys: make(map[string]*jwtKey, len(files)),
+	}
+
+	for _, file := range files {
+		key, err := loadJWTKey(file)
+		if err != nil {
+			return nil, err
+		}
+
+		set.keys[key.id] = key
+	}
+
+	signingKey, ok := set.keys[signingKeyID]
+	if !ok {
+		return nil, fmt.Errorf("JWT signing key %q not found in %s", signingKeyID, dirAbs)
+	}
+	set.signingKey = signingKey
+
+	return set, nil
+}
+
+func loadJWTKey(file string) (*jwtKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key %s: %w", file, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode JWT key %s: no PEM data found", file)
+	}
+
+	var privateKey any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT key %s: %w", file, err)
+	}
+
+	key := &jwtKey{
+		id: strings.TrimSuffix(filepath.Base(file), jwtKeyFileExtension),
+	}
+
+	switch k := privateKey.(type) {
+	case ed25519.PrivateKey:
+		key.method = jwt.SigningMethodEdDSA
+		key.privateKey = k
+	case *rsa.PrivateKey:
+		key.method = jwt.SigningMethodRS256
+		key.privateKey = k
+	default:
+		return nil, fmt.Errorf("unsupported JWT key type %T of %s", privateKey, file)
+	}
+
+	return key, nil
+}
+
+func (s *jwtKeySet) sign(claims accessTokenClaims) (string, error) {
+	token := jwt.NewWithClaims(s.signingKey.method, claims)
+	token.Header["kid"] = s.signingKey.id
+
+	return token.SignedString(s.signingKey.privateKey)
+}
+
+// keyFunc returns the public key matching the kid and algorithm of the token to verify.
+func (s *jwtKeySet) keyFunc(token *jwt.Token) (any, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, errors.New("missing kid header")
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s for kid %q", token.Method.Alg(), kid)
+	}
+
+	return key.privateKey.Public(), nil
+}
+
+func (s *jwtKeySet) toDTO() dto.JSONWebKeySet {
+	result := dto.JSONWebKeySet{
+		Keys: make([]dto.JSONWebKey, 0, len(s.keys)),
+	}
+
+	for _, key := range s.keys {
+		result.Keys = append(result.Keys, key.toDTO())
+	}
+
+	slices.SortFunc(result.Keys, func(a, b dto.JSONWebKey) int {
+		return strings.Compare(a.KeyID, b.KeyID)
+	})
+
+	return result
+}
@@ -0,0 +1,30 @@
+package auth
+
+import "slices"
+
+// Permission grants access to an action on a resource, formatted as "resource:action".
+type Permission string
+
+const (
+	PermissionRolesRead        Permission = "roles:read"
+	PermissionRolesAssign      Permission = "roles:assign"
+	PermissionUsersRead        Permission = "users:read"
+	PermissionUsersWrite       Permission = "users:write"
+	PermissionUsersImpersonate Permission = "users:impersonate"
+	PermissionAuditRead        Permission = "audit:read"
+	PermissionInvitesRead      Permission = "invites:read"
+	PermissionInvitesWrite     Permission = "invites:write"
+	PermissionBroadcastsRead   Permission = "broadcasts:read"
+	PermissionBroadcastsWrite  Permission = "broadcasts:write"
+)
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+// Permissions is the set of permissions granted to a user by the roles named in the user's scopes.
+type Permissions []string
+
+func (p Permissions) Has(permission Permission) bool {
+	return slices.Contains(p, permission.String())
+}
@@ -4,6 +4,7 @@ type Scope string
 
 const (
 	ScopeApp Scope = "app"
+	ScopeCMS Scope = "cms"
 )
 
 func (s Scope) String() string {
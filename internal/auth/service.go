@@ -4,15 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
 	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/data/dto"
 	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/util"
-	"allaboutapps.dev/aw/go-starter/internal/util/db"
 	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+	"allaboutapps.dev/aw/go-starter/internal/util/password"
+	"allaboutapps.dev/aw/go-starter/internal/util/webauthn"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -21,17 +26,68 @@ import (
 )
 
 type Service struct {
-	config config.Server
-	db     *sql.DB
-	clock  time2.Clock
+	config      config.Server
+	db          *sql.DB
+	clock       time2.Clock
+	jwtKeys     *jwtKeySet
+	jwtDenylist *jwtDenylist
+	oidcClients map[string]*oauth2.OIDCClient
+	webAuthn    webauthn.RelyingParty
+	// passwordPolicy is enforced for all passwords chosen by users, see validatePassword
+	passwordPolicy password.Policy
+	hasher         *hashing.Hasher
+	// audit records security relevant events within the transactions of the service
+	audit *audit.Service
 }
 
-func NewService(config config.Server, db *sql.DB, clock time2.Clock) *Service {
-	return &Service{
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, audit *audit.Service) (*Service, error) {
+	s := &Service{
 		config: config,
 		db:     db,
 		clock:  clock,
+		audit:  audit,
+		jwtDenylist: &jwtDenylist{
+			validUntil: make(map[string]time.Time),
+			pending:    make(map[boil.ContextExecutor][]models.AccessTokenDenylist),
+		},
+		oidcClients: make(map[string]*oauth2.OIDCClient, len(config.Auth.OIDCProviders)),
 	}
+
+	for _, provider := range config.Auth.OIDCProviders {
+		s.oidcClients[provider.Name] = oauth2.NewOIDCClient(provider, nil)
+	}
+
+	if s.jwtEnabled() {
+		keys, err := loadJWTKeySet(config.Auth.JWTKeysDirAbs, config.Auth.JWTSigningKeyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT keys: %w", err)
+		}
+
+		s.jwtKeys = keys
+	}
+
+	relyingParty, err := newWebAuthnRelyingParty(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize WebAuthn relying party: %w", err)
+	}
+
+	s.webAuthn = relyingParty
+
+	policy, err := newPasswordPolicy(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize password policy: %w", err)
+	}
+
+	s.passwordPolicy = policy
+
+	hasher, err := newPasswordHasher(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize password hasher: %w", err)
+	}
+
+	s.hasher = hasher
+
+	return s, nil
 }
 
 func (s *Service) GetAppUserProfile(ctx context.Context, userID string) (*dto.AppUserProfile, error) {
@@ -56,6 +112,12 @@ func (s *Service) GetAppUserProfile(ctx context.Context, userID string) (*dto.Ap
 func (s *Service) UpdatePassword(ctx context.Context, request dto.UpdatePasswordRequest) (dto.LoginResult, error) {
 	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
 
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.LoginResult{}, err
+	}
+
 	if !request.User.IsActive {
 		log.Debug().Msg("User is deactivated, rejecting password change")
 		return dto.LoginResult{}, httperrors.ErrForbiddenUserDeactivated
@@ -67,7 +129,7 @@ func (s *Service) UpdatePassword(ctx context.Context, request dto.UpdatePassword
 	}
 
 	if !request.SkipCurrentPasswordVerification {
-		match, err := hashing.ComparePasswordAndHash(request.CurrentPassword, request.User.PasswordHash.String)
+		match, err := s.hasher.ComparePasswordAndHash(ctx, request.CurrentPassword, request.User.PasswordHash.String)
 		if err != nil {
 			log.Err(err).Msg("Failed to compare password with stored hash")
 			return dto.LoginResult{}, err
@@ -79,33 +141,61 @@ func (s *Service) UpdatePassword(ctx context.Context, request dto.UpdatePassword
 		}
 	}
 
-	hash, err := hashing.HashPassword(request.NewPassword, hashing.DefaultArgon2Params)
+	// password resets provide the new password as "password" instead of "newPassword"
+	passwordKey := "newPassword"
+	if request.SkipCurrentPasswordVerification {
+		passwordKey = "password"
+	}
+
+	if err := s.validatePassword(ctx, s.db, passwordKey, request.NewPassword, request.User); err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	hash, err := s.hasher.HashPassword(ctx, request.NewPassword)
 	if err != nil {
 		log.Err(err).Msg("Failed to hash new password")
 		return dto.LoginResult{}, httperrors.ErrBadRequestInvalidPassword
 	}
 
 	var result dto.LoginResult
-	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		if err := s.recordPasswordHistory(ctx, exec, request.User); err != nil {
+			return err
+		}
+
 		request.User.PasswordHash = null.StringFrom(hash)
 
 		user := request.User.ToModels()
+		user.PasswordResetRequired = false
 
-		if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.Password, models.UserColumns.UpdatedAt)); err != nil {
+		if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.Password, models.UserColumns.PasswordResetRequired, models.UserColumns.UpdatedAt)); err != nil {
 			log.Err(err).Msg("Failed to update user")
 			return err
 		}
 
-		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
+		authenticateRequest := dto.AuthenticateUserRequest{
 			User:                     request.User,
 			InvalidateExistingTokens: true,
-		})
+			Session:                  request.Session,
+		}
+
+		// without the current password (e.g. password reset), the second factor still needs to be provided
+		if request.SkipCurrentPasswordVerification {
+			result, err = s.authenticateUserWithTwoFactor(ctx, exec, authenticateRequest)
+		} else {
+			result, err = s.authenticateUser(ctx, exec, authenticateRequest)
+		}
 		if err != nil {
 			log.Err(err).Msg("Failed to authenticate user after password change")
 			return err
 		}
 
-		return nil
+		eventType := audit.EventTypePasswordChanged
+		if request.SkipCurrentPasswordVerification {
+			eventType = audit.EventTypePasswordReset
+		}
+
+		return s.recordAuditEvent(ctx, exec, eventType, request.User.ID, nil)
 	}); err != nil {
 		log.Debug().Err(err).Msg("Failed to change password")
 		return dto.LoginResult{}, err
@@ -136,16 +226,38 @@ func (s *Service) ResetPassword(ctx context.Context, request dto.ResetPasswordRe
 		return dto.LoginResult{}, httperrors.ErrConflictTokenExpired
 	}
 
-	return s.UpdatePassword(ctx, dto.UpdatePasswordRequest{
+	result, err := s.UpdatePassword(ctx, dto.UpdatePasswordRequest{
 		User:                            mapper.LocalUserToDTO(passwordResetToken.R.User),
 		NewPassword:                     request.NewPassword,
 		SkipCurrentPasswordVerification: true,
+		Session:                         request.Session,
 	})
+	if err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	// a successful password reset unlocks the account
+	if err := s.resetFailedAttempts(ctx, s.db, s.loginAttemptKeys(passwordResetToken.R.User.Username.String, "")); err != nil {
+		log.Err(err).Msg("Failed to reset failed login attempts after password reset")
+		return dto.LoginResult{}, err
+	}
+
+	return result, nil
 }
 
 func (s *Service) InitPasswordReset(ctx context.Context, request dto.InitPasswordResetRequest) (dto.InitPasswordResetResult, error) {
 	log := util.LogFromContext(ctx).With().Str("username", request.Username.String()).Logger()
 
+	// every request counts as attempt, as we cannot tell failed ones apart without allowing user enumeration
+	attemptKeys := s.passwordResetAttemptKeys(request.IPAddress)
+	if err := s.checkLockout(ctx, attemptKeys); err != nil {
+		return dto.InitPasswordResetResult{}, err
+	}
+
+	if err := s.recordFailedAttempt(ctx, attemptKeys); err != nil {
+		return dto.InitPasswordResetResult{}, err
+	}
+
 	user, err := models.Users(
 		models.UserWhere.Username.EQ(null.StringFrom(request.Username.String())),
 	).One(ctx, s.db)
@@ -186,7 +298,7 @@ func (s *Service) InitPasswordReset(ctx context.Context, request dto.InitPasswor
 	}
 
 	var result dto.InitPasswordResetResult
-	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
 		passwordResetToken, err := user.PasswordResetTokens(
 			models.PasswordResetTokenWhere.CreatedAt.GT(s.clock.Now().Add(-s.config.Auth.PasswordResetTokenReuseDuration)),
 			models.PasswordResetTokenWhere.ValidUntil.GT(s.clock.Now()),
@@ -212,7 +324,7 @@ func (s *Service) InitPasswordReset(ctx context.Context, request dto.InitPasswor
 
 		result.ResetToken = null.StringFrom(passwordResetToken.Token)
 
-		return nil
+		return s.recordAuditEvent(ctx, exec, audit.EventTypePasswordResetRequested, user.ID, nil)
 	}); err != nil {
 		log.Debug().Err(err).Msg("Failed to initiate password reset")
 		return dto.InitPasswordResetResult{}, err
@@ -224,9 +336,8 @@ func (s *Service) InitPasswordReset(ctx context.Context, request dto.InitPasswor
 func (s *Service) Logout(ctx context.Context, request dto.LogoutRequest) error {
 	log := util.LogFromContext(ctx)
 
-	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
-		if _, err := models.AccessTokens(models.AccessTokenWhere.Token.EQ(request.AccessToken)).DeleteAll(ctx, exec); err != nil {
-			log.Err(err).Msg("Failed to delete access token")
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		if err := s.revokeAccessTokens(ctx, exec, models.AccessTokenWhere.Token.EQ(request.AccessToken)); err != nil {
 			return err
 		}
 
@@ -234,8 +345,22 @@ func (s *Service) Logout(ctx context.Context, request dto.LogoutRequest) error {
 			return nil
 		}
 
-		if _, err := models.RefreshTokens(models.RefreshTokenWhere.Token.EQ(request.RefreshToken.String)).DeleteAll(ctx, exec); err != nil {
-			log.Err(err).Msg("Failed to delete refresh token")
+		refreshToken, err := models.RefreshTokens(
+			qm.Select(models.RefreshTokenColumns.SessionID),
+			models.RefreshTokenWhere.Token.EQ(request.RefreshToken.String),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+
+			log.Err(err).Msg("Failed to load refresh token")
+			return err
+		}
+
+		// rotated refresh tokens of the session are deleted as well
+		if _, err := models.RefreshTokens(models.RefreshTokenWhere.SessionID.EQ(refreshToken.SessionID)).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete refresh tokens")
 			return err
 		}
 
@@ -251,6 +376,11 @@ func (s *Service) Logout(ctx context.Context, request dto.LogoutRequest) error {
 func (s *Service) Login(ctx context.Context, request dto.LoginRequest) (dto.LoginResult, error) {
 	log := util.LogFromContext(ctx)
 
+	attemptKeys := s.loginAttemptKeys(request.Username.String(), request.Session.IPAddress)
+	if err := s.checkLockout(ctx, attemptKeys); err != nil {
+		return dto.LoginResult{}, err
+	}
+
 	user, err := models.Users(
 		models.UserWhere.Username.EQ(null.StringFrom(request.Username.String())),
 	).One(ctx, s.db)
@@ -261,7 +391,7 @@ func (s *Service) Login(ctx context.Context, request dto.LoginRequest) (dto.Logi
 
 		log.Err(err).Msg("Failed to load user")
 
-		return dto.LoginResult{}, echo.ErrUnauthorized
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.String{}, loginMethodPassword)
 	}
 
 	if !user.IsActive {
@@ -271,31 +401,51 @@ func (s *Service) Login(ctx context.Context, request dto.LoginRequest) (dto.Logi
 
 	if !user.Password.Valid {
 		log.Debug().Msg("User is missing password, forbidding authentication")
-		return dto.LoginResult{}, echo.ErrUnauthorized
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.StringFrom(user.ID), loginMethodPassword)
 	}
 
-	match, err := hashing.ComparePasswordAndHash(request.Password, user.Password.String)
+	match, err := s.hasher.ComparePasswordAndHash(ctx, request.Password, user.Password.String)
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to compare password with stored hash")
-		return dto.LoginResult{}, echo.ErrUnauthorized
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.StringFrom(user.ID), loginMethodPassword)
 	}
 
 	if !match {
 		log.Debug().Msg("Provided password does not match stored hash")
-		return dto.LoginResult{}, echo.ErrUnauthorized
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.StringFrom(user.ID), loginMethodPassword)
+	}
+
+	s.rehashPasswordIfNeeded(ctx, s.db, user, request.Password)
+
+	if user.PasswordResetRequired {
+		log.Debug().Msg("User is required to reset password, rejecting authentication")
+		return dto.LoginResult{}, httperrors.ErrForbiddenPasswordResetRequired
 	}
 
 	var result dto.LoginResult
-	err = db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+	err = s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
 		var err error
-		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
-			User: mapper.LocalUserToDTO(user),
+		result, err = s.authenticateUserWithTwoFactor(ctx, exec, dto.AuthenticateUserRequest{
+			User:    mapper.LocalUserToDTO(user),
+			Session: request.Session,
 		})
 		if err != nil {
 			log.Err(err).Msg("Failed to authenticate user")
 			return err
 		}
 
+		// failed attempts are only reset once fully authenticated, otherwise the
+		// correct password would allow unlimited attempts to guess the second factor
+		if !result.RequiresTwoFactor() {
+			if err := s.resetFailedAttempts(ctx, exec, attemptKeys[:1]); err != nil {
+				return err
+			}
+
+			if err := s.recordLogin(ctx, exec, user.ID, loginMethodPassword); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -309,57 +459,121 @@ func (s *Service) Login(ctx context.Context, request dto.LoginRequest) (dto.Logi
 func (s *Service) Refresh(ctx context.Context, request dto.RefreshRequest) (dto.LoginResult, error) {
 	log := util.LogFromContext(ctx)
 
-	oldRefreshToken, err := models.RefreshTokens(
-		models.RefreshTokenWhere.Token.EQ(request.RefreshToken),
-		qm.Load(models.RefreshTokenRels.User),
-	).One(ctx, s.db)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Debug().Err(err).Msg("Refresh token not found")
-			return dto.LoginResult{}, echo.ErrUnauthorized
+	var (
+		result  dto.LoginResult
+		revoked bool
+	)
+	err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		// locking the refresh token ensures concurrent refreshes cannot both rotate it
+		oldRefreshToken, err := models.RefreshTokens(
+			models.RefreshTokenWhere.Token.EQ(request.RefreshToken),
+			qm.Load(models.RefreshTokenRels.User),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Refresh token not found")
+				return echo.ErrUnauthorized
+			}
+
+			log.Err(err).Msg("Failed to load refresh token")
+			return err
 		}
 
-		log.Err(err).Msg("Failed to load refresh token")
-		return dto.LoginResult{}, err
-	}
+		log := log.With().Str("userID", oldRefreshToken.UserID).Str("sessionID", oldRefreshToken.SessionID).Logger()
 
-	user := oldRefreshToken.R.User
+		if oldRefreshToken.RotatedAt.Valid {
+			log.Warn().Time("rotatedAt", oldRefreshToken.RotatedAt.Time).Msg("Rotated refresh token was used again, revoking session")
 
-	if !user.IsActive {
-		log.Debug().Msg("User is deactivated, rejecting token refresh")
-		return dto.LoginResult{}, httperrors.ErrForbiddenUserDeactivated
-	}
+			if err := s.revokeReusedRefreshToken(ctx, exec, oldRefreshToken, request.Session); err != nil {
+				return err
+			}
 
-	var result dto.LoginResult
-	err = db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
-		_, err = oldRefreshToken.Delete(ctx, exec)
-		if err != nil {
-			log.Err(err).Msg("Failed to delete old refresh token")
+			revoked = true
+			return nil
+		}
+
+		now := s.clock.Now()
+
+		if s.refreshTokenExpired(oldRefreshToken, now) {
+			log.Debug().Time("lastUsedAt", oldRefreshToken.LastUsedAt).Time("sessionStartedAt", oldRefreshToken.SessionStartedAt).Msg("Refresh token has expired, ending session")
+
+			if err := s.revokeSessions(ctx, exec, oldRefreshToken.UserID, []string{oldRefreshToken.SessionID}); err != nil {
+				return err
+			}
+
+			revoked = true
+			return nil
+		}
+
+		user := oldRefreshToken.R.User
+
+		if !user.IsActive {
+			log.Debug().Msg("User is deactivated, rejecting token refresh")
+			return httperrors.ErrForbiddenUserDeactivated
+		}
+
+		// rotated refresh tokens are kept to detect their reuse
+		oldRefreshToken.RotatedAt = null.TimeFrom(now)
+		if _, err := oldRefreshToken.Update(ctx, exec, boil.Whitelist(models.RefreshTokenColumns.RotatedAt, models.RefreshTokenColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to rotate old refresh token")
 			return err
 		}
 
+		// once past the idle timeout, rotated refresh tokens would be rejected anyway and are no longer needed
+		if s.config.Auth.RefreshTokenIdleTimeout > 0 {
+			if _, err := models.RefreshTokens(
+				models.RefreshTokenWhere.SessionID.EQ(oldRefreshToken.SessionID),
+				models.RefreshTokenWhere.RotatedAt.IsNotNull(),
+				models.RefreshTokenWhere.LastUsedAt.LT(now.Add(-s.config.Auth.RefreshTokenIdleTimeout)),
+			).DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete idle rotated refresh tokens")
+				return err
+			}
+		}
+
+		// the refreshed tokens continue the session of the old refresh token
+		session := request.Session
+		session.DeviceName = oldRefreshToken.DeviceName
+
 		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
 			User:                     mapper.LocalUserToDTO(user),
 			InvalidateExistingTokens: false,
+			Session:                  session,
+			SessionID:                null.StringFrom(oldRefreshToken.SessionID),
+			SessionStartedAt:         oldRefreshToken.SessionStartedAt,
 		})
 		if err != nil {
 			log.Err(err).Msg("Failed to authenticate user")
 			return err
 		}
 
-		return nil
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeTokenRefreshed, user.ID, map[string]any{"sessionId": oldRefreshToken.SessionID})
 	})
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to refresh token")
 		return dto.LoginResult{}, err
 	}
 
+	if revoked {
+		return dto.LoginResult{}, echo.ErrUnauthorized
+	}
+
 	return result, nil
 }
 
 func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dto.RegisterResult, error) {
 	log := util.LogFromContext(ctx).With().Str("username", request.Username.String()).Logger()
 
+	if request.InviteToken.Valid {
+		return s.registerWithInvite(ctx, request)
+	}
+
+	if s.config.Auth.RegistrationRequiresInvite {
+		log.Debug().Msg("Registration requires an invite, rejecting self-signup")
+		return dto.RegisterResult{}, httperrors.ErrForbiddenInviteRequired
+	}
+
 	user, err := models.Users(
 		models.UserWhere.Username.EQ(null.StringFrom(request.Username.String())),
 	).One(ctx, s.db)
@@ -405,7 +619,13 @@ func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dt
 		}, nil
 	}
 
-	hash, err := hashing.HashPassword(request.Password, hashing.DefaultArgon2Params)
+	if err := s.validatePassword(ctx, s.db, "password", request.Password, dto.User{
+		Username: null.StringFrom(request.Username.String()),
+	}); err != nil {
+		return dto.RegisterResult{}, err
+	}
+
+	hash, err := s.hasher.HashPassword(ctx, request.Password)
 	if err != nil {
 		log.Err(err).Msg("Failed to hash user password")
 		return dto.RegisterResult{}, httperrors.ErrBadRequestInvalidPassword
@@ -415,7 +635,7 @@ func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dt
 		RequiresConfirmation: s.config.Auth.RegistrationRequiresConfirmation,
 	}
 
-	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
 		user := &models.User{
 			Username:             null.StringFrom(request.Username.String()),
 			Password:             null.StringFrom(hash),
@@ -462,55 +682,73 @@ func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dt
 	return result, nil
 }
 
-func (s *Service) DeleteUserAccount(ctx context.Context, request dto.DeleteUserAccountRequest) error {
+// DeleteUserAccount deletes the account of the user. If a grace period is configured, the account is deactivated
+// and only purged after the grace period, see scheduleAccountDeletion.
+func (s *Service) DeleteUserAccount(ctx context.Context, request dto.DeleteUserAccountRequest) (dto.DeleteUserAccountResult, error) {
 	log := util.LogFromContext(ctx)
 
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.DeleteUserAccountResult{}, err
+	}
+
 	if !request.User.IsActive {
 		log.Debug().Msg("User is deactivated, rejecting deletion")
-		return httperrors.ErrForbiddenUserDeactivated
+		return dto.DeleteUserAccountResult{}, httperrors.ErrForbiddenUserDeactivated
 	}
 
 	if !request.User.PasswordHash.Valid {
 		log.Debug().Msg("Failed to delete user account, user is missing password")
-		return httperrors.ErrForbiddenNotLocalUser
+		return dto.DeleteUserAccountResult{}, httperrors.ErrForbiddenNotLocalUser
 	}
 
-	match, err := hashing.ComparePasswordAndHash(request.CurrentPassword, request.User.PasswordHash.String)
+	match, err := s.hasher.ComparePasswordAndHash(ctx, request.CurrentPassword, request.User.PasswordHash.String)
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to compare password with stored hash")
-		return echo.ErrUnauthorized
+		return dto.DeleteUserAccountResult{}, echo.ErrUnauthorized
 	}
 
 	if !match {
 		log.Debug().Msg("Provided password does not match stored hash")
-		return echo.ErrUnauthorized
+		return dto.DeleteUserAccountResult{}, echo.ErrUnauthorized
 	}
 
-	// delete the user and all related data
-	err = db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
-		_, err = models.Users(
-			models.UserWhere.ID.EQ(request.User.ID),
-		).DeleteAll(ctx, exec)
-		if err != nil {
-			log.Err(err).Msg("Failed to delete user")
+	var result dto.DeleteUserAccountResult
+	err = s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		if s.config.Auth.AccountDeletionGracePeriod > 0 {
+			deletion, err := s.scheduleAccountDeletion(ctx, exec, request.User)
+			if err != nil {
+				return err
+			}
+
+			result.Deletion = &deletion
+
+			return s.recordAuditEvent(ctx, exec, audit.EventTypeAccountDeletionScheduled, request.User.ID, map[string]any{
+				"scheduledAt": deletion.ScheduledAt,
+			})
+		}
+
+		// delete the user and all related data
+		if err := s.deleteUser(ctx, exec, request.User.ID); err != nil {
 			return err
 		}
 
-		return nil
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeAccountDeleted, request.User.ID, nil)
 	})
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to delete user account")
-		return err
+		return dto.DeleteUserAccountResult{}, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *Service) CompleteRegister(ctx context.Context, request dto.CompleteRegisterRequest) (dto.LoginResult, error) {
 	log := util.LogFromContext(ctx)
 
 	var result dto.LoginResult
-	err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+	err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
 		confirmationToken, err := models.ConfirmationTokens(
 			models.ConfirmationTokenWhere.Token.EQ(request.ConfirmationToken),
 			models.ConfirmationTokenWhere.ValidUntil.GT(s.clock.Now()),
@@ -545,7 +783,8 @@ func (s *Service) CompleteRegister(ctx context.Context, request dto.CompleteRegi
 		}
 
 		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
-			User: mapper.LocalUserToDTO(confirmationToken.R.User),
+			User:    mapper.LocalUserToDTO(confirmationToken.R.User),
+			Session: request.Session,
 		})
 		if err != nil {
 			log.Err(err).Msg("Failed to authenticate user")
@@ -571,33 +810,24 @@ func (s *Service) authenticateUser(ctx context.Context, exec boil.ContextExecuto
 	}
 
 	if request.InvalidateExistingTokens {
-		if _, err := models.AccessTokens(
-			models.AccessTokenWhere.UserID.EQ(request.User.ID),
-		).DeleteAll(ctx, exec); err != nil {
-			log.Err(err).Msg("Failed to delete existing access tokens")
-			return dto.LoginResult{}, err
-		}
-
-		if _, err := models.RefreshTokens(
-			models.RefreshTokenWhere.UserID.EQ(request.User.ID),
-		).DeleteAll(ctx, exec); err != nil {
-			log.Err(err).Msg("Failed to delete existing refresh tokens")
+		if err := s.deleteUserTokens(ctx, exec, request.User.ID); err != nil {
 			return dto.LoginResult{}, err
 		}
 	}
 
-	accessToken := models.AccessToken{
-		ValidUntil: s.clock.Now().Add(s.config.Auth.AccessTokenValidity),
+	refreshToken := models.RefreshToken{
 		UserID:     request.User.ID,
+		DeviceName: request.Session.DeviceName,
+		UserAgent:  null.NewString(request.Session.UserAgent, len(request.Session.UserAgent) > 0),
+		IPAddress:  null.NewString(request.Session.IPAddress, len(request.Session.IPAddress) > 0),
+		LastUsedAt: s.clock.Now(),
 	}
 
-	if err := accessToken.Insert(ctx, exec, boil.Infer()); err != nil {
-		log.Err(err).Msg("Failed to insert access token")
-		return dto.LoginResult{}, err
-	}
-
-	refreshToken := models.RefreshToken{
-		UserID: request.User.ID,
+	if request.SessionID.Valid {
+		refreshToken.SessionID = request.SessionID.String
+		refreshToken.SessionStartedAt = request.SessionStartedAt
+	} else {
+		refreshToken.SessionStartedAt = refreshToken.LastUsedAt
 	}
 
 	if err := refreshToken.Insert(ctx, exec, boil.Infer()); err != nil {
@@ -613,8 +843,52 @@ func (s *Service) authenticateUser(ctx context.Context, exec boil.ContextExecuto
 		return dto.LoginResult{}, err
 	}
 
-	result.AccessToken = accessToken.Token
+	accessToken, err := s.issueAccessToken(ctx, exec, u, refreshToken.SessionID)
+	if err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	result.AccessToken = accessToken
 	result.RefreshToken = refreshToken.Token
 
 	return result, nil
 }
+
+// rejectLogin records the failed login attempt, returning the error to respond with.
+func (s *Service) rejectLogin(ctx context.Context, attemptKeys []attemptKey, userID null.String, method string) error {
+	if err := s.recordFailedAttempt(ctx, attemptKeys); err != nil {
+		return err
+	}
+
+	if err := s.recordFailedLogin(ctx, userID, method); err != nil {
+		return err
+	}
+
+	return echo.ErrUnauthorized
+}
+
+// deleteUserTokens deletes all access and refresh tokens as well as the API keys of the user, signing the user out
+// everywhere.
+func (s *Service) deleteUserTokens(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	log := util.LogFromContext(ctx)
+
+	if err := s.revokeAccessTokens(ctx, exec, models.AccessTokenWhere.UserID.EQ(userID)); err != nil {
+		return err
+	}
+
+	if _, err := models.RefreshTokens(
+		models.RefreshTokenWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec); err != nil {
+		log.Err(err).Msg("Failed to delete existing refresh tokens")
+		return err
+	}
+
+	if _, err := models.APIKeys(
+		models.APIKeyWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec); err != nil {
+		log.Err(err).Msg("Failed to delete existing API keys")
+		return err
+	}
+
+	return nil
+}
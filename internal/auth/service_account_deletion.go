@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/export"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+)
+
+// CancelAccountDeletion reactivates the account scheduled for deletion using the token sent by email. All tokens
+// have been revoked when scheduling the deletion, so the user has to log in again afterwards.
+func (s *Service) CancelAccountDeletion(ctx context.Context, token string) error {
+	log := util.LogFromContext(ctx)
+
+	return s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		user, err := models.Users(
+			models.UserWhere.DeletionCancelToken.EQ(null.StringFrom(token)),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Account deletion not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Err(err).Msg("Failed to load account deletion")
+			return err
+		}
+
+		if !user.DeletionScheduledAt.Time.After(s.clock.Now()) {
+			log.Debug().Str("userID", user.ID).Time("deletionScheduledAt", user.DeletionScheduledAt.Time).Msg("Account deletion is already due")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		user.IsActive = true
+		user.DeletionScheduledAt = null.Time{}
+		user.DeletionCancelToken = null.String{}
+		user.DeletionReminderSentAt = null.Time{}
+
+		if _, err := user.Update(ctx, exec, boil.Whitelist(
+			models.UserColumns.IsActive,
+			models.UserColumns.DeletionScheduledAt,
+			models.UserColumns.DeletionCancelToken,
+			models.UserColumns.DeletionReminderSentAt,
+			models.UserColumns.UpdatedAt,
+		)); err != nil {
+			log.Err(err).Str("userID", user.ID).Msg("Failed to cancel account deletion")
+			return err
+		}
+
+		log.Debug().Str("userID", user.ID).Msg("Cancelled account deletion")
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeAccountDeletionCancelled, user.ID, nil)
+	})
+}
+
+// ClaimAccountDeletionReminders returns the scheduled account deletions due within AccountDeletionReminderBefore,
+// which have not been reminded of yet. Deletions are marked as reminded, so every reminder is claimed only once.
+func (s *Service) ClaimAccountDeletionReminders(ctx context.Context) ([]dto.AccountDeletion, error) {
+	log := util.LogFromContext(ctx)
+
+	now := s.clock.Now()
+
+	var deletions []dto.AccountDeletion
+	err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		users, err := models.Users(
+			models.UserWhere.IsActive.EQ(false),
+			models.UserWhere.DeletionScheduledAt.GT(null.TimeFrom(now)),
+			models.UserWhere.DeletionScheduledAt.LTE(null.TimeFrom(now.Add(s.config.Auth.AccountDeletionReminderBefore))),
+			models.UserWhere.DeletionReminderSentAt.IsNull(),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to load account deletions to remind of")
+			return err
+		}
+
+		if len(users) == 0 {
+			return nil
+		}
+
+		if _, err := users.UpdateAll(ctx, exec, models.M{
+			models.UserColumns.DeletionReminderSentAt: null.TimeFrom(now),
+		}); err != nil {
+			log.Err(err).Msg("Failed to mark account deletions as reminded")
+			return err
+		}
+
+		deletions = make([]dto.AccountDeletion, 0, len(users))
+		for _, user := range users {
+			deletions = append(deletions, dto.AccountDeletion{
+				UserID:      user.ID,
+				Email:       user.Username,
+				ScheduledAt: user.DeletionScheduledAt.Time,
+				CancelToken: user.DeletionCancelToken.String,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletions, nil
+}
+
+// PurgeAccountDeletions deletes all accounts whose scheduled deletion is due, returning the number of purged accounts.
+func (s *Service) PurgeAccountDeletions(ctx context.Context) (int64, error) {
+	log := util.LogFromContext(ctx)
+
+	var purged int64
+	err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		// accounts reactivated in the meantime (e.g. by administrators) are never purged
+		users, err := models.Users(
+			models.UserWhere.IsActive.EQ(false),
+			models.UserWhere.DeletionScheduledAt.LTE(null.TimeFrom(s.clock.Now())),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to load account deletions to purge")
+			return err
+		}
+
+		if len(users) == 0 {
+			return nil
+		}
+
+		for _, user := range users {
+			if err := export.DeleteUserArchives(ctx, exec, s.config, user.ID); err != nil {
+				return err
+			}
+		}
+
+		purged, err = users.DeleteAll(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to purge account deletions")
+			return err
+		}
+
+		for _, user := range users {
+			if err := s.audit.Record(ctx, exec, audit.Event{
+				Type:      audit.EventTypeAccountDeleted,
+				SubjectID: null.StringFrom(user.ID),
+				Metadata:  map[string]any{"purged": true},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// scheduleAccountDeletion deactivates the user and revokes all tokens, scheduling the purge of the account after
+// AccountDeletionGracePeriod.
+func (s *Service) scheduleAccountDeletion(ctx context.Context, exec boil.ContextExecutor, user dto.User) (dto.AccountDeletion, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", user.ID).Logger()
+
+	if err := s.revokeAllUserTokens(ctx, exec, user.ID); err != nil {
+		return dto.AccountDeletion{}, err
+	}
+
+	deletion := dto.AccountDeletion{
+		UserID:      user.ID,
+		Email:       user.Username,
+		ScheduledAt: s.clock.Now().Add(s.config.Auth.AccountDeletionGracePeriod),
+		CancelToken: uuid.NewString(),
+	}
+
+	if _, err := models.Users(
+		models.UserWhere.ID.EQ(user.ID),
+	).UpdateAll(ctx, exec, models.M{
+		models.UserColumns.IsActive:               false,
+		models.UserColumns.DeletionScheduledAt:    null.TimeFrom(deletion.ScheduledAt),
+		models.UserColumns.DeletionCancelToken:    null.StringFrom(deletion.CancelToken),
+		models.UserColumns.DeletionReminderSentAt: null.Time{},
+		models.UserColumns.UpdatedAt:              s.clock.Now(),
+	}); err != nil {
+		log.Err(err).Msg("Failed to schedule account deletion")
+		return dto.AccountDeletion{}, err
+	}
+
+	log.Debug().Time("deletionScheduledAt", deletion.ScheduledAt).Msg("Scheduled account deletion")
+
+	return deletion, nil
+}
+
+func (s *Service) deleteUser(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	// revoke tokens explicitly as JWT access tokens would otherwise stay valid after their cascaded deletion
+	if err := s.deleteUserTokens(ctx, exec, userID); err != nil {
+		return err
+	}
+
+	if err := s.revokeImpersonationTokens(ctx, exec, userID); err != nil {
+		return err
+	}
+
+	// data exports are deleted by cascade, their archives have to be removed explicitly
+	if err := export.DeleteUserArchives(ctx, exec, s.config, userID); err != nil {
+		return err
+	}
+
+	if _, err := models.Users(
+		models.UserWhere.ID.EQ(userID),
+	).DeleteAll(ctx, exec); err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to delete user")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+func (s *Service) GetAdminUsers(ctx context.Context, request dto.GetAdminUsersRequest) (dto.AdminUsers, error) {
+	log := util.LogFromContext(ctx)
+
+	filters := []qm.QueryMod{}
+	if request.Query.Valid && len(request.Query.String) > 0 {
+		filters = append(filters, db.ILikeSearch(request.Query.String, models.TableNames.Users, models.UserColumns.Username))
+	}
+
+	if request.IsActive.Valid {
+		filters = append(filters, models.UserWhere.IsActive.EQ(request.IsActive.Bool))
+	}
+
+	if request.Scope.Valid {
+		filters = append(filters, qm.Where("? = ANY("+models.TableNames.Users+"."+models.UserColumns.Scopes+")", request.Scope.String))
+	}
+
+	total, err := models.Users(filters...).Count(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to count users")
+		return dto.AdminUsers{}, err
+	}
+
+	var orderBy qm.QueryMod
+	switch request.OrderBy {
+	case dto.AdminUserOrderByCreatedAt:
+		orderBy = db.OrderBy(request.OrderDir, models.TableNames.Users, models.UserColumns.CreatedAt)
+	case dto.AdminUserOrderByLastAuthenticatedAt:
+		orderBy = db.OrderByWithNulls(request.OrderDir, db.OrderByNullsLast, models.TableNames.Users, models.UserColumns.LastAuthenticatedAt)
+	default:
+		orderBy = db.OrderByLowerWithNulls(request.OrderDir, db.OrderByNullsLast, models.TableNames.Users, models.UserColumns.Username)
+	}
+
+	// the ID keeps the order stable across pages for users with equal values
+	mods := append([]qm.QueryMod{}, filters...)
+	mods = append(mods,
+		orderBy,
+		db.OrderBy(request.OrderDir, models.TableNames.Users, models.UserColumns.ID),
+		qm.Offset(int(request.Offset)),
+		qm.Limit(int(request.Limit)),
+	)
+
+	users, err := models.Users(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load users")
+		return dto.AdminUsers{}, err
+	}
+
+	result := dto.AdminUsers{
+		Data:   make([]dto.AdminUser, 0, len(users)),
+		Offset: request.Offset,
+		Limit:  request.Limit,
+		Total:  total,
+	}
+
+	for _, user := range users {
+		result.Data = append(result.Data, mapper.LocalUserToAdminDTO(user))
+	}
+
+	return result, nil
+}
+
+// GetAdminUser returns the user including the current login lockout state.
+func (s *Service) GetAdminUser(ctx context.Context, userID string) (dto.AdminUser, error) {
+	user, err := s.findAdminUser(ctx, s.db, userID)
+	if err != nil {
+		return dto.AdminUser{}, err
+	}
+
+	result := mapper.LocalUserToAdminDTO(user)
+
+	if user.Username.Valid {
+		lockout, err := s.GetUserLockout(ctx, dto.NewUsername(user.Username.String))
+		if err != nil {
+			return dto.AdminUser{}, err
+		}
+
+		result.Lockout = &lockout
+	}
+
+	return result, nil
+}
+
+// SetUserActive activates or deactivates the user, deactivation revokes all sessions of the user.
+func (s *Service) SetUserActive(ctx context.Context, request dto.SetUserActiveRequest) (dto.AdminUser, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.UserID).Bool("isActive", request.IsActive).Logger()
+
+	var result dto.AdminUser
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		user, err := s.findAdminUser(ctx, exec, request.UserID)
+		if err != nil {
+			return err
+		}
+
+		user.IsActive = request.IsActive
+		if request.IsActive {
+			// reactivating the user cancels a scheduled account deletion
+			user.DeletionScheduledAt = null.Time{}
+			user.DeletionCancelToken = null.String{}
+			user.DeletionReminderSentAt = null.Time{}
+		}
+
+		if _, err := user.Update(ctx, exec, boil.Whitelist(
+			models.UserColumns.IsActive,
+			models.UserColumns.DeletionScheduledAt,
+			models.UserColumns.DeletionCancelToken,
+			models.UserColumns.DeletionReminderSentAt,
+			models.UserColumns.UpdatedAt,
+		)); err != nil {
+			log.Err(err).Msg("Failed to update user")
+			return err
+		}
+
+		if !request.IsActive {
+			if err := s.revokeAllUserTokens(ctx, exec, user.ID); err != nil {
+				return err
+			}
+		}
+
+		result = mapper.LocalUserToAdminDTO(user)
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to set user active state")
+		return dto.AdminUser{}, err
+	}
+
+	return result, nil
+}
+
+// ForceLogout revokes all sessions of the user.
+func (s *Service) ForceLogout(ctx context.Context, userID string) error {
+	log := util.LogFromContext(ctx).With().Str("userID", userID).Logger()
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		if _, err := s.findAdminUser(ctx, exec, userID); err != nil {
+			return err
+		}
+
+		return s.revokeAllUserTokens(ctx, exec, userID)
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to force logout of user")
+		return err
+	}
+
+	return nil
+}
+
+// ForcePasswordReset revokes all sessions of the user and rejects all logins until the password was
+// reset using the returned reset token. Debouncing and lockout of the public password reset do not apply.
+func (s *Service) ForcePasswordReset(ctx context.Context, userID string) (dto.ForcePasswordResetResult, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", userID).Logger()
+
+	var result dto.ForcePasswordResetResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		user, err := s.findAdminUser(ctx, exec, userID)
+		if err != nil {
+			return err
+		}
+
+		if !user.Password.Valid {
+			log.Debug().Msg("User is missing password, rejecting forced password reset")
+			return httperrors.ErrForbiddenNotLocalUser
+		}
+
+		user.PasswordResetRequired = true
+		if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.PasswordResetRequired, models.UserColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to update user")
+			return err
+		}
+
+		if err := s.revokeAllUserTokens(ctx, exec, user.ID); err != nil {
+			return err
+		}
+
+		passwordResetToken := models.PasswordResetToken{
+			UserID:     user.ID,
+			ValidUntil: s.clock.Now().Add(s.config.Auth.PasswordResetTokenValidity),
+		}
+
+		if err := passwordResetToken.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert password reset token")
+			return err
+		}
+
+		result = dto.ForcePasswordResetResult{
+			Username:   user.Username.String,
+			ResetToken: null.StringFrom(passwordResetToken.Token),
+		}
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypePasswordResetRequested, user.ID, map[string]any{"forced": true})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to force password reset")
+		return dto.ForcePasswordResetResult{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) findAdminUser(ctx context.Context, exec boil.ContextExecutor, userID string) (*models.User, error) {
+	user, err := models.FindUser(ctx, exec, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			util.LogFromContext(ctx).Debug().Err(err).Str("userID", userID).Msg("User not found")
+			return nil, httperrors.ErrNotFoundUserNotFound
+		}
+
+		util.LogFromContext(ctx).Err(err).Str("userID", userID).Msg("Failed to load user")
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// revokeAllUserTokens deletes all access, refresh and push tokens and API keys of the user, including the impersonation
+// tokens issued to the user.
+func (s *Service) revokeAllUserTokens(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	if err := s.deleteUserTokens(ctx, exec, userID); err != nil {
+		return err
+	}
+
+	if err := s.revokeImpersonationTokens(ctx, exec, userID); err != nil {
+		return err
+	}
+
+	if _, err := models.PushTokens(models.PushTokenWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to delete push tokens")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	apiKeyPrefixBytes = 8
+	apiKeySecretBytes = 32
+	// apiKeyLastUsedAtInterval limits the writes caused by tracking the last usage of API keys
+	apiKeyLastUsedAtInterval = time.Minute
+)
+
+func (s *Service) GetAPIKeys(ctx context.Context, userID string) (dto.APIKeys, error) {
+	apiKeys, err := models.APIKeys(
+		models.APIKeyWhere.UserID.EQ(userID),
+		qm.OrderBy(models.APIKeyColumns.CreatedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Str("userID", userID).Msg("Failed to load API keys")
+		return nil, err
+	}
+
+	result := make(dto.APIKeys, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		result = append(result, mapper.LocalAPIKeyToDTO(apiKey))
+	}
+
+	return result, nil
+}
+
+// CreateAPIKey creates an API key granting the requested scopes, which have to be assigned to the user.
+func (s *Service) CreateAPIKey(ctx context.Context, request dto.CreateAPIKeyRequest) (dto.CreateAPIKeyResult, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.UserID).Logger()
+
+	if request.ValidUntil.Valid && !request.ValidUntil.Time.After(s.clock.Now()) {
+		log.Debug().Time("validUntil", request.ValidUntil.Time).Msg("API key expiry is not in the future")
+		return dto.CreateAPIKeyResult{}, httperrors.ErrBadRequestInvalidAPIKeyExpiry
+	}
+
+	user, err := models.FindUser(ctx, s.db, request.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("User not found")
+			return dto.CreateAPIKeyResult{}, httperrors.ErrNotFoundUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.CreateAPIKeyResult{}, err
+	}
+
+	for _, scope := range request.Scopes {
+		if !slices.Contains(user.Scopes, scope) {
+			log.Debug().Str("scope", scope).Strs("userScopes", user.Scopes).Msg("API key scope is not assigned to user")
+			return dto.CreateAPIKeyResult{}, httperrors.ErrBadRequestInvalidAPIKeyScopes
+		}
+	}
+
+	prefix, err := util.GenerateRandomHexString(apiKeyPrefixBytes)
+	if err != nil {
+		log.Err(err).Msg("Failed to generate API key prefix")
+		return dto.CreateAPIKeyResult{}, err
+	}
+
+	secret, err := util.GenerateRandomHexString(apiKeySecretBytes)
+	if err != nil {
+		log.Err(err).Msg("Failed to generate API key secret")
+		return dto.CreateAPIKeyResult{}, err
+	}
+
+	apiKey := models.APIKey{
+		UserID:     user.ID,
+		Name:       request.Name,
+		Prefix:     prefix,
+		KeyHash:    hashAPIKeySecret(secret),
+		Scopes:     request.Scopes,
+		ValidUntil: request.ValidUntil,
+	}
+
+	if err := apiKey.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert API key")
+		return dto.CreateAPIKeyResult{}, err
+	}
+
+	return dto.CreateAPIKeyResult{
+		APIKey: mapper.LocalAPIKeyToDTO(&apiKey),
+		Key:    prefix + "." + secret,
+	}, nil
+}
+
+func (s *Service) DeleteAPIKey(ctx context.Context, request dto.DeleteAPIKeyRequest) error {
+	log := util.LogFromContext(ctx).With().Str("userID", request.UserID).Str("apiKeyID", request.APIKeyID).Logger()
+
+	count, err := models.APIKeys(
+		models.APIKeyWhere.ID.EQ(request.APIKeyID),
+		models.APIKeyWhere.UserID.EQ(request.UserID),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to delete API key")
+		return err
+	}
+
+	if count == 0 {
+		log.Debug().Msg("API key not found")
+		return httperrors.ErrNotFoundAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// ValidateAPIKey looks up the API key by its prefix and verifies its secret. The scopes of the result are the
+// scopes granted to the key that are still assigned to the user, the token is the ID of the API key.
+func (s *Service) ValidateAPIKey(ctx context.Context, key string) (Result, error) {
+	log := util.LogFromContext(ctx)
+
+	prefix, secret, ok := strings.Cut(key, ".")
+	if !ok || len(prefix) == 0 || len(secret) == 0 {
+		log.Trace().Msg("API key is malformed")
+		return Result{}, ErrInvalidToken
+	}
+
+	apiKey, err := models.APIKeys(
+		models.APIKeyWhere.Prefix.EQ(prefix),
+		qm.Load(qm.Rels(models.APIKeyRels.User, models.UserRels.AppUserProfile)),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Trace().Err(err).Msg("API key not found in database")
+			return Result{}, ErrInvalidToken
+		}
+
+		log.Err(err).Msg("Failed to load API key")
+		return Result{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(secret)), []byte(apiKey.KeyHash)) != 1 {
+		log.Trace().Msg("API key secret does not match stored hash")
+		return Result{}, ErrInvalidToken
+	}
+
+	scopes := make([]string, 0, len(apiKey.Scopes))
+	for _, scope := range apiKey.Scopes {
+		if slices.Contains(apiKey.R.User.Scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	permissions, err := s.getPermissions(ctx, s.db, scopes)
+	if err != nil {
+		return Result{}, err
+	}
+
+	now := s.clock.Now()
+	if !apiKey.LastUsedAt.Valid || now.Sub(apiKey.LastUsedAt.Time) >= apiKeyLastUsedAtInterval {
+		apiKey.LastUsedAt.SetValid(now)
+		if _, err := apiKey.Update(ctx, s.db, boil.Whitelist(models.APIKeyColumns.LastUsedAt)); err != nil {
+			// tracking the last usage is best effort only, the request may proceed regardless
+			log.Err(err).Str("apiKeyID", apiKey.ID).Msg("Failed to update last used time of API key")
+		}
+	}
+
+	user := mapper.LocalUserToDTO(apiKey.R.User)
+	user.Scopes = scopes
+
+	return Result{
+		Token:       apiKey.ID,
+		User:        &user,
+		ValidUntil:  apiKey.ValidUntil.Time,
+		Scopes:      scopes,
+		Permissions: permissions,
+	}, nil
+}
+
+// hashAPIKeySecret returns the hex encoded SHA-256 digest of the secret. Unlike passwords, secrets are random with
+// apiKeySecretBytes of entropy, so a fast hash suffices and validating API keys does not compete with logins for the
+// password hasher.
+func hashAPIKeySecret(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// Login methods recorded within the metadata of login audit events.
+const (
+	loginMethodPassword  = "password"
+	loginMethodTwoFactor = "two_factor"
+	loginMethodMagicLink = "magic_link"
+	loginMethodOIDC      = "oidc"
+	loginMethodPasskey   = "passkey"
+)
+
+// recordAuditEvent records the event affecting the user's account. The event is attributed to the authenticated user
+// of the context if present (e.g. administrators) or its impersonator, otherwise to the user itself.
+func (s *Service) recordAuditEvent(ctx context.Context, exec boil.ContextExecutor, eventType audit.EventType, userID string, metadata map[string]any) error {
+	actorID := userID
+	if impersonator := ImpersonatorFromContext(ctx); impersonator != nil {
+		actorID = impersonator.ID
+	} else if user := UserFromContext(ctx); user != nil {
+		actorID = user.ID
+	}
+
+	return s.audit.Record(ctx, exec, audit.Event{
+		Type:      eventType,
+		ActorID:   null.StringFrom(actorID),
+		SubjectID: null.StringFrom(userID),
+		Metadata:  metadata,
+	})
+}
+
+func (s *Service) recordLogin(ctx context.Context, exec boil.ContextExecutor, userID string, method string) error {
+	return s.recordAuditEvent(ctx, exec, audit.EventTypeLogin, userID, map[string]any{"method": method})
+}
+
+// recordFailedLogin records the failed login outside of any transaction, as the login itself is rejected. The
+// user is only known if the provided credentials could be associated with an account.
+func (s *Service) recordFailedLogin(ctx context.Context, userID null.String, method string) error {
+	return s.audit.Record(ctx, s.db, audit.Event{
+		Type:      audit.EventTypeLoginFailed,
+		SubjectID: userID,
+		Metadata:  map[string]any{"method": method},
+	})
+}
@@ -0,0 +1,274 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/labstack/echo/v4"
+)
+
+// InitEmailChange stores the new email address of the user as pending change, replacing previously requested
+// changes not confirmed yet. The username is only updated once the change has been confirmed using the returned
+// confirmation token, while the revert token allows the previous address to undo the change.
+func (s *Service) InitEmailChange(ctx context.Context, request dto.InitEmailChangeRequest) (dto.InitEmailChangeResult, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.InitEmailChangeResult{}, err
+	}
+
+	if !request.User.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting email change")
+		return dto.InitEmailChangeResult{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	if !request.User.PasswordHash.Valid {
+		log.Debug().Msg("Failed to change email, user is missing password")
+		return dto.InitEmailChangeResult{}, httperrors.ErrForbiddenNotLocalUser
+	}
+
+	match, err := s.hasher.ComparePasswordAndHash(ctx, request.CurrentPassword, request.User.PasswordHash.String)
+	if err != nil {
+		log.Err(err).Msg("Failed to compare password with stored hash")
+		return dto.InitEmailChangeResult{}, err
+	}
+
+	if !match {
+		log.Debug().Msg("Failed to change email, provided password does not match stored hash")
+		return dto.InitEmailChangeResult{}, echo.ErrUnauthorized
+	}
+
+	if err := s.checkEmailAvailable(ctx, s.db, request.NewEmail.String()); err != nil {
+		return dto.InitEmailChangeResult{}, err
+	}
+
+	var result dto.InitEmailChangeResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		if _, err := models.EmailChangeRequests(
+			models.EmailChangeRequestWhere.UserID.EQ(request.User.ID),
+			models.EmailChangeRequestWhere.ConfirmedAt.IsNull(),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete pending email change requests")
+			return err
+		}
+
+		now := s.clock.Now()
+		emailChangeRequest := &models.EmailChangeRequest{
+			UserID:           request.User.ID,
+			OldEmail:         request.User.Username.String,
+			NewEmail:         request.NewEmail.String(),
+			ValidUntil:       now.Add(s.config.Auth.EmailChangeTokenValidity),
+			RevertValidUntil: now.Add(s.config.Auth.EmailChangeRevertValidity),
+		}
+
+		if err := emailChangeRequest.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert email change request")
+			return err
+		}
+
+		result = dto.InitEmailChangeResult{
+			OldEmail:          emailChangeRequest.OldEmail,
+			NewEmail:          emailChangeRequest.NewEmail,
+			ConfirmationToken: emailChangeRequest.ConfirmationToken,
+			RevertToken:       emailChangeRequest.RevertToken,
+		}
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to initiate email change")
+		return dto.InitEmailChangeResult{}, err
+	}
+
+	return result, nil
+}
+
+// ConfirmEmailChange updates the username of the user to the pending email address. As with password changes,
+// all existing tokens of the user are invalidated and a new set of auth tokens is returned.
+func (s *Service) ConfirmEmailChange(ctx context.Context, request dto.ConfirmEmailChangeRequest) (dto.LoginResult, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	if !request.User.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting email change")
+		return dto.LoginResult{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	var result dto.LoginResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		// the request is locked as it might be confirmed or reverted concurrently
+		emailChangeRequest, err := models.EmailChangeRequests(
+			models.EmailChangeRequestWhere.ConfirmationToken.EQ(request.ConfirmationToken),
+			models.EmailChangeRequestWhere.UserID.EQ(request.User.ID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Email change request not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Err(err).Msg("Failed to load email change request")
+			return err
+		}
+
+		if emailChangeRequest.ConfirmedAt.Valid {
+			log.Debug().Msg("Email change has already been confirmed")
+			return httperrors.ErrNotFoundTokenNotFound
+		}
+
+		if s.clock.Now().After(emailChangeRequest.ValidUntil) {
+			log.Debug().Time("validUntil", emailChangeRequest.ValidUntil).Msg("Email change confirmation token is no longer valid, rejecting email change")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		// the address might have been registered by someone else in the meantime
+		if err := s.checkEmailAvailable(ctx, exec, emailChangeRequest.NewEmail); err != nil {
+			return err
+		}
+
+		request.User.Username = null.StringFrom(emailChangeRequest.NewEmail)
+
+		user := request.User.ToModels()
+		if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.Username, models.UserColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to update user")
+			return err
+		}
+
+		emailChangeRequest.ConfirmedAt = null.TimeFrom(s.clock.Now())
+		if _, err := emailChangeRequest.Update(ctx, exec, boil.Whitelist(models.EmailChangeRequestColumns.ConfirmedAt, models.EmailChangeRequestColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to update email change request")
+			return err
+		}
+
+		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
+			User:                     request.User,
+			InvalidateExistingTokens: true,
+			Session:                  request.Session,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to authenticate user after email change")
+			return err
+		}
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeEmailChanged, request.User.ID, nil)
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to confirm email change")
+		return dto.LoginResult{}, err
+	}
+
+	return result, nil
+}
+
+// RevertEmailChange discards the email change identified by the revert token sent to the previous address.
+// If the change has already been confirmed, the previous address is restored, all tokens of the user are revoked
+// and the password has to be reset, as the account might have been taken over.
+func (s *Service) RevertEmailChange(ctx context.Context, request dto.RevertEmailChangeRequest) error {
+	log := util.LogFromContext(ctx).With().Logger()
+
+	var confirmed bool
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		// the request is locked as it might be confirmed concurrently, which has to be reverted as well
+		emailChangeRequest, err := models.EmailChangeRequests(
+			models.EmailChangeRequestWhere.RevertToken.EQ(request.RevertToken),
+			qm.Load(models.EmailChangeRequestRels.User),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Email change request not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Err(err).Msg("Failed to load email change request")
+			return err
+		}
+
+		user := emailChangeRequest.R.User
+		log = log.With().Str("userID", user.ID).Logger()
+
+		if s.clock.Now().After(emailChangeRequest.RevertValidUntil) {
+			log.Debug().Time("revertValidUntil", emailChangeRequest.RevertValidUntil).Msg("Email change revert token is no longer valid, rejecting revert")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		confirmed = emailChangeRequest.ConfirmedAt.Valid
+		if confirmed {
+			if err := s.checkEmailAvailable(ctx, exec, emailChangeRequest.OldEmail); err != nil {
+				return err
+			}
+
+			// whoever confirmed the change might also know the password, so it has to be reset via the restored address
+			user.Username = null.StringFrom(emailChangeRequest.OldEmail)
+			user.PasswordResetRequired = user.Password.Valid
+			if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.Username, models.UserColumns.PasswordResetRequired, models.UserColumns.UpdatedAt)); err != nil {
+				log.Err(err).Msg("Failed to update user")
+				return err
+			}
+
+			if err := s.revokeAllUserTokens(ctx, exec, user.ID); err != nil {
+				return err
+			}
+
+			// tokens requested in the meantime were sent to the address being reverted
+			if _, err := user.PasswordResetTokens().DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete password reset tokens")
+				return err
+			}
+
+			if _, err := user.MagicLinkTokens().DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete magic link tokens")
+				return err
+			}
+		}
+
+		// pending email changes of the user are discarded as well, they might have been requested by someone else
+		if _, err := user.EmailChangeRequests().DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete email change requests")
+			return err
+		}
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeEmailChangeReverted, user.ID, map[string]any{"confirmed": confirmed})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to revert email change")
+		return err
+	}
+
+	log.Info().Bool("confirmed", confirmed).Msg("Reverted email change")
+
+	return nil
+}
+
+func (s *Service) checkEmailAvailable(ctx context.Context, exec boil.ContextExecutor, email string) error {
+	log := util.LogFromContext(ctx)
+
+	exists, err := models.Users(
+		models.UserWhere.Username.EQ(null.StringFrom(email)),
+	).Exists(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to check whether email is already in use")
+		return err
+	}
+
+	if exists {
+		log.Debug().Msg("Email is already in use")
+		return httperrors.ErrConflictUserAlreadyExists
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/rs/zerolog/log"
+)
+
+func newPasswordHasher(config config.Server) (*hashing.Hasher, error) {
+	params := config.Auth.Argon2Params
+	if params == nil {
+		params = hashing.DefaultArgon2Params
+	}
+
+	if config.Auth.Argon2CalibrationTarget > 0 {
+		calibrated, elapsed, err := hashing.Calibrate(params, config.Auth.Argon2CalibrationTarget)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calibrate argon2 params: %w", err)
+		}
+
+		log.Info().
+			Uint32("time", calibrated.Time).
+			Uint32("memory", calibrated.Memory).
+			Uint8("threads", calibrated.Threads).
+			Dur("target", config.Auth.Argon2CalibrationTarget).
+			Dur("elapsed", elapsed).
+			Msg("Calibrated argon2 params")
+
+		params = calibrated
+	}
+
+	return hashing.NewHasher(params, config.Auth.Argon2MaxConcurrency), nil
+}
+
+// rehashPasswordIfNeeded replaces the stored hash of the user by one using the current argon2 params if it was
+// created using weaker ones, given the password has just been verified. Failures are only logged, as they must
+// not prevent the user from logging in.
+func (s *Service) rehashPasswordIfNeeded(ctx context.Context, exec boil.ContextExecutor, user *models.User, password string) {
+	log := util.LogFromContext(ctx).With().Str("userID", user.ID).Logger()
+
+	needsRehash, err := s.hasher.NeedsRehash(user.Password.String)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to check whether password needs to be rehashed")
+		return
+	}
+
+	if !needsRehash {
+		return
+	}
+
+	hash, err := s.hasher.HashPassword(ctx, password)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to rehash password")
+		return
+	}
+
+	// only replace the verified hash, the password might have been changed concurrently
+	updated, err := models.Users(
+		models.UserWhere.ID.EQ(user.ID),
+		models.UserWhere.Password.EQ(user.Password),
+	).UpdateAll(ctx, exec, models.M{
+		models.UserColumns.Password:  null.StringFrom(hash),
+		models.UserColumns.UpdatedAt: s.clock.Now(),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to update rehashed password")
+		return
+	}
+
+	if updated > 0 {
+		user.Password = null.StringFrom(hash)
+		log.Debug().Msg("Rehashed password using current argon2 params")
+	}
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/labstack/echo/v4"
+)
+
+// ImpersonateUser issues an access token of the user to the authenticated support staff user, valid for
+// ImpersonationTokenValidity. Impersonation tokens are not bound to a session and cannot be refreshed.
+// Deactivated users and CMS users cannot be impersonated, so impersonation never grants additional permissions.
+func (s *Service) ImpersonateUser(ctx context.Context, userID string) (dto.ImpersonateUserResult, error) {
+	log := util.LogFromContext(ctx).With().Str("targetUserID", userID).Logger()
+
+	impersonator := UserFromContext(ctx)
+	if impersonator == nil {
+		log.Debug().Msg("Impersonation requires an authenticated user")
+		return dto.ImpersonateUserResult{}, echo.ErrUnauthorized
+	}
+
+	if ImpersonatorFromContext(ctx) != nil {
+		log.Debug().Msg("User is already being impersonated, rejecting nested impersonation")
+		return dto.ImpersonateUserResult{}, httperrors.ErrForbiddenImpersonation
+	}
+
+	var result dto.ImpersonateUserResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		user, err := s.findAdminUser(ctx, exec, userID)
+		if err != nil {
+			return err
+		}
+
+		if user.ID == impersonator.ID || slices.Contains(user.Scopes, ScopeCMS.String()) {
+			log.Debug().Strs("scopes", user.Scopes).Msg("User cannot be impersonated")
+			return httperrors.ErrForbiddenNotImpersonatable
+		}
+
+		if !user.IsActive {
+			log.Debug().Msg("User is deactivated, rejecting impersonation")
+			return httperrors.ErrForbiddenUserDeactivated
+		}
+
+		validUntil := s.clock.Now().Add(s.config.Auth.ImpersonationTokenValidity)
+
+		accessToken, err := s.insertAccessToken(ctx, exec, user, &models.AccessToken{
+			ValidUntil:     validUntil,
+			UserID:         user.ID,
+			ImpersonatorID: null.StringFrom(impersonator.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		result = dto.ImpersonateUserResult{
+			AccessToken: accessToken,
+			ExpiresIn:   int64(s.config.Auth.ImpersonationTokenValidity.Seconds()),
+			TokenType:   TokenTypeBearer,
+		}
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeUserImpersonated, user.ID, map[string]any{
+			"validUntil": validUntil,
+		})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to impersonate user")
+		return dto.ImpersonateUserResult{}, err
+	}
+
+	log.Info().Str("impersonatorID", impersonator.ID).Msg("Issued impersonation token")
+
+	return result, nil
+}
+
+// revokeImpersonationTokens revokes all impersonation tokens issued to the user, e.g. once the user is deactivated.
+func (s *Service) revokeImpersonationTokens(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	return s.revokeAccessTokens(ctx, exec, models.AccessTokenWhere.ImpersonatorID.EQ(null.StringFrom(userID)))
+}
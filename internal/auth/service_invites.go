@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// CreateInvite creates an invite for the email address, replacing any pending invite of the same address so only
+// the most recently sent invite can be accepted. The invite is attributed to the authenticated user of the context.
+func (s *Service) CreateInvite(ctx context.Context, request dto.CreateInviteRequest) (dto.CreateInviteResult, error) {
+	email := request.Email.String()
+	log := util.LogFromContext(ctx).With().Str("email", email).Logger()
+
+	validUntil := s.clock.Now().Add(s.config.Auth.InviteValidity)
+	if request.ValidUntil.Valid {
+		if !request.ValidUntil.Time.After(s.clock.Now()) {
+			log.Debug().Time("validUntil", request.ValidUntil.Time).Msg("Invite expiry is not in the future")
+			return dto.CreateInviteResult{}, httperrors.ErrBadRequestInvalidInviteExpiry
+		}
+
+		validUntil = request.ValidUntil.Time
+	}
+
+	var createdByID null.String
+	if user := UserFromContext(ctx); user != nil {
+		createdByID = null.StringFrom(user.ID)
+	}
+
+	var result dto.CreateInviteResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		scopes := s.config.Auth.DefaultUserScopes
+		if len(request.Scopes) > 0 {
+			var err error
+			scopes, err = s.validateRoles(ctx, exec, request.Scopes)
+			if err != nil {
+				return err
+			}
+		}
+
+		// users still pending confirmation may be invited, registering with the invite takes over their account
+		userExists, err := models.Users(
+			models.UserWhere.Username.EQ(null.StringFrom(email)),
+			models.UserWhere.RequiresConfirmation.EQ(false),
+		).Exists(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to check whether user exists")
+			return err
+		}
+
+		if userExists {
+			log.Debug().Msg("User with given email already exists")
+			return httperrors.ErrConflictUserAlreadyExists
+		}
+
+		if _, err := models.Invites(
+			models.InviteWhere.Email.EQ(email),
+			models.InviteWhere.AcceptedAt.IsNull(),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete pending invites")
+			return err
+		}
+
+		invite := models.Invite{
+			Email:       email,
+			Scopes:      scopes,
+			ValidUntil:  validUntil,
+			CreatedByID: createdByID,
+		}
+
+		if err := invite.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert invite")
+			return err
+		}
+
+		result = dto.CreateInviteResult{
+			Invite: mapper.LocalInviteToDTO(&invite),
+			Token:  invite.Token,
+		}
+
+		return s.audit.Record(ctx, exec, audit.Event{
+			Type:    audit.EventTypeInviteCreated,
+			ActorID: createdByID,
+			Metadata: map[string]any{
+				"inviteID": invite.ID,
+				"email":    email,
+				"scopes":   scopes,
+			},
+		})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to create invite")
+		return dto.CreateInviteResult{}, err
+	}
+
+	return result, nil
+}
+
+// GetInvites returns all pending invites which have neither been accepted nor expired, most recent first.
+func (s *Service) GetInvites(ctx context.Context) (dto.Invites, error) {
+	invites, err := models.Invites(
+		models.InviteWhere.AcceptedAt.IsNull(),
+		models.InviteWhere.ValidUntil.GT(s.clock.Now()),
+		qm.OrderBy(models.InviteColumns.CreatedAt+" DESC, "+models.InviteColumns.ID),
+	).All(ctx, s.db)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to load invites")
+		return nil, err
+	}
+
+	result := make(dto.Invites, 0, len(invites))
+	for _, invite := range invites {
+		result = append(result, mapper.LocalInviteToDTO(invite))
+	}
+
+	return result, nil
+}
+
+// DeleteInvite revokes the pending invite. Accepted invites are kept as they link the registered user.
+func (s *Service) DeleteInvite(ctx context.Context, inviteID string) error {
+	log := util.LogFromContext(ctx).With().Str("inviteID", inviteID).Logger()
+
+	rowsAffected, err := models.Invites(
+		models.InviteWhere.ID.EQ(inviteID),
+		models.InviteWhere.AcceptedAt.IsNull(),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to delete invite")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Msg("Invite not found")
+		return httperrors.ErrNotFoundInviteNotFound
+	}
+
+	return nil
+}
+
+// registerWithInvite registers the user with the scopes of the invite. The invite confirms the email address, so the
+// user is activated immediately. A pending registration of the same username is taken over by the invite.
+func (s *Service) registerWithInvite(ctx context.Context, request dto.RegisterRequest) (dto.RegisterResult, error) {
+	username := request.Username.String()
+	log := util.LogFromContext(ctx).With().Str("username", username).Logger()
+
+	if err := s.validatePassword(ctx, s.db, "password", request.Password, dto.User{
+		Username: null.StringFrom(username),
+	}); err != nil {
+		return dto.RegisterResult{}, err
+	}
+
+	hash, err := s.hasher.HashPassword(ctx, request.Password)
+	if err != nil {
+		log.Err(err).Msg("Failed to hash user password")
+		return dto.RegisterResult{}, httperrors.ErrBadRequestInvalidPassword
+	}
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		invite, err := models.Invites(
+			models.InviteWhere.Token.EQ(request.InviteToken.String),
+			models.InviteWhere.AcceptedAt.IsNull(),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Invite not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Err(err).Msg("Failed to load invite")
+			return err
+		}
+
+		if !invite.ValidUntil.After(s.clock.Now()) {
+			log.Debug().Time("validUntil", invite.ValidUntil).Msg("Invite has expired")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		if invite.Email != username {
+			log.Debug().Str("inviteEmail", invite.Email).Msg("Username does not match invited email address")
+			return httperrors.ErrForbiddenInviteEmailMismatch
+		}
+
+		user, err := models.Users(
+			models.UserWhere.Username.EQ(null.StringFrom(username)),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to check whether user exists")
+			return err
+		}
+
+		if user != nil {
+			if !user.RequiresConfirmation {
+				log.Debug().Msg("User with given username already exists")
+				return httperrors.ErrConflictUserAlreadyExists
+			}
+
+			user.Password = null.StringFrom(hash)
+			user.LastAuthenticatedAt = null.TimeFrom(s.clock.Now())
+			user.IsActive = true
+			user.RequiresConfirmation = false
+			user.Scopes = invite.Scopes
+			if _, err := user.Update(ctx, exec, boil.Whitelist(
+				models.UserColumns.Password,
+				models.UserColumns.LastAuthenticatedAt,
+				models.UserColumns.IsActive,
+				models.UserColumns.RequiresConfirmation,
+				models.UserColumns.Scopes,
+				models.UserColumns.UpdatedAt,
+			)); err != nil {
+				log.Err(err).Msg("Failed to update user")
+				return err
+			}
+
+			if _, err := user.ConfirmationTokens().DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete confirmation tokens")
+				return err
+			}
+		} else {
+			user = &models.User{
+				Username:            null.StringFrom(username),
+				Password:            null.StringFrom(hash),
+				LastAuthenticatedAt: null.TimeFrom(s.clock.Now()),
+				IsActive:            true,
+				Scopes:              invite.Scopes,
+			}
+
+			if err := user.Insert(ctx, exec, boil.Infer()); err != nil {
+				log.Err(err).Msg("Failed to insert user")
+				return err
+			}
+
+			appUserProfile := models.AppUserProfile{
+				UserID: user.ID,
+			}
+
+			if err := appUserProfile.Insert(ctx, exec, boil.Infer()); err != nil {
+				log.Err(err).Msg("Failed to insert app user profile")
+				return err
+			}
+		}
+
+		invite.AcceptedAt = null.TimeFrom(s.clock.Now())
+		invite.UserID = null.StringFrom(user.ID)
+		if _, err := invite.Update(ctx, exec, boil.Whitelist(models.InviteColumns.AcceptedAt, models.InviteColumns.UserID, models.InviteColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to update invite")
+			return err
+		}
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeInviteAccepted, user.ID, map[string]any{
+			"inviteID": invite.ID,
+			"scopes":   invite.Scopes,
+		})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to register user with invite")
+		return dto.RegisterResult{}, err
+	}
+
+	return dto.RegisterResult{
+		RequiresConfirmation: false,
+	}, nil
+}
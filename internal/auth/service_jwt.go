@@ -0,0 +1,323 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// jwtDenylist caches the IDs of revoked JWT access tokens. It is synced with the access_token_denylist table
+// every JWTDenylistRefreshInterval to pick up revocations of other instances.
+type jwtDenylist struct {
+	mu          sync.Mutex
+	validUntil  map[string]time.Time
+	refreshedAt time.Time
+	// pending holds the entries revoked within transactions started by Service.withTransaction, they are only
+	// added to the cache once the transaction has been committed
+	pending map[boil.ContextExecutor][]models.AccessTokenDenylist
+}
+
+// addOrDefer adds the entries to the cache right away, unless exec is a transaction started by
+// Service.withTransaction.
+func (d *jwtDenylist) addOrDefer(exec boil.ContextExecutor, entries []models.AccessTokenDenylist) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if pending, ok := d.pending[exec]; ok {
+		d.pending[exec] = append(pending, entries...)
+		return
+	}
+
+	for _, entry := range entries {
+		d.validUntil[entry.Jti] = entry.ValidUntil
+	}
+}
+
+func (d *jwtDenylist) begin(tx boil.ContextExecutor) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pending[tx] = []models.AccessTokenDenylist{}
+}
+
+func (d *jwtDenylist) end(tx boil.ContextExecutor, committed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if committed {
+		for _, entry := range d.pending[tx] {
+			d.validUntil[entry.Jti] = entry.ValidUntil
+		}
+	}
+
+	delete(d.pending, tx)
+}
+
+// withTransaction executes txHandler within a transaction like db.WithTransaction. Access tokens revoked within the
+// transaction are only added to the JWT denylist cache once the transaction has been committed, so a rollback does
+// not deny tokens that are still valid.
+func (s *Service) withTransaction(ctx context.Context, txHandler db.TxFn) error {
+	var tx boil.ContextExecutor
+
+	err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		tx = exec
+		s.jwtDenylist.begin(tx)
+
+		return txHandler(exec)
+	})
+
+	if tx != nil {
+		s.jwtDenylist.end(tx, err == nil)
+	}
+
+	return err
+}
+
+func (s *Service) jwtEnabled() bool {
+	return s.config.Auth.AccessTokenFormat == config.AccessTokenFormatJWT
+}
+
+func (s *Service) GetJSONWebKeySet(_ context.Context) (dto.JSONWebKeySet, error) {
+	if !s.jwtEnabled() {
+		return dto.JSONWebKeySet{}, ErrNotFound
+	}
+
+	return s.jwtKeys.toDTO(), nil
+}
+
+// ValidateJWTAccessToken verifies the signature and claims of a JWT access token and checks it has not been revoked.
+// The user returned is built from the token's claims and therefore reflects the state at the time the token was issued.
+func (s *Service) ValidateJWTAccessToken(ctx context.Context, token string) (Result, error) {
+	log := util.LogFromContext(ctx)
+
+	if !s.jwtEnabled() {
+		return Result{}, ErrInvalidToken
+	}
+
+	var claims accessTokenClaims
+	if _, err := jwt.ParseWithClaims(token, &claims, s.jwtKeys.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(s.config.Auth.JWTIssuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.clock.Now),
+	); err != nil {
+		log.Trace().Err(err).Msg("Failed to verify JWT access token")
+		return Result{}, ErrInvalidToken
+	}
+
+	if len(claims.ID) == 0 || len(claims.Subject) == 0 {
+		log.Trace().Msg("JWT access token is missing jti or sub claim")
+		return Result{}, ErrInvalidToken
+	}
+
+	denied, err := s.isAccessTokenDenied(ctx, claims.ID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if denied {
+		log.Trace().Str("jti", claims.ID).Msg("JWT access token has been revoked")
+		return Result{}, ErrInvalidToken
+	}
+
+	user := &dto.User{
+		ID:        claims.Subject,
+		IsActive:  true,
+		Scopes:    claims.Scopes,
+		UpdatedAt: time.Unix(claims.UpdatedAt, 0),
+	}
+
+	if len(claims.Email) > 0 {
+		user.Username = null.StringFrom(claims.Email)
+	}
+
+	if claims.AuthTime > 0 {
+		user.LastAuthenticatedAt = null.TimeFrom(time.Unix(claims.AuthTime, 0))
+	}
+
+	result := Result{
+		Token:       claims.ID,
+		User:        user,
+		ValidUntil:  claims.ExpiresAt.Time,
+		Permissions: claims.Permissions,
+	}
+
+	if claims.Actor != nil {
+		result.Impersonator = &dto.User{
+			ID:       claims.Actor.Subject,
+			IsActive: true,
+		}
+	}
+
+	return result, nil
+}
+
+// issueAccessToken stores a new access token of the user's session, returning the opaque token or the signed JWT
+// using the stored token as jti, depending on the configured access token format.
+func (s *Service) issueAccessToken(ctx context.Context, exec boil.ContextExecutor, user *models.User, sessionID string) (string, error) {
+	return s.insertAccessToken(ctx, exec, user, &models.AccessToken{
+		ValidUntil: s.clock.Now().Add(s.config.Auth.AccessTokenValidity),
+		UserID:     user.ID,
+		SessionID:  null.StringFrom(sessionID),
+	})
+}
+
+// insertAccessToken stores the access token of the user, returning the opaque token or the signed JWT.
+func (s *Service) insertAccessToken(ctx context.Context, exec boil.ContextExecutor, user *models.User, accessToken *models.AccessToken) (string, error) {
+	log := util.LogFromContext(ctx)
+
+	now := s.clock.Now()
+
+	if err := accessToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert access token")
+		return "", err
+	}
+
+	if !s.jwtEnabled() {
+		return accessToken.Token, nil
+	}
+
+	permissions, err := s.getPermissions(ctx, exec, user.Scopes)
+	if err != nil {
+		return "", err
+	}
+
+	claims := accessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        accessToken.Token,
+			Subject:   user.ID,
+			Issuer:    s.config.Auth.JWTIssuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(accessToken.ValidUntil),
+		},
+		Email:       user.Username.String,
+		Scopes:      user.Scopes,
+		Permissions: permissions,
+		UpdatedAt:   user.UpdatedAt.Unix(),
+	}
+
+	if user.LastAuthenticatedAt.Valid {
+		claims.AuthTime = user.LastAuthenticatedAt.Time.Unix()
+	}
+
+	if accessToken.ImpersonatorID.Valid {
+		claims.Actor = &actorClaim{Subject: accessToken.ImpersonatorID.String}
+	}
+
+	token, err := s.jwtKeys.sign(claims)
+	if err != nil {
+		log.Err(err).Msg("Failed to sign JWT access token")
+		return "", err
+	}
+
+	return token, nil
+}
+
+// revokeAccessTokens deletes all access tokens matching mods. As JWT access tokens remain valid without their
+// database entry, their IDs are additionally added to the denylist until they expire.
+func (s *Service) revokeAccessTokens(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) error {
+	log := util.LogFromContext(ctx)
+
+	if s.jwtEnabled() {
+		accessTokens, err := models.AccessTokens(mods...).All(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to load access tokens to revoke")
+			return err
+		}
+
+		entries := make([]models.AccessTokenDenylist, 0, len(accessTokens))
+		for _, accessToken := range accessTokens {
+			entry := models.AccessTokenDenylist{
+				Jti:        accessToken.Token,
+				ValidUntil: accessToken.ValidUntil,
+			}
+
+			if err := entry.Upsert(ctx, exec, false, []string{models.AccessTokenDenylistColumns.Jti}, boil.None(), boil.Infer()); err != nil {
+				log.Err(err).Msg("Failed to insert access token into denylist")
+				return err
+			}
+
+			entries = append(entries, entry)
+		}
+
+		s.jwtDenylist.addOrDefer(exec, entries)
+	}
+
+	if _, err := models.AccessTokens(mods...).DeleteAll(ctx, exec); err != nil {
+		log.Err(err).Msg("Failed to delete access tokens")
+		return err
+	}
+
+	return nil
+}
+
+// loadCurrentUser reloads users authenticated by a JWT access token, as the user built from the token's claims
+// lacks the password hash and might no longer reflect the current state.
+func (s *Service) loadCurrentUser(ctx context.Context, user dto.User) (dto.User, error) {
+	log := util.LogFromContext(ctx)
+
+	if !s.jwtEnabled() {
+		return user, nil
+	}
+
+	u, err := models.FindUser(ctx, s.db, user.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("userID", user.ID).Msg("User of JWT access token not found")
+			return dto.User{}, echo.ErrUnauthorized
+		}
+
+		log.Err(err).Str("userID", user.ID).Msg("Failed to load user")
+		return dto.User{}, err
+	}
+
+	return mapper.LocalUserToDTO(u), nil
+}
+
+func (s *Service) isAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	log := util.LogFromContext(ctx)
+
+	d := s.jwtDenylist
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := s.clock.Now()
+	if d.refreshedAt.IsZero() || now.Sub(d.refreshedAt) >= s.config.Auth.JWTDenylistRefreshInterval {
+		if _, err := models.AccessTokenDenylists(
+			models.AccessTokenDenylistWhere.ValidUntil.LTE(now),
+		).DeleteAll(ctx, s.db); err != nil {
+			log.Err(err).Msg("Failed to delete expired access token denylist entries")
+			return false, err
+		}
+
+		entries, err := models.AccessTokenDenylists().All(ctx, s.db)
+		if err != nil {
+			log.Err(err).Msg("Failed to load access token denylist")
+			return false, err
+		}
+
+		d.validUntil = make(map[string]time.Time, len(entries))
+		for _, entry := range entries {
+			d.validUntil[entry.Jti] = entry.ValidUntil
+		}
+		d.refreshedAt = now
+	}
+
+	validUntil, ok := d.validUntil[jti]
+
+	return ok && validUntil.After(now), nil
+}
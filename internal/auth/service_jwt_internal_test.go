@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTDenylistDefersUntilCommit(t *testing.T) {
+	d := &jwtDenylist{
+		validUntil: make(map[string]time.Time),
+		pending:    make(map[boil.ContextExecutor][]models.AccessTokenDenylist),
+	}
+
+	validUntil := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	committed, rolledBack := &sql.Tx{}, &sql.Tx{}
+
+	d.begin(committed)
+	d.begin(rolledBack)
+	d.addOrDefer(committed, []models.AccessTokenDenylist{{Jti: "committed", ValidUntil: validUntil}})
+	d.addOrDefer(rolledBack, []models.AccessTokenDenylist{{Jti: "rolled-back", ValidUntil: validUntil}})
+	assert.Empty(t, d.validUntil)
+
+	d.end(committed, true)
+	d.end(rolledBack, false)
+	assert.Equal(t, map[string]time.Time{"committed": validUntil}, d.validUntil)
+	assert.Empty(t, d.pending)
+
+	// entries revoked outside of a transaction started by the service are added right away
+	d.addOrDefer(&sql.DB{}, []models.AccessTokenDenylist{{Jti: "direct", ValidUntil: validUntil}})
+	assert.Contains(t, d.validUntil, "direct")
+}
@@ -0,0 +1,57 @@
+package auth_test
+
+import (
+	"errors"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRollback = errors.New("rollback")
+
+func TestRevokeAccessTokensWithinTransaction(t *testing.T) {
+	test.WithTestServerConfigurable(t, test.DefaultServiceConfigWithJWTAccessTokens(test.JWTTestKeyIDEd25519), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		service, ok := s.Auth.(*auth.Service)
+		require.True(t, ok)
+
+		jti := fix.User1AccessToken1.Token
+
+		// loads the denylist, further checks are served from the cache as the mock clock does not advance
+		denied, err := service.IsAccessTokenDenied(ctx, jti)
+		require.NoError(t, err)
+		require.False(t, denied)
+
+		err = service.WithTransaction(ctx, func(exec boil.ContextExecutor) error {
+			require.NoError(t, service.RevokeAccessTokens(ctx, exec, models.AccessTokenWhere.Token.EQ(jti)))
+			return errRollback
+		})
+		require.ErrorIs(t, err, errRollback)
+
+		denied, err = service.IsAccessTokenDenied(ctx, jti)
+		require.NoError(t, err)
+		assert.False(t, denied)
+
+		exists, err := models.AccessTokenExists(ctx, s.DB, jti)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		err = service.WithTransaction(ctx, func(exec boil.ContextExecutor) error {
+			return service.RevokeAccessTokens(ctx, exec, models.AccessTokenWhere.Token.EQ(jti))
+		})
+		require.NoError(t, err)
+
+		denied, err = service.IsAccessTokenDenied(ctx, jti)
+		require.NoError(t, err)
+		assert.True(t, denied)
+	})
+}
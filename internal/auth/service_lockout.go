@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/labstack/echo/v4"
+)
+
+// attemptKey identifies a counter of failed attempts, e.g. the login attempts for a specific username.
+type attemptKey struct {
+	scope       string
+	key         string
+	maxAttempts int
+}
+
+func (k attemptKey) enabled() bool {
+	return k.maxAttempts > 0 && len(k.key) > 0
+}
+
+func (s *Service) loginAttemptKeys(username string, ipAddress string) []attemptKey {
+	return []attemptKey{
+		{scope: models.AuthAttemptScopeLoginUsername, key: username, maxAttempts: s.config.Auth.LoginMaxFailedAttemptsPerUsername},
+		{scope: models.AuthAttemptScopeLoginIP, key: ipAddress, maxAttempts: s.config.Auth.LoginMaxFailedAttemptsPerIP},
+	}
+}
+
+func (s *Service) passwordResetAttemptKeys(ipAddress string) []attemptKey {
+	return []attemptKey{
+		{scope: models.AuthAttemptScopePasswordResetIP, key: ipAddress, maxAttempts: s.config.Auth.PasswordResetMaxAttemptsPerIP},
+	}
+}
+
+func (s *Service) magicLinkAttemptKeys(ipAddress string) []attemptKey {
+	return []attemptKey{
+		{scope: models.AuthAttemptScopeMagicLinkIP, key: ipAddress, maxAttempts: s.config.Auth.MagicLinkMaxAttemptsPerIP},
+	}
+}
+
+func (s *Service) GetUserLockout(ctx context.Context, username dto.Username) (dto.UserLockout, error) {
+	log := util.LogFromContext(ctx).With().Str("username", username.String()).Logger()
+
+	attempt, err := models.FindAuthFailedAttempt(ctx, s.db, models.AuthAttemptScopeLoginUsername, username.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UserLockout{}, nil
+		}
+
+		log.Err(err).Msg("Failed to load failed login attempts")
+		return dto.UserLockout{}, err
+	}
+
+	now := s.clock.Now()
+	if s.isAttemptExpired(attempt, now) {
+		return dto.UserLockout{}, nil
+	}
+
+	result := dto.UserLockout{
+		FailedAttempts: attempt.FailedCount,
+	}
+
+	if attempt.LockedUntil.Valid && attempt.LockedUntil.Time.After(now) {
+		result.LockedUntil = attempt.LockedUntil
+	}
+
+	return result, nil
+}
+
+// checkLockout returns ErrTooManyRequestsTooManyAttempts including a Retry-After header if any of the keys is currently locked.
+func (s *Service) checkLockout(ctx context.Context, keys []attemptKey) error {
+	log := util.LogFromContext(ctx)
+
+	now := s.clock.Now()
+	var lockedUntil time.Time
+	for _, k := range keys {
+		if !k.enabled() {
+			continue
+		}
+
+		attempt, err := models.FindAuthFailedAttempt(ctx, s.db, k.scope, k.key)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+
+			log.Err(err).Str("scope", k.scope).Msg("Failed to load failed attempts")
+			return err
+		}
+
+		if attempt.LockedUntil.Valid && attempt.LockedUntil.Time.After(lockedUntil) {
+			lockedUntil = attempt.LockedUntil.Time
+		}
+	}
+
+	if !lockedUntil.After(now) {
+		return nil
+	}
+
+	retryAfter := int64(math.Ceil(lockedUntil.Sub(now).Seconds()))
+	log.Debug().Time("lockedUntil", lockedUntil).Msg("Too many failed attempts, rejecting request")
+
+	return httperrors.ErrTooManyRequestsTooManyAttempts.WithHeader(echo.HeaderRetryAfter, strconv.FormatInt(retryAfter, 10))
+}
+
+// recordFailedAttempt increments the failed attempts counters of all keys, locking them once their threshold is reached.
+// Consecutive lockouts within the cooldown duration increase the lockout duration exponentially.
+func (s *Service) recordFailedAttempt(ctx context.Context, keys []attemptKey) error {
+	log := util.LogFromContext(ctx)
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		now := s.clock.Now()
+
+		for _, k := range keys {
+			if !k.enabled() {
+				continue
+			}
+
+			initial := models.AuthFailedAttempt{
+				Scope:        k.scope,
+				Key:          k.key,
+				LastFailedAt: now,
+			}
+
+			if err := initial.Upsert(ctx, exec, false, []string{models.AuthFailedAttemptColumns.Scope, models.AuthFailedAttemptColumns.Key}, boil.None(), boil.Infer()); err != nil {
+				log.Err(err).Str("scope", k.scope).Msg("Failed to insert failed attempts")
+				return err
+			}
+
+			attempt, err := models.AuthFailedAttempts(
+				models.AuthFailedAttemptWhere.Scope.EQ(k.scope),
+				models.AuthFailedAttemptWhere.Key.EQ(k.key),
+				qm.For("UPDATE"),
+			).One(ctx, exec)
+			if err != nil {
+				log.Err(err).Str("scope", k.scope).Msg("Failed to load failed attempts")
+				return err
+			}
+
+			if s.isAttemptExpired(attempt, now) {
+				attempt.FailedCount = 0
+				attempt.LockoutCount = 0
+				attempt.LockedUntil = null.Time{}
+			}
+
+			attempt.FailedCount++
+			attempt.LastFailedAt = now
+
+			if attempt.FailedCount >= k.maxAttempts {
+				attempt.LockoutCount++
+				attempt.LockedUntil = null.TimeFrom(now.Add(s.lockoutDuration(attempt.LockoutCount)))
+				attempt.FailedCount = 0
+
+				log.Info().Str("scope", k.scope).Int("lockoutCount", attempt.LockoutCount).Time("lockedUntil", attempt.LockedUntil.Time).Msg("Too many failed attempts, locking")
+			}
+
+			if _, err := attempt.Update(ctx, exec, boil.Infer()); err != nil {
+				log.Err(err).Str("scope", k.scope).Msg("Failed to update failed attempts")
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to record failed attempt")
+		return err
+	}
+
+	return nil
+}
+
+// resetFailedAttempts removes all failed attempts (and therefore any lock) of the keys.
+func (s *Service) resetFailedAttempts(ctx context.Context, exec boil.ContextExecutor, keys []attemptKey) error {
+	log := util.LogFromContext(ctx)
+
+	for _, k := range keys {
+		if len(k.key) == 0 {
+			continue
+		}
+
+		if _, err := models.AuthFailedAttempts(
+			models.AuthFailedAttemptWhere.Scope.EQ(k.scope),
+			models.AuthFailedAttemptWhere.Key.EQ(k.key),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Str("scope", k.scope).Msg("Failed to reset failed attempts")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PurgeFailedAttempts deletes all failed attempts which are neither locked nor had a failed attempt within
+// the cooldown duration, returning the number of purged counters. These would be reset by the next failed
+// attempt anyway, see isAttemptExpired.
+func (s *Service) PurgeFailedAttempts(ctx context.Context) (int64, error) {
+	log := util.LogFromContext(ctx)
+
+	now := s.clock.Now()
+	mods := []qm.QueryMod{
+		models.AuthFailedAttemptWhere.LastFailedAt.LT(now.Add(-s.config.Auth.LockoutCooldownDuration)),
+	}
+	mods = append(mods, db.CombineWithOr([]qm.QueryMod{
+		models.AuthFailedAttemptWhere.LockedUntil.IsNull(),
+		models.AuthFailedAttemptWhere.LockedUntil.LTE(null.TimeFrom(now)),
+	})...)
+
+	purged, err := models.AuthFailedAttempts(mods...).DeleteAll(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge failed attempts")
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// isAttemptExpired reports whether the counters should be reset, as the key is not locked and no
+// failed attempt happened within the cooldown duration.
+func (s *Service) isAttemptExpired(attempt *models.AuthFailedAttempt, now time.Time) bool {
+	if attempt.LockedUntil.Valid && attempt.LockedUntil.Time.After(now) {
+		return false
+	}
+
+	return attempt.LastFailedAt.Add(s.config.Auth.LockoutCooldownDuration).Before(now)
+}
+
+func (s *Service) lockoutDuration(lockoutCount int) time.Duration {
+	d := s.config.Auth.LockoutBaseDuration
+	for i := 1; i < lockoutCount && d < s.config.Auth.LockoutMaxDuration; i++ {
+		d *= 2
+	}
+
+	return min(d, s.config.Auth.LockoutMaxDuration)
+}
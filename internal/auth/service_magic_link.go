@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// InitMagicLink issues a magic link token for the active user with the given username. No token is returned
+// if the user does not exist or a token was issued within the debounce duration, which callers must not
+// reveal to prevent user enumeration.
+func (s *Service) InitMagicLink(ctx context.Context, request dto.InitMagicLinkRequest) (dto.InitMagicLinkResult, error) {
+	log := util.LogFromContext(ctx).With().Str("username", request.Username.String()).Logger()
+
+	// every request counts as attempt, as we cannot tell failed ones apart without allowing user enumeration
+	attemptKeys := s.magicLinkAttemptKeys(request.IPAddress)
+	if err := s.checkLockout(ctx, attemptKeys); err != nil {
+		return dto.InitMagicLinkResult{}, err
+	}
+
+	if err := s.recordFailedAttempt(ctx, attemptKeys); err != nil {
+		return dto.InitMagicLinkResult{}, err
+	}
+
+	user, err := models.Users(
+		models.UserWhere.Username.EQ(null.StringFrom(request.Username.String())),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("User not found")
+			return dto.InitMagicLinkResult{}, nil
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.InitMagicLinkResult{}, err
+	}
+
+	if !user.IsActive {
+		log.Debug().Msg("User is deactivated, skipping magic link")
+		return dto.InitMagicLinkResult{}, nil
+	}
+
+	if s.config.Auth.MagicLinkTokenDebounceDuration > 0 {
+		tokenInDebounceTimeExists, err := user.MagicLinkTokens(
+			models.MagicLinkTokenWhere.CreatedAt.GT(s.clock.Now().Add(-s.config.Auth.MagicLinkTokenDebounceDuration)),
+			models.MagicLinkTokenWhere.ValidUntil.GT(s.clock.Now()),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Err(err).Msg("Failed to check for existing magic link token")
+			return dto.InitMagicLinkResult{}, err
+		}
+
+		if tokenInDebounceTimeExists {
+			log.Debug().Msg("Magic link token exists within debounce time, not sending new one")
+			return dto.InitMagicLinkResult{}, nil
+		}
+	}
+
+	var result dto.InitMagicLinkResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		magicLinkToken, err := user.MagicLinkTokens(
+			models.MagicLinkTokenWhere.CreatedAt.GT(s.clock.Now().Add(-s.config.Auth.MagicLinkTokenReuseDuration)),
+			models.MagicLinkTokenWhere.ValidUntil.GT(s.clock.Now()),
+		).One(ctx, exec)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.Err(err).Msg("Failed to check for existing valid magic link token")
+				return err
+			}
+
+			log.Debug().Err(err).Msg("No valid magic link token exists, creating new one")
+
+			magicLinkToken = &models.MagicLinkToken{
+				UserID:     user.ID,
+				ValidUntil: s.clock.Now().Add(s.config.Auth.MagicLinkTokenValidity),
+			}
+
+			if err := magicLinkToken.Insert(ctx, exec, boil.Infer()); err != nil {
+				log.Err(err).Msg("Failed to insert magic link token")
+				return err
+			}
+		}
+
+		result.MagicLinkToken = null.StringFrom(magicLinkToken.Token)
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to initiate magic link")
+		return dto.InitMagicLinkResult{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) CompleteMagicLink(ctx context.Context, request dto.CompleteMagicLinkRequest) (dto.LoginResult, error) {
+	log := util.LogFromContext(ctx).With().Logger()
+
+	var (
+		result  dto.LoginResult
+		expired bool
+	)
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		// the token is locked until the transaction ends, so concurrent requests cannot redeem it twice
+		magicLinkToken, err := models.MagicLinkTokens(
+			models.MagicLinkTokenWhere.Token.EQ(request.MagicLinkToken),
+			qm.Load(models.MagicLinkTokenRels.User),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Magic link token not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Err(err).Msg("Failed to load magic link token")
+			return err
+		}
+
+		user := magicLinkToken.R.User
+		log := log.With().Str("userID", user.ID).Logger()
+
+		if s.clock.Now().After(magicLinkToken.ValidUntil) {
+			log.Debug().Time("validUntil", magicLinkToken.ValidUntil).Msg("Magic link token is no longer valid, rejecting authentication")
+
+			if _, err := magicLinkToken.Delete(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete expired magic link token")
+				return err
+			}
+
+			expired = true
+			return nil
+		}
+
+		if !user.IsActive {
+			log.Debug().Msg("User is deactivated, rejecting authentication")
+			return httperrors.ErrForbiddenUserDeactivated
+		}
+
+		if user.PasswordResetRequired {
+			log.Debug().Msg("User is required to reset password, rejecting authentication")
+			return httperrors.ErrForbiddenPasswordResetRequired
+		}
+
+		// all pending magic links of the user are invalidated, not only the one used
+		if _, err := user.MagicLinkTokens().DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete magic link tokens")
+			return err
+		}
+
+		result, err = s.authenticateUserWithTwoFactor(ctx, exec, dto.AuthenticateUserRequest{
+			User:    mapper.LocalUserToDTO(user),
+			Session: request.Session,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to authenticate user")
+			return err
+		}
+
+		if result.RequiresTwoFactor() {
+			return nil
+		}
+
+		return s.recordLogin(ctx, exec, user.ID, loginMethodMagicLink)
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to complete magic link login")
+		return dto.LoginResult{}, err
+	}
+
+	if expired {
+		return dto.LoginResult{}, httperrors.ErrConflictTokenExpired
+	}
+
+	return result, nil
+}
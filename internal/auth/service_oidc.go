@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	oidcNonceLength = 32
+)
+
+func (s *Service) InitOIDCLogin(ctx context.Context, request dto.InitOIDCLoginRequest) (dto.InitOIDCLoginResult, error) {
+	log := util.LogFromContext(ctx).With().Str("provider", request.Provider).Logger()
+
+	client, ok := s.oidcClients[request.Provider]
+	if !ok {
+		log.Debug().Msg("OIDC provider not found")
+		return dto.InitOIDCLoginResult{}, httperrors.ErrNotFoundOIDCProviderNotFound
+	}
+
+	codeVerifier, err := oauth2.GetPKCECodeVerifier()
+	if err != nil {
+		log.Err(err).Msg("Failed to generate PKCE code verifier")
+		return dto.InitOIDCLoginResult{}, err
+	}
+
+	nonce, err := util.GenerateRandomString(oidcNonceLength, []util.CharRange{util.CharRangeNumeric, util.CharRangeAlphaLowerCase, util.CharRangeAlphaUpperCase}, "")
+	if err != nil {
+		log.Err(err).Msg("Failed to generate OIDC nonce")
+		return dto.InitOIDCLoginResult{}, err
+	}
+
+	now := s.clock.Now()
+
+	if _, err := models.OidcAuthStates(
+		models.OidcAuthStateWhere.ValidUntil.LTE(now),
+	).DeleteAll(ctx, s.db); err != nil {
+		log.Err(err).Msg("Failed to delete expired OIDC auth states")
+		return dto.InitOIDCLoginResult{}, err
+	}
+
+	state := models.OidcAuthState{
+		Provider:     request.Provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ValidUntil:   now.Add(s.config.Auth.OIDCStateValidity),
+	}
+
+	if err := state.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert OIDC auth state")
+		return dto.InitOIDCLoginResult{}, err
+	}
+
+	authorizationURL, err := client.AuthCodeURL(ctx, state.State, nonce, oauth2.GetPKCECodeChallengeS256(codeVerifier))
+	if err != nil {
+		log.Err(err).Msg("Failed to build OIDC authorization URL")
+		return dto.InitOIDCLoginResult{}, err
+	}
+
+	return dto.InitOIDCLoginResult{
+		AuthorizationURL: authorizationURL,
+	}, nil
+}
+
+func (s *Service) CompleteOIDCLogin(ctx context.Context, request dto.CompleteOIDCLoginRequest) (dto.LoginResult, error) {
+	log := util.LogFromContext(ctx).With().Str("provider", request.Provider).Logger()
+
+	client, ok := s.oidcClients[request.Provider]
+	if !ok {
+		log.Debug().Msg("OIDC provider not found")
+		return dto.LoginResult{}, httperrors.ErrNotFoundOIDCProviderNotFound
+	}
+
+	state, err := models.OidcAuthStates(
+		models.OidcAuthStateWhere.State.EQ(request.State),
+		models.OidcAuthStateWhere.Provider.EQ(request.Provider),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("OIDC auth state not found")
+			return dto.LoginResult{}, httperrors.ErrNotFoundTokenNotFound
+		}
+
+		log.Err(err).Msg("Failed to load OIDC auth state")
+		return dto.LoginResult{}, err
+	}
+
+	// states may only be used once, regardless of the outcome
+	if _, err := state.Delete(ctx, s.db); err != nil {
+		log.Err(err).Msg("Failed to delete OIDC auth state")
+		return dto.LoginResult{}, err
+	}
+
+	if s.clock.Now().After(state.ValidUntil) {
+		log.Debug().Time("validUntil", state.ValidUntil).Msg("OIDC auth state is no longer valid, rejecting login")
+		return dto.LoginResult{}, httperrors.ErrConflictTokenExpired
+	}
+
+	tokens, err := client.Exchange(ctx, request.Code, state.CodeVerifier)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to exchange OIDC authorization code")
+		return dto.LoginResult{}, httperrors.ErrUnauthorizedOIDCAuthFailed
+	}
+
+	claims, err := client.VerifyIDToken(ctx, tokens.IDToken, state.Nonce, s.clock.Now())
+	if err != nil {
+		if errors.Is(err, oauth2.ErrOIDCInvalidIDToken) {
+			log.Warn().Err(err).Msg("Failed to verify OIDC ID token")
+			return dto.LoginResult{}, httperrors.ErrUnauthorizedOIDCAuthFailed
+		}
+
+		log.Err(err).Msg("Failed to load OIDC provider keys")
+		return dto.LoginResult{}, err
+	}
+
+	var result dto.LoginResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		user, err := s.findOrCreateOIDCUser(ctx, exec, request.Provider, claims)
+		if err != nil {
+			return err
+		}
+
+		if !user.IsActive {
+			log.Debug().Str("userID", user.ID).Msg("User is deactivated, rejecting authentication")
+			return httperrors.ErrForbiddenUserDeactivated
+		}
+
+		if user.PasswordResetRequired {
+			log.Debug().Str("userID", user.ID).Msg("User is required to reset password, rejecting authentication")
+			return httperrors.ErrForbiddenPasswordResetRequired
+		}
+
+		result, err = s.authenticateUserWithTwoFactor(ctx, exec, dto.AuthenticateUserRequest{
+			User:    mapper.LocalUserToDTO(user),
+			Session: request.Session,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to authenticate user")
+			return err
+		}
+
+		if result.RequiresTwoFactor() {
+			return nil
+		}
+
+		return s.recordAuditEvent(ctx, exec, audit.EventTypeLogin, user.ID, map[string]any{
+			"method":   loginMethodOIDC,
+			"provider": request.Provider,
+		})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to complete OIDC login")
+		return dto.LoginResult{}, err
+	}
+
+	return result, nil
+}
+
+// findOrCreateOIDCUser returns the user linked to the external identity. Unknown identities are linked
+// to the user with the same email if verified by the provider, otherwise a new user is created unless
+// registration requires an invite.
+func (s *Service) findOrCreateOIDCUser(ctx context.Context, exec boil.ContextExecutor, provider string, claims oauth2.OIDCIDTokenClaims) (*models.User, error) {
+	log := util.LogFromContext(ctx).With().Str("provider", provider).Str("subject", claims.Subject).Logger()
+
+	identity, err := models.UserIdentities(
+		models.UserIdentityWhere.Provider.EQ(provider),
+		models.UserIdentityWhere.Subject.EQ(claims.Subject),
+		qm.Load(models.UserIdentityRels.User),
+	).One(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Err(err).Msg("Failed to load user identity")
+		return nil, err
+	}
+
+	var email null.String
+	if len(claims.Email) > 0 && bool(claims.EmailVerified) {
+		email = null.StringFrom(dto.NewUsername(claims.Email).String())
+	}
+
+	if identity != nil {
+		if identity.Email != email {
+			identity.Email = email
+			if _, err := identity.Update(ctx, exec, boil.Whitelist(models.UserIdentityColumns.Email, models.UserIdentityColumns.UpdatedAt)); err != nil {
+				log.Err(err).Msg("Failed to update user identity")
+				return nil, err
+			}
+		}
+
+		return identity.R.User, nil
+	}
+
+	var user *models.User
+	if email.Valid {
+		user, err = models.Users(
+			models.UserWhere.Username.EQ(email),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to load user by email")
+			return nil, err
+		}
+	}
+
+	if user != nil {
+		log.Debug().Str("userID", user.ID).Msg("Linking user identity to existing user with verified email")
+
+		// the provider verified the email, so pending confirmations are obsolete. The password of the unconfirmed
+		// registration was never proven to belong to the owner of the email and is therefore discarded, otherwise
+		// whoever pre-registered the email could log in once the owner links their identity.
+		if user.RequiresConfirmation {
+			user.Password = null.String{}
+			user.IsActive = true
+			user.RequiresConfirmation = false
+			if _, err := user.Update(ctx, exec, boil.Whitelist(
+				models.UserColumns.Password,
+				models.UserColumns.IsActive,
+				models.UserColumns.RequiresConfirmation,
+				models.UserColumns.UpdatedAt,
+			)); err != nil {
+				log.Err(err).Msg("Failed to update user")
+				return nil, err
+			}
+
+			if _, err := user.ConfirmationTokens().DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete confirmation tokens")
+				return nil, err
+			}
+
+			if _, err := user.PasswordResetTokens().DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete password reset tokens")
+				return nil, err
+			}
+
+			if err := s.deleteUserTokens(ctx, exec, user.ID); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// external identities cannot carry an invite, so they may only sign in to existing users
+		if s.config.Auth.RegistrationRequiresInvite {
+			log.Debug().Msg("Registration requires an invite, rejecting new user for user identity")
+			return nil, httperrors.ErrForbiddenInviteRequired
+		}
+
+		log.Debug().Msg("Creating new user for user identity")
+
+		user = &models.User{
+			Username:            email,
+			LastAuthenticatedAt: null.TimeFrom(s.clock.Now()),
+			IsActive:            true,
+			Scopes:              s.config.Auth.DefaultUserScopes,
+		}
+
+		if err := user.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert user")
+			return nil, err
+		}
+
+		appUserProfile := models.AppUserProfile{
+			UserID: user.ID,
+		}
+
+		if err := appUserProfile.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert app user profile")
+			return nil, err
+		}
+	}
+
+	identity = &models.UserIdentity{
+		UserID:   user.ID,
+		Provider: provider,
+		Subject:  claims.Subject,
+		Email:    email,
+	}
+
+	if err := identity.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert user identity")
+		return nil, err
+	}
+
+	return user, nil
+}
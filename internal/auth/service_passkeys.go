@@ -0,0 +1,451 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/webauthn"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// newWebAuthnRelyingParty accepts passkeys from the configured origins, iOS apps associated with the
+// relying party ID and the Android apps listed in the Digital Asset Links file served at /.well-known.
+func newWebAuthnRelyingParty(config config.Server) (webauthn.RelyingParty, error) {
+	origins := append([]string{}, config.Auth.WebAuthnOrigins...)
+	origins = append(origins, "https://"+config.Auth.WebAuthnRPID)
+
+	if len(config.Paths.AndroidAssetlinksFile) > 0 {
+		data, err := os.ReadFile(config.Paths.AndroidAssetlinksFile)
+		if err != nil {
+			return webauthn.RelyingParty{}, fmt.Errorf("failed to read android asset links file: %w", err)
+		}
+
+		androidOrigins, err := webauthn.AndroidOriginsFromAssetLinks(data)
+		if err != nil {
+			return webauthn.RelyingParty{}, err
+		}
+
+		origins = append(origins, androidOrigins...)
+	}
+
+	slices.Sort(origins)
+
+	return webauthn.RelyingParty{
+		ID:      config.Auth.WebAuthnRPID,
+		Name:    config.Auth.WebAuthnRPName,
+		Origins: slices.Compact(origins),
+	}, nil
+}
+
+func (s *Service) GetPasskeys(ctx context.Context, userID string) (dto.Passkeys, error) {
+	credentials, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.UserID.EQ(userID),
+		qm.OrderBy(models.WebauthnCredentialColumns.CreatedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Str("userID", userID).Msg("Failed to load passkeys")
+		return nil, err
+	}
+
+	result := make(dto.Passkeys, 0, len(credentials))
+	for _, credential := range credentials {
+		result = append(result, mapper.LocalWebauthnCredentialToPasskeyDTO(credential))
+	}
+
+	return result, nil
+}
+
+func (s *Service) DeletePasskey(ctx context.Context, request dto.DeletePasskeyRequest) error {
+	log := util.LogFromContext(ctx).With().Str("userID", request.UserID).Str("passkeyID", request.PasskeyID).Logger()
+
+	count, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.ID.EQ(request.PasskeyID),
+		models.WebauthnCredentialWhere.UserID.EQ(request.UserID),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to delete passkey")
+		return err
+	}
+
+	if count == 0 {
+		log.Debug().Msg("Passkey not found")
+		return httperrors.ErrNotFoundPasskeyNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) BeginPasskeyRegistration(ctx context.Context, request dto.BeginPasskeyRegistrationRequest) (dto.BeginPasskeyRegistrationResult, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.BeginPasskeyRegistrationResult{}, err
+	}
+
+	if !request.User.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting passkey registration")
+		return dto.BeginPasskeyRegistrationResult{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	credentials, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.UserID.EQ(request.User.ID),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load existing passkeys")
+		return dto.BeginPasskeyRegistrationResult{}, err
+	}
+
+	challenge, err := s.createWebAuthnChallenge(ctx, models.WebauthnCeremonyRegistration, null.StringFrom(request.User.ID))
+	if err != nil {
+		return dto.BeginPasskeyRegistrationResult{}, err
+	}
+
+	excludeCredentials := make([]dto.PasskeyDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		excludeCredentials = append(excludeCredentials, dto.PasskeyDescriptor{
+			CredentialID: base64.RawURLEncoding.EncodeToString(credential.CredentialID),
+			Transports:   credential.Transports,
+		})
+	}
+
+	// users created via OpenID Connect might not have a username
+	userName := request.User.Username.String
+	if !request.User.Username.Valid {
+		userName = request.User.ID
+	}
+
+	return dto.BeginPasskeyRegistrationResult{
+		Challenge:          challenge.Challenge,
+		RPID:               s.webAuthn.ID,
+		RPName:             s.webAuthn.Name,
+		UserHandle:         base64.RawURLEncoding.EncodeToString([]byte(request.User.ID)),
+		UserName:           userName,
+		Algorithms:         webauthn.SupportedAlgorithms,
+		Timeout:            s.config.Auth.WebAuthnChallengeValidity,
+		ExcludeCredentials: excludeCredentials,
+	}, nil
+}
+
+func (s *Service) FinishPasskeyRegistration(ctx context.Context, request dto.FinishPasskeyRegistrationRequest) (dto.Passkey, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.Passkey{}, err
+	}
+
+	if !request.User.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting passkey registration")
+		return dto.Passkey{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	credentialID, errID := webauthn.DecodeBase64URL(request.CredentialID)
+	clientDataJSON, errClientData := webauthn.DecodeBase64URL(request.ClientDataJSON)
+	attestationObject, errAttestation := webauthn.DecodeBase64URL(request.AttestationObject)
+	if err := errors.Join(errID, errClientData, errAttestation); err != nil {
+		log.Debug().Err(err).Msg("Passkey credential is not base64url encoded")
+		return dto.Passkey{}, httperrors.ErrBadRequestInvalidPasskey
+	}
+
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to parse passkey client data")
+		return dto.Passkey{}, httperrors.ErrBadRequestInvalidPasskey
+	}
+
+	challenge, err := s.consumeWebAuthnChallenge(ctx, clientData.Challenge, models.WebauthnCeremonyRegistration)
+	if err != nil {
+		return dto.Passkey{}, err
+	}
+
+	if challenge.UserID.String != request.User.ID {
+		log.Debug().Msg("Passkey registration challenge belongs to another user")
+		return dto.Passkey{}, httperrors.ErrNotFoundTokenNotFound
+	}
+
+	credential, err := s.webAuthn.VerifyRegistration(webauthn.AttestationResponse{
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+	}, challenge.Challenge)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to verify passkey registration")
+		return dto.Passkey{}, httperrors.ErrBadRequestInvalidPasskey
+	}
+
+	if !bytes.Equal(credential.ID, credentialID) {
+		log.Debug().Msg("Passkey credential ID does not match attested credential data")
+		return dto.Passkey{}, httperrors.ErrBadRequestInvalidPasskey
+	}
+
+	var result dto.Passkey
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		exists, err := models.WebauthnCredentials(
+			models.WebauthnCredentialWhere.CredentialID.EQ(credential.ID),
+		).Exists(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to check if passkey exists")
+			return err
+		}
+
+		if exists {
+			log.Debug().Msg("Passkey is already registered")
+			return httperrors.ErrConflictPasskeyRegistered
+		}
+
+		webauthnCredential := models.WebauthnCredential{
+			UserID:         request.User.ID,
+			CredentialID:   credential.ID,
+			Name:           request.Name,
+			PublicKey:      credential.PublicKey,
+			SignCount:      int64(credential.SignCount),
+			Transports:     request.Transports,
+			Aaguid:         webauthn.FormatAAGUID(credential.AAGUID),
+			BackupEligible: credential.BackupEligible,
+			BackupState:    credential.BackupState,
+		}
+
+		if webauthnCredential.Transports == nil {
+			webauthnCredential.Transports = []string{}
+		}
+
+		if err := webauthnCredential.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert passkey")
+			return err
+		}
+
+		result = mapper.LocalWebauthnCredentialToPasskeyDTO(&webauthnCredential)
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to register passkey")
+		return dto.Passkey{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) BeginPasskeyLogin(ctx context.Context) (dto.BeginPasskeyLoginResult, error) {
+	challenge, err := s.createWebAuthnChallenge(ctx, models.WebauthnCeremonyLogin, null.String{})
+	if err != nil {
+		return dto.BeginPasskeyLoginResult{}, err
+	}
+
+	return dto.BeginPasskeyLoginResult{
+		Challenge: challenge.Challenge,
+		RPID:      s.webAuthn.ID,
+		Timeout:   s.config.Auth.WebAuthnChallengeValidity,
+	}, nil
+}
+
+// FinishPasskeyLogin authenticates the user of the passkey which signed the login challenge. Passkeys require
+// user verification and therefore count as two factors, so no additional two-factor challenge is issued.
+func (s *Service) FinishPasskeyLogin(ctx context.Context, request dto.FinishPasskeyLoginRequest) (dto.LoginResult, error) {
+	log := util.LogFromContext(ctx).With().Logger()
+
+	// the username is not known upfront, so only the IP address of the client is tracked
+	attemptKeys := s.loginAttemptKeys("", request.Session.IPAddress)
+	if err := s.checkLockout(ctx, attemptKeys); err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	credentialID, errID := webauthn.DecodeBase64URL(request.CredentialID)
+	clientDataJSON, errClientData := webauthn.DecodeBase64URL(request.ClientDataJSON)
+	authenticatorData, errAuthData := webauthn.DecodeBase64URL(request.AuthenticatorData)
+	signature, errSignature := webauthn.DecodeBase64URL(request.Signature)
+	if err := errors.Join(errID, errClientData, errAuthData, errSignature); err != nil {
+		log.Debug().Err(err).Msg("Passkey assertion is not base64url encoded")
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.String{}, loginMethodPasskey)
+	}
+
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to parse passkey client data")
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.String{}, loginMethodPasskey)
+	}
+
+	challenge, err := s.consumeWebAuthnChallenge(ctx, clientData.Challenge, models.WebauthnCeremonyLogin)
+	if err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	webauthnCredential, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.CredentialID.EQ(credentialID),
+		qm.Load(models.WebauthnCredentialRels.User),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("Passkey not found")
+			return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.String{}, loginMethodPasskey)
+		}
+
+		log.Err(err).Msg("Failed to load passkey")
+		return dto.LoginResult{}, err
+	}
+
+	log = log.With().Str("userID", webauthnCredential.UserID).Str("passkeyID", webauthnCredential.ID).Logger()
+
+	if request.UserHandle.Valid && len(request.UserHandle.String) > 0 {
+		userHandle, err := webauthn.DecodeBase64URL(request.UserHandle.String)
+		if err != nil || string(userHandle) != webauthnCredential.UserID {
+			log.Debug().Msg("Passkey user handle does not match user of passkey")
+			return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.StringFrom(webauthnCredential.UserID), loginMethodPasskey)
+		}
+	}
+
+	credential, err := s.webAuthn.VerifyAssertion(webauthn.AssertionResponse{
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authenticatorData,
+		Signature:         signature,
+	}, challenge.Challenge, webauthn.Credential{
+		ID:             webauthnCredential.CredentialID,
+		PublicKey:      webauthnCredential.PublicKey,
+		SignCount:      uint32(webauthnCredential.SignCount), //nolint:gosec
+		BackupEligible: webauthnCredential.BackupEligible,
+		BackupState:    webauthnCredential.BackupState,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, webauthn.ErrSignCountRollback):
+			log.Warn().Err(err).Msg("Passkey sign count did not increase, rejecting authentication")
+		case errors.Is(err, webauthn.ErrInvalidResponse):
+			log.Debug().Err(err).Msg("Failed to verify passkey assertion")
+		default:
+			log.Err(err).Msg("Failed to verify passkey assertion")
+			return dto.LoginResult{}, err
+		}
+
+		return dto.LoginResult{}, s.rejectLogin(ctx, attemptKeys, null.StringFrom(webauthnCredential.UserID), loginMethodPasskey)
+	}
+
+	user := webauthnCredential.R.User
+	if !user.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting authentication")
+		return dto.LoginResult{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	if user.PasswordResetRequired {
+		log.Debug().Msg("User is required to reset password, rejecting authentication")
+		return dto.LoginResult{}, httperrors.ErrForbiddenPasswordResetRequired
+	}
+
+	var result dto.LoginResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		webauthnCredential.SignCount = int64(credential.SignCount)
+		webauthnCredential.BackupState = credential.BackupState
+		webauthnCredential.LastUsedAt = null.TimeFrom(s.clock.Now())
+
+		if _, err := webauthnCredential.Update(ctx, exec, boil.Whitelist(
+			models.WebauthnCredentialColumns.SignCount,
+			models.WebauthnCredentialColumns.BackupState,
+			models.WebauthnCredentialColumns.LastUsedAt,
+			models.WebauthnCredentialColumns.UpdatedAt,
+		)); err != nil {
+			log.Err(err).Msg("Failed to update passkey")
+			return err
+		}
+
+		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
+			User:    mapper.LocalUserToDTO(user),
+			Session: request.Session,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to authenticate user")
+			return err
+		}
+
+		return s.recordLogin(ctx, exec, user.ID, loginMethodPasskey)
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to complete passkey login")
+		return dto.LoginResult{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) createWebAuthnChallenge(ctx context.Context, ceremony string, userID null.String) (*models.WebauthnChallenge, error) {
+	log := util.LogFromContext(ctx).With().Str("ceremony", ceremony).Logger()
+
+	now := s.clock.Now()
+
+	if _, err := models.WebauthnChallenges(
+		models.WebauthnChallengeWhere.ValidUntil.LTE(now),
+	).DeleteAll(ctx, s.db); err != nil {
+		log.Err(err).Msg("Failed to delete expired WebAuthn challenges")
+		return nil, err
+	}
+
+	value, err := webauthn.GenerateChallenge()
+	if err != nil {
+		log.Err(err).Msg("Failed to generate WebAuthn challenge")
+		return nil, err
+	}
+
+	challenge := &models.WebauthnChallenge{
+		Challenge:  value,
+		Ceremony:   ceremony,
+		UserID:     userID,
+		ValidUntil: now.Add(s.config.Auth.WebAuthnChallengeValidity),
+	}
+
+	if err := challenge.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert WebAuthn challenge")
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// consumeWebAuthnChallenge loads and deletes the challenge, as challenges may only be used once regardless of the outcome.
+func (s *Service) consumeWebAuthnChallenge(ctx context.Context, value string, ceremony string) (*models.WebauthnChallenge, error) {
+	log := util.LogFromContext(ctx).With().Str("ceremony", ceremony).Logger()
+
+	challenge, err := models.WebauthnChallenges(
+		models.WebauthnChallengeWhere.Challenge.EQ(value),
+		models.WebauthnChallengeWhere.Ceremony.EQ(ceremony),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("WebAuthn challenge not found")
+			return nil, httperrors.ErrNotFoundTokenNotFound
+		}
+
+		log.Err(err).Msg("Failed to load WebAuthn challenge")
+		return nil, err
+	}
+
+	// concurrent requests might have loaded the same challenge, only the one actually deleting it may use it
+	deleted, err := challenge.Delete(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to delete WebAuthn challenge")
+		return nil, err
+	}
+
+	if deleted != 1 {
+		log.Debug().Msg("WebAuthn challenge was already consumed")
+		return nil, httperrors.ErrNotFoundTokenNotFound
+	}
+
+	if s.clock.Now().After(challenge.ValidUntil) {
+		log.Debug().Time("validUntil", challenge.ValidUntil).Msg("WebAuthn challenge is no longer valid")
+		return nil, httperrors.ErrConflictTokenExpired
+	}
+
+	return challenge, nil
+}
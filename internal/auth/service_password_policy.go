@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/password"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+func newPasswordPolicy(config config.Server) (password.Policy, error) {
+	policy := password.Policy{
+		MinLength:        config.Auth.PasswordMinLength,
+		RequireLowercase: config.Auth.PasswordRequireLowercase,
+		RequireUppercase: config.Auth.PasswordRequireUppercase,
+		RequireDigit:     config.Auth.PasswordRequireDigit,
+		RequireSpecial:   config.Auth.PasswordRequireSpecial,
+		DisallowUsername: config.Auth.PasswordDisallowUsername,
+	}
+
+	if len(config.Auth.PasswordBreachedListDirAbs) > 0 {
+		breached, err := password.NewBreachedList(config.Auth.PasswordBreachedListDirAbs)
+		if err != nil {
+			return password.Policy{}, fmt.Errorf("failed to load breached passwords: %w", err)
+		}
+
+		policy.Breached = breached
+	}
+
+	return policy, nil
+}
+
+// validatePassword checks the new password of the user against the password policy and, for existing users,
+// their password history. Violations are returned as validation errors of the body field key.
+func (s *Service) validatePassword(ctx context.Context, exec boil.ContextExecutor, key string, newPassword string, user dto.User) error {
+	log := util.LogFromContext(ctx)
+
+	violations, err := s.passwordPolicy.Validate(newPassword, user.Username.String)
+	if err != nil {
+		log.Err(err).Msg("Failed to validate password against password policy")
+		return err
+	}
+
+	reused, err := s.isPasswordReused(ctx, exec, newPassword, user)
+	if err != nil {
+		return err
+	}
+
+	if reused {
+		violations = append(violations, password.HistoryViolation(s.config.Auth.PasswordHistorySize))
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	rules := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, violation.Message)
+		rules = append(rules, string(violation.Rule))
+	}
+
+	log.Debug().Strs("rules", rules).Msg("Password violates password policy")
+
+	return httperrors.NewHTTPValidationErrorInvalidPassword(key, messages)
+}
+
+// isPasswordReused reports whether the password matches the current one of the user or one of the previous
+// ones kept in the history, which together make up the last PasswordHistorySize passwords.
+func (s *Service) isPasswordReused(ctx context.Context, exec boil.ContextExecutor, newPassword string, user dto.User) (bool, error) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Auth.PasswordHistorySize <= 0 || len(user.ID) == 0 {
+		return false, nil
+	}
+
+	var hashes []string
+	if user.PasswordHash.Valid {
+		hashes = append(hashes, user.PasswordHash.String)
+	}
+
+	if s.config.Auth.PasswordHistorySize > 1 {
+		entries, err := models.PasswordHistoryEntries(
+			models.PasswordHistoryEntryWhere.UserID.EQ(user.ID),
+			qm.OrderBy(models.PasswordHistoryEntryColumns.CreatedAt+" DESC"),
+			qm.Limit(s.config.Auth.PasswordHistorySize-1),
+		).All(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to load password history")
+			return false, err
+		}
+
+		for _, entry := range entries {
+			hashes = append(hashes, entry.PasswordHash)
+		}
+	}
+
+	for _, hash := range hashes {
+		match, err := s.hasher.ComparePasswordAndHash(ctx, newPassword, hash)
+		if err != nil {
+			log.Err(err).Msg("Failed to compare password with password history")
+			return false, err
+		}
+
+		if match {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// recordPasswordHistory adds the current password of the user, which is about to be replaced, to the password
+// history, only keeping as many previous passwords as required by PasswordHistorySize.
+func (s *Service) recordPasswordHistory(ctx context.Context, exec boil.ContextExecutor, user dto.User) error {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Auth.PasswordHistorySize <= 1 || !user.PasswordHash.Valid {
+		return nil
+	}
+
+	entry := models.PasswordHistoryEntry{
+		UserID:       user.ID,
+		PasswordHash: user.PasswordHash.String,
+	}
+
+	if err := entry.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert password history entry")
+		return err
+	}
+
+	outdated, err := models.PasswordHistoryEntries(
+		models.PasswordHistoryEntryWhere.UserID.EQ(user.ID),
+		qm.OrderBy(models.PasswordHistoryEntryColumns.CreatedAt+" DESC"),
+		qm.Offset(s.config.Auth.PasswordHistorySize-1),
+	).All(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to load outdated password history entries")
+		return err
+	}
+
+	if _, err := outdated.DeleteAll(ctx, exec); err != nil {
+		log.Err(err).Msg("Failed to delete outdated password history entries")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// GetPermissions returns the permissions granted by the roles named in the given scopes. Scopes without
+// matching role do not grant any permissions.
+func (s *Service) GetPermissions(ctx context.Context, scopes []string) (Permissions, error) {
+	return s.getPermissions(ctx, s.db, scopes)
+}
+
+func (s *Service) getPermissions(ctx context.Context, exec boil.ContextExecutor, scopes []string) (Permissions, error) {
+	permissions := Permissions{}
+	if len(scopes) == 0 {
+		return permissions, nil
+	}
+
+	roles, err := models.Roles(
+		models.RoleWhere.Name.IN(scopes),
+		qm.Load(models.RoleRels.Permissions),
+	).All(ctx, exec)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to load roles of user")
+		return nil, err
+	}
+
+	for _, role := range roles {
+		for _, permission := range role.R.Permissions {
+			if !slices.Contains(permissions, permission.Name) {
+				permissions = append(permissions, permission.Name)
+			}
+		}
+	}
+
+	slices.Sort(permissions)
+
+	return permissions, nil
+}
+
+func (s *Service) GetRoles(ctx context.Context) (dto.Roles, error) {
+	log := util.LogFromContext(ctx)
+
+	roles, err := models.Roles(
+		qm.Load(models.RoleRels.Permissions, qm.OrderBy(models.PermissionColumns.Name)),
+		qm.OrderBy(models.RoleColumns.Name),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load roles")
+		return nil, err
+	}
+
+	result := make(dto.Roles, 0, len(roles))
+	for _, role := range roles {
+		permissions := make([]string, 0, len(role.R.Permissions))
+		for _, permission := range role.R.Permissions {
+			permissions = append(permissions, permission.Name)
+		}
+
+		result = append(result, dto.Role{
+			ID:          role.ID,
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		})
+	}
+
+	return result, nil
+}
+
+// UpdateUserRoles replaces the roles of the user, stored as the user's scopes. Changes apply to opaque access
+// tokens immediately, JWT access tokens keep their scopes and permissions until refreshed.
+func (s *Service) UpdateUserRoles(ctx context.Context, request dto.UpdateUserRolesRequest) (dto.UserRoles, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.UserID).Logger()
+
+	var result dto.UserRoles
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		user, err := models.FindUser(ctx, exec, request.UserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("User not found")
+				return httperrors.ErrNotFoundUserNotFound
+			}
+
+			log.Err(err).Msg("Failed to load user")
+			return err
+		}
+
+		scopes, err := s.validateRoles(ctx, exec, request.Roles)
+		if err != nil {
+			return err
+		}
+
+		previousScopes := user.Scopes
+		if previousScopes == nil {
+			previousScopes = []string{}
+		}
+
+		user.Scopes = scopes
+		if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.Scopes, models.UserColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to update user scopes")
+			return err
+		}
+
+		if err := s.recordAuditEvent(ctx, exec, audit.EventTypeScopesChanged, user.ID, map[string]any{
+			"previousScopes": previousScopes,
+			"scopes":         scopes,
+		}); err != nil {
+			return err
+		}
+
+		permissions, err := s.getPermissions(ctx, exec, scopes)
+		if err != nil {
+			return err
+		}
+
+		result = dto.UserRoles{
+			Roles:       scopes,
+			Permissions: permissions,
+		}
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to update user roles")
+		return dto.UserRoles{}, err
+	}
+
+	return result, nil
+}
+
+// validateRoles returns the deduplicated role names, ensuring all of them exist.
+func (s *Service) validateRoles(ctx context.Context, exec boil.ContextExecutor, roles []string) ([]string, error) {
+	scopes := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if !slices.Contains(scopes, role) {
+			scopes = append(scopes, role)
+		}
+	}
+
+	count, err := models.Roles(models.RoleWhere.Name.IN(scopes)).Count(ctx, exec)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to count roles")
+		return nil, err
+	}
+
+	if count != int64(len(scopes)) {
+		util.LogFromContext(ctx).Debug().Strs("roles", scopes).Msg("Role not found")
+		return nil, httperrors.ErrBadRequestRoleNotFound
+	}
+
+	return scopes, nil
+}
@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// GetSessions returns all sessions of the user, a session being the chain of refresh tokens started
+// by a single login.
+func (s *Service) GetSessions(ctx context.Context, request dto.GetSessionsRequest) (dto.Sessions, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	currentSessionID, err := s.currentSessionID(ctx, request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshTokens, err := models.RefreshTokens(
+		models.RefreshTokenWhere.UserID.EQ(request.User.ID),
+		models.RefreshTokenWhere.RotatedAt.IsNull(),
+		qm.OrderBy(models.RefreshTokenColumns.LastUsedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load refresh tokens")
+		return nil, err
+	}
+
+	now := s.clock.Now()
+
+	sessions := make(dto.Sessions, 0, len(refreshTokens))
+	for _, refreshToken := range refreshTokens {
+		if s.refreshTokenExpired(refreshToken, now) {
+			continue
+		}
+
+		sessions = append(sessions, dto.Session{
+			ID:         refreshToken.SessionID,
+			DeviceName: refreshToken.DeviceName,
+			UserAgent:  refreshToken.UserAgent,
+			IPAddress:  refreshToken.IPAddress,
+			LastUsedAt: refreshToken.LastUsedAt,
+			Current:    currentSessionID.Valid && refreshToken.SessionID == currentSessionID.String,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (s *Service) RevokeSession(ctx context.Context, request dto.RevokeSessionRequest) error {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Str("sessionID", request.SessionID).Logger()
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		exists, err := models.RefreshTokens(
+			models.RefreshTokenWhere.UserID.EQ(request.User.ID),
+			models.RefreshTokenWhere.SessionID.EQ(request.SessionID),
+			models.RefreshTokenWhere.RotatedAt.IsNull(),
+		).Exists(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to check if session exists")
+			return err
+		}
+
+		if !exists {
+			log.Debug().Msg("Session not found")
+			return httperrors.ErrNotFoundSessionNotFound
+		}
+
+		return s.revokeSessions(ctx, exec, request.User.ID, []string{request.SessionID})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to revoke session")
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) RevokeOtherSessions(ctx context.Context, request dto.RevokeOtherSessionsRequest) error {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	currentSessionID, err := s.currentSessionID(ctx, request.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		mods := []qm.QueryMod{
+			qm.Distinct(models.RefreshTokenColumns.SessionID),
+			models.RefreshTokenWhere.UserID.EQ(request.User.ID),
+		}
+		if currentSessionID.Valid {
+			mods = append(mods, models.RefreshTokenWhere.SessionID.NEQ(currentSessionID.String))
+		}
+
+		refreshTokens, err := models.RefreshTokens(mods...).All(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to load sessions to revoke")
+			return err
+		}
+
+		sessionIDs := make([]string, 0, len(refreshTokens))
+		for _, refreshToken := range refreshTokens {
+			sessionIDs = append(sessionIDs, refreshToken.SessionID)
+		}
+
+		return s.revokeSessions(ctx, exec, request.User.ID, sessionIDs)
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to revoke other sessions")
+		return err
+	}
+
+	return nil
+}
+
+// currentSessionID returns the session of the given access token, access tokens issued before sessions
+// were introduced do not belong to any session.
+func (s *Service) currentSessionID(ctx context.Context, token string) (null.String, error) {
+	accessToken, err := models.AccessTokens(
+		qm.Select(models.AccessTokenColumns.SessionID),
+		models.AccessTokenWhere.Token.EQ(token),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.String{}, nil
+		}
+
+		util.LogFromContext(ctx).Err(err).Msg("Failed to load access token of current session")
+		return null.String{}, err
+	}
+
+	return accessToken.SessionID, nil
+}
+
+// refreshTokenExpired reports whether the refresh token exceeded the idle timeout or its session the absolute lifetime.
+func (s *Service) refreshTokenExpired(refreshToken *models.RefreshToken, now time.Time) bool {
+	if s.config.Auth.RefreshTokenIdleTimeout > 0 && now.After(refreshToken.LastUsedAt.Add(s.config.Auth.RefreshTokenIdleTimeout)) {
+		return true
+	}
+
+	if s.config.Auth.RefreshTokenLifetime > 0 && now.After(refreshToken.SessionStartedAt.Add(s.config.Auth.RefreshTokenLifetime)) {
+		return true
+	}
+
+	return false
+}
+
+// revokeReusedRefreshToken revokes the session of an already rotated refresh token, as either the legitimate
+// client or an attacker holds a copy of it, and records the reuse.
+func (s *Service) revokeReusedRefreshToken(ctx context.Context, exec boil.ContextExecutor, refreshToken *models.RefreshToken, session dto.SessionInfo) error {
+	log := util.LogFromContext(ctx)
+
+	if err := s.revokeSessions(ctx, exec, refreshToken.UserID, []string{refreshToken.SessionID}); err != nil {
+		return err
+	}
+
+	event := models.RefreshTokenReuseEvent{
+		UserID:    refreshToken.UserID,
+		SessionID: refreshToken.SessionID,
+		RotatedAt: refreshToken.RotatedAt.Time,
+		UserAgent: null.NewString(session.UserAgent, len(session.UserAgent) > 0),
+		IPAddress: null.NewString(session.IPAddress, len(session.IPAddress) > 0),
+	}
+
+	if err := event.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert refresh token reuse event")
+		return err
+	}
+
+	return nil
+}
+
+// revokeSessions deletes all access, refresh and push tokens of the given sessions of the user.
+func (s *Service) revokeSessions(ctx context.Context, exec boil.ContextExecutor, userID string, sessionIDs []string) error {
+	log := util.LogFromContext(ctx)
+
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	if err := s.revokeAccessTokens(ctx, exec,
+		models.AccessTokenWhere.UserID.EQ(userID),
+		models.AccessTokenWhere.SessionID.IN(sessionIDs),
+	); err != nil {
+		return err
+	}
+
+	if _, err := models.RefreshTokens(
+		models.RefreshTokenWhere.UserID.EQ(userID),
+		models.RefreshTokenWhere.SessionID.IN(sessionIDs),
+	).DeleteAll(ctx, exec); err != nil {
+		log.Err(err).Msg("Failed to delete refresh tokens of sessions")
+		return err
+	}
+
+	if _, err := models.PushTokens(
+		models.PushTokenWhere.UserID.EQ(userID),
+		models.PushTokenWhere.SessionID.IN(sessionIDs),
+	).DeleteAll(ctx, exec); err != nil {
+		log.Err(err).Msg("Failed to delete push tokens of sessions")
+		return err
+	}
+
+	return nil
+}
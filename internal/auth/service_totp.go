@@ -0,0 +1,459 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+func (s *Service) EnrollTOTP(ctx context.Context, request dto.EnrollTOTPRequest) (dto.EnrollTOTPResult, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	var err error
+	request.User, err = s.loadCurrentUser(ctx, request.User)
+	if err != nil {
+		return dto.EnrollTOTPResult{}, err
+	}
+
+	if !request.User.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting two-factor enrollment")
+		return dto.EnrollTOTPResult{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	if !request.User.PasswordHash.Valid {
+		log.Debug().Msg("User is missing password, rejecting two-factor enrollment")
+		return dto.EnrollTOTPResult{}, httperrors.ErrForbiddenNotLocalUser
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Err(err).Msg("Failed to generate TOTP secret")
+		return dto.EnrollTOTPResult{}, err
+	}
+
+	recoveryCodes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := util.GenerateRandomString(recoveryCodeLength, []util.CharRange{util.CharRangeNumeric, util.CharRangeAlphaLowerCase}, "")
+		if err != nil {
+			log.Err(err).Msg("Failed to generate recovery code")
+			return dto.EnrollTOTPResult{}, err
+		}
+
+		recoveryCodes = append(recoveryCodes, fmt.Sprintf("%s-%s", code[:recoveryCodeLength/2], code[recoveryCodeLength/2:]))
+	}
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		existing, err := models.TotpSecrets(
+			models.TotpSecretWhere.UserID.EQ(request.User.ID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to load existing TOTP secret")
+			return err
+		}
+
+		if existing != nil {
+			if existing.ConfirmedAt.Valid {
+				log.Debug().Msg("Two-factor authentication is already enabled, rejecting enrollment")
+				return httperrors.ErrConflictTOTPAlreadyEnabled
+			}
+
+			if _, err := existing.Delete(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete unconfirmed TOTP secret")
+				return err
+			}
+		}
+
+		if _, err := models.TotpRecoveryCodes(
+			models.TotpRecoveryCodeWhere.UserID.EQ(request.User.ID),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete existing recovery codes")
+			return err
+		}
+
+		totpSecret := models.TotpSecret{
+			UserID: request.User.ID,
+			Secret: secret,
+		}
+
+		if err := totpSecret.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert TOTP secret")
+			return err
+		}
+
+		for _, code := range recoveryCodes {
+			recoveryCode := models.TotpRecoveryCode{
+				UserID:   request.User.ID,
+				CodeHash: hashRecoveryCode(code),
+			}
+
+			if err := recoveryCode.Insert(ctx, exec, boil.Infer()); err != nil {
+				log.Err(err).Msg("Failed to insert recovery code")
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to enroll two-factor authentication")
+		return dto.EnrollTOTPResult{}, err
+	}
+
+	return dto.EnrollTOTPResult{
+		Secret:        secret,
+		KeyURI:        totp.KeyURI(s.config.Auth.TOTPIssuer, request.User.Username.String, secret),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *Service) ConfirmTOTP(ctx context.Context, request dto.TOTPCodeRequest) error {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		totpSecret, err := models.TotpSecrets(
+			models.TotpSecretWhere.UserID.EQ(request.User.ID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("No TOTP enrollment found")
+				return httperrors.ErrConflictTOTPNotEnabled
+			}
+
+			log.Err(err).Msg("Failed to load TOTP secret")
+			return err
+		}
+
+		if totpSecret.ConfirmedAt.Valid {
+			log.Debug().Msg("Two-factor authentication is already enabled, rejecting confirmation")
+			return httperrors.ErrConflictTOTPAlreadyEnabled
+		}
+
+		// recovery codes are not accepted here, we need proof the authenticator app was set up correctly
+		valid, err := s.verifyTOTPCode(ctx, exec, totpSecret, request.Code)
+		if err != nil {
+			return err
+		}
+
+		if !valid {
+			log.Debug().Msg("Invalid TOTP code provided, rejecting confirmation")
+			return httperrors.ErrUnauthorizedInvalidTOTPCode
+		}
+
+		totpSecret.ConfirmedAt = null.TimeFrom(s.clock.Now())
+		if _, err := totpSecret.Update(ctx, exec, boil.Whitelist(models.TotpSecretColumns.ConfirmedAt, models.TotpSecretColumns.UpdatedAt)); err != nil {
+			log.Err(err).Msg("Failed to update TOTP secret")
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to confirm two-factor authentication")
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) DisableTOTP(ctx context.Context, request dto.TOTPCodeRequest) error {
+	log := util.LogFromContext(ctx).With().Str("userID", request.User.ID).Logger()
+
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		totpSecret, err := models.TotpSecrets(
+			models.TotpSecretWhere.UserID.EQ(request.User.ID),
+			models.TotpSecretWhere.ConfirmedAt.IsNotNull(),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Two-factor authentication is not enabled")
+				return httperrors.ErrConflictTOTPNotEnabled
+			}
+
+			log.Err(err).Msg("Failed to load TOTP secret")
+			return err
+		}
+
+		valid, err := s.verifyTwoFactorCode(ctx, exec, totpSecret, request.Code)
+		if err != nil {
+			return err
+		}
+
+		if !valid {
+			log.Debug().Msg("Invalid two-factor code provided, rejecting disabling")
+			return httperrors.ErrUnauthorizedInvalidTOTPCode
+		}
+
+		if _, err := totpSecret.Delete(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete TOTP secret")
+			return err
+		}
+
+		if _, err := models.TotpRecoveryCodes(
+			models.TotpRecoveryCodeWhere.UserID.EQ(request.User.ID),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete recovery codes")
+			return err
+		}
+
+		if _, err := models.TwoFactorChallengeTokens(
+			models.TwoFactorChallengeTokenWhere.UserID.EQ(request.User.ID),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete two-factor challenge tokens")
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to disable two-factor authentication")
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) VerifyTwoFactorChallenge(ctx context.Context, request dto.VerifyTwoFactorChallengeRequest) (dto.LoginResult, error) {
+	log := util.LogFromContext(ctx)
+
+	challengeToken, err := models.TwoFactorChallengeTokens(
+		models.TwoFactorChallengeTokenWhere.Token.EQ(request.ChallengeToken),
+		qm.Load(models.TwoFactorChallengeTokenRels.User),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("Two-factor challenge token not found")
+			return dto.LoginResult{}, httperrors.ErrNotFoundTokenNotFound
+		}
+
+		log.Err(err).Msg("Failed to load two-factor challenge token")
+		return dto.LoginResult{}, err
+	}
+
+	if s.clock.Now().After(challengeToken.ValidUntil) {
+		log.Debug().Time("validUntil", challengeToken.ValidUntil).Msg("Two-factor challenge token is no longer valid, rejecting login")
+		return dto.LoginResult{}, httperrors.ErrConflictTokenExpired
+	}
+
+	user := challengeToken.R.User
+	if !user.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting authentication")
+		return dto.LoginResult{}, httperrors.ErrForbiddenUserDeactivated
+	}
+
+	if user.PasswordResetRequired {
+		log.Debug().Msg("User is required to reset password, rejecting authentication")
+		return dto.LoginResult{}, httperrors.ErrForbiddenPasswordResetRequired
+	}
+
+	attemptKeys := s.loginAttemptKeys(user.Username.String, request.Session.IPAddress)
+	if err := s.checkLockout(ctx, attemptKeys); err != nil {
+		return dto.LoginResult{}, err
+	}
+
+	var result dto.LoginResult
+	if err := s.withTransaction(ctx, func(exec boil.ContextExecutor) error {
+		// deleting the challenge token first locks it, concurrent verifications of the same challenge
+		// wait for this one to complete and fail if it succeeded
+		deleted, err := challengeToken.Delete(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to delete two-factor challenge token")
+			return err
+		}
+
+		if deleted != 1 {
+			log.Debug().Msg("Two-factor challenge token was already used")
+			return httperrors.ErrNotFoundTokenNotFound
+		}
+
+		totpSecret, err := models.TotpSecrets(
+			models.TotpSecretWhere.UserID.EQ(user.ID),
+			models.TotpSecretWhere.ConfirmedAt.IsNotNull(),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to load TOTP secret")
+			return err
+		}
+
+		// the password was already verified while issuing the challenge token, if two-factor
+		// authentication was disabled in the meantime, there is no second factor left to check.
+		if totpSecret != nil {
+			valid, err := s.verifyTwoFactorCode(ctx, exec, totpSecret, request.Code)
+			if err != nil {
+				return err
+			}
+
+			if !valid {
+				log.Debug().Msg("Invalid two-factor code provided, rejecting login")
+				return httperrors.ErrUnauthorizedInvalidTOTPCode
+			}
+		}
+
+		result, err = s.authenticateUser(ctx, exec, dto.AuthenticateUserRequest{
+			User:    mapper.LocalUserToDTO(user),
+			Session: request.Session,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to authenticate user")
+			return err
+		}
+
+		if err := s.resetFailedAttempts(ctx, exec, attemptKeys[:1]); err != nil {
+			return err
+		}
+
+		return s.recordLogin(ctx, exec, user.ID, loginMethodTwoFactor)
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to verify two-factor challenge")
+
+		if errors.Is(err, httperrors.ErrUnauthorizedInvalidTOTPCode) {
+			if err := s.recordFailedAttempt(ctx, attemptKeys); err != nil {
+				return dto.LoginResult{}, err
+			}
+
+			if err := s.recordFailedLogin(ctx, null.StringFrom(user.ID), loginMethodTwoFactor); err != nil {
+				return dto.LoginResult{}, err
+			}
+		}
+
+		return dto.LoginResult{}, err
+	}
+
+	return result, nil
+}
+
+// authenticateUserWithTwoFactor authenticates the user if two-factor authentication is not enabled,
+// otherwise a two-factor challenge token is issued which has to be completed using VerifyTwoFactorChallenge.
+func (s *Service) authenticateUserWithTwoFactor(ctx context.Context, exec boil.ContextExecutor, request dto.AuthenticateUserRequest) (dto.LoginResult, error) {
+	log := util.LogFromContext(ctx)
+
+	enabled, err := models.TotpSecrets(
+		models.TotpSecretWhere.UserID.EQ(request.User.ID),
+		models.TotpSecretWhere.ConfirmedAt.IsNotNull(),
+	).Exists(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to check whether two-factor authentication is enabled")
+		return dto.LoginResult{}, err
+	}
+
+	if !enabled {
+		return s.authenticateUser(ctx, exec, request)
+	}
+
+	if request.InvalidateExistingTokens {
+		if err := s.deleteUserTokens(ctx, exec, request.User.ID); err != nil {
+			return dto.LoginResult{}, err
+		}
+	}
+
+	challengeToken := models.TwoFactorChallengeToken{
+		UserID:     request.User.ID,
+		ValidUntil: s.clock.Now().Add(s.config.Auth.TwoFactorChallengeTokenValidity),
+	}
+
+	if err := challengeToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert two-factor challenge token")
+		return dto.LoginResult{}, err
+	}
+
+	return dto.LoginResult{
+		UserID: request.User.ID,
+		TwoFactorChallenge: &dto.TwoFactorChallenge{
+			ChallengeToken: challengeToken.Token,
+			ExpiresIn:      int64(s.config.Auth.TwoFactorChallengeTokenValidity.Seconds()),
+		},
+	}, nil
+}
+
+// verifyTwoFactorCode accepts either a TOTP code or one of the user's unused recovery codes,
+// the latter being marked as used on success.
+func (s *Service) verifyTwoFactorCode(ctx context.Context, exec boil.ContextExecutor, totpSecret *models.TotpSecret, code string) (bool, error) {
+	log := util.LogFromContext(ctx)
+
+	code = normalizeTwoFactorCode(code)
+	if len(code) == totp.DefaultDigits {
+		return s.verifyTOTPCode(ctx, exec, totpSecret, code)
+	}
+
+	recoveryCode, err := models.TotpRecoveryCodes(
+		models.TotpRecoveryCodeWhere.UserID.EQ(totpSecret.UserID),
+		models.TotpRecoveryCodeWhere.CodeHash.EQ(hashRecoveryCode(code)),
+		models.TotpRecoveryCodeWhere.UsedAt.IsNull(),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("Recovery code not found or already used")
+			return false, nil
+		}
+
+		log.Err(err).Msg("Failed to load recovery code")
+		return false, err
+	}
+
+	recoveryCode.UsedAt = null.TimeFrom(s.clock.Now())
+	if _, err := recoveryCode.Update(ctx, exec, boil.Whitelist(models.TotpRecoveryCodeColumns.UsedAt, models.TotpRecoveryCodeColumns.UpdatedAt)); err != nil {
+		log.Err(err).Msg("Failed to mark recovery code as used")
+		return false, err
+	}
+
+	return true, nil
+}
+
+// verifyTOTPCode validates the code against the user's secret, rejecting codes of time steps
+// that were already consumed to prevent replays.
+func (s *Service) verifyTOTPCode(ctx context.Context, exec boil.ContextExecutor, totpSecret *models.TotpSecret, code string) (bool, error) {
+	log := util.LogFromContext(ctx)
+
+	valid, timeStep, err := totp.Validate(totpSecret.Secret, normalizeTwoFactorCode(code), s.clock.Now())
+	if err != nil {
+		log.Err(err).Msg("Failed to validate TOTP code")
+		return false, err
+	}
+
+	if !valid {
+		return false, nil
+	}
+
+	if totpSecret.LastUsedTimeStep.Valid && timeStep <= totpSecret.LastUsedTimeStep.Int64 {
+		log.Debug().Int64("timeStep", timeStep).Msg("TOTP code was already used")
+		return false, nil
+	}
+
+	totpSecret.LastUsedTimeStep = null.Int64From(timeStep)
+	if _, err := totpSecret.Update(ctx, exec, boil.Whitelist(models.TotpSecretColumns.LastUsedTimeStep, models.TotpSecretColumns.UpdatedAt)); err != nil {
+		log.Err(err).Msg("Failed to update last used TOTP time step")
+		return false, err
+	}
+
+	return true, nil
+}
+
+func normalizeTwoFactorCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+// recovery codes are high entropy random strings, a fast hash is sufficient (in contrast to user passwords).
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeTwoFactorCode(code)))
+	return hex.EncodeToString(sum[:])
+}
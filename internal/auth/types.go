@@ -11,4 +11,9 @@ type Result struct {
 	User       *dto.User
 	ValidUntil time.Time
 	Scopes     []string
+	// Permissions granted by the roles named in the user's scopes
+	Permissions Permissions
+	// Impersonator is the support staff user acting on behalf of User, only set for impersonation tokens.
+	// Impersonators authenticated by JWT access tokens only carry their ID.
+	Impersonator *dto.User
 }
@@ -0,0 +1,14 @@
+package config
+
+type AccessTokenFormat string
+
+var (
+	// AccessTokenFormatUUID issues opaque access tokens, looked up in the database on every request.
+	AccessTokenFormatUUID AccessTokenFormat = "uuid"
+	// AccessTokenFormatJWT issues signed JWT access tokens, verified without database access.
+	AccessTokenFormatJWT AccessTokenFormat = "jwt"
+)
+
+func (f AccessTokenFormat) String() string {
+	return string(f)
+}
@@ -0,0 +1,18 @@
+package config
+
+// IPExtractor determines how the client IP is extracted from requests, used for rate limiting, lockouts and
+// sessions. See https://echo.labstack.com/docs/ip-address
+type IPExtractor string
+
+var (
+	// IPExtractorDirect uses the IP of the peer connecting to the server, use if the server is directly exposed
+	IPExtractorDirect IPExtractor = "direct"
+	// IPExtractorXFF uses the X-Forwarded-For header set by one of the trusted proxies
+	IPExtractorXFF IPExtractor = "x-forwarded-for"
+	// IPExtractorXRealIP uses the X-Real-IP header set by one of the trusted proxies
+	IPExtractorXRealIP IPExtractor = "x-real-ip"
+)
+
+func (e IPExtractor) String() string {
+	return string(e)
+}
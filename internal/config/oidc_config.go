@@ -0,0 +1,37 @@
+package config
+
+import (
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+)
+
+// oidcDefaultIssuerURLs allows omitting the issuer of well-known providers.
+var oidcDefaultIssuerURLs = map[string]string{
+	"google": "https://accounts.google.com",
+	"apple":  "https://appleid.apple.com",
+}
+
+// oidcProvidersFromEnv reads the config of all providers listed in SERVER_AUTH_OIDC_PROVIDERS,
+// e.g. SERVER_AUTH_OIDC_PROVIDERS=google enables SERVER_AUTH_OIDC_GOOGLE_CLIENT_ID etc.
+func oidcProvidersFromEnv() []oauth2.OIDCProviderConfig {
+	names := util.GetEnvAsStringArrTrimmed("SERVER_AUTH_OIDC_PROVIDERS", []string{})
+
+	providers := make([]oauth2.OIDCProviderConfig, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(name)
+		prefix := "SERVER_AUTH_OIDC_" + strings.ToUpper(name) + "_"
+
+		providers = append(providers, oauth2.OIDCProviderConfig{
+			Name:         name,
+			IssuerURL:    util.GetEnv(prefix+"ISSUER_URL", oidcDefaultIssuerURLs[name]),
+			ClientID:     util.GetEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: util.GetEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  util.GetEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:       util.GetEnvAsStringArrTrimmed(prefix+"SCOPES", []string{"openid", "email"}, " "),
+		})
+	}
+
+	return providers
+}
@@ -0,0 +1,45 @@
+package config
+
+import "time"
+
+type RateLimitStore string
+
+var (
+	RateLimitStoreMemory   RateLimitStore = "memory"
+	RateLimitStorePostgres RateLimitStore = "postgres"
+)
+
+func (s RateLimitStore) String() string {
+	return string(s)
+}
+
+type RateLimitKey string
+
+var (
+	RateLimitKeyIP    RateLimitKey = "ip"
+	RateLimitKeyUser  RateLimitKey = "user"
+	RateLimitKeyRoute RateLimitKey = "route"
+)
+
+func (k RateLimitKey) String() string {
+	return string(k)
+}
+
+// EchoServerRateLimitMiddleware configures the token bucket rate limiting applied to the api.Router groups.
+// The memory store only limits requests per instance, use the postgres store to share limits between replicas.
+type EchoServerRateLimitMiddleware struct {
+	Store              RateLimitStore
+	APIV1Admin         EchoServerRateLimitGroup
+	APIV1Auth          EchoServerRateLimitGroup
+	APIV1Notifications EchoServerRateLimitGroup
+	APIV1Push          EchoServerRateLimitGroup
+	WellKnown          EchoServerRateLimitGroup
+}
+
+// EchoServerRateLimitGroup allows up to Requests requests per Period for each key, rate limiting is disabled for
+// the group if Requests is 0.
+type EchoServerRateLimitGroup struct {
+	Requests int
+	Period   time.Duration
+	Key      RateLimitKey
+}
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
+	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
 	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
 	"github.com/rs/zerolog"
 	"golang.org/x/text/language"
 )
@@ -26,8 +29,14 @@ type EchoServer struct {
 	EnableTrailingSlashMiddleware  bool
 	EnableSecureMiddleware         bool
 	EnableCacheControlMiddleware   bool
-	SecureMiddleware               EchoServerSecureMiddleware
-	WebTemplatesViewsBaseDirAbs    string
+	EnableRateLimitMiddleware      bool
+	IPExtractor                    IPExtractor
+	// TrustedProxies are the CIDR ranges of proxies whose headers are trusted by the x-forwarded-for and
+	// x-real-ip extractors, headers of other peers are ignored as clients could spoof them otherwise
+	TrustedProxies              []string
+	SecureMiddleware            EchoServerSecureMiddleware
+	RateLimitMiddleware         EchoServerRateLimitMiddleware
+	WebTemplatesViewsBaseDirAbs string
 }
 
 type PprofServer struct {
@@ -56,11 +65,91 @@ type AuthServer struct {
 	PasswordResetTokenValidity         time.Duration
 	PasswordResetTokenDebounceDuration time.Duration
 	PasswordResetTokenReuseDuration    time.Duration
+	MagicLinkTokenValidity             time.Duration
+	MagicLinkTokenDebounceDuration     time.Duration
+	MagicLinkTokenReuseDuration        time.Duration
 	DefaultUserScopes                  []string
 	LastAuthenticatedAtThreshold       time.Duration
 	RegistrationRequiresConfirmation   bool
-	ConfirmationTokenValidity          time.Duration
-	ConfirmationTokenDebounceDuration  time.Duration
+	// RegistrationRequiresInvite closes self-signup, registering then requires a valid invite token.
+	RegistrationRequiresInvite        bool
+	InviteValidity                    time.Duration
+	ConfirmationTokenValidity         time.Duration
+	ConfirmationTokenDebounceDuration time.Duration
+	TwoFactorChallengeTokenValidity   time.Duration
+	TOTPIssuer                        string
+	// Failed attempts beyond the Max* thresholds lock the username/IP for an exponentially growing duration,
+	// starting at LockoutBaseDuration and capped at LockoutMaxDuration. Counters and back-off are reset once
+	// no failed attempt happened for LockoutCooldownDuration. A threshold of 0 disables the respective lockout.
+	LoginMaxFailedAttemptsPerUsername int
+	LoginMaxFailedAttemptsPerIP       int
+	PasswordResetMaxAttemptsPerIP     int
+	MagicLinkMaxAttemptsPerIP         int
+	LockoutBaseDuration               time.Duration
+	LockoutMaxDuration                time.Duration
+	LockoutCooldownDuration           time.Duration
+	// With AccessTokenFormatJWT, access tokens are signed with the private key JWTSigningKeyID out of all PEM
+	// encoded Ed25519 (EdDSA) or RSA (RS256) keys in JWTKeysDirAbs, using the file name without extension as kid.
+	// Keep previous keys in the directory for at least AccessTokenValidity after rotating the signing key.
+	// Revoked tokens are kept in a denylist, which is synced from the database every JWTDenylistRefreshInterval.
+	AccessTokenFormat          AccessTokenFormat
+	JWTKeysDirAbs              string
+	JWTSigningKeyID            string
+	JWTIssuer                  string
+	JWTDenylistRefreshInterval time.Duration
+	// Users may log in using any of the OIDCProviders (see oidcProvidersFromEnv), linking the external
+	// identity to the local user with the same verified email or creating a new user without password.
+	// The state of pending authorizations expires after OIDCStateValidity.
+	OIDCProviders     []oauth2.OIDCProviderConfig
+	OIDCStateValidity time.Duration
+	// Refresh tokens expire if not used within RefreshTokenIdleTimeout, their session ends RefreshTokenLifetime after
+	// the initial login regardless of rotation. Using a rotated refresh token again revokes its whole session.
+	// A duration of 0 disables the respective expiry.
+	RefreshTokenLifetime    time.Duration
+	RefreshTokenIdleTimeout time.Duration
+	// Passkeys (WebAuthn) are bound to WebAuthnRPID, the domain also serving the apple-app-site-association
+	// (webcredentials) and assetlinks.json (get_login_creds) files for native apps. Responses are accepted from
+	// WebAuthnOrigins, iOS apps (https://<WebAuthnRPID>) and the Android apps of Paths.AndroidAssetlinksFile.
+	// Registration and login ceremonies need to be completed within WebAuthnChallengeValidity.
+	WebAuthnRPID              string
+	WebAuthnRPName            string
+	WebAuthnOrigins           []string
+	WebAuthnChallengeValidity time.Duration
+	// Email changes need to be confirmed using the link sent to the new address within EmailChangeTokenValidity,
+	// the link sent to the previous address allows reverting the change within EmailChangeRevertValidity.
+	EmailChangeTokenValidity  time.Duration
+	EmailChangeRevertValidity time.Duration
+	// New passwords have to satisfy the password policy, which rejects passwords matching one of the
+	// PasswordHistorySize most recent passwords of the user (0 disables the history) and, if
+	// PasswordBreachedListDirAbs is set, passwords contained in its breached password ranges (see password.BreachedList).
+	PasswordMinLength          int
+	PasswordRequireLowercase   bool
+	PasswordRequireUppercase   bool
+	PasswordRequireDigit       bool
+	PasswordRequireSpecial     bool
+	PasswordDisallowUsername   bool
+	PasswordHistorySize        int
+	PasswordBreachedListDirAbs string
+	// Passwords are hashed using Argon2Params, which are raised on startup until hashing takes at least
+	// Argon2CalibrationTarget on the current hardware if set. Stored hashes using weaker params are rehashed
+	// on login. At most Argon2MaxConcurrency hashes are computed at the same time, each allocating Memory KiB.
+	Argon2Params            *hashing.Argon2Params
+	Argon2CalibrationTarget time.Duration
+	Argon2MaxConcurrency    int
+	// Data exports are stored in the "exports" directory of PathsServer.MntBaseDirAbs and can be downloaded
+	// using the link sent by email within DataExportValidity.
+	DataExportValidity time.Duration
+	// Further exports are not started while an export of the user is being built or was requested within
+	// DataExportDebounceDuration (0 only prevents concurrent exports).
+	DataExportDebounceDuration time.Duration
+	// Deleted accounts are deleted immediately by default. If AccountDeletionGracePeriod is set, they are deactivated
+	// and only purged after the grace period, the deletion can be cancelled using the link sent by email until then. A reminder is sent
+	// AccountDeletionReminderBefore the purge, see jobs.PurgeAccountDeletions.
+	AccountDeletionGracePeriod    time.Duration
+	AccountDeletionReminderBefore time.Duration
+	// Access tokens issued to support staff impersonating users are valid for ImpersonationTokenValidity
+	// and cannot be refreshed.
+	ImpersonationTokenValidity time.Duration
 }
 
 type PathsServer struct {
@@ -70,6 +159,11 @@ type PathsServer struct {
 	AndroidAssetlinksFile       string
 }
 
+type AuditServer struct {
+	// Audit events older than Retention are deleted by jobs.PurgeAuditEvents, 0 keeps them forever.
+	Retention time.Duration
+}
+
 type ManagementServer struct {
 	Secret                  string `json:"-"` // sensitive
 	ReadinessTimeout        time.Duration
@@ -82,6 +176,13 @@ type ManagementServer struct {
 type FrontendServer struct {
 	BaseURL               string
 	PasswordResetEndpoint string
+	MagicLinkEndpoint     string
+	// EmailChangeConfirmEndpoint is opened by the new address, EmailChangeRevertEndpoint by the previous one
+	EmailChangeConfirmEndpoint string
+	EmailChangeRevertEndpoint  string
+	// AccountDeletionCancelEndpoint allows cancelling a scheduled account deletion during its grace period
+	AccountDeletionCancelEndpoint string
+	InviteEndpoint                string
 }
 
 type LoggerServer struct {
@@ -107,6 +208,7 @@ type Server struct {
 	Pprof      PprofServer
 	Paths      PathsServer
 	Auth       AuthServer
+	Audit      AuditServer
 	Management ManagementServer
 	Mailer     Mailer
 	SMTP       transport.SMTPMailTransportConfig
@@ -114,6 +216,7 @@ type Server struct {
 	Logger     LoggerServer
 	Push       PushService
 	FCMConfig  provider.FCMConfig
+	APNSConfig provider.APNSConfig
 	I18n       I18n
 }
 
@@ -162,6 +265,11 @@ func DefaultServiceConfigFromEnv() Server {
 			EnableTrailingSlashMiddleware:  util.GetEnvAsBool("SERVER_ECHO_ENABLE_TRAILING_SLASH_MIDDLEWARE", true),
 			EnableSecureMiddleware:         util.GetEnvAsBool("SERVER_ECHO_ENABLE_SECURE_MIDDLEWARE", true),
 			EnableCacheControlMiddleware:   util.GetEnvAsBool("SERVER_ECHO_ENABLE_CACHE_CONTROL_MIDDLEWARE", true),
+			// Disabled by default as the client IP used for keying is only reliable if the server is either
+			// directly exposed or the IPExtractor and TrustedProxies are configured for the proxies in front of it.
+			EnableRateLimitMiddleware: util.GetEnvAsBool("SERVER_ECHO_ENABLE_RATE_LIMIT_MIDDLEWARE", false),
+			IPExtractor:               IPExtractor(util.GetEnvEnum("SERVER_ECHO_IP_EXTRACTOR", IPExtractorDirect.String(), []string{IPExtractorDirect.String(), IPExtractorXFF.String(), IPExtractorXRealIP.String()})),
+			TrustedProxies:            util.GetEnvAsStringArrTrimmed("SERVER_ECHO_TRUSTED_PROXIES", []string{}),
 			// see https://echo.labstack.com/middleware/secure
 			// see https://github.com/labstack/echo/blob/master/middleware/secure.go
 			SecureMiddleware: EchoServerSecureMiddleware{
@@ -175,6 +283,34 @@ func DefaultServiceConfigFromEnv() Server {
 				HSTSPreloadEnabled:    util.GetEnvAsBool("SERVER_ECHO_SECURE_MIDDLEWARE_HSTS_PRELOAD_ENABLED", false),
 				ReferrerPolicy:        util.GetEnv("SERVER_ECHO_SECURE_MIDDLEWARE_REFERRER_POLICY", ""),
 			},
+			RateLimitMiddleware: EchoServerRateLimitMiddleware{
+				Store: RateLimitStore(util.GetEnvEnum("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_STORE", RateLimitStoreMemory.String(), []string{RateLimitStoreMemory.String(), RateLimitStorePostgres.String()})),
+				APIV1Admin: EchoServerRateLimitGroup{
+					Requests: util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_ADMIN_REQUESTS", 120),
+					Period:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_ADMIN_PERIOD_SECONDS", 60)),
+					Key:      RateLimitKey(util.GetEnvEnum("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_ADMIN_KEY", RateLimitKeyUser.String(), []string{RateLimitKeyIP.String(), RateLimitKeyUser.String(), RateLimitKeyRoute.String()})),
+				},
+				APIV1Auth: EchoServerRateLimitGroup{
+					Requests: util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_AUTH_REQUESTS", 60),
+					Period:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_AUTH_PERIOD_SECONDS", 60)),
+					Key:      RateLimitKey(util.GetEnvEnum("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_AUTH_KEY", RateLimitKeyIP.String(), []string{RateLimitKeyIP.String(), RateLimitKeyUser.String(), RateLimitKeyRoute.String()})),
+				},
+				APIV1Notifications: EchoServerRateLimitGroup{
+					Requests: util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_NOTIFICATIONS_REQUESTS", 60),
+					Period:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_NOTIFICATIONS_PERIOD_SECONDS", 60)),
+					Key:      RateLimitKey(util.GetEnvEnum("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_NOTIFICATIONS_KEY", RateLimitKeyUser.String(), []string{RateLimitKeyIP.String(), RateLimitKeyUser.String(), RateLimitKeyRoute.String()})),
+				},
+				APIV1Push: EchoServerRateLimitGroup{
+					Requests: util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_PUSH_REQUESTS", 120),
+					Period:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_PUSH_PERIOD_SECONDS", 60)),
+					Key:      RateLimitKey(util.GetEnvEnum("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_APIV1_PUSH_KEY", RateLimitKeyUser.String(), []string{RateLimitKeyIP.String(), RateLimitKeyUser.String(), RateLimitKeyRoute.String()})),
+				},
+				WellKnown: EchoServerRateLimitGroup{
+					Requests: util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_WELL_KNOWN_REQUESTS", 0),
+					Period:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_WELL_KNOWN_PERIOD_SECONDS", 60)),
+					Key:      RateLimitKey(util.GetEnvEnum("SERVER_ECHO_RATE_LIMIT_MIDDLEWARE_WELL_KNOWN_KEY", RateLimitKeyIP.String(), []string{RateLimitKeyIP.String(), RateLimitKeyUser.String(), RateLimitKeyRoute.String()})),
+				},
+			},
 			WebTemplatesViewsBaseDirAbs: util.GetEnv("SERVER_ECHO_WEB_TEMPLATES_VIEWS_BASE_DIR_ABS", filepath.Join(util.GetProjectRootDir(), "/web/templates/views")),
 		},
 		Pprof: PprofServer{
@@ -196,11 +332,59 @@ func DefaultServiceConfigFromEnv() Server {
 			PasswordResetTokenValidity:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_PASSWORD_RESET_TOKEN_VALIDITY", 900)),
 			PasswordResetTokenDebounceDuration: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_PASSWORD_RESET_TOKEN_DEBOUNCE_DURATION_SECONDS", 60)),
 			PasswordResetTokenReuseDuration:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_PASSWORD_RESET_TOKEN_REUSE_DURATION_SECONDS", 0)),
+			MagicLinkTokenValidity:             time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_MAGIC_LINK_TOKEN_VALIDITY_SECONDS", 600)),
+			MagicLinkTokenDebounceDuration:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_MAGIC_LINK_TOKEN_DEBOUNCE_DURATION_SECONDS", 60)),
+			MagicLinkTokenReuseDuration:        time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_MAGIC_LINK_TOKEN_REUSE_DURATION_SECONDS", 0)),
 			DefaultUserScopes:                  util.GetEnvAsStringArr("SERVER_AUTH_DEFAULT_USER_SCOPES", []string{"app"}),
 			LastAuthenticatedAtThreshold:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LAST_AUTHENTICATED_AT_THRESHOLD", 900)),
 			RegistrationRequiresConfirmation:   util.GetEnvAsBool("SERVER_AUTH_REGISTRATION_REQUIRES_CONFIRMATION", false),
+			RegistrationRequiresInvite:         util.GetEnvAsBool("SERVER_AUTH_REGISTRATION_REQUIRES_INVITE", false),
+			InviteValidity:                     time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_INVITE_VALIDITY_SECONDS", 604800)),
 			ConfirmationTokenValidity:          time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_CONFIRMATION_TOKEN_VALIDITY_SECONDS", 86400)),
 			ConfirmationTokenDebounceDuration:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_CONFIRMATION_TOKEN_DEBOUNCE_DURATION_SECONDS", 60)),
+			TwoFactorChallengeTokenValidity:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_TWO_FACTOR_CHALLENGE_TOKEN_VALIDITY_SECONDS", 300)),
+			TOTPIssuer:                         util.GetEnv("SERVER_AUTH_TOTP_ISSUER", "go-starter"),
+			LoginMaxFailedAttemptsPerUsername:  util.GetEnvAsInt("SERVER_AUTH_LOGIN_MAX_FAILED_ATTEMPTS_PER_USERNAME", 5),
+			LoginMaxFailedAttemptsPerIP:        util.GetEnvAsInt("SERVER_AUTH_LOGIN_MAX_FAILED_ATTEMPTS_PER_IP", 20),
+			PasswordResetMaxAttemptsPerIP:      util.GetEnvAsInt("SERVER_AUTH_PASSWORD_RESET_MAX_ATTEMPTS_PER_IP", 10),
+			MagicLinkMaxAttemptsPerIP:          util.GetEnvAsInt("SERVER_AUTH_MAGIC_LINK_MAX_ATTEMPTS_PER_IP", 10),
+			LockoutBaseDuration:                time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_BASE_DURATION_SECONDS", 60)),
+			LockoutMaxDuration:                 time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_MAX_DURATION_SECONDS", 3600)),
+			LockoutCooldownDuration:            time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_COOLDOWN_DURATION_SECONDS", 900)),
+			AccessTokenFormat:                  AccessTokenFormat(util.GetEnvEnum("SERVER_AUTH_ACCESS_TOKEN_FORMAT", AccessTokenFormatUUID.String(), []string{AccessTokenFormatUUID.String(), AccessTokenFormatJWT.String()})),
+			JWTKeysDirAbs:                      util.GetEnv("SERVER_AUTH_JWT_KEYS_DIR_ABS", ""),
+			JWTSigningKeyID:                    util.GetEnv("SERVER_AUTH_JWT_SIGNING_KEY_ID", ""),
+			JWTIssuer:                          util.GetEnv("SERVER_AUTH_JWT_ISSUER", "go-starter"),
+			JWTDenylistRefreshInterval:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_JWT_DENYLIST_REFRESH_INTERVAL_SECONDS", 10)),
+			OIDCProviders:                      oidcProvidersFromEnv(),
+			OIDCStateValidity:                  time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_OIDC_STATE_VALIDITY_SECONDS", 600)),
+			RefreshTokenLifetime:               time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_REFRESH_TOKEN_LIFETIME_SECONDS", 7776000)),
+			RefreshTokenIdleTimeout:            time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_REFRESH_TOKEN_IDLE_TIMEOUT_SECONDS", 2592000)),
+			WebAuthnRPID:                       util.GetEnv("SERVER_AUTH_WEBAUTHN_RP_ID", "localhost"),
+			WebAuthnRPName:                     util.GetEnv("SERVER_AUTH_WEBAUTHN_RP_NAME", "go-starter"),
+			WebAuthnOrigins:                    util.GetEnvAsStringArrTrimmed("SERVER_AUTH_WEBAUTHN_ORIGINS", []string{"http://localhost:3000"}),
+			WebAuthnChallengeValidity:          time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_WEBAUTHN_CHALLENGE_VALIDITY_SECONDS", 300)),
+			EmailChangeTokenValidity:           time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_EMAIL_CHANGE_TOKEN_VALIDITY_SECONDS", 86400)),
+			EmailChangeRevertValidity:          time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_EMAIL_CHANGE_REVERT_VALIDITY_SECONDS", 604800)),
+			PasswordMinLength:                  util.GetEnvAsInt("SERVER_AUTH_PASSWORD_MIN_LENGTH", 8),
+			PasswordRequireLowercase:           util.GetEnvAsBool("SERVER_AUTH_PASSWORD_REQUIRE_LOWERCASE", false),
+			PasswordRequireUppercase:           util.GetEnvAsBool("SERVER_AUTH_PASSWORD_REQUIRE_UPPERCASE", false),
+			PasswordRequireDigit:               util.GetEnvAsBool("SERVER_AUTH_PASSWORD_REQUIRE_DIGIT", false),
+			PasswordRequireSpecial:             util.GetEnvAsBool("SERVER_AUTH_PASSWORD_REQUIRE_SPECIAL", false),
+			PasswordDisallowUsername:           util.GetEnvAsBool("SERVER_AUTH_PASSWORD_DISALLOW_USERNAME", true),
+			PasswordHistorySize:                util.GetEnvAsInt("SERVER_AUTH_PASSWORD_HISTORY_SIZE", 0),
+			PasswordBreachedListDirAbs:         util.GetEnv("SERVER_AUTH_PASSWORD_BREACHED_LIST_DIR_ABS", ""),
+			Argon2Params:                       hashing.DefaultArgon2ParamsFromEnv(),
+			Argon2CalibrationTarget:            time.Millisecond * time.Duration(util.GetEnvAsInt("SERVER_AUTH_ARGON2_CALIBRATION_TARGET_MS", 0)),
+			Argon2MaxConcurrency:               util.GetEnvAsInt("SERVER_AUTH_ARGON2_MAX_CONCURRENCY", runtime.NumCPU()),
+			DataExportValidity:                 time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_DATA_EXPORT_VALIDITY_SECONDS", 172800)),
+			DataExportDebounceDuration:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_DATA_EXPORT_DEBOUNCE_DURATION_SECONDS", 3600)),
+			AccountDeletionGracePeriod:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_ACCOUNT_DELETION_GRACE_PERIOD_SECONDS", 0)),
+			AccountDeletionReminderBefore:      time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_ACCOUNT_DELETION_REMINDER_BEFORE_SECONDS", 259200)),
+			ImpersonationTokenValidity:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_IMPERSONATION_TOKEN_VALIDITY_SECONDS", 900)),
+		},
+		Audit: AuditServer{
+			Retention: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUDIT_RETENTION_SECONDS", 31536000)),
 		},
 		Management: ManagementServer{
 			Secret:           util.GetMgmtSecret("SERVER_MANAGEMENT_SECRET"),
@@ -227,8 +411,13 @@ func DefaultServiceConfigFromEnv() Server {
 			TLSConfig:  nil,
 		},
 		Frontend: FrontendServer{
-			BaseURL:               util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
-			PasswordResetEndpoint: util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			BaseURL:                       util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
+			PasswordResetEndpoint:         util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			MagicLinkEndpoint:             util.GetEnv("SERVER_FRONTEND_MAGIC_LINK_ENDPOINT", "/magic-link"),
+			EmailChangeConfirmEndpoint:    util.GetEnv("SERVER_FRONTEND_EMAIL_CHANGE_CONFIRM_ENDPOINT", "/confirm-email-change"),
+			EmailChangeRevertEndpoint:     util.GetEnv("SERVER_FRONTEND_EMAIL_CHANGE_REVERT_ENDPOINT", "/revert-email-change"),
+			AccountDeletionCancelEndpoint: util.GetEnv("SERVER_FRONTEND_ACCOUNT_DELETION_CANCEL_ENDPOINT", "/cancel-account-deletion"),
+			InviteEndpoint:                util.GetEnv("SERVER_FRONTEND_INVITE_ENDPOINT", "/accept-invite"),
 		},
 		Logger: LoggerServer{
 			Level:              util.LogLevelFromString(util.GetEnv("SERVER_LOGGER_LEVEL", zerolog.DebugLevel.String())),
@@ -242,14 +431,33 @@ func DefaultServiceConfigFromEnv() Server {
 			PrettyPrintConsole: util.GetEnvAsBool("SERVER_LOGGER_PRETTY_PRINT_CONSOLE", false),
 		},
 		Push: PushService{
-			UseFCMProvider:  util.GetEnvAsBool("SERVER_PUSH_USE_FCM", false),
-			UseMockProvider: util.GetEnvAsBool("SERVER_PUSH_USE_MOCK", true),
+			UseFCMProvider:     util.GetEnvAsBool("SERVER_PUSH_USE_FCM", false),
+			UseAPNSProvider:    util.GetEnvAsBool("SERVER_PUSH_USE_APNS", false),
+			UseMockProvider:    util.GetEnvAsBool("SERVER_PUSH_USE_MOCK", true),
+			EnableOutboxWorker: util.GetEnvAsBool("SERVER_PUSH_OUTBOX_WORKER_ENABLED", true),
+			Outbox: push.OutboxConfig{
+				PollInterval:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_OUTBOX_POLL_INTERVAL_SECONDS", 5)),
+				BatchSize:      util.GetEnvAsInt("SERVER_PUSH_OUTBOX_BATCH_SIZE", 100),
+				MaxAttempts:    util.GetEnvAsInt("SERVER_PUSH_OUTBOX_MAX_ATTEMPTS", 8),
+				RetryBaseDelay: time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_OUTBOX_RETRY_BASE_DELAY_SECONDS", 30)),
+				RetryMaxDelay:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_OUTBOX_RETRY_MAX_DELAY_SECONDS", 3600)),
+				LeaseDuration:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_OUTBOX_LEASE_DURATION_SECONDS", 300)),
+				Retention:      time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_OUTBOX_RETENTION_SECONDS", 604800)),
+			},
 		},
 		FCMConfig: provider.FCMConfig{
 			GoogleApplicationCredentials: util.GetEnv("GOOGLE_APPLICATION_CREDENTIALS", ""),
 			ProjectID:                    util.GetEnv("SERVER_FCM_PROJECT_ID", "no-fcm-project-id-set"),
 			ValidateOnly:                 util.GetEnvAsBool("SERVER_FCM_VALIDATE_ONLY", true),
 		},
+		APNSConfig: provider.APNSConfig{
+			AuthKeyPath: util.GetEnv("SERVER_APNS_AUTH_KEY_PATH", ""),
+			KeyID:       util.GetEnv("SERVER_APNS_KEY_ID", ""),
+			TeamID:      util.GetEnv("SERVER_APNS_TEAM_ID", ""),
+			Topic:       util.GetEnv("SERVER_APNS_TOPIC", ""),
+			Production:  util.GetEnvAsBool("SERVER_APNS_PRODUCTION", false),
+			Endpoint:    util.GetEnv("SERVER_APNS_ENDPOINT", ""),
+		},
 		I18n: I18n{
 			DefaultLanguage: util.GetEnvAsLanguageTag("SERVER_I18N_DEFAULT_LANGUAGE", language.English),
 			BundleDirAbs:    util.GetEnv("SERVER_I18N_BUNDLE_DIR_ABS", filepath.Join(util.GetProjectRootDir(), "/web/i18n")), // /app/web/i18n
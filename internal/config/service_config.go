@@ -1,6 +1,12 @@
 package config
 
+import "allaboutapps.dev/aw/go-starter/internal/push"
+
 type PushService struct {
 	UseFCMProvider  bool
+	UseAPNSProvider bool
 	UseMockProvider bool
+	// EnableOutboxWorker drains the push outbox within the server process, disable it to drain the outbox by a job instead
+	EnableOutboxWorker bool
+	Outbox             push.OutboxConfig
 }
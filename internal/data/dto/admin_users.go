@@ -0,0 +1,121 @@
+package dto
+
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+type AdminUser struct {
+	ID                    string
+	Username              null.String
+	IsActive              bool
+	RequiresConfirmation  bool
+	PasswordResetRequired bool
+	Scopes                []string
+	LastAuthenticatedAt   null.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	// Lockout is only loaded for the detail of a single user
+	Lockout *UserLockout
+}
+
+func (u AdminUser) ToTypes() *types.AdminUser {
+	result := &types.AdminUser{
+		ID:                    conv.UUID4(strfmt.UUID4(u.ID)),
+		Username:              u.Username.String,
+		IsActive:              swag.Bool(u.IsActive),
+		RequiresConfirmation:  swag.Bool(u.RequiresConfirmation),
+		PasswordResetRequired: swag.Bool(u.PasswordResetRequired),
+		Scopes:                u.Scopes,
+		CreatedAt:             conv.DateTime(strfmt.DateTime(u.CreatedAt)),
+		UpdatedAt:             conv.DateTime(strfmt.DateTime(u.UpdatedAt)),
+	}
+
+	if result.Scopes == nil {
+		result.Scopes = []string{}
+	}
+
+	if u.LastAuthenticatedAt.Valid {
+		result.LastAuthenticatedAt = strfmt.DateTime(u.LastAuthenticatedAt.Time)
+	}
+
+	if u.Lockout != nil {
+		result.FailedLoginAttempts = int64(u.Lockout.FailedAttempts)
+		if u.Lockout.LockedUntil.Valid {
+			result.LockedUntil = strfmt.DateTime(u.Lockout.LockedUntil.Time)
+		}
+	}
+
+	return result
+}
+
+type AdminUserOrderBy string
+
+const (
+	AdminUserOrderByUsername            AdminUserOrderBy = "username"
+	AdminUserOrderByCreatedAt           AdminUserOrderBy = "createdAt"
+	AdminUserOrderByLastAuthenticatedAt AdminUserOrderBy = "lastAuthenticatedAt"
+)
+
+type GetAdminUsersRequest struct {
+	Offset   int64
+	Limit    int64
+	OrderBy  AdminUserOrderBy
+	OrderDir types.OrderDir
+	Query    null.String
+	IsActive null.Bool
+	Scope    null.String
+}
+
+type AdminUsers struct {
+	Data   []AdminUser
+	Offset int64
+	Limit  int64
+	Total  int64
+}
+
+func (u AdminUsers) ToTypes() *types.GetAdminUsersResponse {
+	result := &types.GetAdminUsersResponse{
+		Paginatable: types.Paginatable{
+			Offset: swag.Int64(u.Offset),
+			Limit:  swag.Int64(u.Limit),
+			Total:  swag.Int64(u.Total),
+		},
+		Data: make([]*types.AdminUser, 0, len(u.Data)),
+	}
+
+	for _, user := range u.Data {
+		result.Data = append(result.Data, user.ToTypes())
+	}
+
+	return result
+}
+
+type SetUserActiveRequest struct {
+	UserID   string
+	IsActive bool
+}
+
+type ForcePasswordResetResult struct {
+	Username   string
+	ResetToken null.String
+}
+
+type ImpersonateUserResult struct {
+	AccessToken string
+	ExpiresIn   int64
+	TokenType   string
+}
+
+func (r ImpersonateUserResult) ToTypes() *types.PostAdminUserImpersonateResponse {
+	return &types.PostAdminUserImpersonateResponse{
+		AccessToken: swag.String(r.AccessToken),
+		ExpiresIn:   swag.Int64(r.ExpiresIn),
+		TokenType:   swag.String(r.TokenType),
+	}
+}
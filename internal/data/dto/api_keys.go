@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+type APIKey struct {
+	ID         string
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ValidUntil null.Time
+	LastUsedAt null.Time
+	CreatedAt  time.Time
+}
+
+func (k APIKey) ToTypes() *types.APIKey {
+	result := &types.APIKey{
+		ID:        conv.UUID4(strfmt.UUID4(k.ID)),
+		Name:      swag.String(k.Name),
+		Prefix:    swag.String(k.Prefix),
+		Scopes:    k.Scopes,
+		CreatedAt: conv.DateTime(strfmt.DateTime(k.CreatedAt)),
+	}
+
+	if k.ValidUntil.Valid {
+		result.ValidUntil = strfmt.DateTime(k.ValidUntil.Time)
+	}
+
+	if k.LastUsedAt.Valid {
+		result.LastUsedAt = strfmt.DateTime(k.LastUsedAt.Time)
+	}
+
+	return result
+}
+
+type APIKeys []APIKey
+
+func (k APIKeys) ToTypes() *types.GetAPIKeysResponse {
+	result := &types.GetAPIKeysResponse{
+		Data: make([]*types.APIKey, 0, len(k)),
+	}
+
+	for _, apiKey := range k {
+		result.Data = append(result.Data, apiKey.ToTypes())
+	}
+
+	return result
+}
+
+type CreateAPIKeyRequest struct {
+	UserID     string
+	Name       string
+	Scopes     []string
+	ValidUntil null.Time
+}
+
+type CreateAPIKeyResult struct {
+	APIKey APIKey
+	// Key is the full API key, only available right after creation as just its hash is stored
+	Key string
+}
+
+func (r CreateAPIKeyResult) ToTypes() *types.PostAPIKeyResponse {
+	return &types.PostAPIKeyResponse{
+		APIKey: *r.APIKey.ToTypes(),
+		Key:    swag.String(r.Key),
+	}
+}
+
+type DeleteAPIKeyRequest struct {
+	UserID   string
+	APIKeyID string
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+type AuditEvent struct {
+	ID        string
+	EventType string
+	ActorID   null.String
+	SubjectID null.String
+	IPAddress null.String
+	UserAgent null.String
+	RequestID null.String
+	Metadata  map[string]any
+	CreatedAt time.Time
+}
+
+func (e AuditEvent) ToTypes() *types.AuditEvent {
+	result := &types.AuditEvent{
+		ID:        conv.UUID4(strfmt.UUID4(e.ID)),
+		EventType: swag.String(e.EventType),
+		IPAddress: e.IPAddress.String,
+		UserAgent: e.UserAgent.String,
+		RequestID: e.RequestID.String,
+		Metadata:  e.Metadata,
+		CreatedAt: conv.DateTime(strfmt.DateTime(e.CreatedAt)),
+	}
+
+	if e.ActorID.Valid {
+		result.ActorID = strfmt.UUID4(e.ActorID.String)
+	}
+
+	if e.SubjectID.Valid {
+		result.SubjectID = strfmt.UUID4(e.SubjectID.String)
+	}
+
+	if result.Metadata == nil {
+		result.Metadata = map[string]any{}
+	}
+
+	return result
+}
+
+type GetAuditEventsRequest struct {
+	Limit     int64
+	Cursor    null.String
+	ActorID   null.String
+	SubjectID null.String
+	EventType null.String
+	From      null.Time
+	To        null.Time
+}
+
+type AuditEvents struct {
+	Data []AuditEvent
+	// NextCursor is only set if further (older) events are available
+	NextCursor null.String
+}
+
+func (e AuditEvents) ToTypes() *types.GetAdminAuditEventsResponse {
+	result := &types.GetAdminAuditEventsResponse{
+		Data:       make([]*types.AuditEvent, 0, len(e.Data)),
+		NextCursor: e.NextCursor.String,
+	}
+
+	for _, event := range e.Data {
+		result.Data = append(result.Data, event.ToTypes())
+	}
+
+	return result
+}
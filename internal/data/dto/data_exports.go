@@ -0,0 +1,9 @@
+package dto
+
+import "io"
+
+// DataExportFile is the ZIP archive of a completed data export, the caller has to close Reader.
+type DataExportFile struct {
+	FileName string
+	Reader   io.ReadCloser
+}
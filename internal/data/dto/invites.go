@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+)
+
+type Invite struct {
+	ID          string
+	Email       string
+	Scopes      []string
+	ValidUntil  time.Time
+	CreatedByID null.String
+	CreatedAt   time.Time
+}
+
+func (i Invite) ToTypes() *types.Invite {
+	email := strfmt.Email(i.Email)
+
+	result := &types.Invite{
+		ID:         conv.UUID4(strfmt.UUID4(i.ID)),
+		Email:      &email,
+		Scopes:     i.Scopes,
+		ValidUntil: conv.DateTime(strfmt.DateTime(i.ValidUntil)),
+		CreatedAt:  conv.DateTime(strfmt.DateTime(i.CreatedAt)),
+	}
+
+	if result.Scopes == nil {
+		result.Scopes = []string{}
+	}
+
+	if i.CreatedByID.Valid {
+		result.CreatedByID = strfmt.UUID4(i.CreatedByID.String)
+	}
+
+	return result
+}
+
+type Invites []Invite
+
+func (i Invites) ToTypes() *types.GetAdminInvitesResponse {
+	result := &types.GetAdminInvitesResponse{
+		Data: make([]*types.Invite, 0, len(i)),
+	}
+
+	for _, invite := range i {
+		result.Data = append(result.Data, invite.ToTypes())
+	}
+
+	return result
+}
+
+type CreateInviteRequest struct {
+	Email Username
+	// Scopes default to the configured default user scopes if empty
+	Scopes []string
+	// ValidUntil defaults to the configured invite validity if not set
+	ValidUntil null.Time
+}
+
+type CreateInviteResult struct {
+	Invite Invite
+	// Token has to be passed on registration, only available right after creation to be sent to the invited user
+	Token string
+}
+
+type InviteNotificationPayload struct {
+	Link       string
+	ValidUntil time.Time
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/go-openapi/swag"
+)
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey
+}
+
+func (s JSONWebKeySet) ToTypes() *types.GetJWKSResponse {
+	result := &types.GetJWKSResponse{
+		Keys: make([]*types.JSONWebKey, 0, len(s.Keys)),
+	}
+
+	for _, key := range s.Keys {
+		result.Keys = append(result.Keys, key.ToTypes())
+	}
+
+	return result
+}
+
+type JSONWebKey struct {
+	KeyType   string
+	KeyID     string
+	Use       string
+	Algorithm string
+	Curve     string
+	X         string
+	N         string
+	E         string
+}
+
+func (k JSONWebKey) ToTypes() *types.JSONWebKey {
+	return &types.JSONWebKey{
+		Kty: swag.String(k.KeyType),
+		Kid: swag.String(k.KeyID),
+		Use: swag.String(k.Use),
+		Alg: swag.String(k.Algorithm),
+		Crv: k.Curve,
+		X:   k.X,
+		N:   k.N,
+		E:   k.E,
+	}
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/swag"
+)
+
+type NotificationCategoryPreference struct {
+	Category notification.Category
+	Push     bool
+	Email    bool
+}
+
+type NotificationPreferences struct {
+	TimeZone string
+	// QuietHours are nil if disabled
+	QuietHours *notification.QuietHours
+	// Categories lists all known categories, using the category defaults for channels the user has not changed
+	Categories []NotificationCategoryPreference
+}
+
+func (p NotificationPreferences) ToTypes() *types.NotificationPreferences {
+	result := &types.NotificationPreferences{
+		TimeZone:   swag.String(p.TimeZone),
+		Categories: make([]*types.NotificationCategoryPreference, 0, len(p.Categories)),
+	}
+
+	if p.QuietHours != nil {
+		result.QuietHours = &types.QuietHours{
+			Start: swag.String(notification.FormatClock(p.QuietHours.Start)),
+			End:   swag.String(notification.FormatClock(p.QuietHours.End)),
+		}
+	}
+
+	for _, preference := range p.Categories {
+		category := types.NotificationCategory(preference.Category)
+		result.Categories = append(result.Categories, &types.NotificationCategoryPreference{
+			Category:  &category,
+			Mandatory: swag.Bool(preference.Category.IsMandatory()),
+			Push:      swag.Bool(preference.Push),
+			Email:     swag.Bool(preference.Email),
+		})
+	}
+
+	return result
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	UserID   string
+	TimeZone string
+	// QuietHours are disabled if nil
+	QuietHours *notification.QuietHours
+	// Categories omitted keep their current preferences
+	Categories []NotificationCategoryPreference
+}
+
+type NotificationMailPayload struct {
+	Subject string
+	Body    string
+	// Link is rendered as a call to action if set
+	Link null.String
+}
@@ -0,0 +1,16 @@
+package dto
+
+type InitOIDCLoginRequest struct {
+	Provider string
+}
+
+type InitOIDCLoginResult struct {
+	AuthorizationURL string
+}
+
+type CompleteOIDCLoginRequest struct {
+	Provider string
+	Code     string
+	State    string
+	Session  SessionInfo
+}
@@ -0,0 +1,170 @@
+package dto
+
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+const (
+	passkeyCredentialType   = "public-key"
+	passkeyUserVerification = "required"
+	passkeyResidentKey      = "required"
+	passkeyAttestationNone  = "none"
+)
+
+type Passkey struct {
+	ID          string
+	Name        null.String
+	AAGUID      string
+	Transports  []string
+	BackupState bool
+	LastUsedAt  null.Time
+	CreatedAt   time.Time
+}
+
+func (p Passkey) ToTypes() *types.Passkey {
+	result := &types.Passkey{
+		ID:         conv.UUID4(strfmt.UUID4(p.ID)),
+		Name:       p.Name.String,
+		Aaguid:     conv.UUID(strfmt.UUID(p.AAGUID)),
+		Transports: p.Transports,
+		BackedUp:   swag.Bool(p.BackupState),
+		CreatedAt:  conv.DateTime(strfmt.DateTime(p.CreatedAt)),
+	}
+
+	if p.LastUsedAt.Valid {
+		result.LastUsedAt = strfmt.DateTime(p.LastUsedAt.Time)
+	}
+
+	return result
+}
+
+type Passkeys []Passkey
+
+func (p Passkeys) ToTypes() *types.GetPasskeysResponse {
+	result := &types.GetPasskeysResponse{
+		Data: make([]*types.Passkey, 0, len(p)),
+	}
+
+	for _, passkey := range p {
+		result.Data = append(result.Data, passkey.ToTypes())
+	}
+
+	return result
+}
+
+// PasskeyDescriptor identifies an existing passkey, its ID is base64url encoded.
+type PasskeyDescriptor struct {
+	CredentialID string
+	Transports   []string
+}
+
+func (d PasskeyDescriptor) ToTypes() *types.PasskeyCredentialDescriptor {
+	return &types.PasskeyCredentialDescriptor{
+		Type:       swag.String(passkeyCredentialType),
+		ID:         swag.String(d.CredentialID),
+		Transports: d.Transports,
+	}
+}
+
+type BeginPasskeyRegistrationRequest struct {
+	User User
+}
+
+type BeginPasskeyRegistrationResult struct {
+	Challenge string
+	RPID      string
+	RPName    string
+	// UserHandle is the base64url encoded user handle
+	UserHandle         string
+	UserName           string
+	Algorithms         []int64
+	Timeout            time.Duration
+	ExcludeCredentials []PasskeyDescriptor
+}
+
+func (r BeginPasskeyRegistrationResult) ToTypes() *types.PostPasskeyRegisterBeginResponse {
+	pubKeyCredParams := make([]*types.PasskeyCreationOptionsPubKeyCredParamsItems0, 0, len(r.Algorithms))
+	for _, alg := range r.Algorithms {
+		pubKeyCredParams = append(pubKeyCredParams, &types.PasskeyCreationOptionsPubKeyCredParamsItems0{
+			Type: swag.String(passkeyCredentialType),
+			Alg:  swag.Int64(alg),
+		})
+	}
+
+	excludeCredentials := make([]*types.PasskeyCredentialDescriptor, 0, len(r.ExcludeCredentials))
+	for _, descriptor := range r.ExcludeCredentials {
+		excludeCredentials = append(excludeCredentials, descriptor.ToTypes())
+	}
+
+	return &types.PostPasskeyRegisterBeginResponse{
+		PublicKey: &types.PasskeyCreationOptions{
+			Challenge: swag.String(r.Challenge),
+			Rp: &types.PasskeyCreationOptionsRp{
+				ID:   swag.String(r.RPID),
+				Name: swag.String(r.RPName),
+			},
+			User: &types.PasskeyCreationOptionsUser{
+				ID:          swag.String(r.UserHandle),
+				Name:        swag.String(r.UserName),
+				DisplayName: swag.String(r.UserName),
+			},
+			PubKeyCredParams:   pubKeyCredParams,
+			Timeout:            swag.Int64(r.Timeout.Milliseconds()),
+			ExcludeCredentials: excludeCredentials,
+			AuthenticatorSelection: &types.PasskeyCreationOptionsAuthenticatorSelection{
+				ResidentKey:        swag.String(passkeyResidentKey),
+				RequireResidentKey: swag.Bool(true),
+				UserVerification:   swag.String(passkeyUserVerification),
+			},
+			Attestation: swag.String(passkeyAttestationNone),
+		},
+	}
+}
+
+// FinishPasskeyRegistrationRequest contains the base64url encoded credential created by the authenticator.
+type FinishPasskeyRegistrationRequest struct {
+	User              User
+	Name              null.String
+	CredentialID      string
+	ClientDataJSON    string
+	AttestationObject string
+	Transports        []string
+}
+
+type BeginPasskeyLoginResult struct {
+	Challenge string
+	RPID      string
+	Timeout   time.Duration
+}
+
+func (r BeginPasskeyLoginResult) ToTypes() *types.PostPasskeyLoginBeginResponse {
+	return &types.PostPasskeyLoginBeginResponse{
+		PublicKey: &types.PasskeyRequestOptions{
+			Challenge:        swag.String(r.Challenge),
+			RpID:             swag.String(r.RPID),
+			Timeout:          swag.Int64(r.Timeout.Milliseconds()),
+			UserVerification: swag.String(passkeyUserVerification),
+		},
+	}
+}
+
+// FinishPasskeyLoginRequest contains the base64url encoded assertion signed by the authenticator.
+type FinishPasskeyLoginRequest struct {
+	CredentialID      string
+	ClientDataJSON    string
+	AuthenticatorData string
+	Signature         string
+	UserHandle        null.String
+	Session           SessionInfo
+}
+
+type DeletePasskeyRequest struct {
+	UserID    string
+	PasskeyID string
+}
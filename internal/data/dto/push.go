@@ -1,10 +1,137 @@
 package dto
 
-import "github.com/aarondl/null/v8"
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
 
 type UpdatePushTokenRequest struct {
 	User          User
+	AccessToken   string
 	Token         string
 	Provider      string
 	ExistingToken null.String
+	Locale        null.String
+}
+
+type PushDelivery struct {
+	ID            string
+	UserID        string
+	Provider      string
+	Token         string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     null.String
+	SentAt        null.Time
+	CreatedAt     time.Time
+}
+
+type PushTopics []string
+
+func (t PushTopics) ToTypes() *types.GetPushTopicsResponse {
+	result := &types.GetPushTopicsResponse{
+		Topics: t,
+	}
+
+	if result.Topics == nil {
+		result.Topics = []string{}
+	}
+
+	return result
+}
+
+// PushSegment selects the users a broadcast is sent to. All criteria set have to match, an empty segment matches
+// all users.
+type PushSegment struct {
+	Topic null.String
+	Scope null.String
+	// Locale matches devices with the locale or a regional variant of it, e.g. "de" matches "de-AT"
+	Locale null.String
+}
+
+type PushBroadcast struct {
+	ID          string
+	Title       string
+	Body        string
+	DeepLink    null.String
+	ImageURL    null.String
+	Data        map[string]string
+	Category    notification.Category
+	Segment     PushSegment
+	Status      string
+	ScheduledAt time.Time
+	SentAt      null.Time
+	SentCount   int
+	FailedCount int
+	CreatedByID null.String
+	CreatedAt   time.Time
+}
+
+func (b PushBroadcast) ToTypes() *types.PushBroadcast {
+	result := &types.PushBroadcast{
+		ID:          conv.UUID4(strfmt.UUID4(b.ID)),
+		Title:       swag.String(b.Title),
+		Body:        swag.String(b.Body),
+		DeepLink:    b.DeepLink.String,
+		ImageURL:    b.ImageURL.String,
+		Data:        b.Data,
+		Category:    swag.String(b.Category.String()),
+		Topic:       b.Segment.Topic.String,
+		Scope:       b.Segment.Scope.String,
+		Locale:      b.Segment.Locale.String,
+		Status:      swag.String(b.Status),
+		ScheduledAt: conv.DateTime(strfmt.DateTime(b.ScheduledAt)),
+		SentCount:   swag.Int64(int64(b.SentCount)),
+		FailedCount: swag.Int64(int64(b.FailedCount)),
+		CreatedAt:   conv.DateTime(strfmt.DateTime(b.CreatedAt)),
+	}
+
+	if result.Data == nil {
+		result.Data = map[string]string{}
+	}
+
+	if b.SentAt.Valid {
+		result.SentAt = strfmt.DateTime(b.SentAt.Time)
+	}
+
+	if b.CreatedByID.Valid {
+		result.CreatedByID = strfmt.UUID4(b.CreatedByID.String)
+	}
+
+	return result
+}
+
+type PushBroadcasts []PushBroadcast
+
+func (b PushBroadcasts) ToTypes() *types.GetAdminPushBroadcastsResponse {
+	result := &types.GetAdminPushBroadcastsResponse{
+		Data: make([]*types.PushBroadcast, 0, len(b)),
+	}
+
+	for _, broadcast := range b {
+		result.Data = append(result.Data, broadcast.ToTypes())
+	}
+
+	return result
+}
+
+type CreatePushBroadcastRequest struct {
+	Title    string
+	Body     string
+	DeepLink null.String
+	ImageURL null.String
+	Data     map[string]string
+	// Category defaults to notification.CategoryUpdates
+	Category notification.Category
+	Segment  PushSegment
+	// ScheduledAt defaults to now, sending the broadcast right away
+	ScheduledAt null.Time
+	CreatedByID null.String
 }
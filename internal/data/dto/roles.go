@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+type Role struct {
+	ID          string
+	Name        string
+	Description string
+	Permissions []string
+}
+
+func (r Role) ToTypes() *types.Role {
+	return &types.Role{
+		ID:          conv.UUID4(strfmt.UUID4(r.ID)),
+		Name:        swag.String(r.Name),
+		Description: swag.String(r.Description),
+		Permissions: r.Permissions,
+	}
+}
+
+type Roles []Role
+
+func (r Roles) ToTypes() *types.GetRolesResponse {
+	result := &types.GetRolesResponse{
+		Data: make([]*types.Role, 0, len(r)),
+	}
+
+	for _, role := range r {
+		result.Data = append(result.Data, role.ToTypes())
+	}
+
+	return result
+}
+
+type UpdateUserRolesRequest struct {
+	UserID string
+	Roles  []string
+}
+
+type UserRoles struct {
+	Roles       []string
+	Permissions []string
+}
+
+func (r UserRoles) ToTypes() *types.UserRolesResponse {
+	return &types.UserRolesResponse{
+		Roles:       r.Roles,
+		Permissions: r.Permissions,
+	}
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+// SessionInfo describes the client a session is created or refreshed for.
+type SessionInfo struct {
+	DeviceName null.String
+	UserAgent  string
+	IPAddress  string
+}
+
+type Session struct {
+	ID         string
+	DeviceName null.String
+	UserAgent  null.String
+	IPAddress  null.String
+	LastUsedAt time.Time
+	Current    bool
+}
+
+func (s Session) ToTypes() *types.Session {
+	return &types.Session{
+		ID:         conv.UUID4(strfmt.UUID4(s.ID)),
+		DeviceName: s.DeviceName.String,
+		UserAgent:  s.UserAgent.String,
+		IPAddress:  s.IPAddress.String,
+		LastUsedAt: conv.DateTime(strfmt.DateTime(s.LastUsedAt)),
+		Current:    swag.Bool(s.Current),
+	}
+}
+
+type Sessions []Session
+
+func (s Sessions) ToTypes() *types.GetSessionsResponse {
+	result := &types.GetSessionsResponse{
+		Data: make([]*types.Session, 0, len(s)),
+	}
+
+	for _, session := range s {
+		result.Data = append(result.Data, session.ToTypes())
+	}
+
+	return result
+}
+
+type GetSessionsRequest struct {
+	User        User
+	AccessToken string
+}
+
+type RevokeSessionRequest struct {
+	User      User
+	SessionID string
+}
+
+type RevokeOtherSessionsRequest struct {
+	User        User
+	AccessToken string
+}
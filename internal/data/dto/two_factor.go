@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+type TwoFactorChallenge struct {
+	ChallengeToken string
+	ExpiresIn      int64
+}
+
+func (c TwoFactorChallenge) ToTypes() *types.PostLoginTwoFactorChallengeResponse {
+	return &types.PostLoginTwoFactorChallengeResponse{
+		ChallengeToken: conv.UUID4(strfmt.UUID4(c.ChallengeToken)),
+		ExpiresIn:      swag.Int64(c.ExpiresIn),
+	}
+}
+
+type EnrollTOTPRequest struct {
+	User User
+}
+
+type EnrollTOTPResult struct {
+	Secret        string
+	KeyURI        string
+	RecoveryCodes []string
+}
+
+func (r EnrollTOTPResult) ToTypes() *types.PostTwoFactorEnrollResponse {
+	return &types.PostTwoFactorEnrollResponse{
+		Secret:        swag.String(r.Secret),
+		OtpauthURI:    swag.String(r.KeyURI),
+		RecoveryCodes: r.RecoveryCodes,
+	}
+}
+
+type TOTPCodeRequest struct {
+	User User
+	Code string
+}
+
+type VerifyTwoFactorChallengeRequest struct {
+	ChallengeToken string
+	Code           string
+	Session        SessionInfo
+}
@@ -10,17 +10,19 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/strfmt/conv"
 	"github.com/go-openapi/swag"
+	"golang.org/x/text/language"
 )
 
 type User struct {
-	ID                  string
-	Username            null.String
-	PasswordHash        null.String
-	IsActive            bool
-	Scopes              []string
-	LastAuthenticatedAt null.Time
-	UpdatedAt           time.Time
-	Profile             *AppUserProfile
+	ID                    string
+	Username              null.String
+	PasswordHash          null.String
+	IsActive              bool
+	PasswordResetRequired bool
+	Scopes                []string
+	LastAuthenticatedAt   null.Time
+	UpdatedAt             time.Time
+	Profile               *AppUserProfile
 }
 
 func (u User) LastUpdatedAt() time.Time {
@@ -36,12 +38,14 @@ func (u User) Ptr() *User {
 }
 
 func (u User) ToTypes() *types.GetUserInfoResponse {
-	return &types.GetUserInfoResponse{
+	result := &types.GetUserInfoResponse{
 		Sub:       swag.String(u.ID),
 		UpdatedAt: swag.Int64(u.LastUpdatedAt().Unix()),
 		Email:     strfmt.Email(u.Username.String),
 		Scopes:    u.Scopes,
 	}
+
+	return result
 }
 
 func (u User) ToModels() *models.User {
@@ -55,6 +59,11 @@ func (u User) ToModels() *models.User {
 	}
 }
 
+type UserLockout struct {
+	FailedAttempts int
+	LockedUntil    null.Time
+}
+
 type AppUserProfile struct {
 	UserID          string
 	LegalAcceptedAt null.Time
@@ -70,6 +79,7 @@ type UpdatePasswordRequest struct {
 	CurrentPassword                 string
 	SkipCurrentPasswordVerification bool
 	NewPassword                     string
+	Session                         SessionInfo
 }
 
 type RegisterResult struct {
@@ -83,11 +93,19 @@ type LoginResult struct {
 	ExpiresIn    int64
 	RefreshToken string
 	TokenType    string
+
+	// TwoFactorChallenge is set instead of the auth tokens if the user has to complete
+	// the login using a second factor first.
+	TwoFactorChallenge *TwoFactorChallenge
+}
+
+func (l LoginResult) RequiresTwoFactor() bool {
+	return l.TwoFactorChallenge != nil
 }
 
 func (l LoginResult) ToTypes() *types.PostLoginResponse {
 	return &types.PostLoginResponse{
-		AccessToken:  conv.UUID4(strfmt.UUID4(l.AccessToken)),
+		AccessToken:  swag.String(l.AccessToken),
 		RefreshToken: conv.UUID4(strfmt.UUID4(l.RefreshToken)),
 		ExpiresIn:    swag.Int64(l.ExpiresIn),
 		TokenType:    swag.String(l.TokenType),
@@ -97,6 +115,7 @@ func (l LoginResult) ToTypes() *types.PostLoginResponse {
 type ResetPasswordRequest struct {
 	ResetToken  string
 	NewPassword string
+	Session     SessionInfo
 }
 
 type Username struct {
@@ -112,16 +131,55 @@ func (u Username) String() string {
 }
 
 type InitPasswordResetRequest struct {
-	Username Username
+	Username  Username
+	IPAddress string
 }
 
 type InitPasswordResetResult struct {
 	ResetToken null.String
 }
 
+type InitMagicLinkRequest struct {
+	Username  Username
+	IPAddress string
+}
+
+type InitMagicLinkResult struct {
+	MagicLinkToken null.String
+}
+
+type CompleteMagicLinkRequest struct {
+	MagicLinkToken string
+	Session        SessionInfo
+}
+
+type InitEmailChangeRequest struct {
+	User            User
+	CurrentPassword string
+	NewEmail        Username
+}
+
+type InitEmailChangeResult struct {
+	OldEmail          string
+	NewEmail          string
+	ConfirmationToken string
+	RevertToken       string
+}
+
+type ConfirmEmailChangeRequest struct {
+	User              User
+	ConfirmationToken string
+	Session           SessionInfo
+}
+
+type RevertEmailChangeRequest struct {
+	RevertToken string
+}
+
 type LoginRequest struct {
 	Username Username
 	Password string
+	Session  SessionInfo
 }
 
 type LogoutRequest struct {
@@ -132,19 +190,28 @@ type LogoutRequest struct {
 type AuthenticateUserRequest struct {
 	User                     User
 	InvalidateExistingTokens bool
+	Session                  SessionInfo
+	// SessionID continues an existing session started at SessionStartedAt instead of starting a new one,
+	// e.g. when refreshing tokens.
+	SessionID        null.String
+	SessionStartedAt time.Time
 }
 
 type RefreshRequest struct {
 	RefreshToken string
+	Session      SessionInfo
 }
 
 type RegisterRequest struct {
 	Username Username
 	Password string
+	// InviteToken registers the user with the scopes of the invite, skipping the confirmation
+	InviteToken null.String
 }
 
 type CompleteRegisterRequest struct {
 	ConfirmationToken string
+	Session           SessionInfo
 }
 
 type DeleteUserAccountRequest struct {
@@ -152,6 +219,42 @@ type DeleteUserAccountRequest struct {
 	CurrentPassword string
 }
 
+// AccountDeletion is the scheduled deletion of a user account, which can be cancelled using CancelToken
+// until the account is purged at ScheduledAt.
+type AccountDeletion struct {
+	UserID      string
+	Email       null.String
+	ScheduledAt time.Time
+	CancelToken string
+}
+
+type DeleteUserAccountResult struct {
+	// Deletion is only set if the account is purged after the grace period instead of being deleted immediately
+	Deletion *AccountDeletion
+}
+
 type ConfirmatioNotificationPayload struct {
 	ConfirmationLink string
 }
+
+type MagicLinkNotificationPayload struct {
+	MagicLink string
+	Validity  time.Duration
+	Language  language.Tag
+}
+
+type EmailChangeNotificationPayload struct {
+	OldEmail string
+	NewEmail string
+	Link     string
+}
+
+type AccountDeletionNotificationPayload struct {
+	Link        string
+	ScheduledAt time.Time
+}
+
+type DataExportNotificationPayload struct {
+	Link       string
+	ValidUntil time.Time
+}
@@ -0,0 +1,174 @@
+package local
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// GetNotificationPreferences returns the time zone, quiet hours and the preferences of all notification categories
+// of the user.
+func (s *Service) GetNotificationPreferences(ctx context.Context, userID string) (dto.NotificationPreferences, error) {
+	return s.getNotificationPreferences(ctx, s.db, userID)
+}
+
+// UpdateNotificationPreferences replaces the time zone and quiet hours of the user and updates the preferences of the
+// categories given.
+func (s *Service) UpdateNotificationPreferences(ctx context.Context, request dto.UpdateNotificationPreferencesRequest) (dto.NotificationPreferences, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", request.UserID).Logger()
+
+	if _, err := notification.LoadTimeZone(request.TimeZone); err != nil {
+		log.Debug().Err(err).Str("timeZone", request.TimeZone).Msg("Invalid time zone")
+		return dto.NotificationPreferences{}, httperrors.ErrBadRequestInvalidTimeZone
+	}
+
+	profile := models.AppUserProfile{
+		UserID:   request.UserID,
+		TimeZone: request.TimeZone,
+	}
+
+	if request.QuietHours != nil {
+		if err := request.QuietHours.Validate(); err != nil {
+			log.Debug().Err(err).Msg("Invalid quiet hours")
+			return dto.NotificationPreferences{}, httperrors.ErrBadRequestInvalidQuietHours
+		}
+
+		profile.QuietHoursStart = null.IntFrom(request.QuietHours.Start)
+		profile.QuietHoursEnd = null.IntFrom(request.QuietHours.End)
+	}
+
+	for _, preference := range request.Categories {
+		if !preference.Category.IsValid() {
+			log.Debug().Str("category", preference.Category.String()).Msg("Unknown notification category")
+			return dto.NotificationPreferences{}, notification.ErrUnknownCategory
+		}
+
+		if preference.Category.IsMandatory() && (!preference.Push || !preference.Email) {
+			log.Debug().Str("category", preference.Category.String()).Msg("Cannot disable mandatory notification category")
+			return dto.NotificationPreferences{}, httperrors.ErrBadRequestNotificationCategoryMandatory
+		}
+	}
+
+	var result dto.NotificationPreferences
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// profiles are created on registration, the upsert only covers users created otherwise
+		if err := profile.Upsert(ctx, exec, true, []string{models.AppUserProfileColumns.UserID}, boil.Whitelist(
+			models.AppUserProfileColumns.TimeZone,
+			models.AppUserProfileColumns.QuietHoursStart,
+			models.AppUserProfileColumns.QuietHoursEnd,
+			models.AppUserProfileColumns.UpdatedAt,
+		), boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to update time zone and quiet hours")
+			return err
+		}
+
+		for _, preference := range request.Categories {
+			// mandatory categories are always enabled, there is nothing to store
+			if preference.Category.IsMandatory() {
+				continue
+			}
+
+			for channel, enabled := range map[notification.Channel]bool{
+				notification.ChannelPush:  preference.Push,
+				notification.ChannelEmail: preference.Email,
+			} {
+				row := models.NotificationPreference{
+					UserID:   request.UserID,
+					Category: preference.Category.String(),
+					Channel:  channel.String(),
+					Enabled:  enabled,
+				}
+
+				if err := row.Upsert(ctx, exec, true, []string{
+					models.NotificationPreferenceColumns.UserID,
+					models.NotificationPreferenceColumns.Category,
+					models.NotificationPreferenceColumns.Channel,
+				}, boil.Whitelist(
+					models.NotificationPreferenceColumns.Enabled,
+					models.NotificationPreferenceColumns.UpdatedAt,
+				), boil.Infer()); err != nil {
+					log.Err(err).Str("category", preference.Category.String()).Str("channel", channel.String()).Msg("Failed to update notification preference")
+					return err
+				}
+			}
+		}
+
+		var err error
+		result, err = s.getNotificationPreferences(ctx, exec, request.UserID)
+
+		return err
+	}); err != nil {
+		return dto.NotificationPreferences{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) getNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, userID string) (dto.NotificationPreferences, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", userID).Logger()
+
+	result := dto.NotificationPreferences{
+		TimeZone: "UTC",
+	}
+
+	profile, err := models.FindAppUserProfile(ctx, exec, userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Err(err).Msg("Failed to load app user profile")
+		return dto.NotificationPreferences{}, err
+	}
+
+	if profile != nil {
+		result.TimeZone = profile.TimeZone
+
+		if profile.QuietHoursStart.Valid && profile.QuietHoursEnd.Valid {
+			result.QuietHours = &notification.QuietHours{
+				Start: profile.QuietHoursStart.Int,
+				End:   profile.QuietHoursEnd.Int,
+			}
+		}
+	}
+
+	preferences, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.UserID.EQ(userID),
+	).All(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to load notification preferences")
+		return dto.NotificationPreferences{}, err
+	}
+
+	enabled := make(map[notification.Category]map[notification.Channel]bool)
+	for _, preference := range preferences {
+		category := notification.Category(preference.Category)
+		if enabled[category] == nil {
+			enabled[category] = make(map[notification.Channel]bool)
+		}
+		enabled[category][notification.Channel(preference.Channel)] = preference.Enabled
+	}
+
+	isEnabled := func(category notification.Category, channel notification.Channel) bool {
+		if value, ok := enabled[category][channel]; ok && !category.IsMandatory() {
+			return value
+		}
+
+		return category.DefaultEnabled()
+	}
+
+	for _, category := range notification.Categories() {
+		result.Categories = append(result.Categories, dto.NotificationCategoryPreference{
+			Category: category,
+			Push:     isEnabled(category, notification.ChannelPush),
+			Email:    isEnabled(category, notification.ChannelEmail),
+		})
+	}
+
+	return result, nil
+}
@@ -11,6 +11,7 @@ import (
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"allaboutapps.dev/aw/go-starter/internal/util/db"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 )
 
 func (s *Service) UpdatePushToken(ctx context.Context, request dto.UpdatePushTokenRequest) error {
@@ -30,10 +31,24 @@ func (s *Service) UpdatePushToken(ctx context.Context, request dto.UpdatePushTok
 			return httperrors.ErrConflictPushToken
 		}
 
+		// link the push token to the session of the access token, so it is removed once the session is revoked
+		accessToken, err := models.AccessTokens(
+			models.AccessTokenWhere.Token.EQ(request.AccessToken),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to load access token")
+			return err
+		}
+
 		newToken := models.PushToken{
 			UserID:   request.User.ID,
 			Token:    request.Token,
 			Provider: request.Provider,
+			Locale:   request.Locale,
+		}
+
+		if accessToken != nil {
+			newToken.SessionID = accessToken.SessionID
 		}
 
 		if err := newToken.Insert(ctx, s.db, boil.Infer()); err != nil {
@@ -73,3 +88,53 @@ func (s *Service) UpdatePushToken(ctx context.Context, request dto.UpdatePushTok
 
 	return nil
 }
+
+// GetPushTopics returns the names of the topics the user is subscribed to, sorted alphabetically.
+func (s *Service) GetPushTopics(ctx context.Context, userID string) (dto.PushTopics, error) {
+	subscriptions, err := models.PushTopicSubscriptions(
+		models.PushTopicSubscriptionWhere.UserID.EQ(userID),
+		qm.OrderBy(models.PushTopicSubscriptionColumns.Topic),
+	).All(ctx, s.db)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Str("userID", userID).Msg("Failed to load push topic subscriptions")
+		return nil, err
+	}
+
+	topics := make(dto.PushTopics, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		topics = append(topics, subscription.Topic)
+	}
+
+	return topics, nil
+}
+
+// SubscribePushTopic subscribes the user to broadcasts sent to the topic, subscribing twice has no effect.
+func (s *Service) SubscribePushTopic(ctx context.Context, userID string, topic string) error {
+	subscription := models.PushTopicSubscription{
+		UserID: userID,
+		Topic:  topic,
+	}
+
+	if err := subscription.Upsert(ctx, s.db, false, []string{
+		models.PushTopicSubscriptionColumns.UserID,
+		models.PushTopicSubscriptionColumns.Topic,
+	}, boil.None(), boil.Infer()); err != nil {
+		util.LogFromContext(ctx).Err(err).Str("userID", userID).Str("topic", topic).Msg("Failed to subscribe to push topic")
+		return err
+	}
+
+	return nil
+}
+
+// UnsubscribePushTopic removes the subscription of the user to the topic if present.
+func (s *Service) UnsubscribePushTopic(ctx context.Context, userID string, topic string) error {
+	if _, err := models.PushTopicSubscriptions(
+		models.PushTopicSubscriptionWhere.UserID.EQ(userID),
+		models.PushTopicSubscriptionWhere.Topic.EQ(topic),
+	).DeleteAll(ctx, s.db); err != nil {
+		util.LogFromContext(ctx).Err(err).Str("userID", userID).Str("topic", topic).Msg("Failed to unsubscribe from push topic")
+		return err
+	}
+
+	return nil
+}
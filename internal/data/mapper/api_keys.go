@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+)
+
+func LocalAPIKeyToDTO(apiKey *models.APIKey) dto.APIKey {
+	return dto.APIKey{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		ValidUntil: apiKey.ValidUntil,
+		LastUsedAt: apiKey.LastUsedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
@@ -0,0 +1,17 @@
+package mapper
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+)
+
+func LocalInviteToDTO(invite *models.Invite) dto.Invite {
+	return dto.Invite{
+		ID:          invite.ID,
+		Email:       invite.Email,
+		Scopes:      invite.Scopes,
+		ValidUntil:  invite.ValidUntil,
+		CreatedByID: invite.CreatedByID,
+		CreatedAt:   invite.CreatedAt,
+	}
+}
@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+)
+
+func LocalWebauthnCredentialToPasskeyDTO(credential *models.WebauthnCredential) dto.Passkey {
+	return dto.Passkey{
+		ID:          credential.ID,
+		Name:        credential.Name,
+		AAGUID:      credential.Aaguid,
+		Transports:  credential.Transports,
+		BackupState: credential.BackupState,
+		LastUsedAt:  credential.LastUsedAt,
+		CreatedAt:   credential.CreatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package mapper
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+)
+
+func LocalPushDeliveryToDTO(delivery *models.PushDelivery) dto.PushDelivery {
+	return dto.PushDelivery{
+		ID:            delivery.ID,
+		UserID:        delivery.UserID,
+		Provider:      delivery.Provider,
+		Token:         delivery.Token,
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+		SentAt:        delivery.SentAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
+
+func LocalPushBroadcastToDTO(broadcast *models.PushBroadcast) dto.PushBroadcast {
+	// data is always stored from a map[string]string, so it cannot fail to unmarshal
+	var data map[string]string
+	_ = broadcast.Data.Unmarshal(&data)
+
+	return dto.PushBroadcast{
+		ID:       broadcast.ID,
+		Title:    broadcast.Title,
+		Body:     broadcast.Body,
+		DeepLink: broadcast.DeepLink,
+		ImageURL: broadcast.ImageURL,
+		Data:     data,
+		Category: notification.Category(broadcast.Category),
+		Segment: dto.PushSegment{
+			Topic:  broadcast.Topic,
+			Scope:  broadcast.Scope,
+			Locale: broadcast.Locale,
+		},
+		Status:      broadcast.Status,
+		ScheduledAt: broadcast.ScheduledAt,
+		SentAt:      broadcast.SentAt,
+		SentCount:   broadcast.SentCount,
+		FailedCount: broadcast.FailedCount,
+		CreatedByID: broadcast.CreatedByID,
+		CreatedAt:   broadcast.CreatedAt,
+	}
+}
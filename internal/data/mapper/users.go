@@ -15,13 +15,14 @@ func LocalAppUserProfileToDTO(appUserProfile *models.AppUserProfile) dto.AppUser
 
 func LocalUserToDTO(user *models.User) dto.User {
 	result := dto.User{
-		ID:                  user.ID,
-		Username:            user.Username,
-		IsActive:            user.IsActive,
-		Scopes:              user.Scopes,
-		LastAuthenticatedAt: user.LastAuthenticatedAt,
-		UpdatedAt:           user.UpdatedAt,
-		PasswordHash:        user.Password,
+		ID:                    user.ID,
+		Username:              user.Username,
+		IsActive:              user.IsActive,
+		PasswordResetRequired: user.PasswordResetRequired,
+		Scopes:                user.Scopes,
+		LastAuthenticatedAt:   user.LastAuthenticatedAt,
+		UpdatedAt:             user.UpdatedAt,
+		PasswordHash:          user.Password,
 	}
 
 	if user.R != nil && user.R.AppUserProfile != nil {
@@ -30,3 +31,17 @@ func LocalUserToDTO(user *models.User) dto.User {
 
 	return result
 }
+
+func LocalUserToAdminDTO(user *models.User) dto.AdminUser {
+	return dto.AdminUser{
+		ID:                    user.ID,
+		Username:              user.Username,
+		IsActive:              user.IsActive,
+		RequiresConfirmation:  user.RequiresConfirmation,
+		PasswordResetRequired: user.PasswordResetRequired,
+		Scopes:                user.Scopes,
+		LastAuthenticatedAt:   user.LastAuthenticatedAt,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
+	}
+}
@@ -0,0 +1,167 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Exporter provides the data stored about a user by a single domain package, which is added to data
+// exports as JSON file named after the exporter. Domain packages register their exporters using
+// Service.RegisterExporter, see api.NewExport.
+type Exporter interface {
+	// Name of the JSON file within the archive (without extension), has to be unique.
+	Name() string
+	// Export returns the data of the user, it will be marshaled to JSON.
+	Export(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error)
+}
+
+type funcExporter struct {
+	name string
+	fn   func(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error)
+}
+
+func (e *funcExporter) Name() string {
+	return e.name
+}
+
+func (e *funcExporter) Export(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	return e.fn(ctx, exec, userID)
+}
+
+// defaultExporters returns the exporters for the data stored about every user, credentials like password
+// hashes or tokens used for authentication are never exported.
+func defaultExporters() []Exporter {
+	return []Exporter{
+		&funcExporter{name: "user", fn: exportUser},
+		&funcExporter{name: "app_user_profile", fn: exportAppUserProfile},
+		&funcExporter{name: "sessions", fn: exportSessions},
+		&funcExporter{name: "push_tokens", fn: exportPushTokens},
+	}
+}
+
+type exportedUser struct {
+	ID                   string    `json:"id"`
+	Username             string    `json:"username,omitempty"`
+	IsActive             bool      `json:"is_active"`
+	Scopes               []string  `json:"scopes"`
+	RequiresConfirmation bool      `json:"requires_confirmation"`
+	LastAuthenticatedAt  null.Time `json:"last_authenticated_at"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+func exportUser(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	user, err := models.FindUser(ctx, exec, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportedUser{
+		ID:                   user.ID,
+		Username:             user.Username.String,
+		IsActive:             user.IsActive,
+		Scopes:               user.Scopes,
+		RequiresConfirmation: user.RequiresConfirmation,
+		LastAuthenticatedAt:  user.LastAuthenticatedAt,
+		CreatedAt:            user.CreatedAt,
+		UpdatedAt:            user.UpdatedAt,
+	}, nil
+}
+
+type exportedAppUserProfile struct {
+	LegalAcceptedAt null.Time `json:"legal_accepted_at"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func exportAppUserProfile(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	profile, err := models.FindAppUserProfile(ctx, exec, userID)
+	if err != nil {
+		// not every user has an app user profile (e.g. CMS users), exported as null
+		if errors.Is(err, sql.ErrNoRows) {
+			return (*exportedAppUserProfile)(nil), nil
+		}
+
+		return nil, err
+	}
+
+	return exportedAppUserProfile{
+		LegalAcceptedAt: profile.LegalAcceptedAt,
+		CreatedAt:       profile.CreatedAt,
+		UpdatedAt:       profile.UpdatedAt,
+	}, nil
+}
+
+type exportedSession struct {
+	ID         string      `json:"id"`
+	DeviceName null.String `json:"device_name"`
+	UserAgent  null.String `json:"user_agent"`
+	IPAddress  null.String `json:"ip_address"`
+	StartedAt  time.Time   `json:"started_at"`
+	LastUsedAt time.Time   `json:"last_used_at"`
+}
+
+func exportSessions(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	refreshTokens, err := models.RefreshTokens(
+		models.RefreshTokenWhere.UserID.EQ(userID),
+		models.RefreshTokenWhere.RotatedAt.IsNull(),
+		qm.OrderBy(models.RefreshTokenColumns.SessionStartedAt+" ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]exportedSession, 0, len(refreshTokens))
+	for _, refreshToken := range refreshTokens {
+		sessions = append(sessions, exportedSession{
+			ID:         refreshToken.SessionID,
+			DeviceName: refreshToken.DeviceName,
+			UserAgent:  refreshToken.UserAgent,
+			IPAddress:  refreshToken.IPAddress,
+			StartedAt:  refreshToken.SessionStartedAt,
+			LastUsedAt: refreshToken.LastUsedAt,
+		})
+	}
+
+	return sessions, nil
+}
+
+type exportedPushToken struct {
+	ID        string      `json:"id"`
+	Token     string      `json:"token"`
+	Provider  string      `json:"provider"`
+	Locale    null.String `json:"locale"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+func exportPushTokens(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	pushTokens, err := models.PushTokens(
+		models.PushTokenWhere.UserID.EQ(userID),
+		qm.OrderBy(models.PushTokenColumns.CreatedAt+" ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]exportedPushToken, 0, len(pushTokens))
+	for _, pushToken := range pushTokens {
+		tokens = append(tokens, exportedPushToken{
+			ID:        pushToken.ID,
+			Token:     pushToken.Token,
+			Provider:  pushToken.Provider,
+			Locale:    pushToken.Locale,
+			CreatedAt: pushToken.CreatedAt,
+			UpdatedAt: pushToken.UpdatedAt,
+		})
+	}
+
+	return tokens, nil
+}
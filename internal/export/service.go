@@ -0,0 +1,451 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/mailer"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// exportsDir is the directory within config.Paths.MntBaseDirAbs archives are stored in
+	exportsDir = "exports"
+	archiveExt = ".zip"
+)
+
+// Service builds data exports of users (right of access) in the background, providing them as ZIP archive
+// containing a JSON file per registered Exporter.
+type Service struct {
+	config    config.Server
+	db        *sql.DB
+	clock     time2.Clock
+	mailer    *mailer.Mailer
+	exporters []Exporter
+	// wg tracks the exports currently being built, see Wait
+	wg sync.WaitGroup
+}
+
+func New(config config.Server, db *sql.DB, clock time2.Clock, mailer *mailer.Mailer) *Service {
+	return &Service{
+		config:    config,
+		db:        db,
+		clock:     clock,
+		mailer:    mailer,
+		exporters: defaultExporters(),
+	}
+}
+
+// RegisterExporter adds the data provided by e to all future exports, replacing a registered exporter of the same name.
+func (s *Service) RegisterExporter(e Exporter) {
+	for i, exporter := range s.exporters {
+		if exporter.Name() == e.Name() {
+			s.exporters[i] = e
+			return
+		}
+	}
+
+	s.exporters = append(s.exporters, e)
+}
+
+// RequestExport starts building an export of the user's data in the background. Once ready, a link to download
+// the export is sent to the user by email, which is valid for config.Auth.DataExportValidity. Requests are ignored
+// while another export of the user is being built or within config.Auth.DataExportDebounceDuration.
+func (s *Service) RequestExport(ctx context.Context, user dto.User) error {
+	log := util.LogFromContext(ctx).With().Str("userID", user.ID).Logger()
+
+	if !user.Username.Valid {
+		log.Debug().Msg("User is missing email address, rejecting data export")
+		return httperrors.ErrForbiddenUserMissingEmail
+	}
+
+	if _, err := s.deleteExpiredExports(ctx, models.DataExportWhere.UserID.EQ(user.ID)); err != nil {
+		return err
+	}
+
+	now := s.clock.Now()
+	export := models.DataExport{
+		UserID:     user.ID,
+		ValidUntil: now.Add(s.config.Auth.DataExportValidity),
+	}
+
+	var debounced bool
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// concurrent requests of the user are serialized by locking the user
+		if _, err := models.Users(
+			qm.Select(models.UserColumns.ID),
+			models.UserWhere.ID.EQ(user.ID),
+			qm.For("UPDATE"),
+		).One(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to lock user")
+			return err
+		}
+
+		pendingExists, err := models.DataExports(append(
+			db.CombineWithOr([]qm.QueryMod{
+				models.DataExportWhere.CompletedAt.IsNull(),
+				models.DataExportWhere.CreatedAt.GT(now.Add(-s.config.Auth.DataExportDebounceDuration)),
+			}),
+			models.DataExportWhere.UserID.EQ(user.ID),
+		)...).Exists(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to check for pending data exports")
+			return err
+		}
+
+		if pendingExists {
+			debounced = true
+			return nil
+		}
+
+		if err := export.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert data export")
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// the pending export is sent to the user once ready, there is no need to build another one
+	if debounced {
+		log.Debug().Msg("Data export is being built or was requested within debounce time, not starting new one")
+		return nil
+	}
+
+	// the export outlives the request, only keep its values (e.g. the logger)
+	buildCtx := context.WithoutCancel(ctx)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.buildExport(buildCtx, &export, user.Username.String)
+	}()
+
+	return nil
+}
+
+// GetExport returns the archive of the completed export identified by token.
+func (s *Service) GetExport(ctx context.Context, token string) (dto.DataExportFile, error) {
+	log := util.LogFromContext(ctx)
+
+	export, err := models.DataExports(
+		models.DataExportWhere.Token.EQ(token),
+		models.DataExportWhere.CompletedAt.IsNotNull(),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("Data export not found")
+			return dto.DataExportFile{}, httperrors.ErrNotFoundTokenNotFound
+		}
+
+		log.Err(err).Msg("Failed to load data export")
+		return dto.DataExportFile{}, err
+	}
+
+	if export.ValidUntil.Before(s.clock.Now()) {
+		log.Debug().Time("validUntil", export.ValidUntil).Msg("Data export has expired")
+		return dto.DataExportFile{}, httperrors.ErrConflictTokenExpired
+	}
+
+	file, err := os.Open(s.exportPath(export.ID))
+	if err != nil {
+		// the archive is removed before the user is deleted, which might have been rolled back
+		if errors.Is(err, os.ErrNotExist) {
+			log.Debug().Err(err).Str("exportID", export.ID).Msg("Data export archive not found")
+			return dto.DataExportFile{}, httperrors.ErrNotFoundTokenNotFound
+		}
+
+		log.Err(err).Str("exportID", export.ID).Msg("Failed to open data export archive")
+		return dto.DataExportFile{}, fmt.Errorf("failed to open data export archive: %w", err)
+	}
+
+	return dto.DataExportFile{
+		FileName: fmt.Sprintf("data-export-%s.zip", export.CreatedAt.UTC().Format("2006-01-02")),
+		Reader:   file,
+	}, nil
+}
+
+// Wait blocks until all exports currently being built are done or ctx is done.
+func (s *Service) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for data exports: %w", ctx.Err())
+	}
+}
+
+func (s *Service) buildExport(ctx context.Context, export *models.DataExport, to string) {
+	log := util.LogFromContext(ctx).With().Str("userID", export.UserID).Str("exportID", export.ID).Logger()
+
+	path := s.exportPath(export.ID)
+
+	if err := s.writeArchive(ctx, path, export.UserID); err != nil {
+		log.Error().Err(err).Msg("Failed to build data export")
+		s.discardExport(ctx, log, export)
+		return
+	}
+
+	export.CompletedAt = null.TimeFrom(s.clock.Now())
+
+	updated, err := export.Update(ctx, s.db, boil.Whitelist(models.DataExportColumns.CompletedAt, models.DataExportColumns.UpdatedAt))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to complete data export")
+		s.discardExport(ctx, log, export)
+		return
+	}
+
+	// the user might have been deleted in the meantime
+	if updated == 0 {
+		log.Debug().Msg("Data export was deleted while being built, discarding archive")
+		s.discardExport(ctx, log, export)
+		return
+	}
+
+	link, err := url.DataExportDownloadURL(s.config, export.Token)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to generate data export download link")
+		return
+	}
+
+	if err := s.mailer.SendDataExport(ctx, to, dto.DataExportNotificationPayload{
+		Link:       link.String(),
+		ValidUntil: export.ValidUntil,
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to send data export email")
+		return
+	}
+
+	log.Debug().Msg("Successfully built data export")
+}
+
+func (s *Service) writeArchive(ctx context.Context, path string, userID string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data exports directory: %w", err)
+	}
+
+	// only move the archive to its final path once complete
+	tmpPath := path + ".tmp"
+
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create data export archive: %w", err)
+	}
+	defer os.Remove(tmpPath)
+
+	if err := s.writeExporters(ctx, file, userID); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close data export archive: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to move data export archive: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) writeExporters(ctx context.Context, file *os.File, userID string) error {
+	archive := zip.NewWriter(file)
+
+	for _, exporter := range s.exporters {
+		data, err := exporter.Export(ctx, s.db, userID)
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", exporter.Name(), err)
+		}
+
+		w, err := archive.Create(exporter.Name() + ".json")
+		if err != nil {
+			return fmt.Errorf("failed to add %s to data export archive: %w", exporter.Name(), err)
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(data); err != nil {
+			return fmt.Errorf("failed to encode %s: %w", exporter.Name(), err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to finalize data export archive: %w", err)
+	}
+
+	return nil
+}
+
+// Purge deletes all expired exports along with their archives, as well as archives left behind without an export
+// (e.g. by users deleted while their export was being built), returning the number of exports deleted.
+func (s *Service) Purge(ctx context.Context) (int64, error) {
+	purged, err := s.deleteExpiredExports(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.removeOrphanedArchives(ctx); err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// DeleteUserArchives removes the archives of all exports of the user. It has to be called before deleting the user,
+// as the exports themselves are deleted by cascade.
+func DeleteUserArchives(ctx context.Context, exec boil.ContextExecutor, cfg config.Server, userID string) error {
+	log := util.LogFromContext(ctx).With().Str("userID", userID).Logger()
+
+	exports, err := models.DataExports(
+		qm.Select(models.DataExportColumns.ID),
+		models.DataExportWhere.UserID.EQ(userID),
+	).All(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to load data exports")
+		return err
+	}
+
+	for _, export := range exports {
+		if err := os.Remove(archivePath(cfg, export.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Err(err).Str("exportID", export.ID).Msg("Failed to remove data export archive")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteExpiredExports removes the expired exports matching mods along with their archives.
+func (s *Service) deleteExpiredExports(ctx context.Context, mods ...qm.QueryMod) (int64, error) {
+	log := util.LogFromContext(ctx)
+
+	expired, err := models.DataExports(append(mods,
+		models.DataExportWhere.ValidUntil.LT(s.clock.Now()),
+	)...).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load expired data exports")
+		return 0, err
+	}
+
+	for _, export := range expired {
+		if err := os.Remove(s.exportPath(export.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Err(err).Str("exportID", export.ID).Msg("Failed to remove expired data export archive")
+			return 0, err
+		}
+	}
+
+	deleted, err := expired.DeleteAll(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to delete expired data exports")
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// removeOrphanedArchives removes the archives within the exports directory whose export does not exist anymore.
+// Archives still being built are only moved to their final path once their export has been completed.
+func (s *Service) removeOrphanedArchives(ctx context.Context) error {
+	log := util.LogFromContext(ctx)
+
+	entries, err := os.ReadDir(filepath.Join(s.config.Paths.MntBaseDirAbs, exportsDir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		log.Err(err).Msg("Failed to read data exports directory")
+		return err
+	}
+
+	archives := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		// ignores unfinished archives and files not created by exports
+		exportID, ok := strings.CutSuffix(entry.Name(), archiveExt)
+		if !ok || !entry.Type().IsRegular() {
+			continue
+		}
+
+		if _, err := uuid.Parse(exportID); err != nil {
+			continue
+		}
+
+		archives[exportID] = struct{}{}
+	}
+
+	if len(archives) == 0 {
+		return nil
+	}
+
+	exports, err := models.DataExports(
+		qm.Select(models.DataExportColumns.ID),
+		models.DataExportWhere.ID.IN(slices.Collect(maps.Keys(archives))),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load data exports of archives")
+		return err
+	}
+
+	for _, export := range exports {
+		delete(archives, export.ID)
+	}
+
+	for exportID := range archives {
+		if err := os.Remove(s.exportPath(exportID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Err(err).Str("exportID", exportID).Msg("Failed to remove orphaned data export archive")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discardExport removes an export which could not be completed along with its archive.
+func (s *Service) discardExport(ctx context.Context, log zerolog.Logger, export *models.DataExport) {
+	if err := os.Remove(s.exportPath(export.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Error().Err(err).Msg("Failed to remove data export archive")
+	}
+
+	if _, err := export.Delete(ctx, s.db); err != nil {
+		log.Error().Err(err).Msg("Failed to delete data export")
+	}
+}
+
+func (s *Service) exportPath(exportID string) string {
+	return archivePath(s.config, exportID)
+}
+
+func archivePath(cfg config.Server, exportID string) string {
+	return filepath.Join(cfg.Paths.MntBaseDirAbs, exportsDir, exportID+archiveExt)
+}
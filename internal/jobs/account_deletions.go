@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/url"
+)
+
+// PurgeAccountDeletions reminds users of their upcoming account deletion and deletes all accounts whose
+// grace period has passed. It is meant to be run periodically, e.g. using `app jobs purge-accounts`.
+func PurgeAccountDeletions(ctx context.Context, s *api.Server) error {
+	log := util.LogFromContext(ctx)
+
+	deletions, err := s.Auth.ClaimAccountDeletionReminders(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to claim account deletion reminders")
+		return err
+	}
+
+	for _, deletion := range deletions {
+		// reminders are claimed once, failing to send a single one must not prevent purging
+		if err := sendAccountDeletionReminder(ctx, s, deletion); err != nil {
+			log.Error().Err(err).Str("userID", deletion.UserID).Msg("Failed to send account deletion reminder")
+		}
+	}
+
+	purged, err := s.Auth.PurgeAccountDeletions(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge account deletions")
+		return err
+	}
+
+	log.Info().Int("remindedCount", len(deletions)).Int64("purgedCount", purged).Msg("Successfully processed account deletions")
+
+	return nil
+}
+
+func sendAccountDeletionReminder(ctx context.Context, s *api.Server, deletion dto.AccountDeletion) error {
+	if !deletion.Email.Valid {
+		return nil
+	}
+
+	cancelLink, err := url.AccountDeletionCancelDeeplinkURL(s.Config, deletion.CancelToken)
+	if err != nil {
+		return err
+	}
+
+	return s.Mailer.SendAccountDeletionReminder(ctx, deletion.Email.String, dto.AccountDeletionNotificationPayload{
+		Link:        cancelLink.String(),
+		ScheduledAt: deletion.ScheduledAt,
+	})
+}
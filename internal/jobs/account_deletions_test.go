@@ -0,0 +1,105 @@
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scheduleDeletion(t *testing.T, s *api.Server, user *models.User, scheduledAt time.Time, cancelToken string) {
+	t.Helper()
+
+	user.IsActive = false
+	user.DeletionScheduledAt = null.TimeFrom(scheduledAt)
+	user.DeletionCancelToken = null.StringFrom(cancelToken)
+
+	_, err := user.Update(t.Context(), s.DB, boil.Whitelist(
+		models.UserColumns.IsActive,
+		models.UserColumns.DeletionScheduledAt,
+		models.UserColumns.DeletionCancelToken,
+	))
+	require.NoError(t, err)
+}
+
+func TestPurgeAccountDeletions(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := s.Clock.Now()
+		scheduleDeletion(t, s, fix.User1, now.Add(s.Config.Auth.AccountDeletionReminderBefore-time.Hour), "6b1f2a3c-4d5e-4f60-8a7b-9c0d1e2f3a4b")
+		scheduleDeletion(t, s, fix.User2, now.Add(-time.Second), "7c2a3b4d-5e6f-4a71-9b8c-0d1e2f3a4b5c")
+
+		err := jobs.PurgeAccountDeletions(ctx, s)
+		require.NoError(t, err)
+
+		// expect the due account to be purged
+		exists, err := models.UserExists(ctx, s.DB, fix.User2.ID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		deleted, err := models.AuditEvents(
+			models.AuditEventWhere.SubjectID.EQ(null.StringFrom(fix.User2.ID)),
+			models.AuditEventWhere.EventType.EQ(audit.EventTypeAccountDeleted.String()),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, deleted.ActorID.Valid)
+		assert.JSONEq(t, `{"purged": true}`, string(deleted.Metadata))
+
+		// expect a reminder for the upcoming deletion
+		user, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.True(t, user.DeletionReminderSentAt.Valid)
+
+		mails := test.GetSentMails(t, s.Mailer)
+		require.Len(t, mails, 1)
+		assert.Equal(t, fix.User1.Username.String, mails[0].To[0])
+		assert.Equal(t, "Your account will be deleted soon", mails[0].Subject)
+		assert.Contains(t, string(mails[0].HTML), user.DeletionCancelToken.String)
+
+		// reminders are only sent once
+		err = jobs.PurgeAccountDeletions(ctx, s)
+		require.NoError(t, err)
+		assert.Len(t, test.GetSentMails(t, s.Mailer), 1)
+
+		test.SetMockClock(t, s, user.DeletionScheduledAt.Time)
+
+		err = jobs.PurgeAccountDeletions(ctx, s)
+		require.NoError(t, err)
+
+		exists, err = models.UserExists(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPurgeAccountDeletionsReactivated(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		scheduleDeletion(t, s, fix.User1, s.Clock.Now().Add(-time.Second), "8d3b4c5e-6f7a-4b82-8c9d-1e2f3a4b5c6d")
+
+		// accounts reactivated by administrators are kept
+		fix.User1.IsActive = true
+		_, err := fix.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive))
+		require.NoError(t, err)
+
+		err = jobs.PurgeAccountDeletions(ctx, s)
+		require.NoError(t, err)
+
+		exists, err := models.UserExists(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// PurgeAuditEvents deletes all audit events older than config.Audit.Retention. It is meant to be run
+// periodically, e.g. using `app jobs purge-audit-events`.
+func PurgeAuditEvents(ctx context.Context, s *api.Server) error {
+	log := util.LogFromContext(ctx)
+
+	purged, err := s.Audit.Purge(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge audit events")
+		return err
+	}
+
+	log.Info().Int64("purgedCount", purged).Msg("Successfully purged audit events")
+
+	return nil
+}
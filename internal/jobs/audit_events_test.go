@@ -0,0 +1,72 @@
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/audit"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeAuditEvents(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := s.Clock.Now()
+		for _, recordedAt := range []time.Time{
+			now.Add(-s.Config.Audit.Retention - time.Hour),
+			now.Add(-s.Config.Audit.Retention + time.Hour),
+		} {
+			test.SetMockClock(t, s, recordedAt)
+
+			err := s.Audit.Record(ctx, s.DB, audit.Event{
+				Type:      audit.EventTypeLogin,
+				ActorID:   null.StringFrom(fix.User1.ID),
+				SubjectID: null.StringFrom(fix.User1.ID),
+			})
+			require.NoError(t, err)
+		}
+
+		test.SetMockClock(t, s, now)
+
+		err := jobs.PurgeAuditEvents(ctx, s)
+		require.NoError(t, err)
+
+		events, err := models.AuditEvents().All(ctx, s.DB)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.True(t, events[0].CreatedAt.After(now.Add(-s.Config.Audit.Retention)))
+	})
+}
+
+func TestPurgeAuditEventsRetentionDisabled(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Audit.Retention = 0
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+
+		test.SetMockClock(t, s, s.Clock.Now().AddDate(-10, 0, 0))
+
+		err := s.Audit.Record(ctx, s.DB, audit.Event{Type: audit.EventTypeLoginFailed})
+		require.NoError(t, err)
+
+		test.SetMockClock(t, s, time.Now())
+
+		err = jobs.PurgeAuditEvents(ctx, s)
+		require.NoError(t, err)
+
+		count, err := models.AuditEvents().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+}
@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// PurgeDataExports deletes all expired data exports along with their archives, as well as orphaned archives. It is
+// meant to be run periodically, e.g. using `app jobs purge-data-exports`.
+func PurgeDataExports(ctx context.Context, s *api.Server) error {
+	log := util.LogFromContext(ctx)
+
+	purged, err := s.Export.Purge(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge data exports")
+		return err
+	}
+
+	log.Info().Int64("purgedCount", purged).Msg("Successfully purged data exports")
+
+	return nil
+}
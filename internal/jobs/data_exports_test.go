@@ -0,0 +1,88 @@
+package jobs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func insertDataExport(t *testing.T, s *api.Server, user *models.User, validUntil time.Time) string {
+	t.Helper()
+
+	export := models.DataExport{
+		UserID:      user.ID,
+		ValidUntil:  validUntil,
+		CompletedAt: null.TimeFrom(s.Clock.Now()),
+	}
+	require.NoError(t, export.Insert(t.Context(), s.DB, boil.Infer()))
+
+	return writeDataExportArchive(t, s, export.ID)
+}
+
+func writeDataExportArchive(t *testing.T, s *api.Server, exportID string) string {
+	t.Helper()
+
+	path := filepath.Join(s.Config.Paths.MntBaseDirAbs, "exports", exportID+".zip")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte("archive"), 0600))
+
+	return path
+}
+
+func TestPurgeDataExports(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Paths.MntBaseDirAbs = t.TempDir()
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := s.Clock.Now()
+		expired := insertDataExport(t, s, fix.User1, now.Add(-time.Second))
+		valid := insertDataExport(t, s, fix.User2, now.Add(time.Hour))
+		orphaned := writeDataExportArchive(t, s, "9f1c2d3e-4a5b-4c6d-8e7f-0a1b2c3d4e5f")
+		unrelated := writeDataExportArchive(t, s, "not-an-export")
+
+		err := jobs.PurgeDataExports(ctx, s)
+		require.NoError(t, err)
+
+		exports, err := models.DataExports().All(ctx, s.DB)
+		require.NoError(t, err)
+		require.Len(t, exports, 1)
+		assert.Equal(t, fix.User2.ID, exports[0].UserID)
+
+		assert.NoFileExists(t, expired)
+		assert.FileExists(t, valid)
+		assert.NoFileExists(t, orphaned)
+		assert.FileExists(t, unrelated)
+	})
+}
+
+func TestPurgeAccountDeletionsRemovesDataExports(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Paths.MntBaseDirAbs = t.TempDir()
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		archive := insertDataExport(t, s, fix.User2, s.Clock.Now().Add(time.Hour))
+		scheduleDeletion(t, s, fix.User2, s.Clock.Now().Add(-time.Second), "7c2a3b4d-5e6f-4a71-9b8c-0d1e2f3a4b5c")
+
+		err := jobs.PurgeAccountDeletions(t.Context(), s)
+		require.NoError(t, err)
+
+		assert.NoFileExists(t, archive)
+	})
+}
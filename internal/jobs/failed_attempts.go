@@ -0,0 +1,25 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// PurgeFailedAttempts deletes all failed login, password reset and magic link attempts which are not locked and
+// older than config.Auth.LockoutCooldownDuration. It is meant to be run periodically, e.g. using
+// `app jobs purge-failed-attempts`.
+func PurgeFailedAttempts(ctx context.Context, s *api.Server) error {
+	log := util.LogFromContext(ctx)
+
+	purged, err := s.Auth.PurgeFailedAttempts(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge failed attempts")
+		return err
+	}
+
+	log.Info().Int64("purgedCount", purged).Msg("Successfully purged failed attempts")
+
+	return nil
+}
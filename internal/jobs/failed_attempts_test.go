@@ -0,0 +1,52 @@
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeFailedAttempts(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+
+		now := s.Clock.Now()
+		expired := now.Add(-s.Config.Auth.LockoutCooldownDuration - time.Minute)
+
+		attempts := []models.AuthFailedAttempt{
+			// no failed attempt within the cooldown duration
+			{Scope: models.AuthAttemptScopeLoginIP, Key: "192.0.2.1", FailedCount: 2, LastFailedAt: expired},
+			// lock has expired as well
+			{Scope: models.AuthAttemptScopeLoginIP, Key: "192.0.2.2", LockoutCount: 1, LastFailedAt: expired, LockedUntil: null.TimeFrom(now.Add(-time.Minute))},
+			// failed attempt within the cooldown duration
+			{Scope: models.AuthAttemptScopeLoginIP, Key: "192.0.2.3", FailedCount: 2, LastFailedAt: now.Add(-time.Minute)},
+			// still locked
+			{Scope: models.AuthAttemptScopeLoginIP, Key: "192.0.2.4", LockoutCount: 3, LastFailedAt: expired, LockedUntil: null.TimeFrom(now.Add(time.Hour))},
+		}
+
+		for i := range attempts {
+			err := attempts[i].Insert(ctx, s.DB, boil.Infer())
+			require.NoError(t, err)
+		}
+
+		err := jobs.PurgeFailedAttempts(ctx, s)
+		require.NoError(t, err)
+
+		remaining, err := models.AuthFailedAttempts().All(ctx, s.DB)
+		require.NoError(t, err)
+
+		keys := make([]string, 0, len(remaining))
+		for _, attempt := range remaining {
+			keys = append(keys, attempt.Key)
+		}
+		assert.ElementsMatch(t, []string{"192.0.2.3", "192.0.2.4"}, keys)
+	})
+}
@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// PurgePushDeliveries deletes all push deliveries completed longer than config.Push.Outbox.Retention ago. It is meant
+// to be run periodically, e.g. using `app jobs purge-push-deliveries`.
+func PurgePushDeliveries(ctx context.Context, s *api.Server) error {
+	log := util.LogFromContext(ctx)
+
+	purged, err := s.Push.Purge(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge push deliveries")
+		return err
+	}
+
+	log.Info().Int64("purgedCount", purged).Msg("Successfully purged push deliveries")
+
+	return nil
+}
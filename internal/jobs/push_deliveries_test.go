@@ -0,0 +1,53 @@
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgePushDeliveries(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		sent, err := s.Push.EnqueueToUser(ctx, s.DB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.NotEmpty(t, sent)
+
+		_, err = s.Push.DrainOutbox(ctx)
+		require.NoError(t, err)
+
+		queued, err := s.Push.EnqueueToUser(ctx, s.DB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.NotEmpty(t, queued)
+
+		// completed deliveries are kept until the retention has passed
+		err = jobs.PurgePushDeliveries(ctx, s)
+		require.NoError(t, err)
+
+		count, err := models.PushDeliveries().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(sent)+len(queued)), count)
+
+		test.SetMockClock(t, s, time.Now().Add(s.Config.Push.Outbox.Retention+time.Hour))
+
+		err = jobs.PurgePushDeliveries(ctx, s)
+		require.NoError(t, err)
+
+		// queued deliveries are never purged
+		deliveries, err := models.PushDeliveries().All(ctx, s.DB)
+		require.NoError(t, err)
+		require.Len(t, deliveries, len(queued))
+		assert.Equal(t, models.PushDeliveryStatusQueued, deliveries[0].Status)
+	})
+}
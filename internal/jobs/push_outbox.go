@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// ProcessPushOutbox sends all push messages and broadcasts currently due. It is meant to be run periodically, e.g.
+// using `app jobs process-push-outbox`, if the outbox worker of the server is disabled.
+func ProcessPushOutbox(ctx context.Context, s *api.Server) error {
+	log := util.LogFromContext(ctx)
+
+	processed, err := s.Push.DrainOutbox(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to process push outbox")
+		return err
+	}
+
+	broadcasts, err := s.Push.ProcessBroadcasts(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to process push broadcasts")
+		return err
+	}
+
+	log.Info().Int("processedCount", processed).Int("broadcastCount", broadcasts).Msg("Successfully processed push outbox")
+
+	return nil
+}
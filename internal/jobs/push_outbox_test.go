@@ -0,0 +1,37 @@
+package jobs_test
+
+import (
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/jobs"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessPushOutbox(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		ids, err := s.Push.EnqueueToUser(ctx, s.DB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.NotEmpty(t, ids)
+
+		err = jobs.ProcessPushOutbox(ctx, s)
+		require.NoError(t, err)
+
+		queued, err := models.PushDeliveries(models.PushDeliveryWhere.Status.EQ(models.PushDeliveryStatusQueued)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), queued)
+
+		sent, err := models.PushDeliveries(models.PushDeliveryWhere.Status.EQ(models.PushDeliveryStatusSent)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(ids)), sent)
+	})
+}
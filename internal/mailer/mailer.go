@@ -3,49 +3,73 @@ package mailer
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"html/template"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/i18n"
 	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
 	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/dropbox/godropbox/time2"
 	"github.com/jordan-wright/email"
 	"github.com/rs/zerolog/log"
 )
 
 var (
 	ErrEmailTemplateNotFound         = errors.New("email template not found")
-	emailTemplatePasswordReset       = "password_reset"       // /app/templates/email/password_reset/**.
-	emailTemplateAccountConfirmation = "account_confirmation" // /app/templates/email/account_confirmation/**
+	ErrNoDatabase                    = errors.New("mailer has no database")
+	emailTemplatePasswordReset       = "password_reset"             // /app/templates/email/password_reset/**.
+	emailTemplateAccountConfirmation = "account_confirmation"       // /app/templates/email/account_confirmation/**
+	emailTemplateMagicLink           = "magic_link"                 // /app/templates/email/magic_link/**
+	emailTemplateEmailChangeConfirm  = "email_change_confirm"       // /app/templates/email/email_change_confirm/**
+	emailTemplateEmailChangeNotify   = "email_change_notify"        // /app/templates/email/email_change_notify/**
+	emailTemplateDataExport          = "data_export"                // /app/templates/email/data_export/**
+	emailTemplateDeletionScheduled   = "account_deletion_scheduled" // /app/templates/email/account_deletion_scheduled/**
+	emailTemplateDeletionReminder    = "account_deletion_reminder"  // /app/templates/email/account_deletion_reminder/**
+	emailTemplateInvite              = "invite"                     // /app/templates/email/invite/**
+	emailTemplateNotification        = "notification"               // /app/templates/email/notification/**
 )
 
 type Mailer struct {
 	Config    config.Mailer
 	Transport transport.MailTransporter
 	Templates map[string]*template.Template
+	// DB is used to look up the notification preferences of recipients, see SendNotification
+	DB *sql.DB
+	// Clock determines the time notification preferences are evaluated at, see SendNotification
+	Clock time2.Clock
+	// I18n localizes the texts of emails sent in the language of the recipient
+	I18n *i18n.Service
 }
 
-func New(config config.Mailer, transport transport.MailTransporter) *Mailer {
+func New(config config.Mailer, transport transport.MailTransporter, db *sql.DB, clock time2.Clock, i18n *i18n.Service) *Mailer {
 	return &Mailer{
 		Config:    config,
 		Transport: transport,
 		Templates: map[string]*template.Template{},
+		DB:        db,
+		Clock:     clock,
+		I18n:      i18n,
 	}
 }
 
-func NewWithConfig(cfg config.Mailer, smtpConfig transport.SMTPMailTransportConfig) (*Mailer, error) {
+func NewWithConfig(cfg config.Mailer, smtpConfig transport.SMTPMailTransportConfig, db *sql.DB, clock time2.Clock, i18n *i18n.Service) (*Mailer, error) {
 	var mailer *Mailer
 
 	switch config.MailerTransporter(cfg.Transporter) {
 	case config.MailerTransporterMock:
 		log.Warn().Msg("Initializing mock mailer")
-		mailer = New(cfg, transport.NewMock())
+		mailer = New(cfg, transport.NewMock(), db, clock, i18n)
 	case config.MailerTransporterSMTP:
-		mailer = New(cfg, transport.NewSMTP(smtpConfig))
+		mailer = New(cfg, transport.NewSMTP(smtpConfig), db, clock, i18n)
 	default:
 		return nil, fmt.Errorf("unsupported mail transporter: %s", cfg.Transporter)
 	}
@@ -160,3 +184,311 @@ func (m *Mailer) SendAccountConfirmation(ctx context.Context, to string, payload
 
 	return nil
 }
+
+func (m *Mailer) SendMagicLink(ctx context.Context, to string, payload dto.MagicLinkNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateMagicLink).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateMagicLink]
+	if !ok {
+		log.Error().Msg("Magic link email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	lang := payload.Language
+	translationData := i18n.Data{
+		"Minutes": strconv.Itoa(int(payload.Validity.Minutes())),
+	}
+
+	data := map[string]interface{}{
+		"lang":      lang.String(),
+		"magicLink": payload.MagicLink,
+		"title":     m.I18n.Translate("Email.MagicLink.Title", lang),
+		"body":      m.I18n.Translate("Email.MagicLink.Body", lang, translationData),
+		"link":      m.I18n.Translate("Email.MagicLink.Link", lang),
+		"ignore":    m.I18n.Translate("Email.MagicLink.Ignore", lang),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute magic link email template")
+		return fmt.Errorf("failed to execute magic link email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = m.I18n.Translate("Email.MagicLink.Subject", lang)
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("magicLink", payload.MagicLink).Msg("Sending has been disabled in mailer config, skipping magic link email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send magic link email")
+		return fmt.Errorf("failed to send magic link email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent magic link email")
+
+	return nil
+}
+
+// SendEmailChangeConfirmation sends the link confirming the email change to the new address.
+func (m *Mailer) SendEmailChangeConfirmation(ctx context.Context, payload dto.EmailChangeNotificationPayload) error {
+	return m.sendEmailChange(ctx, emailTemplateEmailChangeConfirm, payload.NewEmail, "Confirm your new email address", payload)
+}
+
+// SendEmailChangeNotification notifies the previous address about the email change, including the link to revert it.
+func (m *Mailer) SendEmailChangeNotification(ctx context.Context, payload dto.EmailChangeNotificationPayload) error {
+	return m.sendEmailChange(ctx, emailTemplateEmailChangeNotify, payload.OldEmail, "Your email address is being changed", payload)
+}
+
+func (m *Mailer) sendEmailChange(ctx context.Context, templateName string, to string, subject string, payload dto.EmailChangeNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", templateName).Logger()
+
+	tmpl, ok := m.Templates[templateName]
+	if !ok {
+		log.Error().Msg("Email change email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"oldEmail": payload.OldEmail,
+		"newEmail": payload.NewEmail,
+		"link":     payload.Link,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute email change email template")
+		return fmt.Errorf("failed to execute email change email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = subject
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("link", payload.Link).Msg("Sending has been disabled in mailer config, skipping email change email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send email change email")
+		return fmt.Errorf("failed to send email change email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent email change email")
+
+	return nil
+}
+
+// SendDataExport sends the link to download the data export of the user once it is ready.
+func (m *Mailer) SendDataExport(ctx context.Context, to string, payload dto.DataExportNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateDataExport).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateDataExport]
+	if !ok {
+		log.Error().Msg("Data export email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"link":       payload.Link,
+		"validUntil": payload.ValidUntil.UTC().Format(time.RFC1123),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute data export email template")
+		return fmt.Errorf("failed to execute data export email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = "Your data export is ready"
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("link", payload.Link).Msg("Sending has been disabled in mailer config, skipping data export email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send data export email")
+		return fmt.Errorf("failed to send data export email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent data export email")
+
+	return nil
+}
+
+// SendAccountDeletionScheduled confirms the scheduled deletion of the account, including the link to cancel it.
+func (m *Mailer) SendAccountDeletionScheduled(ctx context.Context, to string, payload dto.AccountDeletionNotificationPayload) error {
+	return m.sendAccountDeletion(ctx, emailTemplateDeletionScheduled, to, "Your account is scheduled for deletion", payload)
+}
+
+// SendAccountDeletionReminder reminds of the upcoming deletion of the account, including the link to cancel it.
+func (m *Mailer) SendAccountDeletionReminder(ctx context.Context, to string, payload dto.AccountDeletionNotificationPayload) error {
+	return m.sendAccountDeletion(ctx, emailTemplateDeletionReminder, to, "Your account will be deleted soon", payload)
+}
+
+func (m *Mailer) sendAccountDeletion(ctx context.Context, templateName string, to string, subject string, payload dto.AccountDeletionNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", templateName).Logger()
+
+	tmpl, ok := m.Templates[templateName]
+	if !ok {
+		log.Error().Msg("Account deletion email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"link":        payload.Link,
+		"scheduledAt": payload.ScheduledAt.UTC().Format(time.RFC1123),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute account deletion email template")
+		return fmt.Errorf("failed to execute account deletion email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = subject
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("link", payload.Link).Msg("Sending has been disabled in mailer config, skipping account deletion email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send account deletion email")
+		return fmt.Errorf("failed to send account deletion email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent account deletion email")
+
+	return nil
+}
+
+// SendInvite sends an invite to register an account, including the link to accept it.
+func (m *Mailer) SendInvite(ctx context.Context, to string, payload dto.InviteNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateInvite).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateInvite]
+	if !ok {
+		log.Error().Msg("Invite email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"link":       payload.Link,
+		"validUntil": payload.ValidUntil.UTC().Format(time.RFC1123),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute invite email template")
+		return fmt.Errorf("failed to execute invite email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = "You have been invited"
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("link", payload.Link).Msg("Sending has been disabled in mailer config, skipping invite email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send invite email")
+		return fmt.Errorf("failed to send invite email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent invite email")
+
+	return nil
+}
+
+// SendNotification sends the notification of the category to the user, unless the user has disabled emails of the
+// category. Emails are not deferred during quiet hours, unlike push notifications. Fails with ErrNoDatabase if the
+// mailer was created without a database to look up the preferences.
+func (m *Mailer) SendNotification(ctx context.Context, user dto.User, category notification.Category, payload dto.NotificationMailPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateNotification).Str("userID", user.ID).Str("category", category.String()).Logger()
+
+	if m.DB == nil {
+		log.Error().Msg("Mailer has no database to look up notification preferences")
+		return ErrNoDatabase
+	}
+
+	decision, err := notification.Decide(ctx, m.DB, user.ID, category, notification.ChannelEmail, m.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	if !decision.Allowed {
+		log.Debug().Msg("User disabled emails of category, skipping notification email")
+		return nil
+	}
+
+	if !user.Username.Valid {
+		log.Debug().Msg("User has no email address, skipping notification email")
+		return nil
+	}
+
+	tmpl, ok := m.Templates[emailTemplateNotification]
+	if !ok {
+		log.Error().Msg("Notification email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"subject": payload.Subject,
+		"body":    payload.Body,
+		"link":    payload.Link.String,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute notification email template")
+		return fmt.Errorf("failed to execute notification email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{user.Username.String}
+	mail.Subject = payload.Subject
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", user.Username.String).Msg("Sending has been disabled in mailer config, skipping notification email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send notification email")
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent notification email")
+
+	return nil
+}
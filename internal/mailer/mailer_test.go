@@ -1,13 +1,22 @@
 package mailer_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/mailer"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
 func TestMailerSendPasswordReset(t *testing.T) {
@@ -36,3 +45,238 @@ func TestMailerSendPasswordReset(t *testing.T) {
 	assert.Equal(t, "Password reset", mail.Subject)
 	assert.Contains(t, string(mail.HTML), passwordResetLink)
 }
+
+func TestMailerSendMagicLink(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(2)
+
+	//nolint:gosec
+	magicLink := "http://localhost/magic-link?token=12345"
+
+	err := mailer.SendMagicLink(ctx, fix.User1.Username.String, dto.MagicLinkNotificationPayload{
+		MagicLink: magicLink,
+		Validity:  15 * time.Minute,
+		Language:  language.English,
+	})
+	require.NoError(t, err)
+
+	err = mailer.SendMagicLink(ctx, fix.User1.Username.String, dto.MagicLinkNotificationPayload{
+		MagicLink: magicLink,
+		Validity:  15 * time.Minute,
+		Language:  language.German,
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mails := mailTransport.GetSentMails()
+	require.Len(t, mails, 2)
+
+	assert.Equal(t, fix.User1.Username.String, mails[0].To[0])
+	assert.Equal(t, "Your login link", mails[0].Subject)
+	assert.Contains(t, string(mails[0].HTML), magicLink)
+	assert.Contains(t, string(mails[0].HTML), "expires in 15 minutes")
+
+	assert.Equal(t, "Dein Login-Link", mails[1].Subject)
+	assert.Contains(t, string(mails[1].HTML), magicLink)
+	assert.Contains(t, string(mails[1].HTML), `<html lang="de">`)
+}
+
+func TestMailerSendEmailChange(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(2)
+
+	newEmail := "user1-new@example.com"
+
+	//nolint:gosec
+	confirmationLink := "http://localhost/confirm-email-change?token=12345"
+	//nolint:gosec
+	revertLink := "http://localhost/revert-email-change?token=67890"
+
+	err := mailer.SendEmailChangeConfirmation(ctx, dto.EmailChangeNotificationPayload{
+		OldEmail: fix.User1.Username.String,
+		NewEmail: newEmail,
+		Link:     confirmationLink,
+	})
+	require.NoError(t, err)
+
+	err = mailer.SendEmailChangeNotification(ctx, dto.EmailChangeNotificationPayload{
+		OldEmail: fix.User1.Username.String,
+		NewEmail: newEmail,
+		Link:     revertLink,
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mails := mailTransport.GetSentMails()
+	require.Len(t, mails, 2)
+
+	assert.Equal(t, newEmail, mails[0].To[0])
+	assert.Equal(t, "Confirm your new email address", mails[0].Subject)
+	assert.Contains(t, string(mails[0].HTML), confirmationLink)
+
+	assert.Equal(t, fix.User1.Username.String, mails[1].To[0])
+	assert.Equal(t, "Your email address is being changed", mails[1].Subject)
+	assert.Contains(t, string(mails[1].HTML), revertLink)
+	assert.Contains(t, string(mails[1].HTML), newEmail)
+}
+
+func TestMailerSendDataExport(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(1)
+
+	//nolint:gosec
+	downloadLink := "http://localhost/api/v1/auth/account/export/download?token=12345"
+	err := mailer.SendDataExport(ctx, fix.User1.Username.String, dto.DataExportNotificationPayload{
+		Link:       downloadLink,
+		ValidUntil: time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mail := mailTransport.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, fix.User1.Username.String, mail.To[0])
+	assert.Equal(t, "Your data export is ready", mail.Subject)
+	assert.Contains(t, string(mail.HTML), downloadLink)
+	assert.Contains(t, string(mail.HTML), "Tue, 20 Oct 2026 12:00:00 UTC")
+}
+
+func TestMailerSendAccountDeletion(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(2)
+
+	//nolint:gosec
+	cancelLink := "http://localhost/cancel-account-deletion?token=12345"
+	payload := dto.AccountDeletionNotificationPayload{
+		Link:        cancelLink,
+		ScheduledAt: time.Date(2026, 11, 17, 12, 0, 0, 0, time.UTC),
+	}
+
+	err := mailer.SendAccountDeletionScheduled(ctx, fix.User1.Username.String, payload)
+	require.NoError(t, err)
+
+	err = mailer.SendAccountDeletionReminder(ctx, fix.User1.Username.String, payload)
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mails := mailTransport.GetSentMails()
+	require.Len(t, mails, 2)
+	assert.Equal(t, "Your account is scheduled for deletion", mails[0].Subject)
+	assert.Equal(t, "Your account will be deleted soon", mails[1].Subject)
+
+	for _, mail := range mails {
+		assert.Equal(t, fix.User1.Username.String, mail.To[0])
+		assert.Contains(t, string(mail.HTML), cancelLink)
+		assert.Contains(t, string(mail.HTML), "Tue, 17 Nov 2026 12:00:00 UTC")
+	}
+}
+
+func TestMailerSendInvite(t *testing.T) {
+	ctx := t.Context()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(1)
+
+	//nolint:gosec
+	inviteLink := "http://localhost/accept-invite?token=12345"
+	err := mailer.SendInvite(ctx, "invited@example.com", dto.InviteNotificationPayload{
+		Link:       inviteLink,
+		ValidUntil: time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mail := mailTransport.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, "invited@example.com", mail.To[0])
+	assert.Equal(t, "You have been invited", mail.Subject)
+	assert.Contains(t, string(mail.HTML), inviteLink)
+	assert.Contains(t, string(mail.HTML), "Sun, 25 Oct 2026 12:00:00 UTC")
+}
+
+func TestMailerSendNotificationWithoutDB(t *testing.T) {
+	fix := fixtures.Fixtures()
+
+	m := test.NewTestMailer(t)
+
+	err := m.SendNotification(t.Context(), mapper.LocalUserToDTO(fix.User1), notification.CategoryUpdates, dto.NotificationMailPayload{Subject: "Hello"})
+	require.ErrorIs(t, err, mailer.ErrNoDatabase)
+	assert.Empty(t, test.GetSentMails(t, m))
+}
+
+func TestMailerSendNotification(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		user := mapper.LocalUserToDTO(fix.User1)
+
+		mailer := test.NewTestMailer(t)
+		mailer.DB = db
+		mailTransport := test.GetTestMailerMockTransport(t, mailer)
+		mailTransport.Expect(1)
+
+		payload := dto.NotificationMailPayload{
+			Subject: "New features available",
+			Body:    "Check out what's new in the app.",
+			Link:    null.StringFrom("http://localhost/news/42"),
+		}
+
+		// marketing is opt-in
+		err := mailer.SendNotification(ctx, user, notification.CategoryMarketing, payload)
+		require.NoError(t, err)
+
+		preference := models.NotificationPreference{
+			UserID:   fix.User1.ID,
+			Category: notification.CategoryUpdates.String(),
+			Channel:  notification.ChannelEmail.String(),
+			Enabled:  false,
+		}
+		require.NoError(t, preference.Insert(ctx, db, boil.Infer()))
+
+		err = mailer.SendNotification(ctx, user, notification.CategoryUpdates, payload)
+		require.NoError(t, err)
+
+		// disabling push notifications does not affect emails
+		preference.Category = notification.CategoryMarketing.String()
+		preference.Channel = notification.ChannelPush.String()
+		require.NoError(t, preference.Insert(ctx, db, boil.Infer()))
+
+		preference.Channel = notification.ChannelEmail.String()
+		preference.Enabled = true
+		require.NoError(t, preference.Insert(ctx, db, boil.Infer()))
+
+		err = mailer.SendNotification(ctx, user, notification.CategoryMarketing, payload)
+		require.NoError(t, err)
+
+		mailTransport.WaitWithTimeout(time.Second)
+
+		mails := mailTransport.GetSentMails()
+		require.Len(t, mails, 1)
+		assert.Equal(t, []string{fix.User1.Username.String}, mails[0].To)
+		assert.Equal(t, payload.Subject, mails[0].Subject)
+		assert.Contains(t, string(mails[0].HTML), payload.Body)
+		assert.Contains(t, string(mails[0].HTML), payload.Link.String)
+	})
+}
@@ -0,0 +1,41 @@
+package push
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// maxDeliveriesCount caps the number of push deliveries counted per status
+const maxDeliveriesCount = 10000
+
+var countDeliveriesQuery = fmt.Sprintf(
+	"SELECT COUNT(*) FROM (SELECT 1 FROM %s WHERE %s = $1 LIMIT $2) AS capped;",
+	models.TableNames.PushDeliveries,
+	models.PushDeliveryColumns.Status,
+)
+
+type DatabaseMetricsCollector struct {
+	db *sql.DB
+}
+
+func NewDatabaseMetricsCollector(db *sql.DB) *DatabaseMetricsCollector {
+	return &DatabaseMetricsCollector{db: db}
+}
+
+// GetDeliveriesCount counts the deliveries of the status, up to maxDeliveriesCount to keep scrapes cheap should the
+// outbox back up or completed deliveries not be purged.
+func (c DatabaseMetricsCollector) GetDeliveriesCount(ctx context.Context, status string) float64 {
+	log := util.LogFromContext(ctx)
+
+	var count int64
+	if err := c.db.QueryRowContext(ctx, countDeliveriesQuery, status, maxDeliveriesCount).Scan(&count); err != nil {
+		log.Error().Err(err).Str("status", status).Msg("Failed to count push deliveries")
+		return 0
+	}
+
+	return float64(count)
+}
@@ -0,0 +1,34 @@
+package push
+
+import (
+	"context"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type MetricsCollector interface {
+	GetDeliveriesCount(ctx context.Context, status string) float64
+}
+
+const (
+	MetricNamePushDeliveries = "push_deliveries"
+	MetricLabelStatus        = "status"
+)
+
+// Metrics returns a gauge per push delivery status, labeled with the status. Counts are capped by the collector.
+func Metrics(ctx context.Context, collector MetricsCollector) []prometheus.Collector {
+	metrics := make([]prometheus.Collector, 0, len(models.AllPushDeliveryStatus()))
+	for _, status := range models.AllPushDeliveryStatus() {
+		metrics = append(metrics, prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name:        MetricNamePushDeliveries,
+				Help:        "Push deliveries by status",
+				ConstLabels: prometheus.Labels{MetricLabelStatus: status},
+			},
+			func() float64 { return collector.GetDeliveriesCount(ctx, status) },
+		))
+	}
+
+	return metrics
+}
@@ -27,3 +27,18 @@ func (c DatabaseMetricsCollector) GetTotalUsersCount(ctx context.Context) float6
 
 	return float64(count)
 }
+
+func (c DatabaseMetricsCollector) GetPendingDeletionsCount(ctx context.Context) float64 {
+	log := util.LogFromContext(ctx)
+
+	count, err := models.Users(
+		models.UserWhere.IsActive.EQ(false),
+		models.UserWhere.DeletionScheduledAt.IsNotNull(),
+	).Count(ctx, c.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to count pending user deletions")
+		return 0
+	}
+
+	return float64(count)
+}
@@ -8,10 +8,12 @@ import (
 
 type MetricsCollector interface {
 	GetTotalUsersCount(ctx context.Context) float64
+	GetPendingDeletionsCount(ctx context.Context) float64
 }
 
 const (
-	MetricNameTotalUsers = "total_users"
+	MetricNameTotalUsers           = "total_users"
+	MetricNamePendingUserDeletions = "pending_user_deletions"
 )
 
 func Metrics(ctx context.Context, collector MetricsCollector) []prometheus.Collector {
@@ -23,5 +25,12 @@ func Metrics(ctx context.Context, collector MetricsCollector) []prometheus.Colle
 			},
 			func() float64 { return collector.GetTotalUsersCount(ctx) },
 		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: MetricNamePendingUserDeletions,
+				Help: "Users scheduled for deletion",
+			},
+			func() float64 { return collector.GetPendingDeletionsCount(ctx) },
+		),
 	}
 }
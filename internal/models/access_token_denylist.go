@@ -0,0 +1,765 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AccessTokenDenylist is an object representing the database table.
+type AccessTokenDenylist struct {
+	Jti        string    `boil:"jti" json:"jti" toml:"jti" yaml:"jti"`
+	ValidUntil time.Time `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *accessTokenDenylistR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L accessTokenDenylistL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AccessTokenDenylistColumns = struct {
+	Jti        string
+	ValidUntil string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Jti:        "jti",
+	ValidUntil: "valid_until",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var AccessTokenDenylistTableColumns = struct {
+	Jti        string
+	ValidUntil string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Jti:        "access_token_denylist.jti",
+	ValidUntil: "access_token_denylist.valid_until",
+	CreatedAt:  "access_token_denylist.created_at",
+	UpdatedAt:  "access_token_denylist.updated_at",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
+func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AccessTokenDenylistWhere = struct {
+	Jti        whereHelperstring
+	ValidUntil whereHelpertime_Time
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	Jti:        whereHelperstring{field: "\"access_token_denylist\".\"jti\""},
+	ValidUntil: whereHelpertime_Time{field: "\"access_token_denylist\".\"valid_until\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"access_token_denylist\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"access_token_denylist\".\"updated_at\""},
+}
+
+// AccessTokenDenylistRels is where relationship names are stored.
+var AccessTokenDenylistRels = struct {
+}{}
+
+// accessTokenDenylistR is where relationships are stored.
+type accessTokenDenylistR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*accessTokenDenylistR) NewStruct() *accessTokenDenylistR {
+	return &accessTokenDenylistR{}
+}
+
+// accessTokenDenylistL is where Load methods for each relationship are stored.
+type accessTokenDenylistL struct{}
+
+var (
+	accessTokenDenylistAllColumns            = []string{"jti", "valid_until", "created_at", "updated_at"}
+	accessTokenDenylistColumnsWithoutDefault = []string{"jti", "valid_until", "created_at", "updated_at"}
+	accessTokenDenylistColumnsWithDefault    = []string{}
+	accessTokenDenylistPrimaryKeyColumns     = []string{"jti"}
+	accessTokenDenylistGeneratedColumns      = []string{}
+)
+
+type (
+	// AccessTokenDenylistSlice is an alias for a slice of pointers to AccessTokenDenylist.
+	// This should almost always be used instead of []AccessTokenDenylist.
+	AccessTokenDenylistSlice []*AccessTokenDenylist
+
+	accessTokenDenylistQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	accessTokenDenylistType                 = reflect.TypeOf(&AccessTokenDenylist{})
+	accessTokenDenylistMapping              = queries.MakeStructMapping(accessTokenDenylistType)
+	accessTokenDenylistPrimaryKeyMapping, _ = queries.BindMapping(accessTokenDenylistType, accessTokenDenylistMapping, accessTokenDenylistPrimaryKeyColumns)
+	accessTokenDenylistInsertCacheMut       sync.RWMutex
+	accessTokenDenylistInsertCache          = make(map[string]insertCache)
+	accessTokenDenylistUpdateCacheMut       sync.RWMutex
+	accessTokenDenylistUpdateCache          = make(map[string]updateCache)
+	accessTokenDenylistUpsertCacheMut       sync.RWMutex
+	accessTokenDenylistUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single accessTokenDenylist record from the query.
+func (q accessTokenDenylistQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AccessTokenDenylist, error) {
+	o := &AccessTokenDenylist{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for access_token_denylist")
+	}
+
+	return o, nil
+}
+
+// All returns all AccessTokenDenylist records from the query.
+func (q accessTokenDenylistQuery) All(ctx context.Context, exec boil.ContextExecutor) (AccessTokenDenylistSlice, error) {
+	var o []*AccessTokenDenylist
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AccessTokenDenylist slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AccessTokenDenylist records in the query.
+func (q accessTokenDenylistQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count access_token_denylist rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q accessTokenDenylistQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if access_token_denylist exists")
+	}
+
+	return count > 0, nil
+}
+
+// AccessTokenDenylists retrieves all the records using an executor.
+func AccessTokenDenylists(mods ...qm.QueryMod) accessTokenDenylistQuery {
+	mods = append(mods, qm.From("\"access_token_denylist\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"access_token_denylist\".*"})
+	}
+
+	return accessTokenDenylistQuery{q}
+}
+
+// FindAccessTokenDenylist retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAccessTokenDenylist(ctx context.Context, exec boil.ContextExecutor, jti string, selectCols ...string) (*AccessTokenDenylist, error) {
+	accessTokenDenylistObj := &AccessTokenDenylist{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"access_token_denylist\" where \"jti\"=$1", sel,
+	)
+
+	q := queries.Raw(query, jti)
+
+	err := q.Bind(ctx, exec, accessTokenDenylistObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from access_token_denylist")
+	}
+
+	return accessTokenDenylistObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AccessTokenDenylist) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no access_token_denylist provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accessTokenDenylistColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	accessTokenDenylistInsertCacheMut.RLock()
+	cache, cached := accessTokenDenylistInsertCache[key]
+	accessTokenDenylistInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			accessTokenDenylistAllColumns,
+			accessTokenDenylistColumnsWithDefault,
+			accessTokenDenylistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accessTokenDenylistType, accessTokenDenylistMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(accessTokenDenylistType, accessTokenDenylistMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"access_token_denylist\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"access_token_denylist\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into access_token_denylist")
+	}
+
+	if !cached {
+		accessTokenDenylistInsertCacheMut.Lock()
+		accessTokenDenylistInsertCache[key] = cache
+		accessTokenDenylistInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the AccessTokenDenylist.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AccessTokenDenylist) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	accessTokenDenylistUpdateCacheMut.RLock()
+	cache, cached := accessTokenDenylistUpdateCache[key]
+	accessTokenDenylistUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			accessTokenDenylistAllColumns,
+			accessTokenDenylistPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update access_token_denylist, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"access_token_denylist\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, accessTokenDenylistPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(accessTokenDenylistType, accessTokenDenylistMapping, append(wl, accessTokenDenylistPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update access_token_denylist row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for access_token_denylist")
+	}
+
+	if !cached {
+		accessTokenDenylistUpdateCacheMut.Lock()
+		accessTokenDenylistUpdateCache[key] = cache
+		accessTokenDenylistUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q accessTokenDenylistQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for access_token_denylist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for access_token_denylist")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AccessTokenDenylistSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accessTokenDenylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"access_token_denylist\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, accessTokenDenylistPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in accessTokenDenylist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all accessTokenDenylist")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AccessTokenDenylist) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no access_token_denylist provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accessTokenDenylistColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	accessTokenDenylistUpsertCacheMut.RLock()
+	cache, cached := accessTokenDenylistUpsertCache[key]
+	accessTokenDenylistUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			accessTokenDenylistAllColumns,
+			accessTokenDenylistColumnsWithDefault,
+			accessTokenDenylistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			accessTokenDenylistAllColumns,
+			accessTokenDenylistPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert access_token_denylist, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(accessTokenDenylistAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(accessTokenDenylistPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert access_token_denylist, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(accessTokenDenylistPrimaryKeyColumns))
+			copy(conflict, accessTokenDenylistPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"access_token_denylist\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(accessTokenDenylistType, accessTokenDenylistMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(accessTokenDenylistType, accessTokenDenylistMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert access_token_denylist")
+	}
+
+	if !cached {
+		accessTokenDenylistUpsertCacheMut.Lock()
+		accessTokenDenylistUpsertCache[key] = cache
+		accessTokenDenylistUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single AccessTokenDenylist record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AccessTokenDenylist) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AccessTokenDenylist provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), accessTokenDenylistPrimaryKeyMapping)
+	sql := "DELETE FROM \"access_token_denylist\" WHERE \"jti\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from access_token_denylist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for access_token_denylist")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q accessTokenDenylistQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no accessTokenDenylistQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from access_token_denylist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for access_token_denylist")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AccessTokenDenylistSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accessTokenDenylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"access_token_denylist\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accessTokenDenylistPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from accessTokenDenylist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for access_token_denylist")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AccessTokenDenylist) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAccessTokenDenylist(ctx, exec, o.Jti)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccessTokenDenylistSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AccessTokenDenylistSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accessTokenDenylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"access_token_denylist\".* FROM \"access_token_denylist\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accessTokenDenylistPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AccessTokenDenylistSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AccessTokenDenylistExists checks if the AccessTokenDenylist row exists.
+func AccessTokenDenylistExists(ctx context.Context, exec boil.ContextExecutor, jti string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"access_token_denylist\" where \"jti\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, jti)
+	}
+	row := exec.QueryRowContext(ctx, sql, jti)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if access_token_denylist exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AccessTokenDenylist row exists.
+func (o *AccessTokenDenylist) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AccessTokenDenylistExists(ctx, exec, o.Jti)
+}
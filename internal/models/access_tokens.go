@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,70 +24,102 @@ import (
 
 // AccessToken is an object representing the database table.
 type AccessToken struct {
-	Token      string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	ValidUntil time.Time `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
-	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Token          string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	ValidUntil     time.Time   `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	UserID         string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SessionID      null.String `boil:"session_id" json:"session_id,omitempty" toml:"session_id" yaml:"session_id,omitempty"`
+	ImpersonatorID null.String `boil:"impersonator_id" json:"impersonator_id,omitempty" toml:"impersonator_id" yaml:"impersonator_id,omitempty"`
 
 	R *accessTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accessTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccessTokenColumns = struct {
-	Token      string
-	ValidUntil string
-	UserID     string
-	CreatedAt  string
-	UpdatedAt  string
+	Token          string
+	ValidUntil     string
+	UserID         string
+	CreatedAt      string
+	UpdatedAt      string
+	SessionID      string
+	ImpersonatorID string
 }{
-	Token:      "token",
-	ValidUntil: "valid_until",
-	UserID:     "user_id",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
+	Token:          "token",
+	ValidUntil:     "valid_until",
+	UserID:         "user_id",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	SessionID:      "session_id",
+	ImpersonatorID: "impersonator_id",
 }
 
 var AccessTokenTableColumns = struct {
-	Token      string
-	ValidUntil string
-	UserID     string
-	CreatedAt  string
-	UpdatedAt  string
+	Token          string
+	ValidUntil     string
+	UserID         string
+	CreatedAt      string
+	UpdatedAt      string
+	SessionID      string
+	ImpersonatorID string
 }{
-	Token:      "access_tokens.token",
-	ValidUntil: "access_tokens.valid_until",
-	UserID:     "access_tokens.user_id",
-	CreatedAt:  "access_tokens.created_at",
-	UpdatedAt:  "access_tokens.updated_at",
+	Token:          "access_tokens.token",
+	ValidUntil:     "access_tokens.valid_until",
+	UserID:         "access_tokens.user_id",
+	CreatedAt:      "access_tokens.created_at",
+	UpdatedAt:      "access_tokens.updated_at",
+	SessionID:      "access_tokens.session_id",
+	ImpersonatorID: "access_tokens.impersonator_id",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
-func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
 	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
 }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -94,51 +127,40 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var AccessTokenWhere = struct {
-	Token      whereHelperstring
-	ValidUntil whereHelpertime_Time
-	UserID     whereHelperstring
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpertime_Time
+	Token          whereHelperstring
+	ValidUntil     whereHelpertime_Time
+	UserID         whereHelperstring
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	SessionID      whereHelpernull_String
+	ImpersonatorID whereHelpernull_String
 }{
-	Token:      whereHelperstring{field: "\"access_tokens\".\"token\""},
-	ValidUntil: whereHelpertime_Time{field: "\"access_tokens\".\"valid_until\""},
-	UserID:     whereHelperstring{field: "\"access_tokens\".\"user_id\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"access_tokens\".\"created_at\""},
-	UpdatedAt:  whereHelpertime_Time{field: "\"access_tokens\".\"updated_at\""},
+	Token:          whereHelperstring{field: "\"access_tokens\".\"token\""},
+	ValidUntil:     whereHelpertime_Time{field: "\"access_tokens\".\"valid_until\""},
+	UserID:         whereHelperstring{field: "\"access_tokens\".\"user_id\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"access_tokens\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"access_tokens\".\"updated_at\""},
+	SessionID:      whereHelpernull_String{field: "\"access_tokens\".\"session_id\""},
+	ImpersonatorID: whereHelpernull_String{field: "\"access_tokens\".\"impersonator_id\""},
 }
 
 // AccessTokenRels is where relationship names are stored.
 var AccessTokenRels = struct {
-	User string
+	Impersonator string
+	User         string
 }{
-	User: "User",
+	Impersonator: "Impersonator",
+	User:         "User",
 }
 
 // accessTokenR is where relationships are stored.
 type accessTokenR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Impersonator *User `boil:"Impersonator" json:"Impersonator" toml:"Impersonator" yaml:"Impersonator"`
+	User         *User `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -146,6 +168,22 @@ func (*accessTokenR) NewStruct() *accessTokenR {
 	return &accessTokenR{}
 }
 
+func (o *AccessToken) GetImpersonator() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImpersonator()
+}
+
+func (r *accessTokenR) GetImpersonator() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Impersonator
+}
+
 func (o *AccessToken) GetUser() *User {
 	if o == nil {
 		return nil
@@ -166,9 +204,9 @@ func (r *accessTokenR) GetUser() *User {
 type accessTokenL struct{}
 
 var (
-	accessTokenAllColumns            = []string{"token", "valid_until", "user_id", "created_at", "updated_at"}
+	accessTokenAllColumns            = []string{"token", "valid_until", "user_id", "created_at", "updated_at", "session_id", "impersonator_id"}
 	accessTokenColumnsWithoutDefault = []string{"valid_until", "user_id", "created_at", "updated_at"}
-	accessTokenColumnsWithDefault    = []string{"token"}
+	accessTokenColumnsWithDefault    = []string{"token", "session_id", "impersonator_id"}
 	accessTokenPrimaryKeyColumns     = []string{"token"}
 	accessTokenGeneratedColumns      = []string{}
 )
@@ -264,6 +302,17 @@ func (q accessTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
+// Impersonator pointed to by the foreign key.
+func (o *AccessToken) Impersonator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ImpersonatorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *AccessToken) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -275,6 +324,122 @@ func (o *AccessToken) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// LoadImpersonator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accessTokenL) LoadImpersonator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccessToken interface{}, mods queries.Applicator) error {
+	var slice []*AccessToken
+	var object *AccessToken
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccessToken.(*AccessToken)
+		if !ok {
+			object = new(AccessToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccessToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccessToken))
+			}
+		}
+	} else {
+		s, ok := maybeAccessToken.(*[]*AccessToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccessToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccessToken))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accessTokenR{}
+		}
+		if !queries.IsNil(object.ImpersonatorID) {
+			args[object.ImpersonatorID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accessTokenR{}
+			}
+
+			if !queries.IsNil(obj.ImpersonatorID) {
+				args[obj.ImpersonatorID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Impersonator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpersonatorAccessTokens = append(foreign.R.ImpersonatorAccessTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ImpersonatorID, foreign.ID) {
+				local.R.Impersonator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpersonatorAccessTokens = append(foreign.R.ImpersonatorAccessTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (accessTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccessToken interface{}, mods queries.Applicator) error {
@@ -387,6 +552,86 @@ func (accessTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// SetImpersonator of the accessToken to the related item.
+// Sets o.R.Impersonator to related.
+// Adds o to related.R.ImpersonatorAccessTokens.
+func (o *AccessToken) SetImpersonator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"access_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"impersonator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Token}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ImpersonatorID, related.ID)
+	if o.R == nil {
+		o.R = &accessTokenR{
+			Impersonator: related,
+		}
+	} else {
+		o.R.Impersonator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpersonatorAccessTokens: AccessTokenSlice{o},
+		}
+	} else {
+		related.R.ImpersonatorAccessTokens = append(related.R.ImpersonatorAccessTokens, o)
+	}
+
+	return nil
+}
+
+// RemoveImpersonator relationship.
+// Sets o.R.Impersonator to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AccessToken) RemoveImpersonator(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ImpersonatorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("impersonator_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Impersonator = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ImpersonatorAccessTokens {
+		if queries.Equal(o.ImpersonatorID, ri.ImpersonatorID) {
+			continue
+		}
+
+		ln := len(related.R.ImpersonatorAccessTokens)
+		if ln > 1 && i < ln-1 {
+			related.R.ImpersonatorAccessTokens[i] = related.R.ImpersonatorAccessTokens[ln-1]
+		}
+		related.R.ImpersonatorAccessTokens = related.R.ImpersonatorAccessTokens[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the accessToken to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.AccessTokens.
@@ -353,6 +353,58 @@ func testAccessTokensInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testAccessTokenToOneUserUsingImpersonator(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AccessToken
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, accessTokenDBTypes, true, accessTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccessToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ImpersonatorID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Impersonator().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AccessTokenSlice{&local}
+	if err = local.L.LoadImpersonator(ctx, tx, false, (*[]*AccessToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Impersonator == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Impersonator = nil
+	if err = local.L.LoadImpersonator(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Impersonator == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
 func testAccessTokenToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -405,6 +457,115 @@ func testAccessTokenToOneUserUsingUser(t *testing.T) {
 
 }
 
+func testAccessTokenToOneSetOpUserUsingImpersonator(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AccessToken
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetImpersonator(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Impersonator != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ImpersonatorAccessTokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ImpersonatorID, x.ID) {
+			t.Error("foreign key was wrong value", a.ImpersonatorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ImpersonatorID))
+		reflect.Indirect(reflect.ValueOf(&a.ImpersonatorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ImpersonatorID, x.ID) {
+			t.Error("foreign key was wrong value", a.ImpersonatorID, x.ID)
+		}
+	}
+}
+
+func testAccessTokenToOneRemoveOpUserUsingImpersonator(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AccessToken
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetImpersonator(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveImpersonator(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Impersonator().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Impersonator != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ImpersonatorID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ImpersonatorAccessTokens) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testAccessTokenToOneSetOpUserUsingUser(t *testing.T) {
 	var err error
 
@@ -537,7 +698,7 @@ func testAccessTokensSelect(t *testing.T) {
 }
 
 var (
-	accessTokenDBTypes = map[string]string{`Token`: `uuid`, `ValidUntil`: `timestamp with time zone`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	accessTokenDBTypes = map[string]string{`Token`: `uuid`, `ValidUntil`: `timestamp with time zone`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `SessionID`: `uuid`, `ImpersonatorID`: `uuid`}
 	_                  = bytes.MinRead
 )
 
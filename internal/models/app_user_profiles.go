@@ -28,6 +28,9 @@ type AppUserProfile struct {
 	LegalAcceptedAt null.Time `boil:"legal_accepted_at" json:"legal_accepted_at,omitempty" toml:"legal_accepted_at" yaml:"legal_accepted_at,omitempty"`
 	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	TimeZone        string    `boil:"time_zone" json:"time_zone" toml:"time_zone" yaml:"time_zone"`
+	QuietHoursStart null.Int  `boil:"quiet_hours_start" json:"quiet_hours_start,omitempty" toml:"quiet_hours_start" yaml:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   null.Int  `boil:"quiet_hours_end" json:"quiet_hours_end,omitempty" toml:"quiet_hours_end" yaml:"quiet_hours_end,omitempty"`
 
 	R *appUserProfileR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L appUserProfileL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,11 +41,17 @@ var AppUserProfileColumns = struct {
 	LegalAcceptedAt string
 	CreatedAt       string
 	UpdatedAt       string
+	TimeZone        string
+	QuietHoursStart string
+	QuietHoursEnd   string
 }{
 	UserID:          "user_id",
 	LegalAcceptedAt: "legal_accepted_at",
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
+	TimeZone:        "time_zone",
+	QuietHoursStart: "quiet_hours_start",
+	QuietHoursEnd:   "quiet_hours_end",
 }
 
 var AppUserProfileTableColumns = struct {
@@ -50,49 +59,75 @@ var AppUserProfileTableColumns = struct {
 	LegalAcceptedAt string
 	CreatedAt       string
 	UpdatedAt       string
+	TimeZone        string
+	QuietHoursStart string
+	QuietHoursEnd   string
 }{
 	UserID:          "app_user_profiles.user_id",
 	LegalAcceptedAt: "app_user_profiles.legal_accepted_at",
 	CreatedAt:       "app_user_profiles.created_at",
 	UpdatedAt:       "app_user_profiles.updated_at",
+	TimeZone:        "app_user_profiles.time_zone",
+	QuietHoursStart: "app_user_profiles.quiet_hours_start",
+	QuietHoursEnd:   "app_user_profiles.quiet_hours_end",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
+type whereHelpernull_Int struct{ field string }
 
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
 
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var AppUserProfileWhere = struct {
 	UserID          whereHelperstring
 	LegalAcceptedAt whereHelpernull_Time
 	CreatedAt       whereHelpertime_Time
 	UpdatedAt       whereHelpertime_Time
+	TimeZone        whereHelperstring
+	QuietHoursStart whereHelpernull_Int
+	QuietHoursEnd   whereHelpernull_Int
 }{
 	UserID:          whereHelperstring{field: "\"app_user_profiles\".\"user_id\""},
 	LegalAcceptedAt: whereHelpernull_Time{field: "\"app_user_profiles\".\"legal_accepted_at\""},
 	CreatedAt:       whereHelpertime_Time{field: "\"app_user_profiles\".\"created_at\""},
 	UpdatedAt:       whereHelpertime_Time{field: "\"app_user_profiles\".\"updated_at\""},
+	TimeZone:        whereHelperstring{field: "\"app_user_profiles\".\"time_zone\""},
+	QuietHoursStart: whereHelpernull_Int{field: "\"app_user_profiles\".\"quiet_hours_start\""},
+	QuietHoursEnd:   whereHelpernull_Int{field: "\"app_user_profiles\".\"quiet_hours_end\""},
 }
 
 // AppUserProfileRels is where relationship names are stored.
@@ -132,9 +167,9 @@ func (r *appUserProfileR) GetUser() *User {
 type appUserProfileL struct{}
 
 var (
-	appUserProfileAllColumns            = []string{"user_id", "legal_accepted_at", "created_at", "updated_at"}
+	appUserProfileAllColumns            = []string{"user_id", "legal_accepted_at", "created_at", "updated_at", "time_zone", "quiet_hours_start", "quiet_hours_end"}
 	appUserProfileColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at"}
-	appUserProfileColumnsWithDefault    = []string{"legal_accepted_at"}
+	appUserProfileColumnsWithDefault    = []string{"legal_accepted_at", "time_zone", "quiet_hours_start", "quiet_hours_end"}
 	appUserProfilePrimaryKeyColumns     = []string{"user_id"}
 	appUserProfileGeneratedColumns      = []string{}
 )
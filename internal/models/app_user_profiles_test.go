@@ -533,7 +533,7 @@ func testAppUserProfilesSelect(t *testing.T) {
 }
 
 var (
-	appUserProfileDBTypes = map[string]string{`UserID`: `uuid`, `LegalAcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	appUserProfileDBTypes = map[string]string{`UserID`: `uuid`, `LegalAcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `TimeZone`: `text`, `QuietHoursStart`: `integer`, `QuietHoursEnd`: `integer`}
 	_                     = bytes.MinRead
 )
 
@@ -0,0 +1,765 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AuthFailedAttempt is an object representing the database table.
+type AuthFailedAttempt struct {
+	Scope        string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	Key          string    `boil:"key" json:"key" toml:"key" yaml:"key"`
+	FailedCount  int       `boil:"failed_count" json:"failed_count" toml:"failed_count" yaml:"failed_count"`
+	LockoutCount int       `boil:"lockout_count" json:"lockout_count" toml:"lockout_count" yaml:"lockout_count"`
+	LastFailedAt time.Time `boil:"last_failed_at" json:"last_failed_at" toml:"last_failed_at" yaml:"last_failed_at"`
+	LockedUntil  null.Time `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *authFailedAttemptR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L authFailedAttemptL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuthFailedAttemptColumns = struct {
+	Scope        string
+	Key          string
+	FailedCount  string
+	LockoutCount string
+	LastFailedAt string
+	LockedUntil  string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	Scope:        "scope",
+	Key:          "key",
+	FailedCount:  "failed_count",
+	LockoutCount: "lockout_count",
+	LastFailedAt: "last_failed_at",
+	LockedUntil:  "locked_until",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var AuthFailedAttemptTableColumns = struct {
+	Scope        string
+	Key          string
+	FailedCount  string
+	LockoutCount string
+	LastFailedAt string
+	LockedUntil  string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	Scope:        "auth_failed_attempts.scope",
+	Key:          "auth_failed_attempts.key",
+	FailedCount:  "auth_failed_attempts.failed_count",
+	LockoutCount: "auth_failed_attempts.lockout_count",
+	LastFailedAt: "auth_failed_attempts.last_failed_at",
+	LockedUntil:  "auth_failed_attempts.locked_until",
+	CreatedAt:    "auth_failed_attempts.created_at",
+	UpdatedAt:    "auth_failed_attempts.updated_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AuthFailedAttemptWhere = struct {
+	Scope        whereHelperstring
+	Key          whereHelperstring
+	FailedCount  whereHelperint
+	LockoutCount whereHelperint
+	LastFailedAt whereHelpertime_Time
+	LockedUntil  whereHelpernull_Time
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	Scope:        whereHelperstring{field: "\"auth_failed_attempts\".\"scope\""},
+	Key:          whereHelperstring{field: "\"auth_failed_attempts\".\"key\""},
+	FailedCount:  whereHelperint{field: "\"auth_failed_attempts\".\"failed_count\""},
+	LockoutCount: whereHelperint{field: "\"auth_failed_attempts\".\"lockout_count\""},
+	LastFailedAt: whereHelpertime_Time{field: "\"auth_failed_attempts\".\"last_failed_at\""},
+	LockedUntil:  whereHelpernull_Time{field: "\"auth_failed_attempts\".\"locked_until\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"auth_failed_attempts\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"auth_failed_attempts\".\"updated_at\""},
+}
+
+// AuthFailedAttemptRels is where relationship names are stored.
+var AuthFailedAttemptRels = struct {
+}{}
+
+// authFailedAttemptR is where relationships are stored.
+type authFailedAttemptR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*authFailedAttemptR) NewStruct() *authFailedAttemptR {
+	return &authFailedAttemptR{}
+}
+
+// authFailedAttemptL is where Load methods for each relationship are stored.
+type authFailedAttemptL struct{}
+
+var (
+	authFailedAttemptAllColumns            = []string{"scope", "key", "failed_count", "lockout_count", "last_failed_at", "locked_until", "created_at", "updated_at"}
+	authFailedAttemptColumnsWithoutDefault = []string{"scope", "key", "last_failed_at", "created_at", "updated_at"}
+	authFailedAttemptColumnsWithDefault    = []string{"failed_count", "lockout_count", "locked_until"}
+	authFailedAttemptPrimaryKeyColumns     = []string{"scope", "key"}
+	authFailedAttemptGeneratedColumns      = []string{}
+)
+
+type (
+	// AuthFailedAttemptSlice is an alias for a slice of pointers to AuthFailedAttempt.
+	// This should almost always be used instead of []AuthFailedAttempt.
+	AuthFailedAttemptSlice []*AuthFailedAttempt
+
+	authFailedAttemptQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	authFailedAttemptType                 = reflect.TypeOf(&AuthFailedAttempt{})
+	authFailedAttemptMapping              = queries.MakeStructMapping(authFailedAttemptType)
+	authFailedAttemptPrimaryKeyMapping, _ = queries.BindMapping(authFailedAttemptType, authFailedAttemptMapping, authFailedAttemptPrimaryKeyColumns)
+	authFailedAttemptInsertCacheMut       sync.RWMutex
+	authFailedAttemptInsertCache          = make(map[string]insertCache)
+	authFailedAttemptUpdateCacheMut       sync.RWMutex
+	authFailedAttemptUpdateCache          = make(map[string]updateCache)
+	authFailedAttemptUpsertCacheMut       sync.RWMutex
+	authFailedAttemptUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single authFailedAttempt record from the query.
+func (q authFailedAttemptQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuthFailedAttempt, error) {
+	o := &AuthFailedAttempt{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for auth_failed_attempts")
+	}
+
+	return o, nil
+}
+
+// All returns all AuthFailedAttempt records from the query.
+func (q authFailedAttemptQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuthFailedAttemptSlice, error) {
+	var o []*AuthFailedAttempt
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AuthFailedAttempt slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AuthFailedAttempt records in the query.
+func (q authFailedAttemptQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count auth_failed_attempts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q authFailedAttemptQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if auth_failed_attempts exists")
+	}
+
+	return count > 0, nil
+}
+
+// AuthFailedAttempts retrieves all the records using an executor.
+func AuthFailedAttempts(mods ...qm.QueryMod) authFailedAttemptQuery {
+	mods = append(mods, qm.From("\"auth_failed_attempts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"auth_failed_attempts\".*"})
+	}
+
+	return authFailedAttemptQuery{q}
+}
+
+// FindAuthFailedAttempt retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuthFailedAttempt(ctx context.Context, exec boil.ContextExecutor, scope string, key string, selectCols ...string) (*AuthFailedAttempt, error) {
+	authFailedAttemptObj := &AuthFailedAttempt{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"auth_failed_attempts\" where \"scope\"=$1 AND \"key\"=$2", sel,
+	)
+
+	q := queries.Raw(query, scope, key)
+
+	err := q.Bind(ctx, exec, authFailedAttemptObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from auth_failed_attempts")
+	}
+
+	return authFailedAttemptObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuthFailedAttempt) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no auth_failed_attempts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(authFailedAttemptColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	authFailedAttemptInsertCacheMut.RLock()
+	cache, cached := authFailedAttemptInsertCache[key]
+	authFailedAttemptInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			authFailedAttemptAllColumns,
+			authFailedAttemptColumnsWithDefault,
+			authFailedAttemptColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(authFailedAttemptType, authFailedAttemptMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(authFailedAttemptType, authFailedAttemptMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"auth_failed_attempts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"auth_failed_attempts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into auth_failed_attempts")
+	}
+
+	if !cached {
+		authFailedAttemptInsertCacheMut.Lock()
+		authFailedAttemptInsertCache[key] = cache
+		authFailedAttemptInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the AuthFailedAttempt.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuthFailedAttempt) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	authFailedAttemptUpdateCacheMut.RLock()
+	cache, cached := authFailedAttemptUpdateCache[key]
+	authFailedAttemptUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			authFailedAttemptAllColumns,
+			authFailedAttemptPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update auth_failed_attempts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"auth_failed_attempts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, authFailedAttemptPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(authFailedAttemptType, authFailedAttemptMapping, append(wl, authFailedAttemptPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update auth_failed_attempts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for auth_failed_attempts")
+	}
+
+	if !cached {
+		authFailedAttemptUpdateCacheMut.Lock()
+		authFailedAttemptUpdateCache[key] = cache
+		authFailedAttemptUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q authFailedAttemptQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for auth_failed_attempts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for auth_failed_attempts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuthFailedAttemptSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authFailedAttemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"auth_failed_attempts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, authFailedAttemptPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in authFailedAttempt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all authFailedAttempt")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuthFailedAttempt) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no auth_failed_attempts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(authFailedAttemptColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	authFailedAttemptUpsertCacheMut.RLock()
+	cache, cached := authFailedAttemptUpsertCache[key]
+	authFailedAttemptUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			authFailedAttemptAllColumns,
+			authFailedAttemptColumnsWithDefault,
+			authFailedAttemptColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			authFailedAttemptAllColumns,
+			authFailedAttemptPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert auth_failed_attempts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(authFailedAttemptAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(authFailedAttemptPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert auth_failed_attempts, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(authFailedAttemptPrimaryKeyColumns))
+			copy(conflict, authFailedAttemptPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"auth_failed_attempts\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(authFailedAttemptType, authFailedAttemptMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(authFailedAttemptType, authFailedAttemptMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert auth_failed_attempts")
+	}
+
+	if !cached {
+		authFailedAttemptUpsertCacheMut.Lock()
+		authFailedAttemptUpsertCache[key] = cache
+		authFailedAttemptUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single AuthFailedAttempt record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuthFailedAttempt) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AuthFailedAttempt provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), authFailedAttemptPrimaryKeyMapping)
+	sql := "DELETE FROM \"auth_failed_attempts\" WHERE \"scope\"=$1 AND \"key\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from auth_failed_attempts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for auth_failed_attempts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q authFailedAttemptQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no authFailedAttemptQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from auth_failed_attempts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for auth_failed_attempts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuthFailedAttemptSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authFailedAttemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"auth_failed_attempts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authFailedAttemptPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from authFailedAttempt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for auth_failed_attempts")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuthFailedAttempt) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuthFailedAttempt(ctx, exec, o.Scope, o.Key)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuthFailedAttemptSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuthFailedAttemptSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authFailedAttemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"auth_failed_attempts\".* FROM \"auth_failed_attempts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authFailedAttemptPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AuthFailedAttemptSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuthFailedAttemptExists checks if the AuthFailedAttempt row exists.
+func AuthFailedAttemptExists(ctx context.Context, exec boil.ContextExecutor, scope string, key string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"auth_failed_attempts\" where \"scope\"=$1 AND \"key\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, scope, key)
+	}
+	row := exec.QueryRowContext(ctx, sql, scope, key)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if auth_failed_attempts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuthFailedAttempt row exists.
+func (o *AuthFailedAttempt) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuthFailedAttemptExists(ctx, exec, o.Scope, o.Key)
+}
@@ -8,49 +8,115 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("AccessTokenToUserUsingImpersonator", testAccessTokenToOneUserUsingImpersonator)
 	t.Run("AccessTokenToUserUsingUser", testAccessTokenToOneUserUsingUser)
+	t.Run("APIKeyToUserUsingUser", testAPIKeyToOneUserUsingUser)
 	t.Run("AppUserProfileToUserUsingUser", testAppUserProfileToOneUserUsingUser)
 	t.Run("ConfirmationTokenToUserUsingUser", testConfirmationTokenToOneUserUsingUser)
+	t.Run("DataExportToUserUsingUser", testDataExportToOneUserUsingUser)
+	t.Run("EmailChangeRequestToUserUsingUser", testEmailChangeRequestToOneUserUsingUser)
+	t.Run("InviteToUserUsingCreatedBy", testInviteToOneUserUsingCreatedBy)
+	t.Run("InviteToUserUsingUser", testInviteToOneUserUsingUser)
+	t.Run("MagicLinkTokenToUserUsingUser", testMagicLinkTokenToOneUserUsingUser)
+	t.Run("NotificationPreferenceToUserUsingUser", testNotificationPreferenceToOneUserUsingUser)
+	t.Run("PasswordHistoryEntryToUserUsingUser", testPasswordHistoryEntryToOneUserUsingUser)
 	t.Run("PasswordResetTokenToUserUsingUser", testPasswordResetTokenToOneUserUsingUser)
+	t.Run("PushBroadcastToUserUsingCreatedBy", testPushBroadcastToOneUserUsingCreatedBy)
+	t.Run("PushDeliveryToUserUsingUser", testPushDeliveryToOneUserUsingUser)
 	t.Run("PushTokenToUserUsingUser", testPushTokenToOneUserUsingUser)
+	t.Run("PushTopicSubscriptionToUserUsingUser", testPushTopicSubscriptionToOneUserUsingUser)
+	t.Run("RefreshTokenReuseEventToUserUsingUser", testRefreshTokenReuseEventToOneUserUsingUser)
 	t.Run("RefreshTokenToUserUsingUser", testRefreshTokenToOneUserUsingUser)
+	t.Run("TotpRecoveryCodeToUserUsingUser", testTotpRecoveryCodeToOneUserUsingUser)
+	t.Run("TotpSecretToUserUsingUser", testTotpSecretToOneUserUsingUser)
+	t.Run("TwoFactorChallengeTokenToUserUsingUser", testTwoFactorChallengeTokenToOneUserUsingUser)
+	t.Run("UserIdentityToUserUsingUser", testUserIdentityToOneUserUsingUser)
+	t.Run("WebauthnChallengeToUserUsingUser", testWebauthnChallengeToOneUserUsingUser)
+	t.Run("WebauthnCredentialToUserUsingUser", testWebauthnCredentialToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("UserToAppUserProfileUsingAppUserProfile", testUserOneToOneAppUserProfileUsingAppUserProfile)
+	t.Run("UserToTotpSecretUsingTotpSecret", testUserOneToOneTotpSecretUsingTotpSecret)
 }
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("PermissionToRoles", testPermissionToManyRoles)
+	t.Run("RoleToPermissions", testRoleToManyPermissions)
+	t.Run("UserToImpersonatorAccessTokens", testUserToManyImpersonatorAccessTokens)
 	t.Run("UserToAccessTokens", testUserToManyAccessTokens)
+	t.Run("UserToAPIKeys", testUserToManyAPIKeys)
 	t.Run("UserToConfirmationTokens", testUserToManyConfirmationTokens)
+	t.Run("UserToDataExports", testUserToManyDataExports)
+	t.Run("UserToEmailChangeRequests", testUserToManyEmailChangeRequests)
+	t.Run("UserToCreatedByInvites", testUserToManyCreatedByInvites)
+	t.Run("UserToInvites", testUserToManyInvites)
+	t.Run("UserToMagicLinkTokens", testUserToManyMagicLinkTokens)
+	t.Run("UserToNotificationPreferences", testUserToManyNotificationPreferences)
+	t.Run("UserToPasswordHistoryEntries", testUserToManyPasswordHistoryEntries)
 	t.Run("UserToPasswordResetTokens", testUserToManyPasswordResetTokens)
+	t.Run("UserToCreatedByPushBroadcasts", testUserToManyCreatedByPushBroadcasts)
+	t.Run("UserToPushDeliveries", testUserToManyPushDeliveries)
 	t.Run("UserToPushTokens", testUserToManyPushTokens)
+	t.Run("UserToPushTopicSubscriptions", testUserToManyPushTopicSubscriptions)
+	t.Run("UserToRefreshTokenReuseEvents", testUserToManyRefreshTokenReuseEvents)
 	t.Run("UserToRefreshTokens", testUserToManyRefreshTokens)
+	t.Run("UserToTotpRecoveryCodes", testUserToManyTotpRecoveryCodes)
+	t.Run("UserToTwoFactorChallengeTokens", testUserToManyTwoFactorChallengeTokens)
+	t.Run("UserToUserIdentities", testUserToManyUserIdentities)
+	t.Run("UserToWebauthnChallenges", testUserToManyWebauthnChallenges)
+	t.Run("UserToWebauthnCredentials", testUserToManyWebauthnCredentials)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("AccessTokenToUserUsingImpersonatorAccessTokens", testAccessTokenToOneSetOpUserUsingImpersonator)
 	t.Run("AccessTokenToUserUsingAccessTokens", testAccessTokenToOneSetOpUserUsingUser)
+	t.Run("APIKeyToUserUsingAPIKeys", testAPIKeyToOneSetOpUserUsingUser)
 	t.Run("AppUserProfileToUserUsingAppUserProfile", testAppUserProfileToOneSetOpUserUsingUser)
 	t.Run("ConfirmationTokenToUserUsingConfirmationTokens", testConfirmationTokenToOneSetOpUserUsingUser)
+	t.Run("DataExportToUserUsingDataExports", testDataExportToOneSetOpUserUsingUser)
+	t.Run("EmailChangeRequestToUserUsingEmailChangeRequests", testEmailChangeRequestToOneSetOpUserUsingUser)
+	t.Run("InviteToUserUsingCreatedByInvites", testInviteToOneSetOpUserUsingCreatedBy)
+	t.Run("InviteToUserUsingInvites", testInviteToOneSetOpUserUsingUser)
+	t.Run("MagicLinkTokenToUserUsingMagicLinkTokens", testMagicLinkTokenToOneSetOpUserUsingUser)
+	t.Run("NotificationPreferenceToUserUsingNotificationPreferences", testNotificationPreferenceToOneSetOpUserUsingUser)
+	t.Run("PasswordHistoryEntryToUserUsingPasswordHistoryEntries", testPasswordHistoryEntryToOneSetOpUserUsingUser)
 	t.Run("PasswordResetTokenToUserUsingPasswordResetTokens", testPasswordResetTokenToOneSetOpUserUsingUser)
+	t.Run("PushBroadcastToUserUsingCreatedByPushBroadcasts", testPushBroadcastToOneSetOpUserUsingCreatedBy)
+	t.Run("PushDeliveryToUserUsingPushDeliveries", testPushDeliveryToOneSetOpUserUsingUser)
 	t.Run("PushTokenToUserUsingPushTokens", testPushTokenToOneSetOpUserUsingUser)
+	t.Run("PushTopicSubscriptionToUserUsingPushTopicSubscriptions", testPushTopicSubscriptionToOneSetOpUserUsingUser)
+	t.Run("RefreshTokenReuseEventToUserUsingRefreshTokenReuseEvents", testRefreshTokenReuseEventToOneSetOpUserUsingUser)
 	t.Run("RefreshTokenToUserUsingRefreshTokens", testRefreshTokenToOneSetOpUserUsingUser)
+	t.Run("TotpRecoveryCodeToUserUsingTotpRecoveryCodes", testTotpRecoveryCodeToOneSetOpUserUsingUser)
+	t.Run("TotpSecretToUserUsingTotpSecret", testTotpSecretToOneSetOpUserUsingUser)
+	t.Run("TwoFactorChallengeTokenToUserUsingTwoFactorChallengeTokens", testTwoFactorChallengeTokenToOneSetOpUserUsingUser)
+	t.Run("UserIdentityToUserUsingUserIdentities", testUserIdentityToOneSetOpUserUsingUser)
+	t.Run("WebauthnChallengeToUserUsingWebauthnChallenges", testWebauthnChallengeToOneSetOpUserUsingUser)
+	t.Run("WebauthnCredentialToUserUsingWebauthnCredentials", testWebauthnCredentialToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("AccessTokenToUserUsingImpersonatorAccessTokens", testAccessTokenToOneRemoveOpUserUsingImpersonator)
+	t.Run("InviteToUserUsingCreatedByInvites", testInviteToOneRemoveOpUserUsingCreatedBy)
+	t.Run("InviteToUserUsingInvites", testInviteToOneRemoveOpUserUsingUser)
+	t.Run("PushBroadcastToUserUsingCreatedByPushBroadcasts", testPushBroadcastToOneRemoveOpUserUsingCreatedBy)
+	t.Run("WebauthnChallengeToUserUsingWebauthnChallenges", testWebauthnChallengeToOneRemoveOpUserUsingUser)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("UserToAppUserProfileUsingAppUserProfile", testUserOneToOneSetOpAppUserProfileUsingAppUserProfile)
+	t.Run("UserToTotpSecretUsingTotpSecret", testUserOneToOneSetOpTotpSecretUsingTotpSecret)
 }
 
 // TestOneToOneRemove tests cannot be run in parallel
@@ -60,17 +126,53 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("PermissionToRoles", testPermissionToManyAddOpRoles)
+	t.Run("RoleToPermissions", testRoleToManyAddOpPermissions)
+	t.Run("UserToImpersonatorAccessTokens", testUserToManyAddOpImpersonatorAccessTokens)
 	t.Run("UserToAccessTokens", testUserToManyAddOpAccessTokens)
+	t.Run("UserToAPIKeys", testUserToManyAddOpAPIKeys)
 	t.Run("UserToConfirmationTokens", testUserToManyAddOpConfirmationTokens)
+	t.Run("UserToDataExports", testUserToManyAddOpDataExports)
+	t.Run("UserToEmailChangeRequests", testUserToManyAddOpEmailChangeRequests)
+	t.Run("UserToCreatedByInvites", testUserToManyAddOpCreatedByInvites)
+	t.Run("UserToInvites", testUserToManyAddOpInvites)
+	t.Run("UserToMagicLinkTokens", testUserToManyAddOpMagicLinkTokens)
+	t.Run("UserToNotificationPreferences", testUserToManyAddOpNotificationPreferences)
+	t.Run("UserToPasswordHistoryEntries", testUserToManyAddOpPasswordHistoryEntries)
 	t.Run("UserToPasswordResetTokens", testUserToManyAddOpPasswordResetTokens)
+	t.Run("UserToCreatedByPushBroadcasts", testUserToManyAddOpCreatedByPushBroadcasts)
+	t.Run("UserToPushDeliveries", testUserToManyAddOpPushDeliveries)
 	t.Run("UserToPushTokens", testUserToManyAddOpPushTokens)
+	t.Run("UserToPushTopicSubscriptions", testUserToManyAddOpPushTopicSubscriptions)
+	t.Run("UserToRefreshTokenReuseEvents", testUserToManyAddOpRefreshTokenReuseEvents)
 	t.Run("UserToRefreshTokens", testUserToManyAddOpRefreshTokens)
+	t.Run("UserToTotpRecoveryCodes", testUserToManyAddOpTotpRecoveryCodes)
+	t.Run("UserToTwoFactorChallengeTokens", testUserToManyAddOpTwoFactorChallengeTokens)
+	t.Run("UserToUserIdentities", testUserToManyAddOpUserIdentities)
+	t.Run("UserToWebauthnChallenges", testUserToManyAddOpWebauthnChallenges)
+	t.Run("UserToWebauthnCredentials", testUserToManyAddOpWebauthnCredentials)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("PermissionToRoles", testPermissionToManySetOpRoles)
+	t.Run("RoleToPermissions", testRoleToManySetOpPermissions)
+	t.Run("UserToImpersonatorAccessTokens", testUserToManySetOpImpersonatorAccessTokens)
+	t.Run("UserToCreatedByInvites", testUserToManySetOpCreatedByInvites)
+	t.Run("UserToInvites", testUserToManySetOpInvites)
+	t.Run("UserToCreatedByPushBroadcasts", testUserToManySetOpCreatedByPushBroadcasts)
+	t.Run("UserToWebauthnChallenges", testUserToManySetOpWebauthnChallenges)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("PermissionToRoles", testPermissionToManyRemoveOpRoles)
+	t.Run("RoleToPermissions", testRoleToManyRemoveOpPermissions)
+	t.Run("UserToImpersonatorAccessTokens", testUserToManyRemoveOpImpersonatorAccessTokens)
+	t.Run("UserToCreatedByInvites", testUserToManyRemoveOpCreatedByInvites)
+	t.Run("UserToInvites", testUserToManyRemoveOpInvites)
+	t.Run("UserToCreatedByPushBroadcasts", testUserToManyRemoveOpCreatedByPushBroadcasts)
+	t.Run("UserToWebauthnChallenges", testUserToManyRemoveOpWebauthnChallenges)
+}
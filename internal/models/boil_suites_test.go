@@ -12,168 +12,576 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylists)
 	t.Run("AccessTokens", testAccessTokens)
+	t.Run("APIKeys", testAPIKeys)
 	t.Run("AppUserProfiles", testAppUserProfiles)
+	t.Run("AuditEvents", testAuditEvents)
+	t.Run("AuthFailedAttempts", testAuthFailedAttempts)
 	t.Run("ConfirmationTokens", testConfirmationTokens)
+	t.Run("DataExports", testDataExports)
+	t.Run("EmailChangeRequests", testEmailChangeRequests)
+	t.Run("Invites", testInvites)
+	t.Run("MagicLinkTokens", testMagicLinkTokens)
+	t.Run("NotificationPreferences", testNotificationPreferences)
+	t.Run("OidcAuthStates", testOidcAuthStates)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntries)
 	t.Run("PasswordResetTokens", testPasswordResetTokens)
+	t.Run("Permissions", testPermissions)
+	t.Run("PushBroadcasts", testPushBroadcasts)
+	t.Run("PushDeliveries", testPushDeliveries)
 	t.Run("PushTokens", testPushTokens)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptions)
+	t.Run("RateLimitBuckets", testRateLimitBuckets)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEvents)
 	t.Run("RefreshTokens", testRefreshTokens)
+	t.Run("Roles", testRoles)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodes)
+	t.Run("TotpSecrets", testTotpSecrets)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokens)
+	t.Run("UserIdentities", testUserIdentities)
 	t.Run("Users", testUsers)
+	t.Run("WebauthnChallenges", testWebauthnChallenges)
+	t.Run("WebauthnCredentials", testWebauthnCredentials)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsDelete)
 	t.Run("AccessTokens", testAccessTokensDelete)
+	t.Run("APIKeys", testAPIKeysDelete)
 	t.Run("AppUserProfiles", testAppUserProfilesDelete)
+	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsDelete)
 	t.Run("ConfirmationTokens", testConfirmationTokensDelete)
+	t.Run("DataExports", testDataExportsDelete)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsDelete)
+	t.Run("Invites", testInvitesDelete)
+	t.Run("MagicLinkTokens", testMagicLinkTokensDelete)
+	t.Run("NotificationPreferences", testNotificationPreferencesDelete)
+	t.Run("OidcAuthStates", testOidcAuthStatesDelete)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesDelete)
 	t.Run("PasswordResetTokens", testPasswordResetTokensDelete)
+	t.Run("Permissions", testPermissionsDelete)
+	t.Run("PushBroadcasts", testPushBroadcastsDelete)
+	t.Run("PushDeliveries", testPushDeliveriesDelete)
 	t.Run("PushTokens", testPushTokensDelete)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsDelete)
+	t.Run("RateLimitBuckets", testRateLimitBucketsDelete)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsDelete)
 	t.Run("RefreshTokens", testRefreshTokensDelete)
+	t.Run("Roles", testRolesDelete)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesDelete)
+	t.Run("TotpSecrets", testTotpSecretsDelete)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensDelete)
+	t.Run("UserIdentities", testUserIdentitiesDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WebauthnChallenges", testWebauthnChallengesDelete)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsQueryDeleteAll)
 	t.Run("AccessTokens", testAccessTokensQueryDeleteAll)
+	t.Run("APIKeys", testAPIKeysQueryDeleteAll)
 	t.Run("AppUserProfiles", testAppUserProfilesQueryDeleteAll)
+	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsQueryDeleteAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensQueryDeleteAll)
+	t.Run("DataExports", testDataExportsQueryDeleteAll)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsQueryDeleteAll)
+	t.Run("Invites", testInvitesQueryDeleteAll)
+	t.Run("MagicLinkTokens", testMagicLinkTokensQueryDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesQueryDeleteAll)
+	t.Run("OidcAuthStates", testOidcAuthStatesQueryDeleteAll)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesQueryDeleteAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensQueryDeleteAll)
+	t.Run("Permissions", testPermissionsQueryDeleteAll)
+	t.Run("PushBroadcasts", testPushBroadcastsQueryDeleteAll)
+	t.Run("PushDeliveries", testPushDeliveriesQueryDeleteAll)
 	t.Run("PushTokens", testPushTokensQueryDeleteAll)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsQueryDeleteAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsQueryDeleteAll)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsQueryDeleteAll)
 	t.Run("RefreshTokens", testRefreshTokensQueryDeleteAll)
+	t.Run("Roles", testRolesQueryDeleteAll)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesQueryDeleteAll)
+	t.Run("TotpSecrets", testTotpSecretsQueryDeleteAll)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensQueryDeleteAll)
+	t.Run("UserIdentities", testUserIdentitiesQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesQueryDeleteAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsSliceDeleteAll)
 	t.Run("AccessTokens", testAccessTokensSliceDeleteAll)
+	t.Run("APIKeys", testAPIKeysSliceDeleteAll)
 	t.Run("AppUserProfiles", testAppUserProfilesSliceDeleteAll)
+	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsSliceDeleteAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensSliceDeleteAll)
+	t.Run("DataExports", testDataExportsSliceDeleteAll)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsSliceDeleteAll)
+	t.Run("Invites", testInvitesSliceDeleteAll)
+	t.Run("MagicLinkTokens", testMagicLinkTokensSliceDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceDeleteAll)
+	t.Run("OidcAuthStates", testOidcAuthStatesSliceDeleteAll)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesSliceDeleteAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSliceDeleteAll)
+	t.Run("Permissions", testPermissionsSliceDeleteAll)
+	t.Run("PushBroadcasts", testPushBroadcastsSliceDeleteAll)
+	t.Run("PushDeliveries", testPushDeliveriesSliceDeleteAll)
 	t.Run("PushTokens", testPushTokensSliceDeleteAll)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsSliceDeleteAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsSliceDeleteAll)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsSliceDeleteAll)
 	t.Run("RefreshTokens", testRefreshTokensSliceDeleteAll)
+	t.Run("Roles", testRolesSliceDeleteAll)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesSliceDeleteAll)
+	t.Run("TotpSecrets", testTotpSecretsSliceDeleteAll)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensSliceDeleteAll)
+	t.Run("UserIdentities", testUserIdentitiesSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesSliceDeleteAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsExists)
 	t.Run("AccessTokens", testAccessTokensExists)
+	t.Run("APIKeys", testAPIKeysExists)
 	t.Run("AppUserProfiles", testAppUserProfilesExists)
+	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsExists)
 	t.Run("ConfirmationTokens", testConfirmationTokensExists)
+	t.Run("DataExports", testDataExportsExists)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsExists)
+	t.Run("Invites", testInvitesExists)
+	t.Run("MagicLinkTokens", testMagicLinkTokensExists)
+	t.Run("NotificationPreferences", testNotificationPreferencesExists)
+	t.Run("OidcAuthStates", testOidcAuthStatesExists)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesExists)
 	t.Run("PasswordResetTokens", testPasswordResetTokensExists)
+	t.Run("Permissions", testPermissionsExists)
+	t.Run("PushBroadcasts", testPushBroadcastsExists)
+	t.Run("PushDeliveries", testPushDeliveriesExists)
 	t.Run("PushTokens", testPushTokensExists)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsExists)
+	t.Run("RateLimitBuckets", testRateLimitBucketsExists)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsExists)
 	t.Run("RefreshTokens", testRefreshTokensExists)
+	t.Run("Roles", testRolesExists)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesExists)
+	t.Run("TotpSecrets", testTotpSecretsExists)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensExists)
+	t.Run("UserIdentities", testUserIdentitiesExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WebauthnChallenges", testWebauthnChallengesExists)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsFind)
 	t.Run("AccessTokens", testAccessTokensFind)
+	t.Run("APIKeys", testAPIKeysFind)
 	t.Run("AppUserProfiles", testAppUserProfilesFind)
+	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsFind)
 	t.Run("ConfirmationTokens", testConfirmationTokensFind)
+	t.Run("DataExports", testDataExportsFind)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsFind)
+	t.Run("Invites", testInvitesFind)
+	t.Run("MagicLinkTokens", testMagicLinkTokensFind)
+	t.Run("NotificationPreferences", testNotificationPreferencesFind)
+	t.Run("OidcAuthStates", testOidcAuthStatesFind)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesFind)
 	t.Run("PasswordResetTokens", testPasswordResetTokensFind)
+	t.Run("Permissions", testPermissionsFind)
+	t.Run("PushBroadcasts", testPushBroadcastsFind)
+	t.Run("PushDeliveries", testPushDeliveriesFind)
 	t.Run("PushTokens", testPushTokensFind)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsFind)
+	t.Run("RateLimitBuckets", testRateLimitBucketsFind)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsFind)
 	t.Run("RefreshTokens", testRefreshTokensFind)
+	t.Run("Roles", testRolesFind)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesFind)
+	t.Run("TotpSecrets", testTotpSecretsFind)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensFind)
+	t.Run("UserIdentities", testUserIdentitiesFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WebauthnChallenges", testWebauthnChallengesFind)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsBind)
 	t.Run("AccessTokens", testAccessTokensBind)
+	t.Run("APIKeys", testAPIKeysBind)
 	t.Run("AppUserProfiles", testAppUserProfilesBind)
+	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsBind)
 	t.Run("ConfirmationTokens", testConfirmationTokensBind)
+	t.Run("DataExports", testDataExportsBind)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsBind)
+	t.Run("Invites", testInvitesBind)
+	t.Run("MagicLinkTokens", testMagicLinkTokensBind)
+	t.Run("NotificationPreferences", testNotificationPreferencesBind)
+	t.Run("OidcAuthStates", testOidcAuthStatesBind)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesBind)
 	t.Run("PasswordResetTokens", testPasswordResetTokensBind)
+	t.Run("Permissions", testPermissionsBind)
+	t.Run("PushBroadcasts", testPushBroadcastsBind)
+	t.Run("PushDeliveries", testPushDeliveriesBind)
 	t.Run("PushTokens", testPushTokensBind)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsBind)
+	t.Run("RateLimitBuckets", testRateLimitBucketsBind)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsBind)
 	t.Run("RefreshTokens", testRefreshTokensBind)
+	t.Run("Roles", testRolesBind)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesBind)
+	t.Run("TotpSecrets", testTotpSecretsBind)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensBind)
+	t.Run("UserIdentities", testUserIdentitiesBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WebauthnChallenges", testWebauthnChallengesBind)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsOne)
 	t.Run("AccessTokens", testAccessTokensOne)
+	t.Run("APIKeys", testAPIKeysOne)
 	t.Run("AppUserProfiles", testAppUserProfilesOne)
+	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsOne)
 	t.Run("ConfirmationTokens", testConfirmationTokensOne)
+	t.Run("DataExports", testDataExportsOne)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsOne)
+	t.Run("Invites", testInvitesOne)
+	t.Run("MagicLinkTokens", testMagicLinkTokensOne)
+	t.Run("NotificationPreferences", testNotificationPreferencesOne)
+	t.Run("OidcAuthStates", testOidcAuthStatesOne)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesOne)
 	t.Run("PasswordResetTokens", testPasswordResetTokensOne)
+	t.Run("Permissions", testPermissionsOne)
+	t.Run("PushBroadcasts", testPushBroadcastsOne)
+	t.Run("PushDeliveries", testPushDeliveriesOne)
 	t.Run("PushTokens", testPushTokensOne)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsOne)
+	t.Run("RateLimitBuckets", testRateLimitBucketsOne)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsOne)
 	t.Run("RefreshTokens", testRefreshTokensOne)
+	t.Run("Roles", testRolesOne)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesOne)
+	t.Run("TotpSecrets", testTotpSecretsOne)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensOne)
+	t.Run("UserIdentities", testUserIdentitiesOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WebauthnChallenges", testWebauthnChallengesOne)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsAll)
 	t.Run("AccessTokens", testAccessTokensAll)
+	t.Run("APIKeys", testAPIKeysAll)
 	t.Run("AppUserProfiles", testAppUserProfilesAll)
+	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensAll)
+	t.Run("DataExports", testDataExportsAll)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsAll)
+	t.Run("Invites", testInvitesAll)
+	t.Run("MagicLinkTokens", testMagicLinkTokensAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesAll)
+	t.Run("OidcAuthStates", testOidcAuthStatesAll)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensAll)
+	t.Run("Permissions", testPermissionsAll)
+	t.Run("PushBroadcasts", testPushBroadcastsAll)
+	t.Run("PushDeliveries", testPushDeliveriesAll)
 	t.Run("PushTokens", testPushTokensAll)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsAll)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsAll)
 	t.Run("RefreshTokens", testRefreshTokensAll)
+	t.Run("Roles", testRolesAll)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesAll)
+	t.Run("TotpSecrets", testTotpSecretsAll)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensAll)
+	t.Run("UserIdentities", testUserIdentitiesAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsCount)
 	t.Run("AccessTokens", testAccessTokensCount)
+	t.Run("APIKeys", testAPIKeysCount)
 	t.Run("AppUserProfiles", testAppUserProfilesCount)
+	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsCount)
 	t.Run("ConfirmationTokens", testConfirmationTokensCount)
+	t.Run("DataExports", testDataExportsCount)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsCount)
+	t.Run("Invites", testInvitesCount)
+	t.Run("MagicLinkTokens", testMagicLinkTokensCount)
+	t.Run("NotificationPreferences", testNotificationPreferencesCount)
+	t.Run("OidcAuthStates", testOidcAuthStatesCount)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesCount)
 	t.Run("PasswordResetTokens", testPasswordResetTokensCount)
+	t.Run("Permissions", testPermissionsCount)
+	t.Run("PushBroadcasts", testPushBroadcastsCount)
+	t.Run("PushDeliveries", testPushDeliveriesCount)
 	t.Run("PushTokens", testPushTokensCount)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsCount)
+	t.Run("RateLimitBuckets", testRateLimitBucketsCount)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsCount)
 	t.Run("RefreshTokens", testRefreshTokensCount)
+	t.Run("Roles", testRolesCount)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesCount)
+	t.Run("TotpSecrets", testTotpSecretsCount)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensCount)
+	t.Run("UserIdentities", testUserIdentitiesCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WebauthnChallenges", testWebauthnChallengesCount)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsCount)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsInsert)
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsInsertWhitelist)
 	t.Run("AccessTokens", testAccessTokensInsert)
 	t.Run("AccessTokens", testAccessTokensInsertWhitelist)
+	t.Run("APIKeys", testAPIKeysInsert)
+	t.Run("APIKeys", testAPIKeysInsertWhitelist)
 	t.Run("AppUserProfiles", testAppUserProfilesInsert)
 	t.Run("AppUserProfiles", testAppUserProfilesInsertWhitelist)
+	t.Run("AuditEvents", testAuditEventsInsert)
+	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsInsert)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsInsertWhitelist)
 	t.Run("ConfirmationTokens", testConfirmationTokensInsert)
 	t.Run("ConfirmationTokens", testConfirmationTokensInsertWhitelist)
+	t.Run("DataExports", testDataExportsInsert)
+	t.Run("DataExports", testDataExportsInsertWhitelist)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsInsert)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsInsertWhitelist)
+	t.Run("Invites", testInvitesInsert)
+	t.Run("Invites", testInvitesInsertWhitelist)
+	t.Run("MagicLinkTokens", testMagicLinkTokensInsert)
+	t.Run("MagicLinkTokens", testMagicLinkTokensInsertWhitelist)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsert)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsertWhitelist)
+	t.Run("OidcAuthStates", testOidcAuthStatesInsert)
+	t.Run("OidcAuthStates", testOidcAuthStatesInsertWhitelist)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesInsert)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesInsertWhitelist)
 	t.Run("PasswordResetTokens", testPasswordResetTokensInsert)
 	t.Run("PasswordResetTokens", testPasswordResetTokensInsertWhitelist)
+	t.Run("Permissions", testPermissionsInsert)
+	t.Run("Permissions", testPermissionsInsertWhitelist)
+	t.Run("PushBroadcasts", testPushBroadcastsInsert)
+	t.Run("PushBroadcasts", testPushBroadcastsInsertWhitelist)
+	t.Run("PushDeliveries", testPushDeliveriesInsert)
+	t.Run("PushDeliveries", testPushDeliveriesInsertWhitelist)
 	t.Run("PushTokens", testPushTokensInsert)
 	t.Run("PushTokens", testPushTokensInsertWhitelist)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsInsert)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsInsertWhitelist)
+	t.Run("RateLimitBuckets", testRateLimitBucketsInsert)
+	t.Run("RateLimitBuckets", testRateLimitBucketsInsertWhitelist)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsInsert)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsInsertWhitelist)
 	t.Run("RefreshTokens", testRefreshTokensInsert)
 	t.Run("RefreshTokens", testRefreshTokensInsertWhitelist)
+	t.Run("Roles", testRolesInsert)
+	t.Run("Roles", testRolesInsertWhitelist)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesInsert)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesInsertWhitelist)
+	t.Run("TotpSecrets", testTotpSecretsInsert)
+	t.Run("TotpSecrets", testTotpSecretsInsertWhitelist)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensInsert)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensInsertWhitelist)
+	t.Run("UserIdentities", testUserIdentitiesInsert)
+	t.Run("UserIdentities", testUserIdentitiesInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WebauthnChallenges", testWebauthnChallengesInsert)
+	t.Run("WebauthnChallenges", testWebauthnChallengesInsertWhitelist)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsInsert)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsReload)
 	t.Run("AccessTokens", testAccessTokensReload)
+	t.Run("APIKeys", testAPIKeysReload)
 	t.Run("AppUserProfiles", testAppUserProfilesReload)
+	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsReload)
 	t.Run("ConfirmationTokens", testConfirmationTokensReload)
+	t.Run("DataExports", testDataExportsReload)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsReload)
+	t.Run("Invites", testInvitesReload)
+	t.Run("MagicLinkTokens", testMagicLinkTokensReload)
+	t.Run("NotificationPreferences", testNotificationPreferencesReload)
+	t.Run("OidcAuthStates", testOidcAuthStatesReload)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesReload)
 	t.Run("PasswordResetTokens", testPasswordResetTokensReload)
+	t.Run("Permissions", testPermissionsReload)
+	t.Run("PushBroadcasts", testPushBroadcastsReload)
+	t.Run("PushDeliveries", testPushDeliveriesReload)
 	t.Run("PushTokens", testPushTokensReload)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsReload)
+	t.Run("RateLimitBuckets", testRateLimitBucketsReload)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsReload)
 	t.Run("RefreshTokens", testRefreshTokensReload)
+	t.Run("Roles", testRolesReload)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesReload)
+	t.Run("TotpSecrets", testTotpSecretsReload)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensReload)
+	t.Run("UserIdentities", testUserIdentitiesReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WebauthnChallenges", testWebauthnChallengesReload)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsReloadAll)
 	t.Run("AccessTokens", testAccessTokensReloadAll)
+	t.Run("APIKeys", testAPIKeysReloadAll)
 	t.Run("AppUserProfiles", testAppUserProfilesReloadAll)
+	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsReloadAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensReloadAll)
+	t.Run("DataExports", testDataExportsReloadAll)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsReloadAll)
+	t.Run("Invites", testInvitesReloadAll)
+	t.Run("MagicLinkTokens", testMagicLinkTokensReloadAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesReloadAll)
+	t.Run("OidcAuthStates", testOidcAuthStatesReloadAll)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesReloadAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensReloadAll)
+	t.Run("Permissions", testPermissionsReloadAll)
+	t.Run("PushBroadcasts", testPushBroadcastsReloadAll)
+	t.Run("PushDeliveries", testPushDeliveriesReloadAll)
 	t.Run("PushTokens", testPushTokensReloadAll)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsReloadAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsReloadAll)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsReloadAll)
 	t.Run("RefreshTokens", testRefreshTokensReloadAll)
+	t.Run("Roles", testRolesReloadAll)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesReloadAll)
+	t.Run("TotpSecrets", testTotpSecretsReloadAll)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensReloadAll)
+	t.Run("UserIdentities", testUserIdentitiesReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesReloadAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsSelect)
 	t.Run("AccessTokens", testAccessTokensSelect)
+	t.Run("APIKeys", testAPIKeysSelect)
 	t.Run("AppUserProfiles", testAppUserProfilesSelect)
+	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsSelect)
 	t.Run("ConfirmationTokens", testConfirmationTokensSelect)
+	t.Run("DataExports", testDataExportsSelect)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsSelect)
+	t.Run("Invites", testInvitesSelect)
+	t.Run("MagicLinkTokens", testMagicLinkTokensSelect)
+	t.Run("NotificationPreferences", testNotificationPreferencesSelect)
+	t.Run("OidcAuthStates", testOidcAuthStatesSelect)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesSelect)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSelect)
+	t.Run("Permissions", testPermissionsSelect)
+	t.Run("PushBroadcasts", testPushBroadcastsSelect)
+	t.Run("PushDeliveries", testPushDeliveriesSelect)
 	t.Run("PushTokens", testPushTokensSelect)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsSelect)
+	t.Run("RateLimitBuckets", testRateLimitBucketsSelect)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsSelect)
 	t.Run("RefreshTokens", testRefreshTokensSelect)
+	t.Run("Roles", testRolesSelect)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesSelect)
+	t.Run("TotpSecrets", testTotpSecretsSelect)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensSelect)
+	t.Run("UserIdentities", testUserIdentitiesSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WebauthnChallenges", testWebauthnChallengesSelect)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsUpdate)
 	t.Run("AccessTokens", testAccessTokensUpdate)
+	t.Run("APIKeys", testAPIKeysUpdate)
 	t.Run("AppUserProfiles", testAppUserProfilesUpdate)
+	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsUpdate)
 	t.Run("ConfirmationTokens", testConfirmationTokensUpdate)
+	t.Run("DataExports", testDataExportsUpdate)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsUpdate)
+	t.Run("Invites", testInvitesUpdate)
+	t.Run("MagicLinkTokens", testMagicLinkTokensUpdate)
+	t.Run("NotificationPreferences", testNotificationPreferencesUpdate)
+	t.Run("OidcAuthStates", testOidcAuthStatesUpdate)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesUpdate)
 	t.Run("PasswordResetTokens", testPasswordResetTokensUpdate)
+	t.Run("Permissions", testPermissionsUpdate)
+	t.Run("PushBroadcasts", testPushBroadcastsUpdate)
+	t.Run("PushDeliveries", testPushDeliveriesUpdate)
 	t.Run("PushTokens", testPushTokensUpdate)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsUpdate)
+	t.Run("RateLimitBuckets", testRateLimitBucketsUpdate)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsUpdate)
 	t.Run("RefreshTokens", testRefreshTokensUpdate)
+	t.Run("Roles", testRolesUpdate)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesUpdate)
+	t.Run("TotpSecrets", testTotpSecretsUpdate)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensUpdate)
+	t.Run("UserIdentities", testUserIdentitiesUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WebauthnChallenges", testWebauthnChallengesUpdate)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsSliceUpdateAll)
 	t.Run("AccessTokens", testAccessTokensSliceUpdateAll)
+	t.Run("APIKeys", testAPIKeysSliceUpdateAll)
 	t.Run("AppUserProfiles", testAppUserProfilesSliceUpdateAll)
+	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsSliceUpdateAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensSliceUpdateAll)
+	t.Run("DataExports", testDataExportsSliceUpdateAll)
+	t.Run("EmailChangeRequests", testEmailChangeRequestsSliceUpdateAll)
+	t.Run("Invites", testInvitesSliceUpdateAll)
+	t.Run("MagicLinkTokens", testMagicLinkTokensSliceUpdateAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceUpdateAll)
+	t.Run("OidcAuthStates", testOidcAuthStatesSliceUpdateAll)
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesSliceUpdateAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSliceUpdateAll)
+	t.Run("Permissions", testPermissionsSliceUpdateAll)
+	t.Run("PushBroadcasts", testPushBroadcastsSliceUpdateAll)
+	t.Run("PushDeliveries", testPushDeliveriesSliceUpdateAll)
 	t.Run("PushTokens", testPushTokensSliceUpdateAll)
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsSliceUpdateAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsSliceUpdateAll)
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsSliceUpdateAll)
 	t.Run("RefreshTokens", testRefreshTokensSliceUpdateAll)
+	t.Run("Roles", testRolesSliceUpdateAll)
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesSliceUpdateAll)
+	t.Run("TotpSecrets", testTotpSecretsSliceUpdateAll)
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensSliceUpdateAll)
+	t.Run("UserIdentities", testUserIdentitiesSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesSliceUpdateAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsSliceUpdateAll)
 }
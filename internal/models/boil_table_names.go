@@ -4,19 +4,69 @@
 package models
 
 var TableNames = struct {
-	AccessTokens        string
-	AppUserProfiles     string
-	ConfirmationTokens  string
-	PasswordResetTokens string
-	PushTokens          string
-	RefreshTokens       string
-	Users               string
+	AccessTokenDenylist      string
+	AccessTokens             string
+	APIKeys                  string
+	AppUserProfiles          string
+	AuditEvents              string
+	AuthFailedAttempts       string
+	ConfirmationTokens       string
+	DataExports              string
+	EmailChangeRequests      string
+	Invites                  string
+	MagicLinkTokens          string
+	NotificationPreferences  string
+	OidcAuthStates           string
+	PasswordHistoryEntries   string
+	PasswordResetTokens      string
+	Permissions              string
+	PushBroadcasts           string
+	PushDeliveries           string
+	PushTokens               string
+	PushTopicSubscriptions   string
+	RateLimitBuckets         string
+	RefreshTokenReuseEvents  string
+	RefreshTokens            string
+	RolePermissions          string
+	Roles                    string
+	TotpRecoveryCodes        string
+	TotpSecrets              string
+	TwoFactorChallengeTokens string
+	UserIdentities           string
+	Users                    string
+	WebauthnChallenges       string
+	WebauthnCredentials      string
 }{
-	AccessTokens:        "access_tokens",
-	AppUserProfiles:     "app_user_profiles",
-	ConfirmationTokens:  "confirmation_tokens",
-	PasswordResetTokens: "password_reset_tokens",
-	PushTokens:          "push_tokens",
-	RefreshTokens:       "refresh_tokens",
-	Users:               "users",
+	AccessTokenDenylist:      "access_token_denylist",
+	AccessTokens:             "access_tokens",
+	APIKeys:                  "api_keys",
+	AppUserProfiles:          "app_user_profiles",
+	AuditEvents:              "audit_events",
+	AuthFailedAttempts:       "auth_failed_attempts",
+	ConfirmationTokens:       "confirmation_tokens",
+	DataExports:              "data_exports",
+	EmailChangeRequests:      "email_change_requests",
+	Invites:                  "invites",
+	MagicLinkTokens:          "magic_link_tokens",
+	NotificationPreferences:  "notification_preferences",
+	OidcAuthStates:           "oidc_auth_states",
+	PasswordHistoryEntries:   "password_history_entries",
+	PasswordResetTokens:      "password_reset_tokens",
+	Permissions:              "permissions",
+	PushBroadcasts:           "push_broadcasts",
+	PushDeliveries:           "push_deliveries",
+	PushTokens:               "push_tokens",
+	PushTopicSubscriptions:   "push_topic_subscriptions",
+	RateLimitBuckets:         "rate_limit_buckets",
+	RefreshTokenReuseEvents:  "refresh_token_reuse_events",
+	RefreshTokens:            "refresh_tokens",
+	RolePermissions:          "role_permissions",
+	Roles:                    "roles",
+	TotpRecoveryCodes:        "totp_recovery_codes",
+	TotpSecrets:              "totp_secrets",
+	TwoFactorChallengeTokens: "two_factor_challenge_tokens",
+	UserIdentities:           "user_identities",
+	Users:                    "users",
+	WebauthnChallenges:       "webauthn_challenges",
+	WebauthnCredentials:      "webauthn_credentials",
 }
@@ -51,6 +51,51 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for AuthAttemptScope
+const (
+	AuthAttemptScopeLoginUsername   string = "login_username"
+	AuthAttemptScopeLoginIP         string = "login_ip"
+	AuthAttemptScopePasswordResetIP string = "password_reset_ip"
+	AuthAttemptScopeMagicLinkIP     string = "magic_link_ip"
+)
+
+func AllAuthAttemptScope() []string {
+	return []string{
+		AuthAttemptScopeLoginUsername,
+		AuthAttemptScopeLoginIP,
+		AuthAttemptScopePasswordResetIP,
+		AuthAttemptScopeMagicLinkIP,
+	}
+}
+
+// Enum values for NotificationChannel
+const (
+	NotificationChannelPush  string = "push"
+	NotificationChannelEmail string = "email"
+)
+
+func AllNotificationChannel() []string {
+	return []string{
+		NotificationChannelPush,
+		NotificationChannelEmail,
+	}
+}
+
+// Enum values for PushBroadcastStatus
+const (
+	PushBroadcastStatusScheduled string = "scheduled"
+	PushBroadcastStatusSending   string = "sending"
+	PushBroadcastStatusSent      string = "sent"
+)
+
+func AllPushBroadcastStatus() []string {
+	return []string{
+		PushBroadcastStatusScheduled,
+		PushBroadcastStatusSending,
+		PushBroadcastStatusSent,
+	}
+}
+
 // Enum values for ProviderType
 const (
 	ProviderTypeFCM string = "fcm"
@@ -63,3 +108,33 @@ func AllProviderType() []string {
 		ProviderTypeApn,
 	}
 }
+
+// Enum values for PushDeliveryStatus
+const (
+	PushDeliveryStatusQueued       string = "queued"
+	PushDeliveryStatusSent         string = "sent"
+	PushDeliveryStatusFailed       string = "failed"
+	PushDeliveryStatusInvalidToken string = "invalid_token"
+)
+
+func AllPushDeliveryStatus() []string {
+	return []string{
+		PushDeliveryStatusQueued,
+		PushDeliveryStatusSent,
+		PushDeliveryStatusFailed,
+		PushDeliveryStatusInvalidToken,
+	}
+}
+
+// Enum values for WebauthnCeremony
+const (
+	WebauthnCeremonyRegistration string = "registration"
+	WebauthnCeremonyLogin        string = "login"
+)
+
+func AllWebauthnCeremony() []string {
+	return []string{
+		WebauthnCeremonyRegistration,
+		WebauthnCeremonyLogin,
+	}
+}
@@ -0,0 +1,953 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// EmailChangeRequest is an object representing the database table.
+type EmailChangeRequest struct {
+	ID                string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID            string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	OldEmail          string    `boil:"old_email" json:"old_email" toml:"old_email" yaml:"old_email"`
+	NewEmail          string    `boil:"new_email" json:"new_email" toml:"new_email" yaml:"new_email"`
+	ConfirmationToken string    `boil:"confirmation_token" json:"confirmation_token" toml:"confirmation_token" yaml:"confirmation_token"`
+	RevertToken       string    `boil:"revert_token" json:"revert_token" toml:"revert_token" yaml:"revert_token"`
+	ValidUntil        time.Time `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	RevertValidUntil  time.Time `boil:"revert_valid_until" json:"revert_valid_until" toml:"revert_valid_until" yaml:"revert_valid_until"`
+	ConfirmedAt       null.Time `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *emailChangeRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L emailChangeRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EmailChangeRequestColumns = struct {
+	ID                string
+	UserID            string
+	OldEmail          string
+	NewEmail          string
+	ConfirmationToken string
+	RevertToken       string
+	ValidUntil        string
+	RevertValidUntil  string
+	ConfirmedAt       string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "id",
+	UserID:            "user_id",
+	OldEmail:          "old_email",
+	NewEmail:          "new_email",
+	ConfirmationToken: "confirmation_token",
+	RevertToken:       "revert_token",
+	ValidUntil:        "valid_until",
+	RevertValidUntil:  "revert_valid_until",
+	ConfirmedAt:       "confirmed_at",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+}
+
+var EmailChangeRequestTableColumns = struct {
+	ID                string
+	UserID            string
+	OldEmail          string
+	NewEmail          string
+	ConfirmationToken string
+	RevertToken       string
+	ValidUntil        string
+	RevertValidUntil  string
+	ConfirmedAt       string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "email_change_requests.id",
+	UserID:            "email_change_requests.user_id",
+	OldEmail:          "email_change_requests.old_email",
+	NewEmail:          "email_change_requests.new_email",
+	ConfirmationToken: "email_change_requests.confirmation_token",
+	RevertToken:       "email_change_requests.revert_token",
+	ValidUntil:        "email_change_requests.valid_until",
+	RevertValidUntil:  "email_change_requests.revert_valid_until",
+	ConfirmedAt:       "email_change_requests.confirmed_at",
+	CreatedAt:         "email_change_requests.created_at",
+	UpdatedAt:         "email_change_requests.updated_at",
+}
+
+// Generated where
+
+var EmailChangeRequestWhere = struct {
+	ID                whereHelperstring
+	UserID            whereHelperstring
+	OldEmail          whereHelperstring
+	NewEmail          whereHelperstring
+	ConfirmationToken whereHelperstring
+	RevertToken       whereHelperstring
+	ValidUntil        whereHelpertime_Time
+	RevertValidUntil  whereHelpertime_Time
+	ConfirmedAt       whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperstring{field: "\"email_change_requests\".\"id\""},
+	UserID:            whereHelperstring{field: "\"email_change_requests\".\"user_id\""},
+	OldEmail:          whereHelperstring{field: "\"email_change_requests\".\"old_email\""},
+	NewEmail:          whereHelperstring{field: "\"email_change_requests\".\"new_email\""},
+	ConfirmationToken: whereHelperstring{field: "\"email_change_requests\".\"confirmation_token\""},
+	RevertToken:       whereHelperstring{field: "\"email_change_requests\".\"revert_token\""},
+	ValidUntil:        whereHelpertime_Time{field: "\"email_change_requests\".\"valid_until\""},
+	RevertValidUntil:  whereHelpertime_Time{field: "\"email_change_requests\".\"revert_valid_until\""},
+	ConfirmedAt:       whereHelpernull_Time{field: "\"email_change_requests\".\"confirmed_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"email_change_requests\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"email_change_requests\".\"updated_at\""},
+}
+
+// EmailChangeRequestRels is where relationship names are stored.
+var EmailChangeRequestRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// emailChangeRequestR is where relationships are stored.
+type emailChangeRequestR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*emailChangeRequestR) NewStruct() *emailChangeRequestR {
+	return &emailChangeRequestR{}
+}
+
+func (o *EmailChangeRequest) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *emailChangeRequestR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// emailChangeRequestL is where Load methods for each relationship are stored.
+type emailChangeRequestL struct{}
+
+var (
+	emailChangeRequestAllColumns            = []string{"id", "user_id", "old_email", "new_email", "confirmation_token", "revert_token", "valid_until", "revert_valid_until", "confirmed_at", "created_at", "updated_at"}
+	emailChangeRequestColumnsWithoutDefault = []string{"user_id", "old_email", "new_email", "valid_until", "revert_valid_until", "created_at", "updated_at"}
+	emailChangeRequestColumnsWithDefault    = []string{"id", "confirmation_token", "revert_token", "confirmed_at"}
+	emailChangeRequestPrimaryKeyColumns     = []string{"id"}
+	emailChangeRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// EmailChangeRequestSlice is an alias for a slice of pointers to EmailChangeRequest.
+	// This should almost always be used instead of []EmailChangeRequest.
+	EmailChangeRequestSlice []*EmailChangeRequest
+
+	emailChangeRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	emailChangeRequestType                 = reflect.TypeOf(&EmailChangeRequest{})
+	emailChangeRequestMapping              = queries.MakeStructMapping(emailChangeRequestType)
+	emailChangeRequestPrimaryKeyMapping, _ = queries.BindMapping(emailChangeRequestType, emailChangeRequestMapping, emailChangeRequestPrimaryKeyColumns)
+	emailChangeRequestInsertCacheMut       sync.RWMutex
+	emailChangeRequestInsertCache          = make(map[string]insertCache)
+	emailChangeRequestUpdateCacheMut       sync.RWMutex
+	emailChangeRequestUpdateCache          = make(map[string]updateCache)
+	emailChangeRequestUpsertCacheMut       sync.RWMutex
+	emailChangeRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single emailChangeRequest record from the query.
+func (q emailChangeRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*EmailChangeRequest, error) {
+	o := &EmailChangeRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for email_change_requests")
+	}
+
+	return o, nil
+}
+
+// All returns all EmailChangeRequest records from the query.
+func (q emailChangeRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (EmailChangeRequestSlice, error) {
+	var o []*EmailChangeRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to EmailChangeRequest slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all EmailChangeRequest records in the query.
+func (q emailChangeRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count email_change_requests rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q emailChangeRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if email_change_requests exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *EmailChangeRequest) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (emailChangeRequestL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeEmailChangeRequest interface{}, mods queries.Applicator) error {
+	var slice []*EmailChangeRequest
+	var object *EmailChangeRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeEmailChangeRequest.(*EmailChangeRequest)
+		if !ok {
+			object = new(EmailChangeRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeEmailChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeEmailChangeRequest))
+			}
+		}
+	} else {
+		s, ok := maybeEmailChangeRequest.(*[]*EmailChangeRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeEmailChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeEmailChangeRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &emailChangeRequestR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &emailChangeRequestR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.EmailChangeRequests = append(foreign.R.EmailChangeRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.EmailChangeRequests = append(foreign.R.EmailChangeRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the emailChangeRequest to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.EmailChangeRequests.
+func (o *EmailChangeRequest) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"email_change_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, emailChangeRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &emailChangeRequestR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			EmailChangeRequests: EmailChangeRequestSlice{o},
+		}
+	} else {
+		related.R.EmailChangeRequests = append(related.R.EmailChangeRequests, o)
+	}
+
+	return nil
+}
+
+// EmailChangeRequests retrieves all the records using an executor.
+func EmailChangeRequests(mods ...qm.QueryMod) emailChangeRequestQuery {
+	mods = append(mods, qm.From("\"email_change_requests\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"email_change_requests\".*"})
+	}
+
+	return emailChangeRequestQuery{q}
+}
+
+// FindEmailChangeRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEmailChangeRequest(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*EmailChangeRequest, error) {
+	emailChangeRequestObj := &EmailChangeRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"email_change_requests\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, emailChangeRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from email_change_requests")
+	}
+
+	return emailChangeRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *EmailChangeRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no email_change_requests provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(emailChangeRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	emailChangeRequestInsertCacheMut.RLock()
+	cache, cached := emailChangeRequestInsertCache[key]
+	emailChangeRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			emailChangeRequestAllColumns,
+			emailChangeRequestColumnsWithDefault,
+			emailChangeRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(emailChangeRequestType, emailChangeRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(emailChangeRequestType, emailChangeRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"email_change_requests\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"email_change_requests\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into email_change_requests")
+	}
+
+	if !cached {
+		emailChangeRequestInsertCacheMut.Lock()
+		emailChangeRequestInsertCache[key] = cache
+		emailChangeRequestInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the EmailChangeRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *EmailChangeRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	emailChangeRequestUpdateCacheMut.RLock()
+	cache, cached := emailChangeRequestUpdateCache[key]
+	emailChangeRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			emailChangeRequestAllColumns,
+			emailChangeRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update email_change_requests, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"email_change_requests\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, emailChangeRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(emailChangeRequestType, emailChangeRequestMapping, append(wl, emailChangeRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update email_change_requests row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for email_change_requests")
+	}
+
+	if !cached {
+		emailChangeRequestUpdateCacheMut.Lock()
+		emailChangeRequestUpdateCache[key] = cache
+		emailChangeRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q emailChangeRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for email_change_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for email_change_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EmailChangeRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailChangeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"email_change_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, emailChangeRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in emailChangeRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all emailChangeRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *EmailChangeRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no email_change_requests provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(emailChangeRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	emailChangeRequestUpsertCacheMut.RLock()
+	cache, cached := emailChangeRequestUpsertCache[key]
+	emailChangeRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			emailChangeRequestAllColumns,
+			emailChangeRequestColumnsWithDefault,
+			emailChangeRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			emailChangeRequestAllColumns,
+			emailChangeRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert email_change_requests, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(emailChangeRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(emailChangeRequestPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert email_change_requests, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(emailChangeRequestPrimaryKeyColumns))
+			copy(conflict, emailChangeRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"email_change_requests\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(emailChangeRequestType, emailChangeRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(emailChangeRequestType, emailChangeRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert email_change_requests")
+	}
+
+	if !cached {
+		emailChangeRequestUpsertCacheMut.Lock()
+		emailChangeRequestUpsertCache[key] = cache
+		emailChangeRequestUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single EmailChangeRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *EmailChangeRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no EmailChangeRequest provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), emailChangeRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"email_change_requests\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from email_change_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for email_change_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q emailChangeRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no emailChangeRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from email_change_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for email_change_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EmailChangeRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailChangeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"email_change_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, emailChangeRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from emailChangeRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for email_change_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *EmailChangeRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindEmailChangeRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EmailChangeRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EmailChangeRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailChangeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"email_change_requests\".* FROM \"email_change_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, emailChangeRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in EmailChangeRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EmailChangeRequestExists checks if the EmailChangeRequest row exists.
+func EmailChangeRequestExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"email_change_requests\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if email_change_requests exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the EmailChangeRequest row exists.
+func (o *EmailChangeRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return EmailChangeRequestExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,926 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// NotificationPreference is an object representing the database table.
+type NotificationPreference struct {
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Category  string    `boil:"category" json:"category" toml:"category" yaml:"category"`
+	Channel   string    `boil:"channel" json:"channel" toml:"channel" yaml:"channel"`
+	Enabled   bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *notificationPreferenceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationPreferenceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationPreferenceColumns = struct {
+	UserID    string
+	Category  string
+	Channel   string
+	Enabled   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	UserID:    "user_id",
+	Category:  "category",
+	Channel:   "channel",
+	Enabled:   "enabled",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var NotificationPreferenceTableColumns = struct {
+	UserID    string
+	Category  string
+	Channel   string
+	Enabled   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	UserID:    "notification_preferences.user_id",
+	Category:  "notification_preferences.category",
+	Channel:   "notification_preferences.channel",
+	Enabled:   "notification_preferences.enabled",
+	CreatedAt: "notification_preferences.created_at",
+	UpdatedAt: "notification_preferences.updated_at",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var NotificationPreferenceWhere = struct {
+	UserID    whereHelperstring
+	Category  whereHelperstring
+	Channel   whereHelperstring
+	Enabled   whereHelperbool
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	UserID:    whereHelperstring{field: "\"notification_preferences\".\"user_id\""},
+	Category:  whereHelperstring{field: "\"notification_preferences\".\"category\""},
+	Channel:   whereHelperstring{field: "\"notification_preferences\".\"channel\""},
+	Enabled:   whereHelperbool{field: "\"notification_preferences\".\"enabled\""},
+	CreatedAt: whereHelpertime_Time{field: "\"notification_preferences\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"notification_preferences\".\"updated_at\""},
+}
+
+// NotificationPreferenceRels is where relationship names are stored.
+var NotificationPreferenceRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// notificationPreferenceR is where relationships are stored.
+type notificationPreferenceR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationPreferenceR) NewStruct() *notificationPreferenceR {
+	return &notificationPreferenceR{}
+}
+
+func (o *NotificationPreference) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *notificationPreferenceR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// notificationPreferenceL is where Load methods for each relationship are stored.
+type notificationPreferenceL struct{}
+
+var (
+	notificationPreferenceAllColumns            = []string{"user_id", "category", "channel", "enabled", "created_at", "updated_at"}
+	notificationPreferenceColumnsWithoutDefault = []string{"user_id", "category", "channel", "enabled", "created_at", "updated_at"}
+	notificationPreferenceColumnsWithDefault    = []string{}
+	notificationPreferencePrimaryKeyColumns     = []string{"user_id", "category", "channel"}
+	notificationPreferenceGeneratedColumns      = []string{}
+)
+
+type (
+	// NotificationPreferenceSlice is an alias for a slice of pointers to NotificationPreference.
+	// This should almost always be used instead of []NotificationPreference.
+	NotificationPreferenceSlice []*NotificationPreference
+
+	notificationPreferenceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationPreferenceType                 = reflect.TypeOf(&NotificationPreference{})
+	notificationPreferenceMapping              = queries.MakeStructMapping(notificationPreferenceType)
+	notificationPreferencePrimaryKeyMapping, _ = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, notificationPreferencePrimaryKeyColumns)
+	notificationPreferenceInsertCacheMut       sync.RWMutex
+	notificationPreferenceInsertCache          = make(map[string]insertCache)
+	notificationPreferenceUpdateCacheMut       sync.RWMutex
+	notificationPreferenceUpdateCache          = make(map[string]updateCache)
+	notificationPreferenceUpsertCacheMut       sync.RWMutex
+	notificationPreferenceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single notificationPreference record from the query.
+func (q notificationPreferenceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*NotificationPreference, error) {
+	o := &NotificationPreference{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for notification_preferences")
+	}
+
+	return o, nil
+}
+
+// All returns all NotificationPreference records from the query.
+func (q notificationPreferenceQuery) All(ctx context.Context, exec boil.ContextExecutor) (NotificationPreferenceSlice, error) {
+	var o []*NotificationPreference
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to NotificationPreference slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all NotificationPreference records in the query.
+func (q notificationPreferenceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count notification_preferences rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationPreferenceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if notification_preferences exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *NotificationPreference) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationPreferenceL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationPreference interface{}, mods queries.Applicator) error {
+	var slice []*NotificationPreference
+	var object *NotificationPreference
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationPreference.(*NotificationPreference)
+		if !ok {
+			object = new(NotificationPreference)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationPreference)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationPreference))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationPreference.(*[]*NotificationPreference)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationPreference)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationPreference))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationPreferenceR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationPreferenceR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.NotificationPreferences = append(foreign.R.NotificationPreferences, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.NotificationPreferences = append(foreign.R.NotificationPreferences, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the notificationPreference to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.NotificationPreferences.
+func (o *NotificationPreference) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"notification_preferences\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.Category, o.Channel}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &notificationPreferenceR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			NotificationPreferences: NotificationPreferenceSlice{o},
+		}
+	} else {
+		related.R.NotificationPreferences = append(related.R.NotificationPreferences, o)
+	}
+
+	return nil
+}
+
+// NotificationPreferences retrieves all the records using an executor.
+func NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	mods = append(mods, qm.From("\"notification_preferences\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"notification_preferences\".*"})
+	}
+
+	return notificationPreferenceQuery{q}
+}
+
+// FindNotificationPreference retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationPreference(ctx context.Context, exec boil.ContextExecutor, userID string, category string, channel string, selectCols ...string) (*NotificationPreference, error) {
+	notificationPreferenceObj := &NotificationPreference{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"notification_preferences\" where \"user_id\"=$1 AND \"category\"=$2 AND \"channel\"=$3", sel,
+	)
+
+	q := queries.Raw(query, userID, category, channel)
+
+	err := q.Bind(ctx, exec, notificationPreferenceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from notification_preferences")
+	}
+
+	return notificationPreferenceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationPreference) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no notification_preferences provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationPreferenceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationPreferenceInsertCacheMut.RLock()
+	cache, cached := notificationPreferenceInsertCache[key]
+	notificationPreferenceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferenceColumnsWithDefault,
+			notificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"notification_preferences\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"notification_preferences\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into notification_preferences")
+	}
+
+	if !cached {
+		notificationPreferenceInsertCacheMut.Lock()
+		notificationPreferenceInsertCache[key] = cache
+		notificationPreferenceInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the NotificationPreference.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationPreference) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	notificationPreferenceUpdateCacheMut.RLock()
+	cache, cached := notificationPreferenceUpdateCache[key]
+	notificationPreferenceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferencePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update notification_preferences, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"notification_preferences\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, notificationPreferencePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, append(wl, notificationPreferencePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update notification_preferences row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for notification_preferences")
+	}
+
+	if !cached {
+		notificationPreferenceUpdateCacheMut.Lock()
+		notificationPreferenceUpdateCache[key] = cache
+		notificationPreferenceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationPreferenceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for notification_preferences")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for notification_preferences")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationPreferenceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"notification_preferences\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, notificationPreferencePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in notificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all notificationPreference")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationPreference) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no notification_preferences provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationPreferenceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationPreferenceUpsertCacheMut.RLock()
+	cache, cached := notificationPreferenceUpsertCache[key]
+	notificationPreferenceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferenceColumnsWithDefault,
+			notificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferencePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert notification_preferences, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationPreferenceAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(notificationPreferencePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert notification_preferences, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(notificationPreferencePrimaryKeyColumns))
+			copy(conflict, notificationPreferencePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"notification_preferences\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert notification_preferences")
+	}
+
+	if !cached {
+		notificationPreferenceUpsertCacheMut.Lock()
+		notificationPreferenceUpsertCache[key] = cache
+		notificationPreferenceUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single NotificationPreference record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationPreference) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no NotificationPreference provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationPreferencePrimaryKeyMapping)
+	sql := "DELETE FROM \"notification_preferences\" WHERE \"user_id\"=$1 AND \"category\"=$2 AND \"channel\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from notification_preferences")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for notification_preferences")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationPreferenceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no notificationPreferenceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from notification_preferences")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for notification_preferences")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationPreferenceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"notification_preferences\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, notificationPreferencePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from notificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for notification_preferences")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationPreference) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindNotificationPreference(ctx, exec, o.UserID, o.Category, o.Channel)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationPreferenceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationPreferenceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"notification_preferences\".* FROM \"notification_preferences\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, notificationPreferencePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in NotificationPreferenceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationPreferenceExists checks if the NotificationPreference row exists.
+func NotificationPreferenceExists(ctx context.Context, exec boil.ContextExecutor, userID string, category string, channel string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"notification_preferences\" where \"user_id\"=$1 AND \"category\"=$2 AND \"channel\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, category, channel)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, category, channel)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if notification_preferences exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the NotificationPreference row exists.
+func (o *NotificationPreference) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return NotificationPreferenceExists(ctx, exec, o.UserID, o.Category, o.Channel)
+}
@@ -0,0 +1,734 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OidcAuthState is an object representing the database table.
+type OidcAuthState struct {
+	State        string    `boil:"state" json:"state" toml:"state" yaml:"state"`
+	Provider     string    `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	Nonce        string    `boil:"nonce" json:"nonce" toml:"nonce" yaml:"nonce"`
+	CodeVerifier string    `boil:"code_verifier" json:"code_verifier" toml:"code_verifier" yaml:"code_verifier"`
+	ValidUntil   time.Time `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *oidcAuthStateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcAuthStateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcAuthStateColumns = struct {
+	State        string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ValidUntil   string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	State:        "state",
+	Provider:     "provider",
+	Nonce:        "nonce",
+	CodeVerifier: "code_verifier",
+	ValidUntil:   "valid_until",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var OidcAuthStateTableColumns = struct {
+	State        string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ValidUntil   string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	State:        "oidc_auth_states.state",
+	Provider:     "oidc_auth_states.provider",
+	Nonce:        "oidc_auth_states.nonce",
+	CodeVerifier: "oidc_auth_states.code_verifier",
+	ValidUntil:   "oidc_auth_states.valid_until",
+	CreatedAt:    "oidc_auth_states.created_at",
+	UpdatedAt:    "oidc_auth_states.updated_at",
+}
+
+// Generated where
+
+var OidcAuthStateWhere = struct {
+	State        whereHelperstring
+	Provider     whereHelperstring
+	Nonce        whereHelperstring
+	CodeVerifier whereHelperstring
+	ValidUntil   whereHelpertime_Time
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	State:        whereHelperstring{field: "\"oidc_auth_states\".\"state\""},
+	Provider:     whereHelperstring{field: "\"oidc_auth_states\".\"provider\""},
+	Nonce:        whereHelperstring{field: "\"oidc_auth_states\".\"nonce\""},
+	CodeVerifier: whereHelperstring{field: "\"oidc_auth_states\".\"code_verifier\""},
+	ValidUntil:   whereHelpertime_Time{field: "\"oidc_auth_states\".\"valid_until\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"oidc_auth_states\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"oidc_auth_states\".\"updated_at\""},
+}
+
+// OidcAuthStateRels is where relationship names are stored.
+var OidcAuthStateRels = struct {
+}{}
+
+// oidcAuthStateR is where relationships are stored.
+type oidcAuthStateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcAuthStateR) NewStruct() *oidcAuthStateR {
+	return &oidcAuthStateR{}
+}
+
+// oidcAuthStateL is where Load methods for each relationship are stored.
+type oidcAuthStateL struct{}
+
+var (
+	oidcAuthStateAllColumns            = []string{"state", "provider", "nonce", "code_verifier", "valid_until", "created_at", "updated_at"}
+	oidcAuthStateColumnsWithoutDefault = []string{"provider", "nonce", "code_verifier", "valid_until", "created_at", "updated_at"}
+	oidcAuthStateColumnsWithDefault    = []string{"state"}
+	oidcAuthStatePrimaryKeyColumns     = []string{"state"}
+	oidcAuthStateGeneratedColumns      = []string{}
+)
+
+type (
+	// OidcAuthStateSlice is an alias for a slice of pointers to OidcAuthState.
+	// This should almost always be used instead of []OidcAuthState.
+	OidcAuthStateSlice []*OidcAuthState
+
+	oidcAuthStateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcAuthStateType                 = reflect.TypeOf(&OidcAuthState{})
+	oidcAuthStateMapping              = queries.MakeStructMapping(oidcAuthStateType)
+	oidcAuthStatePrimaryKeyMapping, _ = queries.BindMapping(oidcAuthStateType, oidcAuthStateMapping, oidcAuthStatePrimaryKeyColumns)
+	oidcAuthStateInsertCacheMut       sync.RWMutex
+	oidcAuthStateInsertCache          = make(map[string]insertCache)
+	oidcAuthStateUpdateCacheMut       sync.RWMutex
+	oidcAuthStateUpdateCache          = make(map[string]updateCache)
+	oidcAuthStateUpsertCacheMut       sync.RWMutex
+	oidcAuthStateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single oidcAuthState record from the query.
+func (q oidcAuthStateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcAuthState, error) {
+	o := &OidcAuthState{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_auth_states")
+	}
+
+	return o, nil
+}
+
+// All returns all OidcAuthState records from the query.
+func (q oidcAuthStateQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcAuthStateSlice, error) {
+	var o []*OidcAuthState
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcAuthState slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OidcAuthState records in the query.
+func (q oidcAuthStateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_auth_states rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcAuthStateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_auth_states exists")
+	}
+
+	return count > 0, nil
+}
+
+// OidcAuthStates retrieves all the records using an executor.
+func OidcAuthStates(mods ...qm.QueryMod) oidcAuthStateQuery {
+	mods = append(mods, qm.From("\"oidc_auth_states\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oidc_auth_states\".*"})
+	}
+
+	return oidcAuthStateQuery{q}
+}
+
+// FindOidcAuthState retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcAuthState(ctx context.Context, exec boil.ContextExecutor, state string, selectCols ...string) (*OidcAuthState, error) {
+	oidcAuthStateObj := &OidcAuthState{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oidc_auth_states\" where \"state\"=$1", sel,
+	)
+
+	q := queries.Raw(query, state)
+
+	err := q.Bind(ctx, exec, oidcAuthStateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_auth_states")
+	}
+
+	return oidcAuthStateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcAuthState) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_auth_states provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcAuthStateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcAuthStateInsertCacheMut.RLock()
+	cache, cached := oidcAuthStateInsertCache[key]
+	oidcAuthStateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcAuthStateAllColumns,
+			oidcAuthStateColumnsWithDefault,
+			oidcAuthStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oidcAuthStateType, oidcAuthStateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcAuthStateType, oidcAuthStateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oidc_auth_states\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oidc_auth_states\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_auth_states")
+	}
+
+	if !cached {
+		oidcAuthStateInsertCacheMut.Lock()
+		oidcAuthStateInsertCache[key] = cache
+		oidcAuthStateInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the OidcAuthState.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcAuthState) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	oidcAuthStateUpdateCacheMut.RLock()
+	cache, cached := oidcAuthStateUpdateCache[key]
+	oidcAuthStateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcAuthStateAllColumns,
+			oidcAuthStatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_auth_states, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oidc_auth_states\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oidcAuthStatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcAuthStateType, oidcAuthStateMapping, append(wl, oidcAuthStatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_auth_states row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_auth_states")
+	}
+
+	if !cached {
+		oidcAuthStateUpdateCacheMut.Lock()
+		oidcAuthStateUpdateCache[key] = cache
+		oidcAuthStateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcAuthStateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_auth_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_auth_states")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcAuthStateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcAuthStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oidc_auth_states\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oidcAuthStatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcAuthState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcAuthState")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcAuthState) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no oidc_auth_states provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcAuthStateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcAuthStateUpsertCacheMut.RLock()
+	cache, cached := oidcAuthStateUpsertCache[key]
+	oidcAuthStateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			oidcAuthStateAllColumns,
+			oidcAuthStateColumnsWithDefault,
+			oidcAuthStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oidcAuthStateAllColumns,
+			oidcAuthStatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_auth_states, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(oidcAuthStateAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(oidcAuthStatePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert oidc_auth_states, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(oidcAuthStatePrimaryKeyColumns))
+			copy(conflict, oidcAuthStatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oidc_auth_states\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(oidcAuthStateType, oidcAuthStateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcAuthStateType, oidcAuthStateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_auth_states")
+	}
+
+	if !cached {
+		oidcAuthStateUpsertCacheMut.Lock()
+		oidcAuthStateUpsertCache[key] = cache
+		oidcAuthStateUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single OidcAuthState record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcAuthState) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcAuthState provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcAuthStatePrimaryKeyMapping)
+	sql := "DELETE FROM \"oidc_auth_states\" WHERE \"state\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_auth_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_auth_states")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcAuthStateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcAuthStateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_auth_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_auth_states")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcAuthStateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcAuthStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oidc_auth_states\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcAuthStatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcAuthState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_auth_states")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcAuthState) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcAuthState(ctx, exec, o.State)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcAuthStateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcAuthStateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcAuthStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oidc_auth_states\".* FROM \"oidc_auth_states\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcAuthStatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcAuthStateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcAuthStateExists checks if the OidcAuthState row exists.
+func OidcAuthStateExists(ctx context.Context, exec boil.ContextExecutor, state string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oidc_auth_states\" where \"state\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, state)
+	}
+	row := exec.QueryRowContext(ctx, sql, state)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_auth_states exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OidcAuthState row exists.
+func (o *OidcAuthState) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OidcAuthStateExists(ctx, exec, o.State)
+}
@@ -0,0 +1,591 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOidcAuthStates(t *testing.T) {
+	t.Parallel()
+
+	query := OidcAuthStates()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOidcAuthStatesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcAuthStatesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OidcAuthStates().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcAuthStatesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcAuthStateSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcAuthStatesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OidcAuthStateExists(ctx, tx, o.State)
+	if err != nil {
+		t.Errorf("Unable to check if OidcAuthState exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OidcAuthStateExists to return true, but got false.")
+	}
+}
+
+func testOidcAuthStatesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oidcAuthStateFound, err := FindOidcAuthState(ctx, tx, o.State)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oidcAuthStateFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOidcAuthStatesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OidcAuthStates().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcAuthStatesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OidcAuthStates().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOidcAuthStatesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oidcAuthStateOne := &OidcAuthState{}
+	oidcAuthStateTwo := &OidcAuthState{}
+	if err = randomize.Struct(seed, oidcAuthStateOne, oidcAuthStateDBTypes, false, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcAuthStateTwo, oidcAuthStateDBTypes, false, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcAuthStateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcAuthStateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcAuthStates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOidcAuthStatesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oidcAuthStateOne := &OidcAuthState{}
+	oidcAuthStateTwo := &OidcAuthState{}
+	if err = randomize.Struct(seed, oidcAuthStateOne, oidcAuthStateDBTypes, false, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcAuthStateTwo, oidcAuthStateDBTypes, false, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcAuthStateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcAuthStateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testOidcAuthStatesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcAuthStatesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(oidcAuthStatePrimaryKeyColumns, oidcAuthStateColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcAuthStatesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcAuthStatesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcAuthStateSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcAuthStatesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcAuthStates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oidcAuthStateDBTypes = map[string]string{`State`: `uuid`, `Provider`: `text`, `Nonce`: `text`, `CodeVerifier`: `text`, `ValidUntil`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testOidcAuthStatesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oidcAuthStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oidcAuthStateAllColumns) == len(oidcAuthStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOidcAuthStatesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcAuthStateAllColumns) == len(oidcAuthStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcAuthState{}
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcAuthStateDBTypes, true, oidcAuthStatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oidcAuthStateAllColumns, oidcAuthStatePrimaryKeyColumns) {
+		fields = oidcAuthStateAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oidcAuthStateAllColumns,
+			oidcAuthStatePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OidcAuthStateSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOidcAuthStatesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcAuthStateAllColumns) == len(oidcAuthStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OidcAuthState{}
+	if err = randomize.Struct(seed, &o, oidcAuthStateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcAuthState: %s", err)
+	}
+
+	count, err := OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oidcAuthStateDBTypes, false, oidcAuthStatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcAuthState struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcAuthState: %s", err)
+	}
+
+	count, err = OidcAuthStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
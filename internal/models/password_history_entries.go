@@ -0,0 +1,893 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PasswordHistoryEntry is an object representing the database table.
+type PasswordHistoryEntry struct {
+	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID       string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	PasswordHash string    `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *passwordHistoryEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L passwordHistoryEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PasswordHistoryEntryColumns = struct {
+	ID           string
+	UserID       string
+	PasswordHash string
+	CreatedAt    string
+}{
+	ID:           "id",
+	UserID:       "user_id",
+	PasswordHash: "password_hash",
+	CreatedAt:    "created_at",
+}
+
+var PasswordHistoryEntryTableColumns = struct {
+	ID           string
+	UserID       string
+	PasswordHash string
+	CreatedAt    string
+}{
+	ID:           "password_history_entries.id",
+	UserID:       "password_history_entries.user_id",
+	PasswordHash: "password_history_entries.password_hash",
+	CreatedAt:    "password_history_entries.created_at",
+}
+
+// Generated where
+
+var PasswordHistoryEntryWhere = struct {
+	ID           whereHelperstring
+	UserID       whereHelperstring
+	PasswordHash whereHelperstring
+	CreatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"password_history_entries\".\"id\""},
+	UserID:       whereHelperstring{field: "\"password_history_entries\".\"user_id\""},
+	PasswordHash: whereHelperstring{field: "\"password_history_entries\".\"password_hash\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"password_history_entries\".\"created_at\""},
+}
+
+// PasswordHistoryEntryRels is where relationship names are stored.
+var PasswordHistoryEntryRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// passwordHistoryEntryR is where relationships are stored.
+type passwordHistoryEntryR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*passwordHistoryEntryR) NewStruct() *passwordHistoryEntryR {
+	return &passwordHistoryEntryR{}
+}
+
+func (o *PasswordHistoryEntry) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *passwordHistoryEntryR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// passwordHistoryEntryL is where Load methods for each relationship are stored.
+type passwordHistoryEntryL struct{}
+
+var (
+	passwordHistoryEntryAllColumns            = []string{"id", "user_id", "password_hash", "created_at"}
+	passwordHistoryEntryColumnsWithoutDefault = []string{"user_id", "password_hash", "created_at"}
+	passwordHistoryEntryColumnsWithDefault    = []string{"id"}
+	passwordHistoryEntryPrimaryKeyColumns     = []string{"id"}
+	passwordHistoryEntryGeneratedColumns      = []string{}
+)
+
+type (
+	// PasswordHistoryEntrySlice is an alias for a slice of pointers to PasswordHistoryEntry.
+	// This should almost always be used instead of []PasswordHistoryEntry.
+	PasswordHistoryEntrySlice []*PasswordHistoryEntry
+
+	passwordHistoryEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	passwordHistoryEntryType                 = reflect.TypeOf(&PasswordHistoryEntry{})
+	passwordHistoryEntryMapping              = queries.MakeStructMapping(passwordHistoryEntryType)
+	passwordHistoryEntryPrimaryKeyMapping, _ = queries.BindMapping(passwordHistoryEntryType, passwordHistoryEntryMapping, passwordHistoryEntryPrimaryKeyColumns)
+	passwordHistoryEntryInsertCacheMut       sync.RWMutex
+	passwordHistoryEntryInsertCache          = make(map[string]insertCache)
+	passwordHistoryEntryUpdateCacheMut       sync.RWMutex
+	passwordHistoryEntryUpdateCache          = make(map[string]updateCache)
+	passwordHistoryEntryUpsertCacheMut       sync.RWMutex
+	passwordHistoryEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single passwordHistoryEntry record from the query.
+func (q passwordHistoryEntryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PasswordHistoryEntry, error) {
+	o := &PasswordHistoryEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for password_history_entries")
+	}
+
+	return o, nil
+}
+
+// All returns all PasswordHistoryEntry records from the query.
+func (q passwordHistoryEntryQuery) All(ctx context.Context, exec boil.ContextExecutor) (PasswordHistoryEntrySlice, error) {
+	var o []*PasswordHistoryEntry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PasswordHistoryEntry slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PasswordHistoryEntry records in the query.
+func (q passwordHistoryEntryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count password_history_entries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q passwordHistoryEntryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if password_history_entries exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *PasswordHistoryEntry) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (passwordHistoryEntryL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePasswordHistoryEntry interface{}, mods queries.Applicator) error {
+	var slice []*PasswordHistoryEntry
+	var object *PasswordHistoryEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybePasswordHistoryEntry.(*PasswordHistoryEntry)
+		if !ok {
+			object = new(PasswordHistoryEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePasswordHistoryEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePasswordHistoryEntry))
+			}
+		}
+	} else {
+		s, ok := maybePasswordHistoryEntry.(*[]*PasswordHistoryEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePasswordHistoryEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePasswordHistoryEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &passwordHistoryEntryR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &passwordHistoryEntryR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PasswordHistoryEntries = append(foreign.R.PasswordHistoryEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PasswordHistoryEntries = append(foreign.R.PasswordHistoryEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the passwordHistoryEntry to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PasswordHistoryEntries.
+func (o *PasswordHistoryEntry) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"password_history_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, passwordHistoryEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &passwordHistoryEntryR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PasswordHistoryEntries: PasswordHistoryEntrySlice{o},
+		}
+	} else {
+		related.R.PasswordHistoryEntries = append(related.R.PasswordHistoryEntries, o)
+	}
+
+	return nil
+}
+
+// PasswordHistoryEntries retrieves all the records using an executor.
+func PasswordHistoryEntries(mods ...qm.QueryMod) passwordHistoryEntryQuery {
+	mods = append(mods, qm.From("\"password_history_entries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"password_history_entries\".*"})
+	}
+
+	return passwordHistoryEntryQuery{q}
+}
+
+// FindPasswordHistoryEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPasswordHistoryEntry(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PasswordHistoryEntry, error) {
+	passwordHistoryEntryObj := &PasswordHistoryEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"password_history_entries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, passwordHistoryEntryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from password_history_entries")
+	}
+
+	return passwordHistoryEntryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PasswordHistoryEntry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no password_history_entries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(passwordHistoryEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	passwordHistoryEntryInsertCacheMut.RLock()
+	cache, cached := passwordHistoryEntryInsertCache[key]
+	passwordHistoryEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			passwordHistoryEntryAllColumns,
+			passwordHistoryEntryColumnsWithDefault,
+			passwordHistoryEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(passwordHistoryEntryType, passwordHistoryEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(passwordHistoryEntryType, passwordHistoryEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"password_history_entries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"password_history_entries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into password_history_entries")
+	}
+
+	if !cached {
+		passwordHistoryEntryInsertCacheMut.Lock()
+		passwordHistoryEntryInsertCache[key] = cache
+		passwordHistoryEntryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PasswordHistoryEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PasswordHistoryEntry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	passwordHistoryEntryUpdateCacheMut.RLock()
+	cache, cached := passwordHistoryEntryUpdateCache[key]
+	passwordHistoryEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			passwordHistoryEntryAllColumns,
+			passwordHistoryEntryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update password_history_entries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"password_history_entries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, passwordHistoryEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(passwordHistoryEntryType, passwordHistoryEntryMapping, append(wl, passwordHistoryEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update password_history_entries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for password_history_entries")
+	}
+
+	if !cached {
+		passwordHistoryEntryUpdateCacheMut.Lock()
+		passwordHistoryEntryUpdateCache[key] = cache
+		passwordHistoryEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q passwordHistoryEntryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for password_history_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for password_history_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PasswordHistoryEntrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passwordHistoryEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"password_history_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, passwordHistoryEntryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in passwordHistoryEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all passwordHistoryEntry")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PasswordHistoryEntry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no password_history_entries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(passwordHistoryEntryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	passwordHistoryEntryUpsertCacheMut.RLock()
+	cache, cached := passwordHistoryEntryUpsertCache[key]
+	passwordHistoryEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			passwordHistoryEntryAllColumns,
+			passwordHistoryEntryColumnsWithDefault,
+			passwordHistoryEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			passwordHistoryEntryAllColumns,
+			passwordHistoryEntryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert password_history_entries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(passwordHistoryEntryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(passwordHistoryEntryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert password_history_entries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(passwordHistoryEntryPrimaryKeyColumns))
+			copy(conflict, passwordHistoryEntryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"password_history_entries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(passwordHistoryEntryType, passwordHistoryEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(passwordHistoryEntryType, passwordHistoryEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert password_history_entries")
+	}
+
+	if !cached {
+		passwordHistoryEntryUpsertCacheMut.Lock()
+		passwordHistoryEntryUpsertCache[key] = cache
+		passwordHistoryEntryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PasswordHistoryEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PasswordHistoryEntry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PasswordHistoryEntry provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), passwordHistoryEntryPrimaryKeyMapping)
+	sql := "DELETE FROM \"password_history_entries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from password_history_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for password_history_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q passwordHistoryEntryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no passwordHistoryEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from password_history_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for password_history_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PasswordHistoryEntrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passwordHistoryEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"password_history_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, passwordHistoryEntryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from passwordHistoryEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for password_history_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PasswordHistoryEntry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPasswordHistoryEntry(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PasswordHistoryEntrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PasswordHistoryEntrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passwordHistoryEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"password_history_entries\".* FROM \"password_history_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, passwordHistoryEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PasswordHistoryEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PasswordHistoryEntryExists checks if the PasswordHistoryEntry row exists.
+func PasswordHistoryEntryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"password_history_entries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if password_history_entries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PasswordHistoryEntry row exists.
+func (o *PasswordHistoryEntry) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PasswordHistoryEntryExists(ctx, exec, o.ID)
+}
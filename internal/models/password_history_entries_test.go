@@ -0,0 +1,701 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPasswordHistoryEntries(t *testing.T) {
+	t.Parallel()
+
+	query := PasswordHistoryEntries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPasswordHistoryEntriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasswordHistoryEntriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PasswordHistoryEntries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasswordHistoryEntriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PasswordHistoryEntrySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasswordHistoryEntriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PasswordHistoryEntryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PasswordHistoryEntry exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PasswordHistoryEntryExists to return true, but got false.")
+	}
+}
+
+func testPasswordHistoryEntriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	passwordHistoryEntryFound, err := FindPasswordHistoryEntry(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if passwordHistoryEntryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPasswordHistoryEntriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PasswordHistoryEntries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasswordHistoryEntriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PasswordHistoryEntries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPasswordHistoryEntriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	passwordHistoryEntryOne := &PasswordHistoryEntry{}
+	passwordHistoryEntryTwo := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, passwordHistoryEntryOne, passwordHistoryEntryDBTypes, false, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, passwordHistoryEntryTwo, passwordHistoryEntryDBTypes, false, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = passwordHistoryEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = passwordHistoryEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PasswordHistoryEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPasswordHistoryEntriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	passwordHistoryEntryOne := &PasswordHistoryEntry{}
+	passwordHistoryEntryTwo := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, passwordHistoryEntryOne, passwordHistoryEntryDBTypes, false, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, passwordHistoryEntryTwo, passwordHistoryEntryDBTypes, false, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = passwordHistoryEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = passwordHistoryEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPasswordHistoryEntriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPasswordHistoryEntriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(passwordHistoryEntryPrimaryKeyColumns, passwordHistoryEntryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPasswordHistoryEntryToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PasswordHistoryEntry
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, passwordHistoryEntryDBTypes, false, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PasswordHistoryEntrySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PasswordHistoryEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testPasswordHistoryEntryToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PasswordHistoryEntry
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, passwordHistoryEntryDBTypes, false, strmangle.SetComplement(passwordHistoryEntryPrimaryKeyColumns, passwordHistoryEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PasswordHistoryEntries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testPasswordHistoryEntriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasswordHistoryEntriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PasswordHistoryEntrySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasswordHistoryEntriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PasswordHistoryEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	passwordHistoryEntryDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `PasswordHash`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_                           = bytes.MinRead
+)
+
+func testPasswordHistoryEntriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(passwordHistoryEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(passwordHistoryEntryAllColumns) == len(passwordHistoryEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPasswordHistoryEntriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(passwordHistoryEntryAllColumns) == len(passwordHistoryEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, passwordHistoryEntryDBTypes, true, passwordHistoryEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(passwordHistoryEntryAllColumns, passwordHistoryEntryPrimaryKeyColumns) {
+		fields = passwordHistoryEntryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			passwordHistoryEntryAllColumns,
+			passwordHistoryEntryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PasswordHistoryEntrySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPasswordHistoryEntriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(passwordHistoryEntryAllColumns) == len(passwordHistoryEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PasswordHistoryEntry{}
+	if err = randomize.Struct(seed, &o, passwordHistoryEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PasswordHistoryEntry: %s", err)
+	}
+
+	count, err := PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, passwordHistoryEntryDBTypes, false, passwordHistoryEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasswordHistoryEntry struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PasswordHistoryEntry: %s", err)
+	}
+
+	count, err = PasswordHistoryEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
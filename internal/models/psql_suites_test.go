@@ -6,17 +6,65 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AccessTokenDenylists", testAccessTokenDenylistsUpsert)
+
 	t.Run("AccessTokens", testAccessTokensUpsert)
 
+	t.Run("APIKeys", testAPIKeysUpsert)
+
 	t.Run("AppUserProfiles", testAppUserProfilesUpsert)
 
+	t.Run("AuditEvents", testAuditEventsUpsert)
+
+	t.Run("AuthFailedAttempts", testAuthFailedAttemptsUpsert)
+
 	t.Run("ConfirmationTokens", testConfirmationTokensUpsert)
 
+	t.Run("DataExports", testDataExportsUpsert)
+
+	t.Run("EmailChangeRequests", testEmailChangeRequestsUpsert)
+
+	t.Run("Invites", testInvitesUpsert)
+
+	t.Run("MagicLinkTokens", testMagicLinkTokensUpsert)
+
+	t.Run("NotificationPreferences", testNotificationPreferencesUpsert)
+
+	t.Run("OidcAuthStates", testOidcAuthStatesUpsert)
+
+	t.Run("PasswordHistoryEntries", testPasswordHistoryEntriesUpsert)
+
 	t.Run("PasswordResetTokens", testPasswordResetTokensUpsert)
 
+	t.Run("Permissions", testPermissionsUpsert)
+
+	t.Run("PushBroadcasts", testPushBroadcastsUpsert)
+
+	t.Run("PushDeliveries", testPushDeliveriesUpsert)
+
 	t.Run("PushTokens", testPushTokensUpsert)
 
+	t.Run("PushTopicSubscriptions", testPushTopicSubscriptionsUpsert)
+
+	t.Run("RateLimitBuckets", testRateLimitBucketsUpsert)
+
+	t.Run("RefreshTokenReuseEvents", testRefreshTokenReuseEventsUpsert)
+
 	t.Run("RefreshTokens", testRefreshTokensUpsert)
 
+	t.Run("Roles", testRolesUpsert)
+
+	t.Run("TotpRecoveryCodes", testTotpRecoveryCodesUpsert)
+
+	t.Run("TotpSecrets", testTotpSecretsUpsert)
+
+	t.Run("TwoFactorChallengeTokens", testTwoFactorChallengeTokensUpsert)
+
+	t.Run("UserIdentities", testUserIdentitiesUpsert)
+
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WebauthnChallenges", testWebauthnChallengesUpsert)
+
+	t.Run("WebauthnCredentials", testWebauthnCredentialsUpsert)
 }
@@ -0,0 +1,1040 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PushBroadcast is an object representing the database table.
+type PushBroadcast struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title       string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Body        string      `boil:"body" json:"body" toml:"body" yaml:"body"`
+	DeepLink    null.String `boil:"deep_link" json:"deep_link,omitempty" toml:"deep_link" yaml:"deep_link,omitempty"`
+	ImageURL    null.String `boil:"image_url" json:"image_url,omitempty" toml:"image_url" yaml:"image_url,omitempty"`
+	Data        types.JSON  `boil:"data" json:"data" toml:"data" yaml:"data"`
+	Topic       null.String `boil:"topic" json:"topic,omitempty" toml:"topic" yaml:"topic,omitempty"`
+	Scope       null.String `boil:"scope" json:"scope,omitempty" toml:"scope" yaml:"scope,omitempty"`
+	Locale      null.String `boil:"locale" json:"locale,omitempty" toml:"locale" yaml:"locale,omitempty"`
+	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ScheduledAt time.Time   `boil:"scheduled_at" json:"scheduled_at" toml:"scheduled_at" yaml:"scheduled_at"`
+	SentAt      null.Time   `boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+	SentCount   int         `boil:"sent_count" json:"sent_count" toml:"sent_count" yaml:"sent_count"`
+	FailedCount int         `boil:"failed_count" json:"failed_count" toml:"failed_count" yaml:"failed_count"`
+	CreatedByID null.String `boil:"created_by_id" json:"created_by_id,omitempty" toml:"created_by_id" yaml:"created_by_id,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Category    string      `boil:"category" json:"category" toml:"category" yaml:"category"`
+
+	R *pushBroadcastR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pushBroadcastL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PushBroadcastColumns = struct {
+	ID          string
+	Title       string
+	Body        string
+	DeepLink    string
+	ImageURL    string
+	Data        string
+	Topic       string
+	Scope       string
+	Locale      string
+	Status      string
+	ScheduledAt string
+	SentAt      string
+	SentCount   string
+	FailedCount string
+	CreatedByID string
+	CreatedAt   string
+	UpdatedAt   string
+	Category    string
+}{
+	ID:          "id",
+	Title:       "title",
+	Body:        "body",
+	DeepLink:    "deep_link",
+	ImageURL:    "image_url",
+	Data:        "data",
+	Topic:       "topic",
+	Scope:       "scope",
+	Locale:      "locale",
+	Status:      "status",
+	ScheduledAt: "scheduled_at",
+	SentAt:      "sent_at",
+	SentCount:   "sent_count",
+	FailedCount: "failed_count",
+	CreatedByID: "created_by_id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	Category:    "category",
+}
+
+var PushBroadcastTableColumns = struct {
+	ID          string
+	Title       string
+	Body        string
+	DeepLink    string
+	ImageURL    string
+	Data        string
+	Topic       string
+	Scope       string
+	Locale      string
+	Status      string
+	ScheduledAt string
+	SentAt      string
+	SentCount   string
+	FailedCount string
+	CreatedByID string
+	CreatedAt   string
+	UpdatedAt   string
+	Category    string
+}{
+	ID:          "push_broadcasts.id",
+	Title:       "push_broadcasts.title",
+	Body:        "push_broadcasts.body",
+	DeepLink:    "push_broadcasts.deep_link",
+	ImageURL:    "push_broadcasts.image_url",
+	Data:        "push_broadcasts.data",
+	Topic:       "push_broadcasts.topic",
+	Scope:       "push_broadcasts.scope",
+	Locale:      "push_broadcasts.locale",
+	Status:      "push_broadcasts.status",
+	ScheduledAt: "push_broadcasts.scheduled_at",
+	SentAt:      "push_broadcasts.sent_at",
+	SentCount:   "push_broadcasts.sent_count",
+	FailedCount: "push_broadcasts.failed_count",
+	CreatedByID: "push_broadcasts.created_by_id",
+	CreatedAt:   "push_broadcasts.created_at",
+	UpdatedAt:   "push_broadcasts.updated_at",
+	Category:    "push_broadcasts.category",
+}
+
+// Generated where
+
+var PushBroadcastWhere = struct {
+	ID          whereHelperstring
+	Title       whereHelperstring
+	Body        whereHelperstring
+	DeepLink    whereHelpernull_String
+	ImageURL    whereHelpernull_String
+	Data        whereHelpertypes_JSON
+	Topic       whereHelpernull_String
+	Scope       whereHelpernull_String
+	Locale      whereHelpernull_String
+	Status      whereHelperstring
+	ScheduledAt whereHelpertime_Time
+	SentAt      whereHelpernull_Time
+	SentCount   whereHelperint
+	FailedCount whereHelperint
+	CreatedByID whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	Category    whereHelperstring
+}{
+	ID:          whereHelperstring{field: "\"push_broadcasts\".\"id\""},
+	Title:       whereHelperstring{field: "\"push_broadcasts\".\"title\""},
+	Body:        whereHelperstring{field: "\"push_broadcasts\".\"body\""},
+	DeepLink:    whereHelpernull_String{field: "\"push_broadcasts\".\"deep_link\""},
+	ImageURL:    whereHelpernull_String{field: "\"push_broadcasts\".\"image_url\""},
+	Data:        whereHelpertypes_JSON{field: "\"push_broadcasts\".\"data\""},
+	Topic:       whereHelpernull_String{field: "\"push_broadcasts\".\"topic\""},
+	Scope:       whereHelpernull_String{field: "\"push_broadcasts\".\"scope\""},
+	Locale:      whereHelpernull_String{field: "\"push_broadcasts\".\"locale\""},
+	Status:      whereHelperstring{field: "\"push_broadcasts\".\"status\""},
+	ScheduledAt: whereHelpertime_Time{field: "\"push_broadcasts\".\"scheduled_at\""},
+	SentAt:      whereHelpernull_Time{field: "\"push_broadcasts\".\"sent_at\""},
+	SentCount:   whereHelperint{field: "\"push_broadcasts\".\"sent_count\""},
+	FailedCount: whereHelperint{field: "\"push_broadcasts\".\"failed_count\""},
+	CreatedByID: whereHelpernull_String{field: "\"push_broadcasts\".\"created_by_id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"push_broadcasts\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"push_broadcasts\".\"updated_at\""},
+	Category:    whereHelperstring{field: "\"push_broadcasts\".\"category\""},
+}
+
+// PushBroadcastRels is where relationship names are stored.
+var PushBroadcastRels = struct {
+	CreatedBy string
+}{
+	CreatedBy: "CreatedBy",
+}
+
+// pushBroadcastR is where relationships are stored.
+type pushBroadcastR struct {
+	CreatedBy *User `boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pushBroadcastR) NewStruct() *pushBroadcastR {
+	return &pushBroadcastR{}
+}
+
+func (o *PushBroadcast) GetCreatedBy() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedBy()
+}
+
+func (r *pushBroadcastR) GetCreatedBy() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedBy
+}
+
+// pushBroadcastL is where Load methods for each relationship are stored.
+type pushBroadcastL struct{}
+
+var (
+	pushBroadcastAllColumns            = []string{"id", "title", "body", "deep_link", "image_url", "data", "topic", "scope", "locale", "status", "scheduled_at", "sent_at", "sent_count", "failed_count", "created_by_id", "created_at", "updated_at", "category"}
+	pushBroadcastColumnsWithoutDefault = []string{"title", "body", "scheduled_at", "created_at", "updated_at"}
+	pushBroadcastColumnsWithDefault    = []string{"id", "deep_link", "image_url", "data", "topic", "scope", "locale", "status", "sent_at", "sent_count", "failed_count", "created_by_id", "category"}
+	pushBroadcastPrimaryKeyColumns     = []string{"id"}
+	pushBroadcastGeneratedColumns      = []string{}
+)
+
+type (
+	// PushBroadcastSlice is an alias for a slice of pointers to PushBroadcast.
+	// This should almost always be used instead of []PushBroadcast.
+	PushBroadcastSlice []*PushBroadcast
+
+	pushBroadcastQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pushBroadcastType                 = reflect.TypeOf(&PushBroadcast{})
+	pushBroadcastMapping              = queries.MakeStructMapping(pushBroadcastType)
+	pushBroadcastPrimaryKeyMapping, _ = queries.BindMapping(pushBroadcastType, pushBroadcastMapping, pushBroadcastPrimaryKeyColumns)
+	pushBroadcastInsertCacheMut       sync.RWMutex
+	pushBroadcastInsertCache          = make(map[string]insertCache)
+	pushBroadcastUpdateCacheMut       sync.RWMutex
+	pushBroadcastUpdateCache          = make(map[string]updateCache)
+	pushBroadcastUpsertCacheMut       sync.RWMutex
+	pushBroadcastUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single pushBroadcast record from the query.
+func (q pushBroadcastQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PushBroadcast, error) {
+	o := &PushBroadcast{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for push_broadcasts")
+	}
+
+	return o, nil
+}
+
+// All returns all PushBroadcast records from the query.
+func (q pushBroadcastQuery) All(ctx context.Context, exec boil.ContextExecutor) (PushBroadcastSlice, error) {
+	var o []*PushBroadcast
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PushBroadcast slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PushBroadcast records in the query.
+func (q pushBroadcastQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count push_broadcasts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pushBroadcastQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if push_broadcasts exists")
+	}
+
+	return count > 0, nil
+}
+
+// CreatedBy pointed to by the foreign key.
+func (o *PushBroadcast) CreatedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadCreatedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pushBroadcastL) LoadCreatedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushBroadcast interface{}, mods queries.Applicator) error {
+	var slice []*PushBroadcast
+	var object *PushBroadcast
+
+	if singular {
+		var ok bool
+		object, ok = maybePushBroadcast.(*PushBroadcast)
+		if !ok {
+			object = new(PushBroadcast)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushBroadcast)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushBroadcast))
+			}
+		}
+	} else {
+		s, ok := maybePushBroadcast.(*[]*PushBroadcast)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushBroadcast)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushBroadcast))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pushBroadcastR{}
+		}
+		if !queries.IsNil(object.CreatedByID) {
+			args[object.CreatedByID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushBroadcastR{}
+			}
+
+			if !queries.IsNil(obj.CreatedByID) {
+				args[obj.CreatedByID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByPushBroadcasts = append(foreign.R.CreatedByPushBroadcasts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedByID, foreign.ID) {
+				local.R.CreatedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByPushBroadcasts = append(foreign.R.CreatedByPushBroadcasts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatedBy of the pushBroadcast to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByPushBroadcasts.
+func (o *PushBroadcast) SetCreatedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"push_broadcasts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pushBroadcastPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedByID, related.ID)
+	if o.R == nil {
+		o.R = &pushBroadcastR{
+			CreatedBy: related,
+		}
+	} else {
+		o.R.CreatedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByPushBroadcasts: PushBroadcastSlice{o},
+		}
+	} else {
+		related.R.CreatedByPushBroadcasts = append(related.R.CreatedByPushBroadcasts, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedBy relationship.
+// Sets o.R.CreatedBy to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PushBroadcast) RemoveCreatedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByPushBroadcasts {
+		if queries.Equal(o.CreatedByID, ri.CreatedByID) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByPushBroadcasts)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByPushBroadcasts[i] = related.R.CreatedByPushBroadcasts[ln-1]
+		}
+		related.R.CreatedByPushBroadcasts = related.R.CreatedByPushBroadcasts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// PushBroadcasts retrieves all the records using an executor.
+func PushBroadcasts(mods ...qm.QueryMod) pushBroadcastQuery {
+	mods = append(mods, qm.From("\"push_broadcasts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"push_broadcasts\".*"})
+	}
+
+	return pushBroadcastQuery{q}
+}
+
+// FindPushBroadcast retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPushBroadcast(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PushBroadcast, error) {
+	pushBroadcastObj := &PushBroadcast{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"push_broadcasts\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pushBroadcastObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from push_broadcasts")
+	}
+
+	return pushBroadcastObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PushBroadcast) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_broadcasts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushBroadcastColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pushBroadcastInsertCacheMut.RLock()
+	cache, cached := pushBroadcastInsertCache[key]
+	pushBroadcastInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pushBroadcastAllColumns,
+			pushBroadcastColumnsWithDefault,
+			pushBroadcastColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pushBroadcastType, pushBroadcastMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pushBroadcastType, pushBroadcastMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"push_broadcasts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"push_broadcasts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into push_broadcasts")
+	}
+
+	if !cached {
+		pushBroadcastInsertCacheMut.Lock()
+		pushBroadcastInsertCache[key] = cache
+		pushBroadcastInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PushBroadcast.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PushBroadcast) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	pushBroadcastUpdateCacheMut.RLock()
+	cache, cached := pushBroadcastUpdateCache[key]
+	pushBroadcastUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pushBroadcastAllColumns,
+			pushBroadcastPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update push_broadcasts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"push_broadcasts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pushBroadcastPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pushBroadcastType, pushBroadcastMapping, append(wl, pushBroadcastPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update push_broadcasts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for push_broadcasts")
+	}
+
+	if !cached {
+		pushBroadcastUpdateCacheMut.Lock()
+		pushBroadcastUpdateCache[key] = cache
+		pushBroadcastUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pushBroadcastQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for push_broadcasts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for push_broadcasts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PushBroadcastSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushBroadcastPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"push_broadcasts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pushBroadcastPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pushBroadcast slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pushBroadcast")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PushBroadcast) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no push_broadcasts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushBroadcastColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pushBroadcastUpsertCacheMut.RLock()
+	cache, cached := pushBroadcastUpsertCache[key]
+	pushBroadcastUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pushBroadcastAllColumns,
+			pushBroadcastColumnsWithDefault,
+			pushBroadcastColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pushBroadcastAllColumns,
+			pushBroadcastPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert push_broadcasts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pushBroadcastAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pushBroadcastPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert push_broadcasts, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pushBroadcastPrimaryKeyColumns))
+			copy(conflict, pushBroadcastPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"push_broadcasts\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pushBroadcastType, pushBroadcastMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pushBroadcastType, pushBroadcastMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert push_broadcasts")
+	}
+
+	if !cached {
+		pushBroadcastUpsertCacheMut.Lock()
+		pushBroadcastUpsertCache[key] = cache
+		pushBroadcastUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PushBroadcast record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PushBroadcast) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PushBroadcast provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pushBroadcastPrimaryKeyMapping)
+	sql := "DELETE FROM \"push_broadcasts\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from push_broadcasts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for push_broadcasts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pushBroadcastQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pushBroadcastQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from push_broadcasts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_broadcasts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PushBroadcastSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushBroadcastPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"push_broadcasts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushBroadcastPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pushBroadcast slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_broadcasts")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PushBroadcast) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPushBroadcast(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PushBroadcastSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PushBroadcastSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushBroadcastPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"push_broadcasts\".* FROM \"push_broadcasts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushBroadcastPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PushBroadcastSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PushBroadcastExists checks if the PushBroadcast row exists.
+func PushBroadcastExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"push_broadcasts\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if push_broadcasts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PushBroadcast row exists.
+func (o *PushBroadcast) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PushBroadcastExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,752 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPushBroadcasts(t *testing.T) {
+	t.Parallel()
+
+	query := PushBroadcasts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPushBroadcastsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushBroadcastsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PushBroadcasts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushBroadcastsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushBroadcastSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushBroadcastsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PushBroadcastExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PushBroadcast exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PushBroadcastExists to return true, but got false.")
+	}
+}
+
+func testPushBroadcastsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pushBroadcastFound, err := FindPushBroadcast(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pushBroadcastFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPushBroadcastsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PushBroadcasts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushBroadcastsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PushBroadcasts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPushBroadcastsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pushBroadcastOne := &PushBroadcast{}
+	pushBroadcastTwo := &PushBroadcast{}
+	if err = randomize.Struct(seed, pushBroadcastOne, pushBroadcastDBTypes, false, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushBroadcastTwo, pushBroadcastDBTypes, false, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushBroadcastOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushBroadcastTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushBroadcasts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPushBroadcastsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pushBroadcastOne := &PushBroadcast{}
+	pushBroadcastTwo := &PushBroadcast{}
+	if err = randomize.Struct(seed, pushBroadcastOne, pushBroadcastDBTypes, false, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushBroadcastTwo, pushBroadcastDBTypes, false, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushBroadcastOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushBroadcastTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPushBroadcastsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushBroadcastsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(pushBroadcastPrimaryKeyColumns, pushBroadcastColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushBroadcastToOneUserUsingCreatedBy(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PushBroadcast
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CreatedByID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CreatedBy().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PushBroadcastSlice{&local}
+	if err = local.L.LoadCreatedBy(ctx, tx, false, (*[]*PushBroadcast)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CreatedBy = nil
+	if err = local.L.LoadCreatedBy(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testPushBroadcastToOneSetOpUserUsingCreatedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushBroadcast
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushBroadcastDBTypes, false, strmangle.SetComplement(pushBroadcastPrimaryKeyColumns, pushBroadcastColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetCreatedBy(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CreatedBy != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CreatedByPushBroadcasts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CreatedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedByID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CreatedByID))
+		reflect.Indirect(reflect.ValueOf(&a.CreatedByID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CreatedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedByID, x.ID)
+		}
+	}
+}
+
+func testPushBroadcastToOneRemoveOpUserUsingCreatedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushBroadcast
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushBroadcastDBTypes, false, strmangle.SetComplement(pushBroadcastPrimaryKeyColumns, pushBroadcastColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCreatedBy(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCreatedBy(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CreatedBy().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.CreatedBy != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CreatedByID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CreatedByPushBroadcasts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPushBroadcastsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushBroadcastsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushBroadcastSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushBroadcastsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushBroadcasts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pushBroadcastDBTypes = map[string]string{`ID`: `uuid`, `Title`: `text`, `Body`: `text`, `DeepLink`: `text`, `ImageURL`: `text`, `Data`: `jsonb`, `Topic`: `text`, `Scope`: `text`, `Locale`: `text`, `Status`: `enum.push_broadcast_status('scheduled','sending','sent')`, `ScheduledAt`: `timestamp with time zone`, `SentAt`: `timestamp with time zone`, `SentCount`: `integer`, `FailedCount`: `integer`, `CreatedByID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Category`: `text`}
+	_                    = bytes.MinRead
+)
+
+func testPushBroadcastsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pushBroadcastPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pushBroadcastAllColumns) == len(pushBroadcastPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPushBroadcastsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pushBroadcastAllColumns) == len(pushBroadcastPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushBroadcast{}
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushBroadcastDBTypes, true, pushBroadcastPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pushBroadcastAllColumns, pushBroadcastPrimaryKeyColumns) {
+		fields = pushBroadcastAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pushBroadcastAllColumns,
+			pushBroadcastPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PushBroadcastSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPushBroadcastsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pushBroadcastAllColumns) == len(pushBroadcastPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PushBroadcast{}
+	if err = randomize.Struct(seed, &o, pushBroadcastDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushBroadcast: %s", err)
+	}
+
+	count, err := PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pushBroadcastDBTypes, false, pushBroadcastPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushBroadcast struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushBroadcast: %s", err)
+	}
+
+	count, err = PushBroadcasts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
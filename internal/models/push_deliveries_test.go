@@ -0,0 +1,701 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPushDeliveries(t *testing.T) {
+	t.Parallel()
+
+	query := PushDeliveries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPushDeliveriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushDeliveriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PushDeliveries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushDeliveriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushDeliverySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushDeliveriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PushDeliveryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PushDelivery exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PushDeliveryExists to return true, but got false.")
+	}
+}
+
+func testPushDeliveriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pushDeliveryFound, err := FindPushDelivery(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pushDeliveryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPushDeliveriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PushDeliveries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushDeliveriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PushDeliveries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPushDeliveriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pushDeliveryOne := &PushDelivery{}
+	pushDeliveryTwo := &PushDelivery{}
+	if err = randomize.Struct(seed, pushDeliveryOne, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushDeliveryTwo, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushDeliveryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushDeliveryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPushDeliveriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pushDeliveryOne := &PushDelivery{}
+	pushDeliveryTwo := &PushDelivery{}
+	if err = randomize.Struct(seed, pushDeliveryOne, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushDeliveryTwo, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushDeliveryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushDeliveryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPushDeliveriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushDeliveriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(pushDeliveryPrimaryKeyColumns, pushDeliveryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushDeliveryToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PushDelivery
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PushDeliverySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PushDelivery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testPushDeliveryToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushDelivery
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushDeliveryDBTypes, false, strmangle.SetComplement(pushDeliveryPrimaryKeyColumns, pushDeliveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PushDeliveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testPushDeliveriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushDeliveriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushDeliverySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushDeliveriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pushDeliveryDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Provider`: `enum.provider_type('fcm','apn')`, `Token`: `text`, `Message`: `jsonb`, `Status`: `enum.push_delivery_status('queued','sent','failed','invalid_token')`, `Attempts`: `integer`, `NextAttemptAt`: `timestamp with time zone`, `LastError`: `text`, `SentAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testPushDeliveriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pushDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pushDeliveryAllColumns) == len(pushDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPushDeliveriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pushDeliveryAllColumns) == len(pushDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushDelivery{}
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushDeliveryDBTypes, true, pushDeliveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pushDeliveryAllColumns, pushDeliveryPrimaryKeyColumns) {
+		fields = pushDeliveryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pushDeliveryAllColumns,
+			pushDeliveryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PushDeliverySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPushDeliveriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pushDeliveryAllColumns) == len(pushDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PushDelivery{}
+	if err = randomize.Struct(seed, &o, pushDeliveryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushDelivery: %s", err)
+	}
+
+	count, err := PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pushDeliveryDBTypes, false, pushDeliveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushDelivery struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushDelivery: %s", err)
+	}
+
+	count, err = PushDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,12 +24,14 @@ import (
 
 // PushToken is an object representing the database table.
 type PushToken struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Token     string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	Provider  string    `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Token     string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	Provider  string      `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	UserID    string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SessionID null.String `boil:"session_id" json:"session_id,omitempty" toml:"session_id" yaml:"session_id,omitempty"`
+	Locale    null.String `boil:"locale" json:"locale,omitempty" toml:"locale" yaml:"locale,omitempty"`
 
 	R *pushTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L pushTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -41,6 +44,8 @@ var PushTokenColumns = struct {
 	UserID    string
 	CreatedAt string
 	UpdatedAt string
+	SessionID string
+	Locale    string
 }{
 	ID:        "id",
 	Token:     "token",
@@ -48,6 +53,8 @@ var PushTokenColumns = struct {
 	UserID:    "user_id",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	SessionID: "session_id",
+	Locale:    "locale",
 }
 
 var PushTokenTableColumns = struct {
@@ -57,6 +64,8 @@ var PushTokenTableColumns = struct {
 	UserID    string
 	CreatedAt string
 	UpdatedAt string
+	SessionID string
+	Locale    string
 }{
 	ID:        "push_tokens.id",
 	Token:     "push_tokens.token",
@@ -64,6 +73,8 @@ var PushTokenTableColumns = struct {
 	UserID:    "push_tokens.user_id",
 	CreatedAt: "push_tokens.created_at",
 	UpdatedAt: "push_tokens.updated_at",
+	SessionID: "push_tokens.session_id",
+	Locale:    "push_tokens.locale",
 }
 
 // Generated where
@@ -75,6 +86,8 @@ var PushTokenWhere = struct {
 	UserID    whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	SessionID whereHelpernull_String
+	Locale    whereHelpernull_String
 }{
 	ID:        whereHelperstring{field: "\"push_tokens\".\"id\""},
 	Token:     whereHelperstring{field: "\"push_tokens\".\"token\""},
@@ -82,6 +95,8 @@ var PushTokenWhere = struct {
 	UserID:    whereHelperstring{field: "\"push_tokens\".\"user_id\""},
 	CreatedAt: whereHelpertime_Time{field: "\"push_tokens\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"push_tokens\".\"updated_at\""},
+	SessionID: whereHelpernull_String{field: "\"push_tokens\".\"session_id\""},
+	Locale:    whereHelpernull_String{field: "\"push_tokens\".\"locale\""},
 }
 
 // PushTokenRels is where relationship names are stored.
@@ -121,9 +136,9 @@ func (r *pushTokenR) GetUser() *User {
 type pushTokenL struct{}
 
 var (
-	pushTokenAllColumns            = []string{"id", "token", "provider", "user_id", "created_at", "updated_at"}
+	pushTokenAllColumns            = []string{"id", "token", "provider", "user_id", "created_at", "updated_at", "session_id", "locale"}
 	pushTokenColumnsWithoutDefault = []string{"token", "provider", "user_id", "created_at", "updated_at"}
-	pushTokenColumnsWithDefault    = []string{"id"}
+	pushTokenColumnsWithDefault    = []string{"id", "session_id", "locale"}
 	pushTokenPrimaryKeyColumns     = []string{"id"}
 	pushTokenGeneratedColumns      = []string{}
 )
@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PushTopicSubscription is an object representing the database table.
+type PushTopicSubscription struct {
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Topic     string    `boil:"topic" json:"topic" toml:"topic" yaml:"topic"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *pushTopicSubscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pushTopicSubscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PushTopicSubscriptionColumns = struct {
+	UserID    string
+	Topic     string
+	CreatedAt string
+}{
+	UserID:    "user_id",
+	Topic:     "topic",
+	CreatedAt: "created_at",
+}
+
+var PushTopicSubscriptionTableColumns = struct {
+	UserID    string
+	Topic     string
+	CreatedAt string
+}{
+	UserID:    "push_topic_subscriptions.user_id",
+	Topic:     "push_topic_subscriptions.topic",
+	CreatedAt: "push_topic_subscriptions.created_at",
+}
+
+// Generated where
+
+var PushTopicSubscriptionWhere = struct {
+	UserID    whereHelperstring
+	Topic     whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	UserID:    whereHelperstring{field: "\"push_topic_subscriptions\".\"user_id\""},
+	Topic:     whereHelperstring{field: "\"push_topic_subscriptions\".\"topic\""},
+	CreatedAt: whereHelpertime_Time{field: "\"push_topic_subscriptions\".\"created_at\""},
+}
+
+// PushTopicSubscriptionRels is where relationship names are stored.
+var PushTopicSubscriptionRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// pushTopicSubscriptionR is where relationships are stored.
+type pushTopicSubscriptionR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pushTopicSubscriptionR) NewStruct() *pushTopicSubscriptionR {
+	return &pushTopicSubscriptionR{}
+}
+
+func (o *PushTopicSubscription) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *pushTopicSubscriptionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// pushTopicSubscriptionL is where Load methods for each relationship are stored.
+type pushTopicSubscriptionL struct{}
+
+var (
+	pushTopicSubscriptionAllColumns            = []string{"user_id", "topic", "created_at"}
+	pushTopicSubscriptionColumnsWithoutDefault = []string{"user_id", "topic", "created_at"}
+	pushTopicSubscriptionColumnsWithDefault    = []string{}
+	pushTopicSubscriptionPrimaryKeyColumns     = []string{"user_id", "topic"}
+	pushTopicSubscriptionGeneratedColumns      = []string{}
+)
+
+type (
+	// PushTopicSubscriptionSlice is an alias for a slice of pointers to PushTopicSubscription.
+	// This should almost always be used instead of []PushTopicSubscription.
+	PushTopicSubscriptionSlice []*PushTopicSubscription
+
+	pushTopicSubscriptionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pushTopicSubscriptionType                 = reflect.TypeOf(&PushTopicSubscription{})
+	pushTopicSubscriptionMapping              = queries.MakeStructMapping(pushTopicSubscriptionType)
+	pushTopicSubscriptionPrimaryKeyMapping, _ = queries.BindMapping(pushTopicSubscriptionType, pushTopicSubscriptionMapping, pushTopicSubscriptionPrimaryKeyColumns)
+	pushTopicSubscriptionInsertCacheMut       sync.RWMutex
+	pushTopicSubscriptionInsertCache          = make(map[string]insertCache)
+	pushTopicSubscriptionUpdateCacheMut       sync.RWMutex
+	pushTopicSubscriptionUpdateCache          = make(map[string]updateCache)
+	pushTopicSubscriptionUpsertCacheMut       sync.RWMutex
+	pushTopicSubscriptionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single pushTopicSubscription record from the query.
+func (q pushTopicSubscriptionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PushTopicSubscription, error) {
+	o := &PushTopicSubscription{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for push_topic_subscriptions")
+	}
+
+	return o, nil
+}
+
+// All returns all PushTopicSubscription records from the query.
+func (q pushTopicSubscriptionQuery) All(ctx context.Context, exec boil.ContextExecutor) (PushTopicSubscriptionSlice, error) {
+	var o []*PushTopicSubscription
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PushTopicSubscription slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PushTopicSubscription records in the query.
+func (q pushTopicSubscriptionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count push_topic_subscriptions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pushTopicSubscriptionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if push_topic_subscriptions exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *PushTopicSubscription) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pushTopicSubscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushTopicSubscription interface{}, mods queries.Applicator) error {
+	var slice []*PushTopicSubscription
+	var object *PushTopicSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybePushTopicSubscription.(*PushTopicSubscription)
+		if !ok {
+			object = new(PushTopicSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushTopicSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushTopicSubscription))
+			}
+		}
+	} else {
+		s, ok := maybePushTopicSubscription.(*[]*PushTopicSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushTopicSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushTopicSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pushTopicSubscriptionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushTopicSubscriptionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PushTopicSubscriptions = append(foreign.R.PushTopicSubscriptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PushTopicSubscriptions = append(foreign.R.PushTopicSubscriptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the pushTopicSubscription to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PushTopicSubscriptions.
+func (o *PushTopicSubscription) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"push_topic_subscriptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pushTopicSubscriptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.Topic}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &pushTopicSubscriptionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PushTopicSubscriptions: PushTopicSubscriptionSlice{o},
+		}
+	} else {
+		related.R.PushTopicSubscriptions = append(related.R.PushTopicSubscriptions, o)
+	}
+
+	return nil
+}
+
+// PushTopicSubscriptions retrieves all the records using an executor.
+func PushTopicSubscriptions(mods ...qm.QueryMod) pushTopicSubscriptionQuery {
+	mods = append(mods, qm.From("\"push_topic_subscriptions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"push_topic_subscriptions\".*"})
+	}
+
+	return pushTopicSubscriptionQuery{q}
+}
+
+// FindPushTopicSubscription retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPushTopicSubscription(ctx context.Context, exec boil.ContextExecutor, userID string, topic string, selectCols ...string) (*PushTopicSubscription, error) {
+	pushTopicSubscriptionObj := &PushTopicSubscription{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"push_topic_subscriptions\" where \"user_id\"=$1 AND \"topic\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, topic)
+
+	err := q.Bind(ctx, exec, pushTopicSubscriptionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from push_topic_subscriptions")
+	}
+
+	return pushTopicSubscriptionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PushTopicSubscription) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_topic_subscriptions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushTopicSubscriptionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pushTopicSubscriptionInsertCacheMut.RLock()
+	cache, cached := pushTopicSubscriptionInsertCache[key]
+	pushTopicSubscriptionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pushTopicSubscriptionAllColumns,
+			pushTopicSubscriptionColumnsWithDefault,
+			pushTopicSubscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pushTopicSubscriptionType, pushTopicSubscriptionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pushTopicSubscriptionType, pushTopicSubscriptionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"push_topic_subscriptions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"push_topic_subscriptions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into push_topic_subscriptions")
+	}
+
+	if !cached {
+		pushTopicSubscriptionInsertCacheMut.Lock()
+		pushTopicSubscriptionInsertCache[key] = cache
+		pushTopicSubscriptionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PushTopicSubscription.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PushTopicSubscription) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	pushTopicSubscriptionUpdateCacheMut.RLock()
+	cache, cached := pushTopicSubscriptionUpdateCache[key]
+	pushTopicSubscriptionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pushTopicSubscriptionAllColumns,
+			pushTopicSubscriptionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update push_topic_subscriptions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"push_topic_subscriptions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pushTopicSubscriptionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pushTopicSubscriptionType, pushTopicSubscriptionMapping, append(wl, pushTopicSubscriptionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update push_topic_subscriptions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for push_topic_subscriptions")
+	}
+
+	if !cached {
+		pushTopicSubscriptionUpdateCacheMut.Lock()
+		pushTopicSubscriptionUpdateCache[key] = cache
+		pushTopicSubscriptionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pushTopicSubscriptionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for push_topic_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for push_topic_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PushTopicSubscriptionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushTopicSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"push_topic_subscriptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pushTopicSubscriptionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pushTopicSubscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pushTopicSubscription")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PushTopicSubscription) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no push_topic_subscriptions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushTopicSubscriptionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pushTopicSubscriptionUpsertCacheMut.RLock()
+	cache, cached := pushTopicSubscriptionUpsertCache[key]
+	pushTopicSubscriptionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pushTopicSubscriptionAllColumns,
+			pushTopicSubscriptionColumnsWithDefault,
+			pushTopicSubscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pushTopicSubscriptionAllColumns,
+			pushTopicSubscriptionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert push_topic_subscriptions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pushTopicSubscriptionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pushTopicSubscriptionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert push_topic_subscriptions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pushTopicSubscriptionPrimaryKeyColumns))
+			copy(conflict, pushTopicSubscriptionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"push_topic_subscriptions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pushTopicSubscriptionType, pushTopicSubscriptionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pushTopicSubscriptionType, pushTopicSubscriptionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert push_topic_subscriptions")
+	}
+
+	if !cached {
+		pushTopicSubscriptionUpsertCacheMut.Lock()
+		pushTopicSubscriptionUpsertCache[key] = cache
+		pushTopicSubscriptionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PushTopicSubscription record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PushTopicSubscription) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PushTopicSubscription provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pushTopicSubscriptionPrimaryKeyMapping)
+	sql := "DELETE FROM \"push_topic_subscriptions\" WHERE \"user_id\"=$1 AND \"topic\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from push_topic_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for push_topic_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pushTopicSubscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pushTopicSubscriptionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from push_topic_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_topic_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PushTopicSubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushTopicSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"push_topic_subscriptions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushTopicSubscriptionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pushTopicSubscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_topic_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PushTopicSubscription) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPushTopicSubscription(ctx, exec, o.UserID, o.Topic)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PushTopicSubscriptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PushTopicSubscriptionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushTopicSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"push_topic_subscriptions\".* FROM \"push_topic_subscriptions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushTopicSubscriptionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PushTopicSubscriptionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PushTopicSubscriptionExists checks if the PushTopicSubscription row exists.
+func PushTopicSubscriptionExists(ctx context.Context, exec boil.ContextExecutor, userID string, topic string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"push_topic_subscriptions\" where \"user_id\"=$1 AND \"topic\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, topic)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, topic)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if push_topic_subscriptions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PushTopicSubscription row exists.
+func (o *PushTopicSubscription) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PushTopicSubscriptionExists(ctx, exec, o.UserID, o.Topic)
+}
@@ -0,0 +1,697 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPushTopicSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	query := PushTopicSubscriptions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPushTopicSubscriptionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushTopicSubscriptionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PushTopicSubscriptions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushTopicSubscriptionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushTopicSubscriptionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushTopicSubscriptionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PushTopicSubscriptionExists(ctx, tx, o.UserID, o.Topic)
+	if err != nil {
+		t.Errorf("Unable to check if PushTopicSubscription exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PushTopicSubscriptionExists to return true, but got false.")
+	}
+}
+
+func testPushTopicSubscriptionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pushTopicSubscriptionFound, err := FindPushTopicSubscription(ctx, tx, o.UserID, o.Topic)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pushTopicSubscriptionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPushTopicSubscriptionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PushTopicSubscriptions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushTopicSubscriptionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PushTopicSubscriptions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPushTopicSubscriptionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pushTopicSubscriptionOne := &PushTopicSubscription{}
+	pushTopicSubscriptionTwo := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, pushTopicSubscriptionOne, pushTopicSubscriptionDBTypes, false, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushTopicSubscriptionTwo, pushTopicSubscriptionDBTypes, false, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushTopicSubscriptionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushTopicSubscriptionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushTopicSubscriptions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPushTopicSubscriptionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pushTopicSubscriptionOne := &PushTopicSubscription{}
+	pushTopicSubscriptionTwo := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, pushTopicSubscriptionOne, pushTopicSubscriptionDBTypes, false, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushTopicSubscriptionTwo, pushTopicSubscriptionDBTypes, false, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushTopicSubscriptionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushTopicSubscriptionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPushTopicSubscriptionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushTopicSubscriptionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(pushTopicSubscriptionPrimaryKeyColumns, pushTopicSubscriptionColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushTopicSubscriptionToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PushTopicSubscription
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pushTopicSubscriptionDBTypes, false, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PushTopicSubscriptionSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PushTopicSubscription)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testPushTopicSubscriptionToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushTopicSubscription
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushTopicSubscriptionDBTypes, false, strmangle.SetComplement(pushTopicSubscriptionPrimaryKeyColumns, pushTopicSubscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PushTopicSubscriptions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := PushTopicSubscriptionExists(ctx, tx, a.UserID, a.Topic); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testPushTopicSubscriptionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushTopicSubscriptionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushTopicSubscriptionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushTopicSubscriptionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushTopicSubscriptions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pushTopicSubscriptionDBTypes = map[string]string{`UserID`: `uuid`, `Topic`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_                            = bytes.MinRead
+)
+
+func testPushTopicSubscriptionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pushTopicSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pushTopicSubscriptionAllColumns) == len(pushTopicSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPushTopicSubscriptionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pushTopicSubscriptionAllColumns) == len(pushTopicSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushTopicSubscription{}
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushTopicSubscriptionDBTypes, true, pushTopicSubscriptionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pushTopicSubscriptionAllColumns, pushTopicSubscriptionPrimaryKeyColumns) {
+		fields = pushTopicSubscriptionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pushTopicSubscriptionAllColumns,
+			pushTopicSubscriptionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PushTopicSubscriptionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPushTopicSubscriptionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pushTopicSubscriptionAllColumns) == len(pushTopicSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PushTopicSubscription{}
+	if err = randomize.Struct(seed, &o, pushTopicSubscriptionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushTopicSubscription: %s", err)
+	}
+
+	count, err := PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pushTopicSubscriptionDBTypes, false, pushTopicSubscriptionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushTopicSubscription struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushTopicSubscription: %s", err)
+	}
+
+	count, err = PushTopicSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
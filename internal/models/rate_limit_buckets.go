@@ -0,0 +1,756 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RateLimitBucket is an object representing the database table.
+type RateLimitBucket struct {
+	Key        string    `boil:"key" json:"key" toml:"key" yaml:"key"`
+	Tokens     float64   `boil:"tokens" json:"tokens" toml:"tokens" yaml:"tokens"`
+	RefilledAt time.Time `boil:"refilled_at" json:"refilled_at" toml:"refilled_at" yaml:"refilled_at"`
+	ExpiresAt  time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *rateLimitBucketR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rateLimitBucketL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RateLimitBucketColumns = struct {
+	Key        string
+	Tokens     string
+	RefilledAt string
+	ExpiresAt  string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Key:        "key",
+	Tokens:     "tokens",
+	RefilledAt: "refilled_at",
+	ExpiresAt:  "expires_at",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var RateLimitBucketTableColumns = struct {
+	Key        string
+	Tokens     string
+	RefilledAt string
+	ExpiresAt  string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Key:        "rate_limit_buckets.key",
+	Tokens:     "rate_limit_buckets.tokens",
+	RefilledAt: "rate_limit_buckets.refilled_at",
+	ExpiresAt:  "rate_limit_buckets.expires_at",
+	CreatedAt:  "rate_limit_buckets.created_at",
+	UpdatedAt:  "rate_limit_buckets.updated_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var RateLimitBucketWhere = struct {
+	Key        whereHelperstring
+	Tokens     whereHelperfloat64
+	RefilledAt whereHelpertime_Time
+	ExpiresAt  whereHelpertime_Time
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	Key:        whereHelperstring{field: "\"rate_limit_buckets\".\"key\""},
+	Tokens:     whereHelperfloat64{field: "\"rate_limit_buckets\".\"tokens\""},
+	RefilledAt: whereHelpertime_Time{field: "\"rate_limit_buckets\".\"refilled_at\""},
+	ExpiresAt:  whereHelpertime_Time{field: "\"rate_limit_buckets\".\"expires_at\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"rate_limit_buckets\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"rate_limit_buckets\".\"updated_at\""},
+}
+
+// RateLimitBucketRels is where relationship names are stored.
+var RateLimitBucketRels = struct {
+}{}
+
+// rateLimitBucketR is where relationships are stored.
+type rateLimitBucketR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*rateLimitBucketR) NewStruct() *rateLimitBucketR {
+	return &rateLimitBucketR{}
+}
+
+// rateLimitBucketL is where Load methods for each relationship are stored.
+type rateLimitBucketL struct{}
+
+var (
+	rateLimitBucketAllColumns            = []string{"key", "tokens", "refilled_at", "expires_at", "created_at", "updated_at"}
+	rateLimitBucketColumnsWithoutDefault = []string{"key", "tokens", "refilled_at", "expires_at", "created_at", "updated_at"}
+	rateLimitBucketColumnsWithDefault    = []string{}
+	rateLimitBucketPrimaryKeyColumns     = []string{"key"}
+	rateLimitBucketGeneratedColumns      = []string{}
+)
+
+type (
+	// RateLimitBucketSlice is an alias for a slice of pointers to RateLimitBucket.
+	// This should almost always be used instead of []RateLimitBucket.
+	RateLimitBucketSlice []*RateLimitBucket
+
+	rateLimitBucketQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rateLimitBucketType                 = reflect.TypeOf(&RateLimitBucket{})
+	rateLimitBucketMapping              = queries.MakeStructMapping(rateLimitBucketType)
+	rateLimitBucketPrimaryKeyMapping, _ = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, rateLimitBucketPrimaryKeyColumns)
+	rateLimitBucketInsertCacheMut       sync.RWMutex
+	rateLimitBucketInsertCache          = make(map[string]insertCache)
+	rateLimitBucketUpdateCacheMut       sync.RWMutex
+	rateLimitBucketUpdateCache          = make(map[string]updateCache)
+	rateLimitBucketUpsertCacheMut       sync.RWMutex
+	rateLimitBucketUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single rateLimitBucket record from the query.
+func (q rateLimitBucketQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RateLimitBucket, error) {
+	o := &RateLimitBucket{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rate_limit_buckets")
+	}
+
+	return o, nil
+}
+
+// All returns all RateLimitBucket records from the query.
+func (q rateLimitBucketQuery) All(ctx context.Context, exec boil.ContextExecutor) (RateLimitBucketSlice, error) {
+	var o []*RateLimitBucket
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RateLimitBucket slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RateLimitBucket records in the query.
+func (q rateLimitBucketQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rate_limit_buckets rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rateLimitBucketQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rate_limit_buckets exists")
+	}
+
+	return count > 0, nil
+}
+
+// RateLimitBuckets retrieves all the records using an executor.
+func RateLimitBuckets(mods ...qm.QueryMod) rateLimitBucketQuery {
+	mods = append(mods, qm.From("\"rate_limit_buckets\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rate_limit_buckets\".*"})
+	}
+
+	return rateLimitBucketQuery{q}
+}
+
+// FindRateLimitBucket retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRateLimitBucket(ctx context.Context, exec boil.ContextExecutor, key string, selectCols ...string) (*RateLimitBucket, error) {
+	rateLimitBucketObj := &RateLimitBucket{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rate_limit_buckets\" where \"key\"=$1", sel,
+	)
+
+	q := queries.Raw(query, key)
+
+	err := q.Bind(ctx, exec, rateLimitBucketObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rate_limit_buckets")
+	}
+
+	return rateLimitBucketObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RateLimitBucket) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rate_limit_buckets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitBucketColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rateLimitBucketInsertCacheMut.RLock()
+	cache, cached := rateLimitBucketInsertCache[key]
+	rateLimitBucketInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketColumnsWithDefault,
+			rateLimitBucketColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rate_limit_buckets\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rate_limit_buckets\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rate_limit_buckets")
+	}
+
+	if !cached {
+		rateLimitBucketInsertCacheMut.Lock()
+		rateLimitBucketInsertCache[key] = cache
+		rateLimitBucketInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the RateLimitBucket.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RateLimitBucket) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	rateLimitBucketUpdateCacheMut.RLock()
+	cache, cached := rateLimitBucketUpdateCache[key]
+	rateLimitBucketUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rate_limit_buckets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rate_limit_buckets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rateLimitBucketPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, append(wl, rateLimitBucketPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rate_limit_buckets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rate_limit_buckets")
+	}
+
+	if !cached {
+		rateLimitBucketUpdateCacheMut.Lock()
+		rateLimitBucketUpdateCache[key] = cache
+		rateLimitBucketUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rateLimitBucketQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rate_limit_buckets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rate_limit_buckets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RateLimitBucketSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitBucketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rate_limit_buckets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rateLimitBucketPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rateLimitBucket slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rateLimitBucket")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RateLimitBucket) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no rate_limit_buckets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitBucketColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rateLimitBucketUpsertCacheMut.RLock()
+	cache, cached := rateLimitBucketUpsertCache[key]
+	rateLimitBucketUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketColumnsWithDefault,
+			rateLimitBucketColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rate_limit_buckets, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(rateLimitBucketAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rateLimitBucketPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert rate_limit_buckets, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rateLimitBucketPrimaryKeyColumns))
+			copy(conflict, rateLimitBucketPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rate_limit_buckets\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rate_limit_buckets")
+	}
+
+	if !cached {
+		rateLimitBucketUpsertCacheMut.Lock()
+		rateLimitBucketUpsertCache[key] = cache
+		rateLimitBucketUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single RateLimitBucket record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RateLimitBucket) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RateLimitBucket provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rateLimitBucketPrimaryKeyMapping)
+	sql := "DELETE FROM \"rate_limit_buckets\" WHERE \"key\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rate_limit_buckets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rate_limit_buckets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rateLimitBucketQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rateLimitBucketQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rate_limit_buckets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rate_limit_buckets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RateLimitBucketSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitBucketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rate_limit_buckets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rateLimitBucketPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rateLimitBucket slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rate_limit_buckets")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RateLimitBucket) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRateLimitBucket(ctx, exec, o.Key)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RateLimitBucketSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RateLimitBucketSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitBucketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rate_limit_buckets\".* FROM \"rate_limit_buckets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rateLimitBucketPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RateLimitBucketSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RateLimitBucketExists checks if the RateLimitBucket row exists.
+func RateLimitBucketExists(ctx context.Context, exec boil.ContextExecutor, key string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rate_limit_buckets\" where \"key\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, key)
+	}
+	row := exec.QueryRowContext(ctx, sql, key)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rate_limit_buckets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RateLimitBucket row exists.
+func (o *RateLimitBucket) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RateLimitBucketExists(ctx, exec, o.Key)
+}
@@ -0,0 +1,932 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RefreshTokenReuseEvent is an object representing the database table.
+type RefreshTokenReuseEvent struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	SessionID string      `boil:"session_id" json:"session_id" toml:"session_id" yaml:"session_id"`
+	RotatedAt time.Time   `boil:"rotated_at" json:"rotated_at" toml:"rotated_at" yaml:"rotated_at"`
+	UserAgent null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
+	IPAddress null.String `boil:"ip_address" json:"ip_address,omitempty" toml:"ip_address" yaml:"ip_address,omitempty"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *refreshTokenReuseEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L refreshTokenReuseEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RefreshTokenReuseEventColumns = struct {
+	ID        string
+	UserID    string
+	SessionID string
+	RotatedAt string
+	UserAgent string
+	IPAddress string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	SessionID: "session_id",
+	RotatedAt: "rotated_at",
+	UserAgent: "user_agent",
+	IPAddress: "ip_address",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var RefreshTokenReuseEventTableColumns = struct {
+	ID        string
+	UserID    string
+	SessionID string
+	RotatedAt string
+	UserAgent string
+	IPAddress string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "refresh_token_reuse_events.id",
+	UserID:    "refresh_token_reuse_events.user_id",
+	SessionID: "refresh_token_reuse_events.session_id",
+	RotatedAt: "refresh_token_reuse_events.rotated_at",
+	UserAgent: "refresh_token_reuse_events.user_agent",
+	IPAddress: "refresh_token_reuse_events.ip_address",
+	CreatedAt: "refresh_token_reuse_events.created_at",
+	UpdatedAt: "refresh_token_reuse_events.updated_at",
+}
+
+// Generated where
+
+var RefreshTokenReuseEventWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	SessionID whereHelperstring
+	RotatedAt whereHelpertime_Time
+	UserAgent whereHelpernull_String
+	IPAddress whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"refresh_token_reuse_events\".\"id\""},
+	UserID:    whereHelperstring{field: "\"refresh_token_reuse_events\".\"user_id\""},
+	SessionID: whereHelperstring{field: "\"refresh_token_reuse_events\".\"session_id\""},
+	RotatedAt: whereHelpertime_Time{field: "\"refresh_token_reuse_events\".\"rotated_at\""},
+	UserAgent: whereHelpernull_String{field: "\"refresh_token_reuse_events\".\"user_agent\""},
+	IPAddress: whereHelpernull_String{field: "\"refresh_token_reuse_events\".\"ip_address\""},
+	CreatedAt: whereHelpertime_Time{field: "\"refresh_token_reuse_events\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"refresh_token_reuse_events\".\"updated_at\""},
+}
+
+// RefreshTokenReuseEventRels is where relationship names are stored.
+var RefreshTokenReuseEventRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// refreshTokenReuseEventR is where relationships are stored.
+type refreshTokenReuseEventR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*refreshTokenReuseEventR) NewStruct() *refreshTokenReuseEventR {
+	return &refreshTokenReuseEventR{}
+}
+
+func (o *RefreshTokenReuseEvent) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *refreshTokenReuseEventR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// refreshTokenReuseEventL is where Load methods for each relationship are stored.
+type refreshTokenReuseEventL struct{}
+
+var (
+	refreshTokenReuseEventAllColumns            = []string{"id", "user_id", "session_id", "rotated_at", "user_agent", "ip_address", "created_at", "updated_at"}
+	refreshTokenReuseEventColumnsWithoutDefault = []string{"user_id", "session_id", "rotated_at", "created_at", "updated_at"}
+	refreshTokenReuseEventColumnsWithDefault    = []string{"id", "user_agent", "ip_address"}
+	refreshTokenReuseEventPrimaryKeyColumns     = []string{"id"}
+	refreshTokenReuseEventGeneratedColumns      = []string{}
+)
+
+type (
+	// RefreshTokenReuseEventSlice is an alias for a slice of pointers to RefreshTokenReuseEvent.
+	// This should almost always be used instead of []RefreshTokenReuseEvent.
+	RefreshTokenReuseEventSlice []*RefreshTokenReuseEvent
+
+	refreshTokenReuseEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	refreshTokenReuseEventType                 = reflect.TypeOf(&RefreshTokenReuseEvent{})
+	refreshTokenReuseEventMapping              = queries.MakeStructMapping(refreshTokenReuseEventType)
+	refreshTokenReuseEventPrimaryKeyMapping, _ = queries.BindMapping(refreshTokenReuseEventType, refreshTokenReuseEventMapping, refreshTokenReuseEventPrimaryKeyColumns)
+	refreshTokenReuseEventInsertCacheMut       sync.RWMutex
+	refreshTokenReuseEventInsertCache          = make(map[string]insertCache)
+	refreshTokenReuseEventUpdateCacheMut       sync.RWMutex
+	refreshTokenReuseEventUpdateCache          = make(map[string]updateCache)
+	refreshTokenReuseEventUpsertCacheMut       sync.RWMutex
+	refreshTokenReuseEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single refreshTokenReuseEvent record from the query.
+func (q refreshTokenReuseEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RefreshTokenReuseEvent, error) {
+	o := &RefreshTokenReuseEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for refresh_token_reuse_events")
+	}
+
+	return o, nil
+}
+
+// All returns all RefreshTokenReuseEvent records from the query.
+func (q refreshTokenReuseEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (RefreshTokenReuseEventSlice, error) {
+	var o []*RefreshTokenReuseEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RefreshTokenReuseEvent slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RefreshTokenReuseEvent records in the query.
+func (q refreshTokenReuseEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count refresh_token_reuse_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q refreshTokenReuseEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if refresh_token_reuse_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *RefreshTokenReuseEvent) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (refreshTokenReuseEventL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRefreshTokenReuseEvent interface{}, mods queries.Applicator) error {
+	var slice []*RefreshTokenReuseEvent
+	var object *RefreshTokenReuseEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeRefreshTokenReuseEvent.(*RefreshTokenReuseEvent)
+		if !ok {
+			object = new(RefreshTokenReuseEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRefreshTokenReuseEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRefreshTokenReuseEvent))
+			}
+		}
+	} else {
+		s, ok := maybeRefreshTokenReuseEvent.(*[]*RefreshTokenReuseEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRefreshTokenReuseEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRefreshTokenReuseEvent))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &refreshTokenReuseEventR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &refreshTokenReuseEventR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.RefreshTokenReuseEvents = append(foreign.R.RefreshTokenReuseEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.RefreshTokenReuseEvents = append(foreign.R.RefreshTokenReuseEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the refreshTokenReuseEvent to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.RefreshTokenReuseEvents.
+func (o *RefreshTokenReuseEvent) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"refresh_token_reuse_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, refreshTokenReuseEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &refreshTokenReuseEventR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			RefreshTokenReuseEvents: RefreshTokenReuseEventSlice{o},
+		}
+	} else {
+		related.R.RefreshTokenReuseEvents = append(related.R.RefreshTokenReuseEvents, o)
+	}
+
+	return nil
+}
+
+// RefreshTokenReuseEvents retrieves all the records using an executor.
+func RefreshTokenReuseEvents(mods ...qm.QueryMod) refreshTokenReuseEventQuery {
+	mods = append(mods, qm.From("\"refresh_token_reuse_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"refresh_token_reuse_events\".*"})
+	}
+
+	return refreshTokenReuseEventQuery{q}
+}
+
+// FindRefreshTokenReuseEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRefreshTokenReuseEvent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*RefreshTokenReuseEvent, error) {
+	refreshTokenReuseEventObj := &RefreshTokenReuseEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"refresh_token_reuse_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, refreshTokenReuseEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from refresh_token_reuse_events")
+	}
+
+	return refreshTokenReuseEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RefreshTokenReuseEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no refresh_token_reuse_events provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(refreshTokenReuseEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	refreshTokenReuseEventInsertCacheMut.RLock()
+	cache, cached := refreshTokenReuseEventInsertCache[key]
+	refreshTokenReuseEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			refreshTokenReuseEventAllColumns,
+			refreshTokenReuseEventColumnsWithDefault,
+			refreshTokenReuseEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(refreshTokenReuseEventType, refreshTokenReuseEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(refreshTokenReuseEventType, refreshTokenReuseEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"refresh_token_reuse_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"refresh_token_reuse_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into refresh_token_reuse_events")
+	}
+
+	if !cached {
+		refreshTokenReuseEventInsertCacheMut.Lock()
+		refreshTokenReuseEventInsertCache[key] = cache
+		refreshTokenReuseEventInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the RefreshTokenReuseEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RefreshTokenReuseEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	refreshTokenReuseEventUpdateCacheMut.RLock()
+	cache, cached := refreshTokenReuseEventUpdateCache[key]
+	refreshTokenReuseEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			refreshTokenReuseEventAllColumns,
+			refreshTokenReuseEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update refresh_token_reuse_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"refresh_token_reuse_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, refreshTokenReuseEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(refreshTokenReuseEventType, refreshTokenReuseEventMapping, append(wl, refreshTokenReuseEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update refresh_token_reuse_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for refresh_token_reuse_events")
+	}
+
+	if !cached {
+		refreshTokenReuseEventUpdateCacheMut.Lock()
+		refreshTokenReuseEventUpdateCache[key] = cache
+		refreshTokenReuseEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q refreshTokenReuseEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for refresh_token_reuse_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for refresh_token_reuse_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RefreshTokenReuseEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), refreshTokenReuseEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"refresh_token_reuse_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, refreshTokenReuseEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in refreshTokenReuseEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all refreshTokenReuseEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RefreshTokenReuseEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no refresh_token_reuse_events provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(refreshTokenReuseEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	refreshTokenReuseEventUpsertCacheMut.RLock()
+	cache, cached := refreshTokenReuseEventUpsertCache[key]
+	refreshTokenReuseEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			refreshTokenReuseEventAllColumns,
+			refreshTokenReuseEventColumnsWithDefault,
+			refreshTokenReuseEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			refreshTokenReuseEventAllColumns,
+			refreshTokenReuseEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert refresh_token_reuse_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(refreshTokenReuseEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(refreshTokenReuseEventPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert refresh_token_reuse_events, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(refreshTokenReuseEventPrimaryKeyColumns))
+			copy(conflict, refreshTokenReuseEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"refresh_token_reuse_events\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(refreshTokenReuseEventType, refreshTokenReuseEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(refreshTokenReuseEventType, refreshTokenReuseEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert refresh_token_reuse_events")
+	}
+
+	if !cached {
+		refreshTokenReuseEventUpsertCacheMut.Lock()
+		refreshTokenReuseEventUpsertCache[key] = cache
+		refreshTokenReuseEventUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single RefreshTokenReuseEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RefreshTokenReuseEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RefreshTokenReuseEvent provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), refreshTokenReuseEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"refresh_token_reuse_events\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from refresh_token_reuse_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for refresh_token_reuse_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q refreshTokenReuseEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no refreshTokenReuseEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from refresh_token_reuse_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for refresh_token_reuse_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RefreshTokenReuseEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), refreshTokenReuseEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"refresh_token_reuse_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, refreshTokenReuseEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from refreshTokenReuseEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for refresh_token_reuse_events")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RefreshTokenReuseEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRefreshTokenReuseEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RefreshTokenReuseEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RefreshTokenReuseEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), refreshTokenReuseEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"refresh_token_reuse_events\".* FROM \"refresh_token_reuse_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, refreshTokenReuseEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RefreshTokenReuseEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RefreshTokenReuseEventExists checks if the RefreshTokenReuseEvent row exists.
+func RefreshTokenReuseEventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"refresh_token_reuse_events\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if refresh_token_reuse_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RefreshTokenReuseEvent row exists.
+func (o *RefreshTokenReuseEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RefreshTokenReuseEventExists(ctx, exec, o.ID)
+}
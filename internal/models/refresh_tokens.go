@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,51 +24,100 @@ import (
 
 // RefreshToken is an object representing the database table.
 type RefreshToken struct {
-	Token     string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Token            string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	UserID           string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SessionID        string      `boil:"session_id" json:"session_id" toml:"session_id" yaml:"session_id"`
+	DeviceName       null.String `boil:"device_name" json:"device_name,omitempty" toml:"device_name" yaml:"device_name,omitempty"`
+	UserAgent        null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
+	IPAddress        null.String `boil:"ip_address" json:"ip_address,omitempty" toml:"ip_address" yaml:"ip_address,omitempty"`
+	LastUsedAt       time.Time   `boil:"last_used_at" json:"last_used_at" toml:"last_used_at" yaml:"last_used_at"`
+	RotatedAt        null.Time   `boil:"rotated_at" json:"rotated_at,omitempty" toml:"rotated_at" yaml:"rotated_at,omitempty"`
+	SessionStartedAt time.Time   `boil:"session_started_at" json:"session_started_at" toml:"session_started_at" yaml:"session_started_at"`
 
 	R *refreshTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L refreshTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RefreshTokenColumns = struct {
-	Token     string
-	UserID    string
-	CreatedAt string
-	UpdatedAt string
+	Token            string
+	UserID           string
+	CreatedAt        string
+	UpdatedAt        string
+	SessionID        string
+	DeviceName       string
+	UserAgent        string
+	IPAddress        string
+	LastUsedAt       string
+	RotatedAt        string
+	SessionStartedAt string
 }{
-	Token:     "token",
-	UserID:    "user_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	Token:            "token",
+	UserID:           "user_id",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	SessionID:        "session_id",
+	DeviceName:       "device_name",
+	UserAgent:        "user_agent",
+	IPAddress:        "ip_address",
+	LastUsedAt:       "last_used_at",
+	RotatedAt:        "rotated_at",
+	SessionStartedAt: "session_started_at",
 }
 
 var RefreshTokenTableColumns = struct {
-	Token     string
-	UserID    string
-	CreatedAt string
-	UpdatedAt string
+	Token            string
+	UserID           string
+	CreatedAt        string
+	UpdatedAt        string
+	SessionID        string
+	DeviceName       string
+	UserAgent        string
+	IPAddress        string
+	LastUsedAt       string
+	RotatedAt        string
+	SessionStartedAt string
 }{
-	Token:     "refresh_tokens.token",
-	UserID:    "refresh_tokens.user_id",
-	CreatedAt: "refresh_tokens.created_at",
-	UpdatedAt: "refresh_tokens.updated_at",
+	Token:            "refresh_tokens.token",
+	UserID:           "refresh_tokens.user_id",
+	CreatedAt:        "refresh_tokens.created_at",
+	UpdatedAt:        "refresh_tokens.updated_at",
+	SessionID:        "refresh_tokens.session_id",
+	DeviceName:       "refresh_tokens.device_name",
+	UserAgent:        "refresh_tokens.user_agent",
+	IPAddress:        "refresh_tokens.ip_address",
+	LastUsedAt:       "refresh_tokens.last_used_at",
+	RotatedAt:        "refresh_tokens.rotated_at",
+	SessionStartedAt: "refresh_tokens.session_started_at",
 }
 
 // Generated where
 
 var RefreshTokenWhere = struct {
-	Token     whereHelperstring
-	UserID    whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
+	Token            whereHelperstring
+	UserID           whereHelperstring
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+	SessionID        whereHelperstring
+	DeviceName       whereHelpernull_String
+	UserAgent        whereHelpernull_String
+	IPAddress        whereHelpernull_String
+	LastUsedAt       whereHelpertime_Time
+	RotatedAt        whereHelpernull_Time
+	SessionStartedAt whereHelpertime_Time
 }{
-	Token:     whereHelperstring{field: "\"refresh_tokens\".\"token\""},
-	UserID:    whereHelperstring{field: "\"refresh_tokens\".\"user_id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"refresh_tokens\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"refresh_tokens\".\"updated_at\""},
+	Token:            whereHelperstring{field: "\"refresh_tokens\".\"token\""},
+	UserID:           whereHelperstring{field: "\"refresh_tokens\".\"user_id\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"refresh_tokens\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"refresh_tokens\".\"updated_at\""},
+	SessionID:        whereHelperstring{field: "\"refresh_tokens\".\"session_id\""},
+	DeviceName:       whereHelpernull_String{field: "\"refresh_tokens\".\"device_name\""},
+	UserAgent:        whereHelpernull_String{field: "\"refresh_tokens\".\"user_agent\""},
+	IPAddress:        whereHelpernull_String{field: "\"refresh_tokens\".\"ip_address\""},
+	LastUsedAt:       whereHelpertime_Time{field: "\"refresh_tokens\".\"last_used_at\""},
+	RotatedAt:        whereHelpernull_Time{field: "\"refresh_tokens\".\"rotated_at\""},
+	SessionStartedAt: whereHelpertime_Time{field: "\"refresh_tokens\".\"session_started_at\""},
 }
 
 // RefreshTokenRels is where relationship names are stored.
@@ -107,9 +157,9 @@ func (r *refreshTokenR) GetUser() *User {
 type refreshTokenL struct{}
 
 var (
-	refreshTokenAllColumns            = []string{"token", "user_id", "created_at", "updated_at"}
-	refreshTokenColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at"}
-	refreshTokenColumnsWithDefault    = []string{"token"}
+	refreshTokenAllColumns            = []string{"token", "user_id", "created_at", "updated_at", "session_id", "device_name", "user_agent", "ip_address", "last_used_at", "rotated_at", "session_started_at"}
+	refreshTokenColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at", "last_used_at", "session_started_at"}
+	refreshTokenColumnsWithDefault    = []string{"token", "session_id", "device_name", "user_agent", "ip_address", "rotated_at"}
 	refreshTokenPrimaryKeyColumns     = []string{"token"}
 	refreshTokenGeneratedColumns      = []string{}
 )
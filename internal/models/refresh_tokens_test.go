@@ -537,7 +537,7 @@ func testRefreshTokensSelect(t *testing.T) {
 }
 
 var (
-	refreshTokenDBTypes = map[string]string{`Token`: `uuid`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	refreshTokenDBTypes = map[string]string{`Token`: `uuid`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `SessionID`: `uuid`, `DeviceName`: `text`, `UserAgent`: `text`, `IPAddress`: `text`, `LastUsedAt`: `timestamp with time zone`, `RotatedAt`: `timestamp with time zone`, `SessionStartedAt`: `timestamp with time zone`}
 	_                   = bytes.MinRead
 )
 
@@ -0,0 +1,1023 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Role is an object representing the database table.
+type Role struct {
+	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *roleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L roleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RoleColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	Name:        "name",
+	Description: "description",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var RoleTableColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "roles.id",
+	Name:        "roles.name",
+	Description: "roles.description",
+	CreatedAt:   "roles.created_at",
+	UpdatedAt:   "roles.updated_at",
+}
+
+// Generated where
+
+var RoleWhere = struct {
+	ID          whereHelperstring
+	Name        whereHelperstring
+	Description whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"roles\".\"id\""},
+	Name:        whereHelperstring{field: "\"roles\".\"name\""},
+	Description: whereHelperstring{field: "\"roles\".\"description\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"roles\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"roles\".\"updated_at\""},
+}
+
+// RoleRels is where relationship names are stored.
+var RoleRels = struct {
+	Permissions string
+}{
+	Permissions: "Permissions",
+}
+
+// roleR is where relationships are stored.
+type roleR struct {
+	Permissions PermissionSlice `boil:"Permissions" json:"Permissions" toml:"Permissions" yaml:"Permissions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*roleR) NewStruct() *roleR {
+	return &roleR{}
+}
+
+func (o *Role) GetPermissions() PermissionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPermissions()
+}
+
+func (r *roleR) GetPermissions() PermissionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Permissions
+}
+
+// roleL is where Load methods for each relationship are stored.
+type roleL struct{}
+
+var (
+	roleAllColumns            = []string{"id", "name", "description", "created_at", "updated_at"}
+	roleColumnsWithoutDefault = []string{"name", "created_at", "updated_at"}
+	roleColumnsWithDefault    = []string{"id", "description"}
+	rolePrimaryKeyColumns     = []string{"id"}
+	roleGeneratedColumns      = []string{}
+)
+
+type (
+	// RoleSlice is an alias for a slice of pointers to Role.
+	// This should almost always be used instead of []Role.
+	RoleSlice []*Role
+
+	roleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	roleType                 = reflect.TypeOf(&Role{})
+	roleMapping              = queries.MakeStructMapping(roleType)
+	rolePrimaryKeyMapping, _ = queries.BindMapping(roleType, roleMapping, rolePrimaryKeyColumns)
+	roleInsertCacheMut       sync.RWMutex
+	roleInsertCache          = make(map[string]insertCache)
+	roleUpdateCacheMut       sync.RWMutex
+	roleUpdateCache          = make(map[string]updateCache)
+	roleUpsertCacheMut       sync.RWMutex
+	roleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single role record from the query.
+func (q roleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Role, error) {
+	o := &Role{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for roles")
+	}
+
+	return o, nil
+}
+
+// All returns all Role records from the query.
+func (q roleQuery) All(ctx context.Context, exec boil.ContextExecutor) (RoleSlice, error) {
+	var o []*Role
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Role slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Role records in the query.
+func (q roleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count roles rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q roleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if roles exists")
+	}
+
+	return count > 0, nil
+}
+
+// Permissions retrieves all the permission's Permissions with an executor.
+func (o *Role) Permissions(mods ...qm.QueryMod) permissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"role_permissions\" on \"permissions\".\"id\" = \"role_permissions\".\"permission_id\""),
+		qm.Where("\"role_permissions\".\"role_id\"=?", o.ID),
+	)
+
+	return Permissions(queryMods...)
+}
+
+// LoadPermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadPermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"permissions\".\"id\", \"permissions\".\"name\", \"permissions\".\"description\", \"permissions\".\"created_at\", \"permissions\".\"updated_at\", \"a\".\"role_id\""),
+		qm.From("\"permissions\""),
+		qm.InnerJoin("\"role_permissions\" as \"a\" on \"permissions\".\"id\" = \"a\".\"permission_id\""),
+		qm.WhereIn("\"a\".\"role_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load permissions")
+	}
+
+	var resultSlice []*Permission
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Permission)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for permissions")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice permissions")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for permissions")
+	}
+
+	if singular {
+		object.R.Permissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &permissionR{}
+			}
+			foreign.R.Roles = append(foreign.R.Roles, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Permissions = append(local.R.Permissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &permissionR{}
+				}
+				foreign.R.Roles = append(foreign.R.Roles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPermissions adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.Permissions.
+// Sets related.R.Roles appropriately.
+func (o *Role) AddPermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Permission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"role_permissions\" (\"role_id\", \"permission_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &roleR{
+			Permissions: related,
+		}
+	} else {
+		o.R.Permissions = append(o.R.Permissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &permissionR{
+				Roles: RoleSlice{o},
+			}
+		} else {
+			rel.R.Roles = append(rel.R.Roles, o)
+		}
+	}
+	return nil
+}
+
+// SetPermissions removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Roles's Permissions accordingly.
+// Replaces o.R.Permissions with related.
+// Sets related.R.Roles's Permissions accordingly.
+func (o *Role) SetPermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Permission) error {
+	query := "delete from \"role_permissions\" where \"role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePermissionsFromRolesSlice(o, related)
+	if o.R != nil {
+		o.R.Permissions = nil
+	}
+
+	return o.AddPermissions(ctx, exec, insert, related...)
+}
+
+// RemovePermissions relationships from objects passed in.
+// Removes related items from R.Permissions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Roles.
+func (o *Role) RemovePermissions(ctx context.Context, exec boil.ContextExecutor, related ...*Permission) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"role_permissions\" where \"role_id\" = $1 and \"permission_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePermissionsFromRolesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Permissions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Permissions)
+			if ln > 1 && i < ln-1 {
+				o.R.Permissions[i] = o.R.Permissions[ln-1]
+			}
+			o.R.Permissions = o.R.Permissions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePermissionsFromRolesSlice(o *Role, related []*Permission) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Roles {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Roles)
+			if ln > 1 && i < ln-1 {
+				rel.R.Roles[i] = rel.R.Roles[ln-1]
+			}
+			rel.R.Roles = rel.R.Roles[:ln-1]
+			break
+		}
+	}
+}
+
+// Roles retrieves all the records using an executor.
+func Roles(mods ...qm.QueryMod) roleQuery {
+	mods = append(mods, qm.From("\"roles\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"roles\".*"})
+	}
+
+	return roleQuery{q}
+}
+
+// FindRole retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRole(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Role, error) {
+	roleObj := &Role{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"roles\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, roleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from roles")
+	}
+
+	return roleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Role) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no roles provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	roleInsertCacheMut.RLock()
+	cache, cached := roleInsertCache[key]
+	roleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			roleAllColumns,
+			roleColumnsWithDefault,
+			roleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(roleType, roleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(roleType, roleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"roles\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"roles\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into roles")
+	}
+
+	if !cached {
+		roleInsertCacheMut.Lock()
+		roleInsertCache[key] = cache
+		roleInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the Role.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Role) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	roleUpdateCacheMut.RLock()
+	cache, cached := roleUpdateCache[key]
+	roleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			roleAllColumns,
+			rolePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update roles, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"roles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rolePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(roleType, roleMapping, append(wl, rolePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update roles row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for roles")
+	}
+
+	if !cached {
+		roleUpdateCacheMut.Lock()
+		roleUpdateCache[key] = cache
+		roleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q roleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for roles")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RoleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"roles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rolePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in role slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all role")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Role) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no roles provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	roleUpsertCacheMut.RLock()
+	cache, cached := roleUpsertCache[key]
+	roleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			roleAllColumns,
+			roleColumnsWithDefault,
+			roleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			roleAllColumns,
+			rolePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert roles, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(roleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rolePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert roles, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rolePrimaryKeyColumns))
+			copy(conflict, rolePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"roles\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(roleType, roleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(roleType, roleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert roles")
+	}
+
+	if !cached {
+		roleUpsertCacheMut.Lock()
+		roleUpsertCache[key] = cache
+		roleUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single Role record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Role) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Role provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rolePrimaryKeyMapping)
+	sql := "DELETE FROM \"roles\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for roles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q roleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no roleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for roles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RoleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"roles\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rolePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from role slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for roles")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Role) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRole(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RoleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RoleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"roles\".* FROM \"roles\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rolePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RoleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RoleExists checks if the Role row exists.
+func RoleExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"roles\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if roles exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Role row exists.
+func (o *Role) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RoleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,918 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TotpRecoveryCode is an object representing the database table.
+type TotpRecoveryCode struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CodeHash  string    `boil:"code_hash" json:"code_hash" toml:"code_hash" yaml:"code_hash"`
+	UsedAt    null.Time `boil:"used_at" json:"used_at,omitempty" toml:"used_at" yaml:"used_at,omitempty"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *totpRecoveryCodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L totpRecoveryCodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TotpRecoveryCodeColumns = struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	UsedAt    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	CodeHash:  "code_hash",
+	UsedAt:    "used_at",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TotpRecoveryCodeTableColumns = struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	UsedAt    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "totp_recovery_codes.id",
+	UserID:    "totp_recovery_codes.user_id",
+	CodeHash:  "totp_recovery_codes.code_hash",
+	UsedAt:    "totp_recovery_codes.used_at",
+	CreatedAt: "totp_recovery_codes.created_at",
+	UpdatedAt: "totp_recovery_codes.updated_at",
+}
+
+// Generated where
+
+var TotpRecoveryCodeWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	CodeHash  whereHelperstring
+	UsedAt    whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"totp_recovery_codes\".\"id\""},
+	UserID:    whereHelperstring{field: "\"totp_recovery_codes\".\"user_id\""},
+	CodeHash:  whereHelperstring{field: "\"totp_recovery_codes\".\"code_hash\""},
+	UsedAt:    whereHelpernull_Time{field: "\"totp_recovery_codes\".\"used_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"totp_recovery_codes\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"totp_recovery_codes\".\"updated_at\""},
+}
+
+// TotpRecoveryCodeRels is where relationship names are stored.
+var TotpRecoveryCodeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// totpRecoveryCodeR is where relationships are stored.
+type totpRecoveryCodeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*totpRecoveryCodeR) NewStruct() *totpRecoveryCodeR {
+	return &totpRecoveryCodeR{}
+}
+
+func (o *TotpRecoveryCode) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *totpRecoveryCodeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// totpRecoveryCodeL is where Load methods for each relationship are stored.
+type totpRecoveryCodeL struct{}
+
+var (
+	totpRecoveryCodeAllColumns            = []string{"id", "user_id", "code_hash", "used_at", "created_at", "updated_at"}
+	totpRecoveryCodeColumnsWithoutDefault = []string{"user_id", "code_hash", "created_at", "updated_at"}
+	totpRecoveryCodeColumnsWithDefault    = []string{"id", "used_at"}
+	totpRecoveryCodePrimaryKeyColumns     = []string{"id"}
+	totpRecoveryCodeGeneratedColumns      = []string{}
+)
+
+type (
+	// TotpRecoveryCodeSlice is an alias for a slice of pointers to TotpRecoveryCode.
+	// This should almost always be used instead of []TotpRecoveryCode.
+	TotpRecoveryCodeSlice []*TotpRecoveryCode
+
+	totpRecoveryCodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	totpRecoveryCodeType                 = reflect.TypeOf(&TotpRecoveryCode{})
+	totpRecoveryCodeMapping              = queries.MakeStructMapping(totpRecoveryCodeType)
+	totpRecoveryCodePrimaryKeyMapping, _ = queries.BindMapping(totpRecoveryCodeType, totpRecoveryCodeMapping, totpRecoveryCodePrimaryKeyColumns)
+	totpRecoveryCodeInsertCacheMut       sync.RWMutex
+	totpRecoveryCodeInsertCache          = make(map[string]insertCache)
+	totpRecoveryCodeUpdateCacheMut       sync.RWMutex
+	totpRecoveryCodeUpdateCache          = make(map[string]updateCache)
+	totpRecoveryCodeUpsertCacheMut       sync.RWMutex
+	totpRecoveryCodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single totpRecoveryCode record from the query.
+func (q totpRecoveryCodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TotpRecoveryCode, error) {
+	o := &TotpRecoveryCode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for totp_recovery_codes")
+	}
+
+	return o, nil
+}
+
+// All returns all TotpRecoveryCode records from the query.
+func (q totpRecoveryCodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (TotpRecoveryCodeSlice, error) {
+	var o []*TotpRecoveryCode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TotpRecoveryCode slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TotpRecoveryCode records in the query.
+func (q totpRecoveryCodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count totp_recovery_codes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q totpRecoveryCodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if totp_recovery_codes exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TotpRecoveryCode) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (totpRecoveryCodeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTotpRecoveryCode interface{}, mods queries.Applicator) error {
+	var slice []*TotpRecoveryCode
+	var object *TotpRecoveryCode
+
+	if singular {
+		var ok bool
+		object, ok = maybeTotpRecoveryCode.(*TotpRecoveryCode)
+		if !ok {
+			object = new(TotpRecoveryCode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTotpRecoveryCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTotpRecoveryCode))
+			}
+		}
+	} else {
+		s, ok := maybeTotpRecoveryCode.(*[]*TotpRecoveryCode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTotpRecoveryCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTotpRecoveryCode))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &totpRecoveryCodeR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &totpRecoveryCodeR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TotpRecoveryCodes = append(foreign.R.TotpRecoveryCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TotpRecoveryCodes = append(foreign.R.TotpRecoveryCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the totpRecoveryCode to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TotpRecoveryCodes.
+func (o *TotpRecoveryCode) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"totp_recovery_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, totpRecoveryCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &totpRecoveryCodeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TotpRecoveryCodes: TotpRecoveryCodeSlice{o},
+		}
+	} else {
+		related.R.TotpRecoveryCodes = append(related.R.TotpRecoveryCodes, o)
+	}
+
+	return nil
+}
+
+// TotpRecoveryCodes retrieves all the records using an executor.
+func TotpRecoveryCodes(mods ...qm.QueryMod) totpRecoveryCodeQuery {
+	mods = append(mods, qm.From("\"totp_recovery_codes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"totp_recovery_codes\".*"})
+	}
+
+	return totpRecoveryCodeQuery{q}
+}
+
+// FindTotpRecoveryCode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTotpRecoveryCode(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*TotpRecoveryCode, error) {
+	totpRecoveryCodeObj := &TotpRecoveryCode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"totp_recovery_codes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, totpRecoveryCodeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from totp_recovery_codes")
+	}
+
+	return totpRecoveryCodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TotpRecoveryCode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no totp_recovery_codes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(totpRecoveryCodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	totpRecoveryCodeInsertCacheMut.RLock()
+	cache, cached := totpRecoveryCodeInsertCache[key]
+	totpRecoveryCodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			totpRecoveryCodeAllColumns,
+			totpRecoveryCodeColumnsWithDefault,
+			totpRecoveryCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(totpRecoveryCodeType, totpRecoveryCodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(totpRecoveryCodeType, totpRecoveryCodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"totp_recovery_codes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"totp_recovery_codes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into totp_recovery_codes")
+	}
+
+	if !cached {
+		totpRecoveryCodeInsertCacheMut.Lock()
+		totpRecoveryCodeInsertCache[key] = cache
+		totpRecoveryCodeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the TotpRecoveryCode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TotpRecoveryCode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	totpRecoveryCodeUpdateCacheMut.RLock()
+	cache, cached := totpRecoveryCodeUpdateCache[key]
+	totpRecoveryCodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			totpRecoveryCodeAllColumns,
+			totpRecoveryCodePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update totp_recovery_codes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"totp_recovery_codes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, totpRecoveryCodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(totpRecoveryCodeType, totpRecoveryCodeMapping, append(wl, totpRecoveryCodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update totp_recovery_codes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for totp_recovery_codes")
+	}
+
+	if !cached {
+		totpRecoveryCodeUpdateCacheMut.Lock()
+		totpRecoveryCodeUpdateCache[key] = cache
+		totpRecoveryCodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q totpRecoveryCodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for totp_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for totp_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TotpRecoveryCodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), totpRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"totp_recovery_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, totpRecoveryCodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in totpRecoveryCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all totpRecoveryCode")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TotpRecoveryCode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no totp_recovery_codes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(totpRecoveryCodeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	totpRecoveryCodeUpsertCacheMut.RLock()
+	cache, cached := totpRecoveryCodeUpsertCache[key]
+	totpRecoveryCodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			totpRecoveryCodeAllColumns,
+			totpRecoveryCodeColumnsWithDefault,
+			totpRecoveryCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			totpRecoveryCodeAllColumns,
+			totpRecoveryCodePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert totp_recovery_codes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(totpRecoveryCodeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(totpRecoveryCodePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert totp_recovery_codes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(totpRecoveryCodePrimaryKeyColumns))
+			copy(conflict, totpRecoveryCodePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"totp_recovery_codes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(totpRecoveryCodeType, totpRecoveryCodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(totpRecoveryCodeType, totpRecoveryCodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert totp_recovery_codes")
+	}
+
+	if !cached {
+		totpRecoveryCodeUpsertCacheMut.Lock()
+		totpRecoveryCodeUpsertCache[key] = cache
+		totpRecoveryCodeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single TotpRecoveryCode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TotpRecoveryCode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TotpRecoveryCode provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), totpRecoveryCodePrimaryKeyMapping)
+	sql := "DELETE FROM \"totp_recovery_codes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from totp_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for totp_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q totpRecoveryCodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no totpRecoveryCodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from totp_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for totp_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TotpRecoveryCodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), totpRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"totp_recovery_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, totpRecoveryCodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from totpRecoveryCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for totp_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TotpRecoveryCode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTotpRecoveryCode(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TotpRecoveryCodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TotpRecoveryCodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), totpRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"totp_recovery_codes\".* FROM \"totp_recovery_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, totpRecoveryCodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TotpRecoveryCodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TotpRecoveryCodeExists checks if the TotpRecoveryCode row exists.
+func TotpRecoveryCodeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"totp_recovery_codes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if totp_recovery_codes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TotpRecoveryCode row exists.
+func (o *TotpRecoveryCode) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TotpRecoveryCodeExists(ctx, exec, o.ID)
+}
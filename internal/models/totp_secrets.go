@@ -0,0 +1,956 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TotpSecret is an object representing the database table.
+type TotpSecret struct {
+	UserID           string     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Secret           string     `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	ConfirmedAt      null.Time  `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+	LastUsedTimeStep null.Int64 `boil:"last_used_time_step" json:"last_used_time_step,omitempty" toml:"last_used_time_step" yaml:"last_used_time_step,omitempty"`
+	CreatedAt        time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *totpSecretR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L totpSecretL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TotpSecretColumns = struct {
+	UserID           string
+	Secret           string
+	ConfirmedAt      string
+	LastUsedTimeStep string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	UserID:           "user_id",
+	Secret:           "secret",
+	ConfirmedAt:      "confirmed_at",
+	LastUsedTimeStep: "last_used_time_step",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var TotpSecretTableColumns = struct {
+	UserID           string
+	Secret           string
+	ConfirmedAt      string
+	LastUsedTimeStep string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	UserID:           "totp_secrets.user_id",
+	Secret:           "totp_secrets.secret",
+	ConfirmedAt:      "totp_secrets.confirmed_at",
+	LastUsedTimeStep: "totp_secrets.last_used_time_step",
+	CreatedAt:        "totp_secrets.created_at",
+	UpdatedAt:        "totp_secrets.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var TotpSecretWhere = struct {
+	UserID           whereHelperstring
+	Secret           whereHelperstring
+	ConfirmedAt      whereHelpernull_Time
+	LastUsedTimeStep whereHelpernull_Int64
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+}{
+	UserID:           whereHelperstring{field: "\"totp_secrets\".\"user_id\""},
+	Secret:           whereHelperstring{field: "\"totp_secrets\".\"secret\""},
+	ConfirmedAt:      whereHelpernull_Time{field: "\"totp_secrets\".\"confirmed_at\""},
+	LastUsedTimeStep: whereHelpernull_Int64{field: "\"totp_secrets\".\"last_used_time_step\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"totp_secrets\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"totp_secrets\".\"updated_at\""},
+}
+
+// TotpSecretRels is where relationship names are stored.
+var TotpSecretRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// totpSecretR is where relationships are stored.
+type totpSecretR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*totpSecretR) NewStruct() *totpSecretR {
+	return &totpSecretR{}
+}
+
+func (o *TotpSecret) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *totpSecretR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// totpSecretL is where Load methods for each relationship are stored.
+type totpSecretL struct{}
+
+var (
+	totpSecretAllColumns            = []string{"user_id", "secret", "confirmed_at", "last_used_time_step", "created_at", "updated_at"}
+	totpSecretColumnsWithoutDefault = []string{"user_id", "secret", "created_at", "updated_at"}
+	totpSecretColumnsWithDefault    = []string{"confirmed_at", "last_used_time_step"}
+	totpSecretPrimaryKeyColumns     = []string{"user_id"}
+	totpSecretGeneratedColumns      = []string{}
+)
+
+type (
+	// TotpSecretSlice is an alias for a slice of pointers to TotpSecret.
+	// This should almost always be used instead of []TotpSecret.
+	TotpSecretSlice []*TotpSecret
+
+	totpSecretQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	totpSecretType                 = reflect.TypeOf(&TotpSecret{})
+	totpSecretMapping              = queries.MakeStructMapping(totpSecretType)
+	totpSecretPrimaryKeyMapping, _ = queries.BindMapping(totpSecretType, totpSecretMapping, totpSecretPrimaryKeyColumns)
+	totpSecretInsertCacheMut       sync.RWMutex
+	totpSecretInsertCache          = make(map[string]insertCache)
+	totpSecretUpdateCacheMut       sync.RWMutex
+	totpSecretUpdateCache          = make(map[string]updateCache)
+	totpSecretUpsertCacheMut       sync.RWMutex
+	totpSecretUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single totpSecret record from the query.
+func (q totpSecretQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TotpSecret, error) {
+	o := &TotpSecret{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for totp_secrets")
+	}
+
+	return o, nil
+}
+
+// All returns all TotpSecret records from the query.
+func (q totpSecretQuery) All(ctx context.Context, exec boil.ContextExecutor) (TotpSecretSlice, error) {
+	var o []*TotpSecret
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TotpSecret slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TotpSecret records in the query.
+func (q totpSecretQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count totp_secrets rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q totpSecretQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if totp_secrets exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TotpSecret) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (totpSecretL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTotpSecret interface{}, mods queries.Applicator) error {
+	var slice []*TotpSecret
+	var object *TotpSecret
+
+	if singular {
+		var ok bool
+		object, ok = maybeTotpSecret.(*TotpSecret)
+		if !ok {
+			object = new(TotpSecret)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTotpSecret)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTotpSecret))
+			}
+		}
+	} else {
+		s, ok := maybeTotpSecret.(*[]*TotpSecret)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTotpSecret)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTotpSecret))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &totpSecretR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &totpSecretR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TotpSecret = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TotpSecret = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the totpSecret to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TotpSecret.
+func (o *TotpSecret) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"totp_secrets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, totpSecretPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &totpSecretR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TotpSecret: o,
+		}
+	} else {
+		related.R.TotpSecret = o
+	}
+
+	return nil
+}
+
+// TotpSecrets retrieves all the records using an executor.
+func TotpSecrets(mods ...qm.QueryMod) totpSecretQuery {
+	mods = append(mods, qm.From("\"totp_secrets\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"totp_secrets\".*"})
+	}
+
+	return totpSecretQuery{q}
+}
+
+// FindTotpSecret retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTotpSecret(ctx context.Context, exec boil.ContextExecutor, userID string, selectCols ...string) (*TotpSecret, error) {
+	totpSecretObj := &TotpSecret{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"totp_secrets\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, totpSecretObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from totp_secrets")
+	}
+
+	return totpSecretObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TotpSecret) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no totp_secrets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(totpSecretColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	totpSecretInsertCacheMut.RLock()
+	cache, cached := totpSecretInsertCache[key]
+	totpSecretInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			totpSecretAllColumns,
+			totpSecretColumnsWithDefault,
+			totpSecretColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(totpSecretType, totpSecretMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(totpSecretType, totpSecretMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"totp_secrets\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"totp_secrets\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into totp_secrets")
+	}
+
+	if !cached {
+		totpSecretInsertCacheMut.Lock()
+		totpSecretInsertCache[key] = cache
+		totpSecretInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the TotpSecret.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TotpSecret) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	totpSecretUpdateCacheMut.RLock()
+	cache, cached := totpSecretUpdateCache[key]
+	totpSecretUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			totpSecretAllColumns,
+			totpSecretPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update totp_secrets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"totp_secrets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, totpSecretPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(totpSecretType, totpSecretMapping, append(wl, totpSecretPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update totp_secrets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for totp_secrets")
+	}
+
+	if !cached {
+		totpSecretUpdateCacheMut.Lock()
+		totpSecretUpdateCache[key] = cache
+		totpSecretUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q totpSecretQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for totp_secrets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for totp_secrets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TotpSecretSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), totpSecretPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"totp_secrets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, totpSecretPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in totpSecret slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all totpSecret")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TotpSecret) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no totp_secrets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(totpSecretColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	totpSecretUpsertCacheMut.RLock()
+	cache, cached := totpSecretUpsertCache[key]
+	totpSecretUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			totpSecretAllColumns,
+			totpSecretColumnsWithDefault,
+			totpSecretColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			totpSecretAllColumns,
+			totpSecretPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert totp_secrets, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(totpSecretAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(totpSecretPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert totp_secrets, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(totpSecretPrimaryKeyColumns))
+			copy(conflict, totpSecretPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"totp_secrets\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(totpSecretType, totpSecretMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(totpSecretType, totpSecretMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert totp_secrets")
+	}
+
+	if !cached {
+		totpSecretUpsertCacheMut.Lock()
+		totpSecretUpsertCache[key] = cache
+		totpSecretUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single TotpSecret record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TotpSecret) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TotpSecret provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), totpSecretPrimaryKeyMapping)
+	sql := "DELETE FROM \"totp_secrets\" WHERE \"user_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from totp_secrets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for totp_secrets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q totpSecretQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no totpSecretQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from totp_secrets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for totp_secrets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TotpSecretSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), totpSecretPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"totp_secrets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, totpSecretPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from totpSecret slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for totp_secrets")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TotpSecret) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTotpSecret(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TotpSecretSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TotpSecretSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), totpSecretPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"totp_secrets\".* FROM \"totp_secrets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, totpSecretPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TotpSecretSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TotpSecretExists checks if the TotpSecret row exists.
+func TotpSecretExists(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"totp_secrets\" where \"user_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if totp_secrets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TotpSecret row exists.
+func (o *TotpSecret) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TotpSecretExists(ctx, exec, o.UserID)
+}
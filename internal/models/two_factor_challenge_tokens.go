@@ -0,0 +1,910 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TwoFactorChallengeToken is an object representing the database table.
+type TwoFactorChallengeToken struct {
+	Token      string    `boil:"token" json:"token" toml:"token" yaml:"token"`
+	ValidUntil time.Time `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *twoFactorChallengeTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L twoFactorChallengeTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TwoFactorChallengeTokenColumns = struct {
+	Token      string
+	ValidUntil string
+	UserID     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Token:      "token",
+	ValidUntil: "valid_until",
+	UserID:     "user_id",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var TwoFactorChallengeTokenTableColumns = struct {
+	Token      string
+	ValidUntil string
+	UserID     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Token:      "two_factor_challenge_tokens.token",
+	ValidUntil: "two_factor_challenge_tokens.valid_until",
+	UserID:     "two_factor_challenge_tokens.user_id",
+	CreatedAt:  "two_factor_challenge_tokens.created_at",
+	UpdatedAt:  "two_factor_challenge_tokens.updated_at",
+}
+
+// Generated where
+
+var TwoFactorChallengeTokenWhere = struct {
+	Token      whereHelperstring
+	ValidUntil whereHelpertime_Time
+	UserID     whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	Token:      whereHelperstring{field: "\"two_factor_challenge_tokens\".\"token\""},
+	ValidUntil: whereHelpertime_Time{field: "\"two_factor_challenge_tokens\".\"valid_until\""},
+	UserID:     whereHelperstring{field: "\"two_factor_challenge_tokens\".\"user_id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"two_factor_challenge_tokens\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"two_factor_challenge_tokens\".\"updated_at\""},
+}
+
+// TwoFactorChallengeTokenRels is where relationship names are stored.
+var TwoFactorChallengeTokenRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// twoFactorChallengeTokenR is where relationships are stored.
+type twoFactorChallengeTokenR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*twoFactorChallengeTokenR) NewStruct() *twoFactorChallengeTokenR {
+	return &twoFactorChallengeTokenR{}
+}
+
+func (o *TwoFactorChallengeToken) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *twoFactorChallengeTokenR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// twoFactorChallengeTokenL is where Load methods for each relationship are stored.
+type twoFactorChallengeTokenL struct{}
+
+var (
+	twoFactorChallengeTokenAllColumns            = []string{"token", "valid_until", "user_id", "created_at", "updated_at"}
+	twoFactorChallengeTokenColumnsWithoutDefault = []string{"valid_until", "user_id", "created_at", "updated_at"}
+	twoFactorChallengeTokenColumnsWithDefault    = []string{"token"}
+	twoFactorChallengeTokenPrimaryKeyColumns     = []string{"token"}
+	twoFactorChallengeTokenGeneratedColumns      = []string{}
+)
+
+type (
+	// TwoFactorChallengeTokenSlice is an alias for a slice of pointers to TwoFactorChallengeToken.
+	// This should almost always be used instead of []TwoFactorChallengeToken.
+	TwoFactorChallengeTokenSlice []*TwoFactorChallengeToken
+
+	twoFactorChallengeTokenQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	twoFactorChallengeTokenType                 = reflect.TypeOf(&TwoFactorChallengeToken{})
+	twoFactorChallengeTokenMapping              = queries.MakeStructMapping(twoFactorChallengeTokenType)
+	twoFactorChallengeTokenPrimaryKeyMapping, _ = queries.BindMapping(twoFactorChallengeTokenType, twoFactorChallengeTokenMapping, twoFactorChallengeTokenPrimaryKeyColumns)
+	twoFactorChallengeTokenInsertCacheMut       sync.RWMutex
+	twoFactorChallengeTokenInsertCache          = make(map[string]insertCache)
+	twoFactorChallengeTokenUpdateCacheMut       sync.RWMutex
+	twoFactorChallengeTokenUpdateCache          = make(map[string]updateCache)
+	twoFactorChallengeTokenUpsertCacheMut       sync.RWMutex
+	twoFactorChallengeTokenUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single twoFactorChallengeToken record from the query.
+func (q twoFactorChallengeTokenQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TwoFactorChallengeToken, error) {
+	o := &TwoFactorChallengeToken{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for two_factor_challenge_tokens")
+	}
+
+	return o, nil
+}
+
+// All returns all TwoFactorChallengeToken records from the query.
+func (q twoFactorChallengeTokenQuery) All(ctx context.Context, exec boil.ContextExecutor) (TwoFactorChallengeTokenSlice, error) {
+	var o []*TwoFactorChallengeToken
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TwoFactorChallengeToken slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TwoFactorChallengeToken records in the query.
+func (q twoFactorChallengeTokenQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count two_factor_challenge_tokens rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q twoFactorChallengeTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if two_factor_challenge_tokens exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TwoFactorChallengeToken) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (twoFactorChallengeTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTwoFactorChallengeToken interface{}, mods queries.Applicator) error {
+	var slice []*TwoFactorChallengeToken
+	var object *TwoFactorChallengeToken
+
+	if singular {
+		var ok bool
+		object, ok = maybeTwoFactorChallengeToken.(*TwoFactorChallengeToken)
+		if !ok {
+			object = new(TwoFactorChallengeToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTwoFactorChallengeToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTwoFactorChallengeToken))
+			}
+		}
+	} else {
+		s, ok := maybeTwoFactorChallengeToken.(*[]*TwoFactorChallengeToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTwoFactorChallengeToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTwoFactorChallengeToken))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &twoFactorChallengeTokenR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &twoFactorChallengeTokenR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TwoFactorChallengeTokens = append(foreign.R.TwoFactorChallengeTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TwoFactorChallengeTokens = append(foreign.R.TwoFactorChallengeTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the twoFactorChallengeToken to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TwoFactorChallengeTokens.
+func (o *TwoFactorChallengeToken) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"two_factor_challenge_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, twoFactorChallengeTokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Token}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &twoFactorChallengeTokenR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TwoFactorChallengeTokens: TwoFactorChallengeTokenSlice{o},
+		}
+	} else {
+		related.R.TwoFactorChallengeTokens = append(related.R.TwoFactorChallengeTokens, o)
+	}
+
+	return nil
+}
+
+// TwoFactorChallengeTokens retrieves all the records using an executor.
+func TwoFactorChallengeTokens(mods ...qm.QueryMod) twoFactorChallengeTokenQuery {
+	mods = append(mods, qm.From("\"two_factor_challenge_tokens\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"two_factor_challenge_tokens\".*"})
+	}
+
+	return twoFactorChallengeTokenQuery{q}
+}
+
+// FindTwoFactorChallengeToken retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTwoFactorChallengeToken(ctx context.Context, exec boil.ContextExecutor, token string, selectCols ...string) (*TwoFactorChallengeToken, error) {
+	twoFactorChallengeTokenObj := &TwoFactorChallengeToken{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"two_factor_challenge_tokens\" where \"token\"=$1", sel,
+	)
+
+	q := queries.Raw(query, token)
+
+	err := q.Bind(ctx, exec, twoFactorChallengeTokenObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from two_factor_challenge_tokens")
+	}
+
+	return twoFactorChallengeTokenObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TwoFactorChallengeToken) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no two_factor_challenge_tokens provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(twoFactorChallengeTokenColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	twoFactorChallengeTokenInsertCacheMut.RLock()
+	cache, cached := twoFactorChallengeTokenInsertCache[key]
+	twoFactorChallengeTokenInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			twoFactorChallengeTokenAllColumns,
+			twoFactorChallengeTokenColumnsWithDefault,
+			twoFactorChallengeTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(twoFactorChallengeTokenType, twoFactorChallengeTokenMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(twoFactorChallengeTokenType, twoFactorChallengeTokenMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"two_factor_challenge_tokens\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"two_factor_challenge_tokens\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into two_factor_challenge_tokens")
+	}
+
+	if !cached {
+		twoFactorChallengeTokenInsertCacheMut.Lock()
+		twoFactorChallengeTokenInsertCache[key] = cache
+		twoFactorChallengeTokenInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the TwoFactorChallengeToken.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TwoFactorChallengeToken) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	twoFactorChallengeTokenUpdateCacheMut.RLock()
+	cache, cached := twoFactorChallengeTokenUpdateCache[key]
+	twoFactorChallengeTokenUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			twoFactorChallengeTokenAllColumns,
+			twoFactorChallengeTokenPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update two_factor_challenge_tokens, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"two_factor_challenge_tokens\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, twoFactorChallengeTokenPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(twoFactorChallengeTokenType, twoFactorChallengeTokenMapping, append(wl, twoFactorChallengeTokenPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update two_factor_challenge_tokens row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for two_factor_challenge_tokens")
+	}
+
+	if !cached {
+		twoFactorChallengeTokenUpdateCacheMut.Lock()
+		twoFactorChallengeTokenUpdateCache[key] = cache
+		twoFactorChallengeTokenUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q twoFactorChallengeTokenQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for two_factor_challenge_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for two_factor_challenge_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TwoFactorChallengeTokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), twoFactorChallengeTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"two_factor_challenge_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, twoFactorChallengeTokenPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in twoFactorChallengeToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all twoFactorChallengeToken")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TwoFactorChallengeToken) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no two_factor_challenge_tokens provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(twoFactorChallengeTokenColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	twoFactorChallengeTokenUpsertCacheMut.RLock()
+	cache, cached := twoFactorChallengeTokenUpsertCache[key]
+	twoFactorChallengeTokenUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			twoFactorChallengeTokenAllColumns,
+			twoFactorChallengeTokenColumnsWithDefault,
+			twoFactorChallengeTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			twoFactorChallengeTokenAllColumns,
+			twoFactorChallengeTokenPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert two_factor_challenge_tokens, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(twoFactorChallengeTokenAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(twoFactorChallengeTokenPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert two_factor_challenge_tokens, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(twoFactorChallengeTokenPrimaryKeyColumns))
+			copy(conflict, twoFactorChallengeTokenPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"two_factor_challenge_tokens\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(twoFactorChallengeTokenType, twoFactorChallengeTokenMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(twoFactorChallengeTokenType, twoFactorChallengeTokenMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert two_factor_challenge_tokens")
+	}
+
+	if !cached {
+		twoFactorChallengeTokenUpsertCacheMut.Lock()
+		twoFactorChallengeTokenUpsertCache[key] = cache
+		twoFactorChallengeTokenUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single TwoFactorChallengeToken record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TwoFactorChallengeToken) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TwoFactorChallengeToken provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), twoFactorChallengeTokenPrimaryKeyMapping)
+	sql := "DELETE FROM \"two_factor_challenge_tokens\" WHERE \"token\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from two_factor_challenge_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for two_factor_challenge_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q twoFactorChallengeTokenQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no twoFactorChallengeTokenQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from two_factor_challenge_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for two_factor_challenge_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TwoFactorChallengeTokenSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), twoFactorChallengeTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"two_factor_challenge_tokens\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, twoFactorChallengeTokenPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from twoFactorChallengeToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for two_factor_challenge_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TwoFactorChallengeToken) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTwoFactorChallengeToken(ctx, exec, o.Token)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TwoFactorChallengeTokenSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TwoFactorChallengeTokenSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), twoFactorChallengeTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"two_factor_challenge_tokens\".* FROM \"two_factor_challenge_tokens\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, twoFactorChallengeTokenPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TwoFactorChallengeTokenSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TwoFactorChallengeTokenExists checks if the TwoFactorChallengeToken row exists.
+func TwoFactorChallengeTokenExists(ctx context.Context, exec boil.ContextExecutor, token string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"two_factor_challenge_tokens\" where \"token\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, token)
+	}
+	row := exec.QueryRowContext(ctx, sql, token)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if two_factor_challenge_tokens exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TwoFactorChallengeToken row exists.
+func (o *TwoFactorChallengeToken) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TwoFactorChallengeTokenExists(ctx, exec, o.Token)
+}
@@ -0,0 +1,701 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTwoFactorChallengeTokens(t *testing.T) {
+	t.Parallel()
+
+	query := TwoFactorChallengeTokens()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTwoFactorChallengeTokensDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTwoFactorChallengeTokensQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TwoFactorChallengeTokens().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTwoFactorChallengeTokensSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TwoFactorChallengeTokenSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTwoFactorChallengeTokensExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TwoFactorChallengeTokenExists(ctx, tx, o.Token)
+	if err != nil {
+		t.Errorf("Unable to check if TwoFactorChallengeToken exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TwoFactorChallengeTokenExists to return true, but got false.")
+	}
+}
+
+func testTwoFactorChallengeTokensFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	twoFactorChallengeTokenFound, err := FindTwoFactorChallengeToken(ctx, tx, o.Token)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if twoFactorChallengeTokenFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTwoFactorChallengeTokensBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TwoFactorChallengeTokens().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTwoFactorChallengeTokensOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TwoFactorChallengeTokens().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTwoFactorChallengeTokensAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	twoFactorChallengeTokenOne := &TwoFactorChallengeToken{}
+	twoFactorChallengeTokenTwo := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, twoFactorChallengeTokenOne, twoFactorChallengeTokenDBTypes, false, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, twoFactorChallengeTokenTwo, twoFactorChallengeTokenDBTypes, false, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = twoFactorChallengeTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = twoFactorChallengeTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TwoFactorChallengeTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTwoFactorChallengeTokensCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	twoFactorChallengeTokenOne := &TwoFactorChallengeToken{}
+	twoFactorChallengeTokenTwo := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, twoFactorChallengeTokenOne, twoFactorChallengeTokenDBTypes, false, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, twoFactorChallengeTokenTwo, twoFactorChallengeTokenDBTypes, false, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = twoFactorChallengeTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = twoFactorChallengeTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testTwoFactorChallengeTokensInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTwoFactorChallengeTokensInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(twoFactorChallengeTokenPrimaryKeyColumns, twoFactorChallengeTokenColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTwoFactorChallengeTokenToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TwoFactorChallengeToken
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, twoFactorChallengeTokenDBTypes, false, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TwoFactorChallengeTokenSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*TwoFactorChallengeToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testTwoFactorChallengeTokenToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TwoFactorChallengeToken
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, twoFactorChallengeTokenDBTypes, false, strmangle.SetComplement(twoFactorChallengeTokenPrimaryKeyColumns, twoFactorChallengeTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TwoFactorChallengeTokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testTwoFactorChallengeTokensReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTwoFactorChallengeTokensReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TwoFactorChallengeTokenSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTwoFactorChallengeTokensSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TwoFactorChallengeTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	twoFactorChallengeTokenDBTypes = map[string]string{`Token`: `uuid`, `ValidUntil`: `timestamp with time zone`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                              = bytes.MinRead
+)
+
+func testTwoFactorChallengeTokensUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(twoFactorChallengeTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(twoFactorChallengeTokenAllColumns) == len(twoFactorChallengeTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTwoFactorChallengeTokensSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(twoFactorChallengeTokenAllColumns) == len(twoFactorChallengeTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, twoFactorChallengeTokenDBTypes, true, twoFactorChallengeTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(twoFactorChallengeTokenAllColumns, twoFactorChallengeTokenPrimaryKeyColumns) {
+		fields = twoFactorChallengeTokenAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			twoFactorChallengeTokenAllColumns,
+			twoFactorChallengeTokenPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TwoFactorChallengeTokenSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTwoFactorChallengeTokensUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(twoFactorChallengeTokenAllColumns) == len(twoFactorChallengeTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TwoFactorChallengeToken{}
+	if err = randomize.Struct(seed, &o, twoFactorChallengeTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TwoFactorChallengeToken: %s", err)
+	}
+
+	count, err := TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, twoFactorChallengeTokenDBTypes, false, twoFactorChallengeTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TwoFactorChallengeToken struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TwoFactorChallengeToken: %s", err)
+	}
+
+	count, err = TwoFactorChallengeTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
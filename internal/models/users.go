@@ -25,252 +25,411 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID                   string            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Username             null.String       `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
-	Password             null.String       `boil:"password" json:"password,omitempty" toml:"password" yaml:"password,omitempty"`
-	IsActive             bool              `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
-	Scopes               types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
-	LastAuthenticatedAt  null.Time         `boil:"last_authenticated_at" json:"last_authenticated_at,omitempty" toml:"last_authenticated_at" yaml:"last_authenticated_at,omitempty"`
-	CreatedAt            time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt            time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	RequiresConfirmation bool              `boil:"requires_confirmation" json:"requires_confirmation" toml:"requires_confirmation" yaml:"requires_confirmation"`
+	ID                     string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Username               null.String       `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
+	Password               null.String       `boil:"password" json:"password,omitempty" toml:"password" yaml:"password,omitempty"`
+	IsActive               bool              `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	Scopes                 types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
+	LastAuthenticatedAt    null.Time         `boil:"last_authenticated_at" json:"last_authenticated_at,omitempty" toml:"last_authenticated_at" yaml:"last_authenticated_at,omitempty"`
+	CreatedAt              time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt              time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	RequiresConfirmation   bool              `boil:"requires_confirmation" json:"requires_confirmation" toml:"requires_confirmation" yaml:"requires_confirmation"`
+	PasswordResetRequired  bool              `boil:"password_reset_required" json:"password_reset_required" toml:"password_reset_required" yaml:"password_reset_required"`
+	DeletionScheduledAt    null.Time         `boil:"deletion_scheduled_at" json:"deletion_scheduled_at,omitempty" toml:"deletion_scheduled_at" yaml:"deletion_scheduled_at,omitempty"`
+	DeletionCancelToken    null.String       `boil:"deletion_cancel_token" json:"deletion_cancel_token,omitempty" toml:"deletion_cancel_token" yaml:"deletion_cancel_token,omitempty"`
+	DeletionReminderSentAt null.Time         `boil:"deletion_reminder_sent_at" json:"deletion_reminder_sent_at,omitempty" toml:"deletion_reminder_sent_at" yaml:"deletion_reminder_sent_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID                   string
-	Username             string
-	Password             string
-	IsActive             string
-	Scopes               string
-	LastAuthenticatedAt  string
-	CreatedAt            string
-	UpdatedAt            string
-	RequiresConfirmation string
+	ID                     string
+	Username               string
+	Password               string
+	IsActive               string
+	Scopes                 string
+	LastAuthenticatedAt    string
+	CreatedAt              string
+	UpdatedAt              string
+	RequiresConfirmation   string
+	PasswordResetRequired  string
+	DeletionScheduledAt    string
+	DeletionCancelToken    string
+	DeletionReminderSentAt string
 }{
-	ID:                   "id",
-	Username:             "username",
-	Password:             "password",
-	IsActive:             "is_active",
-	Scopes:               "scopes",
-	LastAuthenticatedAt:  "last_authenticated_at",
-	CreatedAt:            "created_at",
-	UpdatedAt:            "updated_at",
-	RequiresConfirmation: "requires_confirmation",
+	ID:                     "id",
+	Username:               "username",
+	Password:               "password",
+	IsActive:               "is_active",
+	Scopes:                 "scopes",
+	LastAuthenticatedAt:    "last_authenticated_at",
+	CreatedAt:              "created_at",
+	UpdatedAt:              "updated_at",
+	RequiresConfirmation:   "requires_confirmation",
+	PasswordResetRequired:  "password_reset_required",
+	DeletionScheduledAt:    "deletion_scheduled_at",
+	DeletionCancelToken:    "deletion_cancel_token",
+	DeletionReminderSentAt: "deletion_reminder_sent_at",
 }
 
 var UserTableColumns = struct {
-	ID                   string
-	Username             string
-	Password             string
-	IsActive             string
-	Scopes               string
-	LastAuthenticatedAt  string
-	CreatedAt            string
-	UpdatedAt            string
-	RequiresConfirmation string
+	ID                     string
+	Username               string
+	Password               string
+	IsActive               string
+	Scopes                 string
+	LastAuthenticatedAt    string
+	CreatedAt              string
+	UpdatedAt              string
+	RequiresConfirmation   string
+	PasswordResetRequired  string
+	DeletionScheduledAt    string
+	DeletionCancelToken    string
+	DeletionReminderSentAt string
 }{
-	ID:                   "users.id",
-	Username:             "users.username",
-	Password:             "users.password",
-	IsActive:             "users.is_active",
-	Scopes:               "users.scopes",
-	LastAuthenticatedAt:  "users.last_authenticated_at",
-	CreatedAt:            "users.created_at",
-	UpdatedAt:            "users.updated_at",
-	RequiresConfirmation: "users.requires_confirmation",
+	ID:                     "users.id",
+	Username:               "users.username",
+	Password:               "users.password",
+	IsActive:               "users.is_active",
+	Scopes:                 "users.scopes",
+	LastAuthenticatedAt:    "users.last_authenticated_at",
+	CreatedAt:              "users.created_at",
+	UpdatedAt:              "users.updated_at",
+	RequiresConfirmation:   "users.requires_confirmation",
+	PasswordResetRequired:  "users.password_reset_required",
+	DeletionScheduledAt:    "users.deletion_scheduled_at",
+	DeletionCancelToken:    "users.deletion_cancel_token",
+	DeletionReminderSentAt: "users.deletion_reminder_sent_at",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+var UserWhere = struct {
+	ID                     whereHelperstring
+	Username               whereHelpernull_String
+	Password               whereHelpernull_String
+	IsActive               whereHelperbool
+	Scopes                 whereHelpertypes_StringArray
+	LastAuthenticatedAt    whereHelpernull_Time
+	CreatedAt              whereHelpertime_Time
+	UpdatedAt              whereHelpertime_Time
+	RequiresConfirmation   whereHelperbool
+	PasswordResetRequired  whereHelperbool
+	DeletionScheduledAt    whereHelpernull_Time
+	DeletionCancelToken    whereHelpernull_String
+	DeletionReminderSentAt whereHelpernull_Time
+}{
+	ID:                     whereHelperstring{field: "\"users\".\"id\""},
+	Username:               whereHelpernull_String{field: "\"users\".\"username\""},
+	Password:               whereHelpernull_String{field: "\"users\".\"password\""},
+	IsActive:               whereHelperbool{field: "\"users\".\"is_active\""},
+	Scopes:                 whereHelpertypes_StringArray{field: "\"users\".\"scopes\""},
+	LastAuthenticatedAt:    whereHelpernull_Time{field: "\"users\".\"last_authenticated_at\""},
+	CreatedAt:              whereHelpertime_Time{field: "\"users\".\"created_at\""},
+	UpdatedAt:              whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	RequiresConfirmation:   whereHelperbool{field: "\"users\".\"requires_confirmation\""},
+	PasswordResetRequired:  whereHelperbool{field: "\"users\".\"password_reset_required\""},
+	DeletionScheduledAt:    whereHelpernull_Time{field: "\"users\".\"deletion_scheduled_at\""},
+	DeletionCancelToken:    whereHelpernull_String{field: "\"users\".\"deletion_cancel_token\""},
+	DeletionReminderSentAt: whereHelpernull_Time{field: "\"users\".\"deletion_reminder_sent_at\""},
 }
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+// UserRels is where relationship names are stored.
+var UserRels = struct {
+	AppUserProfile           string
+	TotpSecret               string
+	ImpersonatorAccessTokens string
+	AccessTokens             string
+	APIKeys                  string
+	ConfirmationTokens       string
+	DataExports              string
+	EmailChangeRequests      string
+	CreatedByInvites         string
+	Invites                  string
+	MagicLinkTokens          string
+	NotificationPreferences  string
+	PasswordHistoryEntries   string
+	PasswordResetTokens      string
+	CreatedByPushBroadcasts  string
+	PushDeliveries           string
+	PushTokens               string
+	PushTopicSubscriptions   string
+	RefreshTokenReuseEvents  string
+	RefreshTokens            string
+	TotpRecoveryCodes        string
+	TwoFactorChallengeTokens string
+	UserIdentities           string
+	WebauthnChallenges       string
+	WebauthnCredentials      string
+}{
+	AppUserProfile:           "AppUserProfile",
+	TotpSecret:               "TotpSecret",
+	ImpersonatorAccessTokens: "ImpersonatorAccessTokens",
+	AccessTokens:             "AccessTokens",
+	APIKeys:                  "APIKeys",
+	ConfirmationTokens:       "ConfirmationTokens",
+	DataExports:              "DataExports",
+	EmailChangeRequests:      "EmailChangeRequests",
+	CreatedByInvites:         "CreatedByInvites",
+	Invites:                  "Invites",
+	MagicLinkTokens:          "MagicLinkTokens",
+	NotificationPreferences:  "NotificationPreferences",
+	PasswordHistoryEntries:   "PasswordHistoryEntries",
+	PasswordResetTokens:      "PasswordResetTokens",
+	CreatedByPushBroadcasts:  "CreatedByPushBroadcasts",
+	PushDeliveries:           "PushDeliveries",
+	PushTokens:               "PushTokens",
+	PushTopicSubscriptions:   "PushTopicSubscriptions",
+	RefreshTokenReuseEvents:  "RefreshTokenReuseEvents",
+	RefreshTokens:            "RefreshTokens",
+	TotpRecoveryCodes:        "TotpRecoveryCodes",
+	TwoFactorChallengeTokens: "TwoFactorChallengeTokens",
+	UserIdentities:           "UserIdentities",
+	WebauthnChallenges:       "WebauthnChallenges",
+	WebauthnCredentials:      "WebauthnCredentials",
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+// userR is where relationships are stored.
+type userR struct {
+	AppUserProfile           *AppUserProfile              `boil:"AppUserProfile" json:"AppUserProfile" toml:"AppUserProfile" yaml:"AppUserProfile"`
+	TotpSecret               *TotpSecret                  `boil:"TotpSecret" json:"TotpSecret" toml:"TotpSecret" yaml:"TotpSecret"`
+	ImpersonatorAccessTokens AccessTokenSlice             `boil:"ImpersonatorAccessTokens" json:"ImpersonatorAccessTokens" toml:"ImpersonatorAccessTokens" yaml:"ImpersonatorAccessTokens"`
+	AccessTokens             AccessTokenSlice             `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
+	APIKeys                  APIKeySlice                  `boil:"APIKeys" json:"APIKeys" toml:"APIKeys" yaml:"APIKeys"`
+	ConfirmationTokens       ConfirmationTokenSlice       `boil:"ConfirmationTokens" json:"ConfirmationTokens" toml:"ConfirmationTokens" yaml:"ConfirmationTokens"`
+	DataExports              DataExportSlice              `boil:"DataExports" json:"DataExports" toml:"DataExports" yaml:"DataExports"`
+	EmailChangeRequests      EmailChangeRequestSlice      `boil:"EmailChangeRequests" json:"EmailChangeRequests" toml:"EmailChangeRequests" yaml:"EmailChangeRequests"`
+	CreatedByInvites         InviteSlice                  `boil:"CreatedByInvites" json:"CreatedByInvites" toml:"CreatedByInvites" yaml:"CreatedByInvites"`
+	Invites                  InviteSlice                  `boil:"Invites" json:"Invites" toml:"Invites" yaml:"Invites"`
+	MagicLinkTokens          MagicLinkTokenSlice          `boil:"MagicLinkTokens" json:"MagicLinkTokens" toml:"MagicLinkTokens" yaml:"MagicLinkTokens"`
+	NotificationPreferences  NotificationPreferenceSlice  `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	PasswordHistoryEntries   PasswordHistoryEntrySlice    `boil:"PasswordHistoryEntries" json:"PasswordHistoryEntries" toml:"PasswordHistoryEntries" yaml:"PasswordHistoryEntries"`
+	PasswordResetTokens      PasswordResetTokenSlice      `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
+	CreatedByPushBroadcasts  PushBroadcastSlice           `boil:"CreatedByPushBroadcasts" json:"CreatedByPushBroadcasts" toml:"CreatedByPushBroadcasts" yaml:"CreatedByPushBroadcasts"`
+	PushDeliveries           PushDeliverySlice            `boil:"PushDeliveries" json:"PushDeliveries" toml:"PushDeliveries" yaml:"PushDeliveries"`
+	PushTokens               PushTokenSlice               `boil:"PushTokens" json:"PushTokens" toml:"PushTokens" yaml:"PushTokens"`
+	PushTopicSubscriptions   PushTopicSubscriptionSlice   `boil:"PushTopicSubscriptions" json:"PushTopicSubscriptions" toml:"PushTopicSubscriptions" yaml:"PushTopicSubscriptions"`
+	RefreshTokenReuseEvents  RefreshTokenReuseEventSlice  `boil:"RefreshTokenReuseEvents" json:"RefreshTokenReuseEvents" toml:"RefreshTokenReuseEvents" yaml:"RefreshTokenReuseEvents"`
+	RefreshTokens            RefreshTokenSlice            `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	TotpRecoveryCodes        TotpRecoveryCodeSlice        `boil:"TotpRecoveryCodes" json:"TotpRecoveryCodes" toml:"TotpRecoveryCodes" yaml:"TotpRecoveryCodes"`
+	TwoFactorChallengeTokens TwoFactorChallengeTokenSlice `boil:"TwoFactorChallengeTokens" json:"TwoFactorChallengeTokens" toml:"TwoFactorChallengeTokens" yaml:"TwoFactorChallengeTokens"`
+	UserIdentities           UserIdentitySlice            `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	WebauthnChallenges       WebauthnChallengeSlice       `boil:"WebauthnChallenges" json:"WebauthnChallenges" toml:"WebauthnChallenges" yaml:"WebauthnChallenges"`
+	WebauthnCredentials      WebauthnCredentialSlice      `boil:"WebauthnCredentials" json:"WebauthnCredentials" toml:"WebauthnCredentials" yaml:"WebauthnCredentials"`
 }
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+// NewStruct creates a new relationship struct
+func (*userR) NewStruct() *userR {
+	return &userR{}
 }
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
+
+func (o *User) GetAppUserProfile() *AppUserProfile {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAppUserProfile()
 }
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
+
+func (r *userR) GetAppUserProfile() *AppUserProfile {
+	if r == nil {
+		return nil
+	}
+
+	return r.AppUserProfile
 }
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
+
+func (o *User) GetTotpSecret() *TotpSecret {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTotpSecret()
 }
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
+
+func (r *userR) GetTotpSecret() *TotpSecret {
+	if r == nil {
+		return nil
+	}
+
+	return r.TotpSecret
 }
-func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" SIMILAR TO ?", x)
+
+func (o *User) GetImpersonatorAccessTokens() AccessTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImpersonatorAccessTokens()
 }
-func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+
+func (r *userR) GetImpersonatorAccessTokens() AccessTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImpersonatorAccessTokens
 }
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (o *User) GetAccessTokens() AccessTokenSlice {
+	if o == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+
+	return o.R.GetAccessTokens()
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *userR) GetAccessTokens() AccessTokenSlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+	return r.AccessTokens
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (o *User) GetAPIKeys() APIKeySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAPIKeys()
+}
 
-type whereHelperbool struct{ field string }
+func (r *userR) GetAPIKeys() APIKeySlice {
+	if r == nil {
+		return nil
+	}
 
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+	return r.APIKeys
+}
 
-type whereHelpertypes_StringArray struct{ field string }
+func (o *User) GetConfirmationTokens() ConfirmationTokenSlice {
+	if o == nil {
+		return nil
+	}
 
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+	return o.R.GetConfirmationTokens()
 }
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+
+func (r *userR) GetConfirmationTokens() ConfirmationTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ConfirmationTokens
 }
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+
+func (o *User) GetDataExports() DataExportSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDataExports()
 }
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+func (r *userR) GetDataExports() DataExportSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.DataExports
 }
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+func (o *User) GetEmailChangeRequests() EmailChangeRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetEmailChangeRequests()
 }
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (r *userR) GetEmailChangeRequests() EmailChangeRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.EmailChangeRequests
 }
 
-var UserWhere = struct {
-	ID                   whereHelperstring
-	Username             whereHelpernull_String
-	Password             whereHelpernull_String
-	IsActive             whereHelperbool
-	Scopes               whereHelpertypes_StringArray
-	LastAuthenticatedAt  whereHelpernull_Time
-	CreatedAt            whereHelpertime_Time
-	UpdatedAt            whereHelpertime_Time
-	RequiresConfirmation whereHelperbool
-}{
-	ID:                   whereHelperstring{field: "\"users\".\"id\""},
-	Username:             whereHelpernull_String{field: "\"users\".\"username\""},
-	Password:             whereHelpernull_String{field: "\"users\".\"password\""},
-	IsActive:             whereHelperbool{field: "\"users\".\"is_active\""},
-	Scopes:               whereHelpertypes_StringArray{field: "\"users\".\"scopes\""},
-	LastAuthenticatedAt:  whereHelpernull_Time{field: "\"users\".\"last_authenticated_at\""},
-	CreatedAt:            whereHelpertime_Time{field: "\"users\".\"created_at\""},
-	UpdatedAt:            whereHelpertime_Time{field: "\"users\".\"updated_at\""},
-	RequiresConfirmation: whereHelperbool{field: "\"users\".\"requires_confirmation\""},
+func (o *User) GetCreatedByInvites() InviteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByInvites()
 }
 
-// UserRels is where relationship names are stored.
-var UserRels = struct {
-	AppUserProfile      string
-	AccessTokens        string
-	ConfirmationTokens  string
-	PasswordResetTokens string
-	PushTokens          string
-	RefreshTokens       string
-}{
-	AppUserProfile:      "AppUserProfile",
-	AccessTokens:        "AccessTokens",
-	ConfirmationTokens:  "ConfirmationTokens",
-	PasswordResetTokens: "PasswordResetTokens",
-	PushTokens:          "PushTokens",
-	RefreshTokens:       "RefreshTokens",
+func (r *userR) GetCreatedByInvites() InviteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByInvites
 }
 
-// userR is where relationships are stored.
-type userR struct {
-	AppUserProfile      *AppUserProfile         `boil:"AppUserProfile" json:"AppUserProfile" toml:"AppUserProfile" yaml:"AppUserProfile"`
-	AccessTokens        AccessTokenSlice        `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
-	ConfirmationTokens  ConfirmationTokenSlice  `boil:"ConfirmationTokens" json:"ConfirmationTokens" toml:"ConfirmationTokens" yaml:"ConfirmationTokens"`
-	PasswordResetTokens PasswordResetTokenSlice `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
-	PushTokens          PushTokenSlice          `boil:"PushTokens" json:"PushTokens" toml:"PushTokens" yaml:"PushTokens"`
-	RefreshTokens       RefreshTokenSlice       `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+func (o *User) GetInvites() InviteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInvites()
 }
 
-// NewStruct creates a new relationship struct
-func (*userR) NewStruct() *userR {
-	return &userR{}
+func (r *userR) GetInvites() InviteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Invites
 }
 
-func (o *User) GetAppUserProfile() *AppUserProfile {
+func (o *User) GetMagicLinkTokens() MagicLinkTokenSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetAppUserProfile()
+	return o.R.GetMagicLinkTokens()
 }
 
-func (r *userR) GetAppUserProfile() *AppUserProfile {
+func (r *userR) GetMagicLinkTokens() MagicLinkTokenSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.AppUserProfile
+	return r.MagicLinkTokens
 }
 
-func (o *User) GetAccessTokens() AccessTokenSlice {
+func (o *User) GetNotificationPreferences() NotificationPreferenceSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetAccessTokens()
+	return o.R.GetNotificationPreferences()
 }
 
-func (r *userR) GetAccessTokens() AccessTokenSlice {
+func (r *userR) GetNotificationPreferences() NotificationPreferenceSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.AccessTokens
+	return r.NotificationPreferences
 }
 
-func (o *User) GetConfirmationTokens() ConfirmationTokenSlice {
+func (o *User) GetPasswordHistoryEntries() PasswordHistoryEntrySlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetConfirmationTokens()
+	return o.R.GetPasswordHistoryEntries()
 }
 
-func (r *userR) GetConfirmationTokens() ConfirmationTokenSlice {
+func (r *userR) GetPasswordHistoryEntries() PasswordHistoryEntrySlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.ConfirmationTokens
+	return r.PasswordHistoryEntries
 }
 
 func (o *User) GetPasswordResetTokens() PasswordResetTokenSlice {
@@ -289,6 +448,38 @@ func (r *userR) GetPasswordResetTokens() PasswordResetTokenSlice {
 	return r.PasswordResetTokens
 }
 
+func (o *User) GetCreatedByPushBroadcasts() PushBroadcastSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByPushBroadcasts()
+}
+
+func (r *userR) GetCreatedByPushBroadcasts() PushBroadcastSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByPushBroadcasts
+}
+
+func (o *User) GetPushDeliveries() PushDeliverySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPushDeliveries()
+}
+
+func (r *userR) GetPushDeliveries() PushDeliverySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PushDeliveries
+}
+
 func (o *User) GetPushTokens() PushTokenSlice {
 	if o == nil {
 		return nil
@@ -305,6 +496,38 @@ func (r *userR) GetPushTokens() PushTokenSlice {
 	return r.PushTokens
 }
 
+func (o *User) GetPushTopicSubscriptions() PushTopicSubscriptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPushTopicSubscriptions()
+}
+
+func (r *userR) GetPushTopicSubscriptions() PushTopicSubscriptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PushTopicSubscriptions
+}
+
+func (o *User) GetRefreshTokenReuseEvents() RefreshTokenReuseEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRefreshTokenReuseEvents()
+}
+
+func (r *userR) GetRefreshTokenReuseEvents() RefreshTokenReuseEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RefreshTokenReuseEvents
+}
+
 func (o *User) GetRefreshTokens() RefreshTokenSlice {
 	if o == nil {
 		return nil
@@ -321,13 +544,93 @@ func (r *userR) GetRefreshTokens() RefreshTokenSlice {
 	return r.RefreshTokens
 }
 
+func (o *User) GetTotpRecoveryCodes() TotpRecoveryCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTotpRecoveryCodes()
+}
+
+func (r *userR) GetTotpRecoveryCodes() TotpRecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TotpRecoveryCodes
+}
+
+func (o *User) GetTwoFactorChallengeTokens() TwoFactorChallengeTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTwoFactorChallengeTokens()
+}
+
+func (r *userR) GetTwoFactorChallengeTokens() TwoFactorChallengeTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TwoFactorChallengeTokens
+}
+
+func (o *User) GetUserIdentities() UserIdentitySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserIdentities()
+}
+
+func (r *userR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserIdentities
+}
+
+func (o *User) GetWebauthnChallenges() WebauthnChallengeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnChallenges()
+}
+
+func (r *userR) GetWebauthnChallenges() WebauthnChallengeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnChallenges
+}
+
+func (o *User) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnCredentials()
+}
+
+func (r *userR) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCredentials
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "username", "password", "is_active", "scopes", "last_authenticated_at", "created_at", "updated_at", "requires_confirmation"}
+	userAllColumns            = []string{"id", "username", "password", "is_active", "scopes", "last_authenticated_at", "created_at", "updated_at", "requires_confirmation", "password_reset_required", "deletion_scheduled_at", "deletion_cancel_token", "deletion_reminder_sent_at"}
 	userColumnsWithoutDefault = []string{"is_active", "scopes", "created_at", "updated_at"}
-	userColumnsWithDefault    = []string{"id", "username", "password", "last_authenticated_at", "requires_confirmation"}
+	userColumnsWithDefault    = []string{"id", "username", "password", "last_authenticated_at", "requires_confirmation", "password_reset_required", "deletion_scheduled_at", "deletion_cancel_token", "deletion_reminder_sent_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -434,11 +737,36 @@ func (o *User) AppUserProfile(mods ...qm.QueryMod) appUserProfileQuery {
 	return AppUserProfiles(queryMods...)
 }
 
-// AccessTokens retrieves all the access_token's AccessTokens with an executor.
-func (o *User) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+// TotpSecret pointed to by the foreign key.
+func (o *User) TotpSecret(mods ...qm.QueryMod) totpSecretQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TotpSecrets(queryMods...)
+}
+
+// ImpersonatorAccessTokens retrieves all the access_token's AccessTokens with an executor via impersonator_id column.
+func (o *User) ImpersonatorAccessTokens(mods ...qm.QueryMod) accessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"access_tokens\".\"impersonator_id\"=?", o.ID),
+	)
+
+	return AccessTokens(queryMods...)
+}
+
+// AccessTokens retrieves all the access_token's AccessTokens with an executor.
+func (o *User) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
@@ -448,6 +776,20 @@ func (o *User) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
 	return AccessTokens(queryMods...)
 }
 
+// APIKeys retrieves all the api_key's APIKeys with an executor.
+func (o *User) APIKeys(mods ...qm.QueryMod) apiKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_keys\".\"user_id\"=?", o.ID),
+	)
+
+	return APIKeys(queryMods...)
+}
+
 // ConfirmationTokens retrieves all the confirmation_token's ConfirmationTokens with an executor.
 func (o *User) ConfirmationTokens(mods ...qm.QueryMod) confirmationTokenQuery {
 	var queryMods []qm.QueryMod
@@ -462,6 +804,104 @@ func (o *User) ConfirmationTokens(mods ...qm.QueryMod) confirmationTokenQuery {
 	return ConfirmationTokens(queryMods...)
 }
 
+// DataExports retrieves all the data_export's DataExports with an executor.
+func (o *User) DataExports(mods ...qm.QueryMod) dataExportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_exports\".\"user_id\"=?", o.ID),
+	)
+
+	return DataExports(queryMods...)
+}
+
+// EmailChangeRequests retrieves all the email_change_request's EmailChangeRequests with an executor.
+func (o *User) EmailChangeRequests(mods ...qm.QueryMod) emailChangeRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"email_change_requests\".\"user_id\"=?", o.ID),
+	)
+
+	return EmailChangeRequests(queryMods...)
+}
+
+// CreatedByInvites retrieves all the invite's Invites with an executor via created_by_id column.
+func (o *User) CreatedByInvites(mods ...qm.QueryMod) inviteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"invites\".\"created_by_id\"=?", o.ID),
+	)
+
+	return Invites(queryMods...)
+}
+
+// Invites retrieves all the invite's Invites with an executor.
+func (o *User) Invites(mods ...qm.QueryMod) inviteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"invites\".\"user_id\"=?", o.ID),
+	)
+
+	return Invites(queryMods...)
+}
+
+// MagicLinkTokens retrieves all the magic_link_token's MagicLinkTokens with an executor.
+func (o *User) MagicLinkTokens(mods ...qm.QueryMod) magicLinkTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"magic_link_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return MagicLinkTokens(queryMods...)
+}
+
+// NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
+func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_preferences\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationPreferences(queryMods...)
+}
+
+// PasswordHistoryEntries retrieves all the password_history_entry's PasswordHistoryEntries with an executor.
+func (o *User) PasswordHistoryEntries(mods ...qm.QueryMod) passwordHistoryEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"password_history_entries\".\"user_id\"=?", o.ID),
+	)
+
+	return PasswordHistoryEntries(queryMods...)
+}
+
 // PasswordResetTokens retrieves all the password_reset_token's PasswordResetTokens with an executor.
 func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery {
 	var queryMods []qm.QueryMod
@@ -476,6 +916,34 @@ func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery
 	return PasswordResetTokens(queryMods...)
 }
 
+// CreatedByPushBroadcasts retrieves all the push_broadcast's PushBroadcasts with an executor via created_by_id column.
+func (o *User) CreatedByPushBroadcasts(mods ...qm.QueryMod) pushBroadcastQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_broadcasts\".\"created_by_id\"=?", o.ID),
+	)
+
+	return PushBroadcasts(queryMods...)
+}
+
+// PushDeliveries retrieves all the push_delivery's PushDeliveries with an executor.
+func (o *User) PushDeliveries(mods ...qm.QueryMod) pushDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_deliveries\".\"user_id\"=?", o.ID),
+	)
+
+	return PushDeliveries(queryMods...)
+}
+
 // PushTokens retrieves all the push_token's PushTokens with an executor.
 func (o *User) PushTokens(mods ...qm.QueryMod) pushTokenQuery {
 	var queryMods []qm.QueryMod
@@ -490,6 +958,34 @@ func (o *User) PushTokens(mods ...qm.QueryMod) pushTokenQuery {
 	return PushTokens(queryMods...)
 }
 
+// PushTopicSubscriptions retrieves all the push_topic_subscription's PushTopicSubscriptions with an executor.
+func (o *User) PushTopicSubscriptions(mods ...qm.QueryMod) pushTopicSubscriptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_topic_subscriptions\".\"user_id\"=?", o.ID),
+	)
+
+	return PushTopicSubscriptions(queryMods...)
+}
+
+// RefreshTokenReuseEvents retrieves all the refresh_token_reuse_event's RefreshTokenReuseEvents with an executor.
+func (o *User) RefreshTokenReuseEvents(mods ...qm.QueryMod) refreshTokenReuseEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_token_reuse_events\".\"user_id\"=?", o.ID),
+	)
+
+	return RefreshTokenReuseEvents(queryMods...)
+}
+
 // RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
 func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
 	var queryMods []qm.QueryMod
@@ -504,6 +1000,76 @@ func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
 	return RefreshTokens(queryMods...)
 }
 
+// TotpRecoveryCodes retrieves all the totp_recovery_code's TotpRecoveryCodes with an executor.
+func (o *User) TotpRecoveryCodes(mods ...qm.QueryMod) totpRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"totp_recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return TotpRecoveryCodes(queryMods...)
+}
+
+// TwoFactorChallengeTokens retrieves all the two_factor_challenge_token's TwoFactorChallengeTokens with an executor.
+func (o *User) TwoFactorChallengeTokens(mods ...qm.QueryMod) twoFactorChallengeTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"two_factor_challenge_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return TwoFactorChallengeTokens(queryMods...)
+}
+
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *User) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"user_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
+// WebauthnChallenges retrieves all the webauthn_challenge's WebauthnChallenges with an executor.
+func (o *User) WebauthnChallenges(mods ...qm.QueryMod) webauthnChallengeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_challenges\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnChallenges(queryMods...)
+}
+
+// WebauthnCredentials retrieves all the webauthn_credential's WebauthnCredentials with an executor.
+func (o *User) WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_credentials\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnCredentials(queryMods...)
+}
+
 // LoadAppUserProfile allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (userL) LoadAppUserProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -613,9 +1179,9 @@ func (userL) LoadAppUserProfile(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadAccessTokens allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadTotpSecret allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadTotpSecret(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -652,6 +1218,7 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -668,8 +1235,8 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`access_tokens`),
-		qm.WhereIn(`access_tokens.user_id in ?`, argsSlice...),
+		qm.From(`totp_secrets`),
+		qm.WhereIn(`totp_secrets.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -677,38 +1244,40 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load access_tokens")
+		return errors.Wrap(err, "failed to eager load TotpSecret")
 	}
 
-	var resultSlice []*AccessToken
+	var resultSlice []*TotpSecret
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice access_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice TotpSecret")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on access_tokens")
+		return errors.Wrap(err, "failed to close results of eager load for totp_secrets")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for totp_secrets")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
 	if singular {
-		object.R.AccessTokens = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &accessTokenR{}
-			}
-			foreign.R.User = object
+		foreign := resultSlice[0]
+		object.R.TotpSecret = foreign
+		if foreign.R == nil {
+			foreign.R = &totpSecretR{}
 		}
-		return nil
+		foreign.R.User = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.UserID {
-				local.R.AccessTokens = append(local.R.AccessTokens, foreign)
+				local.R.TotpSecret = foreign
 				if foreign.R == nil {
-					foreign.R = &accessTokenR{}
+					foreign.R = &totpSecretR{}
 				}
 				foreign.R.User = local
 				break
@@ -719,9 +1288,9 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadConfirmationTokens allows an eager lookup of values, cached into the
+// LoadImpersonatorAccessTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadConfirmationTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpersonatorAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -774,8 +1343,8 @@ func (userL) LoadConfirmationTokens(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`confirmation_tokens`),
-		qm.WhereIn(`confirmation_tokens.user_id in ?`, argsSlice...),
+		qm.From(`access_tokens`),
+		qm.WhereIn(`access_tokens.impersonator_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -783,40 +1352,40 @@ func (userL) LoadConfirmationTokens(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load confirmation_tokens")
+		return errors.Wrap(err, "failed to eager load access_tokens")
 	}
 
-	var resultSlice []*ConfirmationToken
+	var resultSlice []*AccessToken
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice confirmation_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice access_tokens")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on confirmation_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on access_tokens")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for confirmation_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_tokens")
 	}
 
 	if singular {
-		object.R.ConfirmationTokens = resultSlice
+		object.R.ImpersonatorAccessTokens = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &confirmationTokenR{}
+				foreign.R = &accessTokenR{}
 			}
-			foreign.R.User = object
+			foreign.R.Impersonator = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.ConfirmationTokens = append(local.R.ConfirmationTokens, foreign)
+			if queries.Equal(local.ID, foreign.ImpersonatorID) {
+				local.R.ImpersonatorAccessTokens = append(local.R.ImpersonatorAccessTokens, foreign)
 				if foreign.R == nil {
-					foreign.R = &confirmationTokenR{}
+					foreign.R = &accessTokenR{}
 				}
-				foreign.R.User = local
+				foreign.R.Impersonator = local
 				break
 			}
 		}
@@ -825,9 +1394,9 @@ func (userL) LoadConfirmationTokens(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// LoadAccessTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -880,8 +1449,8 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`password_reset_tokens`),
-		qm.WhereIn(`password_reset_tokens.user_id in ?`, argsSlice...),
+		qm.From(`access_tokens`),
+		qm.WhereIn(`access_tokens.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -889,26 +1458,26 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+		return errors.Wrap(err, "failed to eager load access_tokens")
 	}
 
-	var resultSlice []*PasswordResetToken
+	var resultSlice []*AccessToken
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice access_tokens")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on access_tokens")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_tokens")
 	}
 
 	if singular {
-		object.R.PasswordResetTokens = resultSlice
+		object.R.AccessTokens = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &passwordResetTokenR{}
+				foreign.R = &accessTokenR{}
 			}
 			foreign.R.User = object
 		}
@@ -918,9 +1487,9 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				local.R.AccessTokens = append(local.R.AccessTokens, foreign)
 				if foreign.R == nil {
-					foreign.R = &passwordResetTokenR{}
+					foreign.R = &accessTokenR{}
 				}
 				foreign.R.User = local
 				break
@@ -931,9 +1500,9 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadPushTokens allows an eager lookup of values, cached into the
+// LoadAPIKeys allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAPIKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -986,8 +1555,8 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`push_tokens`),
-		qm.WhereIn(`push_tokens.user_id in ?`, argsSlice...),
+		qm.From(`api_keys`),
+		qm.WhereIn(`api_keys.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -995,26 +1564,26 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load push_tokens")
+		return errors.Wrap(err, "failed to eager load api_keys")
 	}
 
-	var resultSlice []*PushToken
+	var resultSlice []*APIKey
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice push_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on push_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on api_keys")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
 	}
 
 	if singular {
-		object.R.PushTokens = resultSlice
+		object.R.APIKeys = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &pushTokenR{}
+				foreign.R = &apiKeyR{}
 			}
 			foreign.R.User = object
 		}
@@ -1024,9 +1593,9 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.PushTokens = append(local.R.PushTokens, foreign)
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
 				if foreign.R == nil {
-					foreign.R = &pushTokenR{}
+					foreign.R = &apiKeyR{}
 				}
 				foreign.R.User = local
 				break
@@ -1037,9 +1606,221 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadRefreshTokens allows an eager lookup of values, cached into the
+// LoadConfirmationTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadConfirmationTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`confirmation_tokens`),
+		qm.WhereIn(`confirmation_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load confirmation_tokens")
+	}
+
+	var resultSlice []*ConfirmationToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice confirmation_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on confirmation_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for confirmation_tokens")
+	}
+
+	if singular {
+		object.R.ConfirmationTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &confirmationTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ConfirmationTokens = append(local.R.ConfirmationTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &confirmationTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDataExports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDataExports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_exports`),
+		qm.WhereIn(`data_exports.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_exports")
+	}
+
+	var resultSlice []*DataExport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_exports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_exports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_exports")
+	}
+
+	if singular {
+		object.R.DataExports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataExportR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.DataExports = append(local.R.DataExports, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataExportR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEmailChangeRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadEmailChangeRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1076,128 +1857,3230 @@ func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, sing
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
-			args[obj.ID] = struct{}{}
-		}
-	}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`email_change_requests`),
+		qm.WhereIn(`email_change_requests.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load email_change_requests")
+	}
+
+	var resultSlice []*EmailChangeRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice email_change_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on email_change_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_change_requests")
+	}
+
+	if singular {
+		object.R.EmailChangeRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &emailChangeRequestR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.EmailChangeRequests = append(local.R.EmailChangeRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &emailChangeRequestR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByInvites allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByInvites(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`invites`),
+		qm.WhereIn(`invites.created_by_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invites")
+	}
+
+	var resultSlice []*Invite
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invites")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invites")
+	}
+
+	if singular {
+		object.R.CreatedByInvites = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &inviteR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedByID) {
+				local.R.CreatedByInvites = append(local.R.CreatedByInvites, foreign)
+				if foreign.R == nil {
+					foreign.R = &inviteR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvites allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInvites(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`invites`),
+		qm.WhereIn(`invites.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invites")
+	}
+
+	var resultSlice []*Invite
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invites")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invites")
+	}
+
+	if singular {
+		object.R.Invites = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &inviteR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.Invites = append(local.R.Invites, foreign)
+				if foreign.R == nil {
+					foreign.R = &inviteR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMagicLinkTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMagicLinkTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`magic_link_tokens`),
+		qm.WhereIn(`magic_link_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load magic_link_tokens")
+	}
+
+	var resultSlice []*MagicLinkToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice magic_link_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on magic_link_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for magic_link_tokens")
+	}
+
+	if singular {
+		object.R.MagicLinkTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &magicLinkTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.MagicLinkTokens = append(local.R.MagicLinkTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &magicLinkTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_preferences`),
+		qm.WhereIn(`notification_preferences.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_preferences")
+	}
+
+	var resultSlice []*NotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
+	}
+
+	if singular {
+		object.R.NotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordHistoryEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasswordHistoryEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_history_entries`),
+		qm.WhereIn(`password_history_entries.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_history_entries")
+	}
+
+	var resultSlice []*PasswordHistoryEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_history_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_history_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_history_entries")
+	}
+
+	if singular {
+		object.R.PasswordHistoryEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordHistoryEntryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordHistoryEntries = append(local.R.PasswordHistoryEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordHistoryEntryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_reset_tokens`),
+		qm.WhereIn(`password_reset_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+	}
+
+	var resultSlice []*PasswordResetToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+	}
+
+	if singular {
+		object.R.PasswordResetTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordResetTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordResetTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByPushBroadcasts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByPushBroadcasts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`push_broadcasts`),
+		qm.WhereIn(`push_broadcasts.created_by_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_broadcasts")
+	}
+
+	var resultSlice []*PushBroadcast
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_broadcasts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_broadcasts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_broadcasts")
+	}
+
+	if singular {
+		object.R.CreatedByPushBroadcasts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushBroadcastR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedByID) {
+				local.R.CreatedByPushBroadcasts = append(local.R.CreatedByPushBroadcasts, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushBroadcastR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`push_deliveries`),
+		qm.WhereIn(`push_deliveries.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_deliveries")
+	}
+
+	var resultSlice []*PushDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_deliveries")
+	}
+
+	if singular {
+		object.R.PushDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushDeliveryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PushDeliveries = append(local.R.PushDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushDeliveryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`push_tokens`),
+		qm.WhereIn(`push_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_tokens")
+	}
+
+	var resultSlice []*PushToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_tokens")
+	}
+
+	if singular {
+		object.R.PushTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PushTokens = append(local.R.PushTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushTopicSubscriptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushTopicSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`push_topic_subscriptions`),
+		qm.WhereIn(`push_topic_subscriptions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_topic_subscriptions")
+	}
+
+	var resultSlice []*PushTopicSubscription
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_topic_subscriptions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_topic_subscriptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_topic_subscriptions")
+	}
+
+	if singular {
+		object.R.PushTopicSubscriptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushTopicSubscriptionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PushTopicSubscriptions = append(local.R.PushTopicSubscriptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushTopicSubscriptionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokenReuseEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokenReuseEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_token_reuse_events`),
+		qm.WhereIn(`refresh_token_reuse_events.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_token_reuse_events")
+	}
+
+	var resultSlice []*RefreshTokenReuseEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_token_reuse_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_token_reuse_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_token_reuse_events")
+	}
+
+	if singular {
+		object.R.RefreshTokenReuseEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenReuseEventR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokenReuseEvents = append(local.R.RefreshTokenReuseEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenReuseEventR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTotpRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTotpRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`totp_recovery_codes`),
+		qm.WhereIn(`totp_recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load totp_recovery_codes")
+	}
+
+	var resultSlice []*TotpRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice totp_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on totp_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for totp_recovery_codes")
+	}
+
+	if singular {
+		object.R.TotpRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &totpRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TotpRecoveryCodes = append(local.R.TotpRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &totpRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTwoFactorChallengeTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTwoFactorChallengeTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`two_factor_challenge_tokens`),
+		qm.WhereIn(`two_factor_challenge_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load two_factor_challenge_tokens")
+	}
+
+	var resultSlice []*TwoFactorChallengeToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice two_factor_challenge_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on two_factor_challenge_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for two_factor_challenge_tokens")
+	}
+
+	if singular {
+		object.R.TwoFactorChallengeTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &twoFactorChallengeTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TwoFactorChallengeTokens = append(local.R.TwoFactorChallengeTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &twoFactorChallengeTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
+
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnChallenges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnChallenges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_challenges`),
+		qm.WhereIn(`webauthn_challenges.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_challenges")
+	}
+
+	var resultSlice []*WebauthnChallenge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_challenges")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_challenges")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_challenges")
+	}
+
+	if singular {
+		object.R.WebauthnChallenges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnChallengeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.WebauthnChallenges = append(local.R.WebauthnChallenges, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnChallengeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_credentials`),
+		qm.WhereIn(`webauthn_credentials.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_credentials")
+	}
+
+	var resultSlice []*WebauthnCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_credentials")
+	}
+
+	if singular {
+		object.R.WebauthnCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCredentialR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WebauthnCredentials = append(local.R.WebauthnCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCredentialR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAppUserProfile of the user to the related item.
+// Sets o.R.AppUserProfile to related.
+// Adds o to related.R.User.
+func (o *User) SetAppUserProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AppUserProfile) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"app_user_profiles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AppUserProfile: related,
+		}
+	} else {
+		o.R.AppUserProfile = related
+	}
+
+	if related.R == nil {
+		related.R = &appUserProfileR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetTotpSecret of the user to the related item.
+// Sets o.R.TotpSecret to related.
+// Adds o to related.R.User.
+func (o *User) SetTotpSecret(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TotpSecret) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"totp_secrets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, totpSecretPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TotpSecret: related,
+		}
+	} else {
+		o.R.TotpSecret = related
+	}
+
+	if related.R == nil {
+		related.R = &totpSecretR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddImpersonatorAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpersonatorAccessTokens.
+// Sets related.R.Impersonator appropriately.
+func (o *User) AddImpersonatorAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ImpersonatorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"impersonator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ImpersonatorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpersonatorAccessTokens: related,
+		}
+	} else {
+		o.R.ImpersonatorAccessTokens = append(o.R.ImpersonatorAccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accessTokenR{
+				Impersonator: o,
+			}
+		} else {
+			rel.R.Impersonator = o
+		}
+	}
+	return nil
+}
+
+// SetImpersonatorAccessTokens removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Impersonator's ImpersonatorAccessTokens accordingly.
+// Replaces o.R.ImpersonatorAccessTokens with related.
+// Sets related.R.Impersonator's ImpersonatorAccessTokens accordingly.
+func (o *User) SetImpersonatorAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	query := "update \"access_tokens\" set \"impersonator_id\" = null where \"impersonator_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImpersonatorAccessTokens {
+			queries.SetScanner(&rel.ImpersonatorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Impersonator = nil
+		}
+		o.R.ImpersonatorAccessTokens = nil
+	}
+
+	return o.AddImpersonatorAccessTokens(ctx, exec, insert, related...)
+}
+
+// RemoveImpersonatorAccessTokens relationships from objects passed in.
+// Removes related items from R.ImpersonatorAccessTokens (uses pointer comparison, removal does not keep order)
+// Sets related.R.Impersonator.
+func (o *User) RemoveImpersonatorAccessTokens(ctx context.Context, exec boil.ContextExecutor, related ...*AccessToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ImpersonatorID, nil)
+		if rel.R != nil {
+			rel.R.Impersonator = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("impersonator_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImpersonatorAccessTokens {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImpersonatorAccessTokens)
+			if ln > 1 && i < ln-1 {
+				o.R.ImpersonatorAccessTokens[i] = o.R.ImpersonatorAccessTokens[ln-1]
+			}
+			o.R.ImpersonatorAccessTokens = o.R.ImpersonatorAccessTokens[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AccessTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AccessTokens: related,
+		}
+	} else {
+		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accessTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.User appropriately.
+func (o *User) AddAPIKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddConfirmationTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ConfirmationTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConfirmationToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"confirmation_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, confirmationTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ConfirmationTokens: related,
+		}
+	} else {
+		o.R.ConfirmationTokens = append(o.R.ConfirmationTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &confirmationTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddDataExports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DataExports.
+// Sets related.R.User appropriately.
+func (o *User) AddDataExports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataExport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"data_exports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dataExportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DataExports: related,
+		}
+	} else {
+		o.R.DataExports = append(o.R.DataExports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataExportR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddEmailChangeRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailChangeRequests.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailChangeRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailChangeRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_change_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailChangeRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailChangeRequests: related,
+		}
+	} else {
+		o.R.EmailChangeRequests = append(o.R.EmailChangeRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailChangeRequestR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByInvites adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByInvites.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invite) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"invites\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, invitePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByInvites: related,
+		}
+	} else {
+		o.R.CreatedByInvites = append(o.R.CreatedByInvites, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &inviteR{
+				CreatedBy: o,
+			}
+		} else {
+			rel.R.CreatedBy = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByInvites removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedBy's CreatedByInvites accordingly.
+// Replaces o.R.CreatedByInvites with related.
+// Sets related.R.CreatedBy's CreatedByInvites accordingly.
+func (o *User) SetCreatedByInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invite) error {
+	query := "update \"invites\" set \"created_by_id\" = null where \"created_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByInvites {
+			queries.SetScanner(&rel.CreatedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedBy = nil
+		}
+		o.R.CreatedByInvites = nil
+	}
+
+	return o.AddCreatedByInvites(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByInvites relationships from objects passed in.
+// Removes related items from R.CreatedByInvites (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedBy.
+func (o *User) RemoveCreatedByInvites(ctx context.Context, exec boil.ContextExecutor, related ...*Invite) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedByID, nil)
+		if rel.R != nil {
+			rel.R.CreatedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByInvites {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByInvites)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByInvites[i] = o.R.CreatedByInvites[ln-1]
+			}
+			o.R.CreatedByInvites = o.R.CreatedByInvites[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddInvites adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Invites.
+// Sets related.R.User appropriately.
+func (o *User) AddInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invite) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"invites\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, invitePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Invites: related,
+		}
+	} else {
+		o.R.Invites = append(o.R.Invites, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &inviteR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetInvites removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's Invites accordingly.
+// Replaces o.R.Invites with related.
+// Sets related.R.User's Invites accordingly.
+func (o *User) SetInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invite) error {
+	query := "update \"invites\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Invites {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.Invites = nil
+	}
+
+	return o.AddInvites(ctx, exec, insert, related...)
+}
+
+// RemoveInvites relationships from objects passed in.
+// Removes related items from R.Invites (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveInvites(ctx context.Context, exec boil.ContextExecutor, related ...*Invite) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Invites {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Invites)
+			if ln > 1 && i < ln-1 {
+				o.R.Invites[i] = o.R.Invites[ln-1]
+			}
+			o.R.Invites = o.R.Invites[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMagicLinkTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MagicLinkTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddMagicLinkTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MagicLinkToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"magic_link_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, magicLinkTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MagicLinkTokens: related,
+		}
+	} else {
+		o.R.MagicLinkTokens = append(o.R.MagicLinkTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &magicLinkTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Category, rel.Channel}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationPreferences: related,
+		}
+	} else {
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPasswordHistoryEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordHistoryEntries.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordHistoryEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordHistoryEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_history_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordHistoryEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordHistoryEntries: related,
+		}
+	} else {
+		o.R.PasswordHistoryEntries = append(o.R.PasswordHistoryEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordHistoryEntryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPasswordResetTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordResetTokens: related,
+		}
+	} else {
+		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByPushBroadcasts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByPushBroadcasts.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByPushBroadcasts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushBroadcast) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_broadcasts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushBroadcastPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByPushBroadcasts: related,
+		}
+	} else {
+		o.R.CreatedByPushBroadcasts = append(o.R.CreatedByPushBroadcasts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushBroadcastR{
+				CreatedBy: o,
+			}
+		} else {
+			rel.R.CreatedBy = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByPushBroadcasts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedBy's CreatedByPushBroadcasts accordingly.
+// Replaces o.R.CreatedByPushBroadcasts with related.
+// Sets related.R.CreatedBy's CreatedByPushBroadcasts accordingly.
+func (o *User) SetCreatedByPushBroadcasts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushBroadcast) error {
+	query := "update \"push_broadcasts\" set \"created_by_id\" = null where \"created_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByPushBroadcasts {
+			queries.SetScanner(&rel.CreatedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedBy = nil
+		}
+		o.R.CreatedByPushBroadcasts = nil
+	}
+
+	return o.AddCreatedByPushBroadcasts(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByPushBroadcasts relationships from objects passed in.
+// Removes related items from R.CreatedByPushBroadcasts (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedBy.
+func (o *User) RemoveCreatedByPushBroadcasts(ctx context.Context, exec boil.ContextExecutor, related ...*PushBroadcast) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedByID, nil)
+		if rel.R != nil {
+			rel.R.CreatedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByPushBroadcasts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByPushBroadcasts)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByPushBroadcasts[i] = o.R.CreatedByPushBroadcasts[ln-1]
+			}
+			o.R.CreatedByPushBroadcasts = o.R.CreatedByPushBroadcasts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPushDeliveries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushDeliveries.
+// Sets related.R.User appropriately.
+func (o *User) AddPushDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PushDeliveries: related,
+		}
+	} else {
+		o.R.PushDeliveries = append(o.R.PushDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushDeliveryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPushTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PushTokens: related,
+		}
+	} else {
+		o.R.PushTokens = append(o.R.PushTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPushTopicSubscriptions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushTopicSubscriptions.
+// Sets related.R.User appropriately.
+func (o *User) AddPushTopicSubscriptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushTopicSubscription) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_topic_subscriptions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushTopicSubscriptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Topic}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if len(args) == 0 {
-		return nil
+			rel.UserID = o.ID
+		}
 	}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	if o.R == nil {
+		o.R = &userR{
+			PushTopicSubscriptions: related,
+		}
+	} else {
+		o.R.PushTopicSubscriptions = append(o.R.PushTopicSubscriptions, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`refresh_tokens`),
-		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushTopicSubscriptionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load refresh_tokens")
-	}
+// AddRefreshTokenReuseEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokenReuseEvents.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokenReuseEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshTokenReuseEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_token_reuse_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenReuseEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	var resultSlice []*RefreshToken
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+			rel.UserID = o.ID
+		}
 	}
 
-	if singular {
-		object.R.RefreshTokens = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &refreshTokenR{}
-			}
-			foreign.R.User = object
+	if o.R == nil {
+		o.R = &userR{
+			RefreshTokenReuseEvents: related,
 		}
-		return nil
+	} else {
+		o.R.RefreshTokenReuseEvents = append(o.R.RefreshTokenReuseEvents, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
-				if foreign.R == nil {
-					foreign.R = &refreshTokenR{}
-				}
-				foreign.R.User = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenReuseEventR{
+				User: o,
 			}
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// SetAppUserProfile of the user to the related item.
-// Sets o.R.AppUserProfile to related.
-// Adds o to related.R.User.
-func (o *User) SetAppUserProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AppUserProfile) error {
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
 
-	if insert {
-		related.UserID = o.ID
-
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"app_user_profiles\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.UserID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
-		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+			rel.UserID = o.ID
 		}
-
-		related.UserID = o.ID
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			AppUserProfile: related,
+			RefreshTokens: related,
 		}
 	} else {
-		o.R.AppUserProfile = related
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
 	}
 
-	if related.R == nil {
-		related.R = &appUserProfileR{
-			User: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
-	} else {
-		related.R.User = o
 	}
 	return nil
 }
 
-// AddAccessTokens adds the given related objects to the existing relationships
+// AddTotpRecoveryCodes adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AccessTokens.
+// Appends related to o.R.TotpRecoveryCodes.
 // Sets related.R.User appropriately.
-func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+func (o *User) AddTotpRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TotpRecoveryCode) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1207,11 +5090,11 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				"UPDATE \"totp_recovery_codes\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, totpRecoveryCodePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1228,15 +5111,15 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 
 	if o.R == nil {
 		o.R = &userR{
-			AccessTokens: related,
+			TotpRecoveryCodes: related,
 		}
 	} else {
-		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+		o.R.TotpRecoveryCodes = append(o.R.TotpRecoveryCodes, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &accessTokenR{
+			rel.R = &totpRecoveryCodeR{
 				User: o,
 			}
 		} else {
@@ -1246,11 +5129,11 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
-// AddConfirmationTokens adds the given related objects to the existing relationships
+// AddTwoFactorChallengeTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ConfirmationTokens.
+// Appends related to o.R.TwoFactorChallengeTokens.
 // Sets related.R.User appropriately.
-func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConfirmationToken) error {
+func (o *User) AddTwoFactorChallengeTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TwoFactorChallengeToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1260,9 +5143,9 @@ func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecu
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"confirmation_tokens\" SET %s WHERE %s",
+				"UPDATE \"two_factor_challenge_tokens\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, confirmationTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, twoFactorChallengeTokenPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.Token}
 
@@ -1281,15 +5164,15 @@ func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecu
 
 	if o.R == nil {
 		o.R = &userR{
-			ConfirmationTokens: related,
+			TwoFactorChallengeTokens: related,
 		}
 	} else {
-		o.R.ConfirmationTokens = append(o.R.ConfirmationTokens, related...)
+		o.R.TwoFactorChallengeTokens = append(o.R.TwoFactorChallengeTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &confirmationTokenR{
+			rel.R = &twoFactorChallengeTokenR{
 				User: o,
 			}
 		} else {
@@ -1299,11 +5182,11 @@ func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// AddPasswordResetTokens adds the given related objects to the existing relationships
+// AddUserIdentities adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PasswordResetTokens.
+// Appends related to o.R.UserIdentities.
 // Sets related.R.User appropriately.
-func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+func (o *User) AddUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1313,11 +5196,11 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				"UPDATE \"user_identities\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1334,15 +5217,15 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 
 	if o.R == nil {
 		o.R = &userR{
-			PasswordResetTokens: related,
+			UserIdentities: related,
 		}
 	} else {
-		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &passwordResetTokenR{
+			rel.R = &userIdentityR{
 				User: o,
 			}
 		} else {
@@ -1352,25 +5235,25 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// AddPushTokens adds the given related objects to the existing relationships
+// AddWebauthnChallenges adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PushTokens.
+// Appends related to o.R.WebauthnChallenges.
 // Sets related.R.User appropriately.
-func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushToken) error {
+func (o *User) AddWebauthnChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnChallenge) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"push_tokens\" SET %s WHERE %s",
+				"UPDATE \"webauthn_challenges\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, pushTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, webauthnChallengePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.Challenge}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1381,21 +5264,21 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			PushTokens: related,
+			WebauthnChallenges: related,
 		}
 	} else {
-		o.R.PushTokens = append(o.R.PushTokens, related...)
+		o.R.WebauthnChallenges = append(o.R.WebauthnChallenges, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &pushTokenR{
+			rel.R = &webauthnChallengeR{
 				User: o,
 			}
 		} else {
@@ -1405,11 +5288,85 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
-// AddRefreshTokens adds the given related objects to the existing relationships
+// SetWebauthnChallenges removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's WebauthnChallenges accordingly.
+// Replaces o.R.WebauthnChallenges with related.
+// Sets related.R.User's WebauthnChallenges accordingly.
+func (o *User) SetWebauthnChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnChallenge) error {
+	query := "update \"webauthn_challenges\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.WebauthnChallenges {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.WebauthnChallenges = nil
+	}
+
+	return o.AddWebauthnChallenges(ctx, exec, insert, related...)
+}
+
+// RemoveWebauthnChallenges relationships from objects passed in.
+// Removes related items from R.WebauthnChallenges (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveWebauthnChallenges(ctx context.Context, exec boil.ContextExecutor, related ...*WebauthnChallenge) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.WebauthnChallenges {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.WebauthnChallenges)
+			if ln > 1 && i < ln-1 {
+				o.R.WebauthnChallenges[i] = o.R.WebauthnChallenges[ln-1]
+			}
+			o.R.WebauthnChallenges = o.R.WebauthnChallenges[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentials adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.RefreshTokens.
+// Appends related to o.R.WebauthnCredentials.
 // Sets related.R.User appropriately.
-func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+func (o *User) AddWebauthnCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCredential) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1419,11 +5376,11 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1440,15 +5397,15 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &userR{
-			RefreshTokens: related,
+			WebauthnCredentials: related,
 		}
 	} else {
-		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+		o.R.WebauthnCredentials = append(o.R.WebauthnCredentials, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &refreshTokenR{
+			rel.R = &webauthnCredentialR{
 				User: o,
 			}
 		} else {
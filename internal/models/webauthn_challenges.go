@@ -0,0 +1,955 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebauthnChallenge is an object representing the database table.
+type WebauthnChallenge struct {
+	Challenge  string      `boil:"challenge" json:"challenge" toml:"challenge" yaml:"challenge"`
+	Ceremony   string      `boil:"ceremony" json:"ceremony" toml:"ceremony" yaml:"ceremony"`
+	UserID     null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	ValidUntil time.Time   `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *webauthnChallengeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webauthnChallengeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebauthnChallengeColumns = struct {
+	Challenge  string
+	Ceremony   string
+	UserID     string
+	ValidUntil string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Challenge:  "challenge",
+	Ceremony:   "ceremony",
+	UserID:     "user_id",
+	ValidUntil: "valid_until",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var WebauthnChallengeTableColumns = struct {
+	Challenge  string
+	Ceremony   string
+	UserID     string
+	ValidUntil string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	Challenge:  "webauthn_challenges.challenge",
+	Ceremony:   "webauthn_challenges.ceremony",
+	UserID:     "webauthn_challenges.user_id",
+	ValidUntil: "webauthn_challenges.valid_until",
+	CreatedAt:  "webauthn_challenges.created_at",
+	UpdatedAt:  "webauthn_challenges.updated_at",
+}
+
+// Generated where
+
+var WebauthnChallengeWhere = struct {
+	Challenge  whereHelperstring
+	Ceremony   whereHelperstring
+	UserID     whereHelpernull_String
+	ValidUntil whereHelpertime_Time
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	Challenge:  whereHelperstring{field: "\"webauthn_challenges\".\"challenge\""},
+	Ceremony:   whereHelperstring{field: "\"webauthn_challenges\".\"ceremony\""},
+	UserID:     whereHelpernull_String{field: "\"webauthn_challenges\".\"user_id\""},
+	ValidUntil: whereHelpertime_Time{field: "\"webauthn_challenges\".\"valid_until\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"webauthn_challenges\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"webauthn_challenges\".\"updated_at\""},
+}
+
+// WebauthnChallengeRels is where relationship names are stored.
+var WebauthnChallengeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// webauthnChallengeR is where relationships are stored.
+type webauthnChallengeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webauthnChallengeR) NewStruct() *webauthnChallengeR {
+	return &webauthnChallengeR{}
+}
+
+func (o *WebauthnChallenge) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *webauthnChallengeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// webauthnChallengeL is where Load methods for each relationship are stored.
+type webauthnChallengeL struct{}
+
+var (
+	webauthnChallengeAllColumns            = []string{"challenge", "ceremony", "user_id", "valid_until", "created_at", "updated_at"}
+	webauthnChallengeColumnsWithoutDefault = []string{"challenge", "ceremony", "valid_until", "created_at", "updated_at"}
+	webauthnChallengeColumnsWithDefault    = []string{"user_id"}
+	webauthnChallengePrimaryKeyColumns     = []string{"challenge"}
+	webauthnChallengeGeneratedColumns      = []string{}
+)
+
+type (
+	// WebauthnChallengeSlice is an alias for a slice of pointers to WebauthnChallenge.
+	// This should almost always be used instead of []WebauthnChallenge.
+	WebauthnChallengeSlice []*WebauthnChallenge
+
+	webauthnChallengeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webauthnChallengeType                 = reflect.TypeOf(&WebauthnChallenge{})
+	webauthnChallengeMapping              = queries.MakeStructMapping(webauthnChallengeType)
+	webauthnChallengePrimaryKeyMapping, _ = queries.BindMapping(webauthnChallengeType, webauthnChallengeMapping, webauthnChallengePrimaryKeyColumns)
+	webauthnChallengeInsertCacheMut       sync.RWMutex
+	webauthnChallengeInsertCache          = make(map[string]insertCache)
+	webauthnChallengeUpdateCacheMut       sync.RWMutex
+	webauthnChallengeUpdateCache          = make(map[string]updateCache)
+	webauthnChallengeUpsertCacheMut       sync.RWMutex
+	webauthnChallengeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single webauthnChallenge record from the query.
+func (q webauthnChallengeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebauthnChallenge, error) {
+	o := &WebauthnChallenge{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webauthn_challenges")
+	}
+
+	return o, nil
+}
+
+// All returns all WebauthnChallenge records from the query.
+func (q webauthnChallengeQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebauthnChallengeSlice, error) {
+	var o []*WebauthnChallenge
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebauthnChallenge slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebauthnChallenge records in the query.
+func (q webauthnChallengeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webauthn_challenges rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webauthnChallengeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webauthn_challenges exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WebauthnChallenge) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webauthnChallengeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebauthnChallenge interface{}, mods queries.Applicator) error {
+	var slice []*WebauthnChallenge
+	var object *WebauthnChallenge
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebauthnChallenge.(*WebauthnChallenge)
+		if !ok {
+			object = new(WebauthnChallenge)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebauthnChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebauthnChallenge))
+			}
+		}
+	} else {
+		s, ok := maybeWebauthnChallenge.(*[]*WebauthnChallenge)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebauthnChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebauthnChallenge))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webauthnChallengeR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webauthnChallengeR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WebauthnChallenges = append(foreign.R.WebauthnChallenges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WebauthnChallenges = append(foreign.R.WebauthnChallenges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the webauthnChallenge to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WebauthnChallenges.
+func (o *WebauthnChallenge) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webauthn_challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webauthnChallengePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Challenge}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &webauthnChallengeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WebauthnChallenges: WebauthnChallengeSlice{o},
+		}
+	} else {
+		related.R.WebauthnChallenges = append(related.R.WebauthnChallenges, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *WebauthnChallenge) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.WebauthnChallenges {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.WebauthnChallenges)
+		if ln > 1 && i < ln-1 {
+			related.R.WebauthnChallenges[i] = related.R.WebauthnChallenges[ln-1]
+		}
+		related.R.WebauthnChallenges = related.R.WebauthnChallenges[:ln-1]
+		break
+	}
+	return nil
+}
+
+// WebauthnChallenges retrieves all the records using an executor.
+func WebauthnChallenges(mods ...qm.QueryMod) webauthnChallengeQuery {
+	mods = append(mods, qm.From("\"webauthn_challenges\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webauthn_challenges\".*"})
+	}
+
+	return webauthnChallengeQuery{q}
+}
+
+// FindWebauthnChallenge retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebauthnChallenge(ctx context.Context, exec boil.ContextExecutor, challenge string, selectCols ...string) (*WebauthnChallenge, error) {
+	webauthnChallengeObj := &WebauthnChallenge{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webauthn_challenges\" where \"challenge\"=$1", sel,
+	)
+
+	q := queries.Raw(query, challenge)
+
+	err := q.Bind(ctx, exec, webauthnChallengeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webauthn_challenges")
+	}
+
+	return webauthnChallengeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebauthnChallenge) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_challenges provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnChallengeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webauthnChallengeInsertCacheMut.RLock()
+	cache, cached := webauthnChallengeInsertCache[key]
+	webauthnChallengeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webauthnChallengeAllColumns,
+			webauthnChallengeColumnsWithDefault,
+			webauthnChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnChallengeType, webauthnChallengeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webauthnChallengeType, webauthnChallengeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webauthn_challenges\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webauthn_challenges\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webauthn_challenges")
+	}
+
+	if !cached {
+		webauthnChallengeInsertCacheMut.Lock()
+		webauthnChallengeInsertCache[key] = cache
+		webauthnChallengeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the WebauthnChallenge.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebauthnChallenge) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	webauthnChallengeUpdateCacheMut.RLock()
+	cache, cached := webauthnChallengeUpdateCache[key]
+	webauthnChallengeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webauthnChallengeAllColumns,
+			webauthnChallengePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webauthn_challenges, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webauthn_challenges\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webauthnChallengePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webauthnChallengeType, webauthnChallengeMapping, append(wl, webauthnChallengePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webauthn_challenges row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webauthn_challenges")
+	}
+
+	if !cached {
+		webauthnChallengeUpdateCacheMut.Lock()
+		webauthnChallengeUpdateCache[key] = cache
+		webauthnChallengeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webauthnChallengeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webauthn_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webauthn_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebauthnChallengeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webauthn_challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webauthnChallengePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webauthnChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webauthnChallenge")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebauthnChallenge) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no webauthn_challenges provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnChallengeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webauthnChallengeUpsertCacheMut.RLock()
+	cache, cached := webauthnChallengeUpsertCache[key]
+	webauthnChallengeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webauthnChallengeAllColumns,
+			webauthnChallengeColumnsWithDefault,
+			webauthnChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webauthnChallengeAllColumns,
+			webauthnChallengePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webauthn_challenges, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webauthnChallengeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webauthnChallengePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert webauthn_challenges, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webauthnChallengePrimaryKeyColumns))
+			copy(conflict, webauthnChallengePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webauthn_challenges\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnChallengeType, webauthnChallengeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webauthnChallengeType, webauthnChallengeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webauthn_challenges")
+	}
+
+	if !cached {
+		webauthnChallengeUpsertCacheMut.Lock()
+		webauthnChallengeUpsertCache[key] = cache
+		webauthnChallengeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single WebauthnChallenge record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebauthnChallenge) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebauthnChallenge provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webauthnChallengePrimaryKeyMapping)
+	sql := "DELETE FROM \"webauthn_challenges\" WHERE \"challenge\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webauthn_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webauthn_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webauthnChallengeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webauthnChallengeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthn_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebauthnChallengeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webauthn_challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnChallengePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthnChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebauthnChallenge) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebauthnChallenge(ctx, exec, o.Challenge)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnChallengeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebauthnChallengeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webauthn_challenges\".* FROM \"webauthn_challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnChallengePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebauthnChallengeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebauthnChallengeExists checks if the WebauthnChallenge row exists.
+func WebauthnChallengeExists(ctx context.Context, exec boil.ContextExecutor, challenge string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webauthn_challenges\" where \"challenge\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, challenge)
+	}
+	row := exec.QueryRowContext(ctx, sql, challenge)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webauthn_challenges exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebauthnChallenge row exists.
+func (o *WebauthnChallenge) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebauthnChallengeExists(ctx, exec, o.Challenge)
+}
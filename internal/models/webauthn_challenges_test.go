@@ -0,0 +1,752 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebauthnChallenges(t *testing.T) {
+	t.Parallel()
+
+	query := WebauthnChallenges()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebauthnChallengesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebauthnChallengesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebauthnChallenges().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebauthnChallengesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebauthnChallengeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebauthnChallengesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebauthnChallengeExists(ctx, tx, o.Challenge)
+	if err != nil {
+		t.Errorf("Unable to check if WebauthnChallenge exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebauthnChallengeExists to return true, but got false.")
+	}
+}
+
+func testWebauthnChallengesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webauthnChallengeFound, err := FindWebauthnChallenge(ctx, tx, o.Challenge)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webauthnChallengeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebauthnChallengesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WebauthnChallenges().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebauthnChallengesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WebauthnChallenges().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebauthnChallengesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webauthnChallengeOne := &WebauthnChallenge{}
+	webauthnChallengeTwo := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, webauthnChallengeOne, webauthnChallengeDBTypes, false, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webauthnChallengeTwo, webauthnChallengeDBTypes, false, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webauthnChallengeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webauthnChallengeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebauthnChallenges().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebauthnChallengesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webauthnChallengeOne := &WebauthnChallenge{}
+	webauthnChallengeTwo := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, webauthnChallengeOne, webauthnChallengeDBTypes, false, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webauthnChallengeTwo, webauthnChallengeDBTypes, false, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webauthnChallengeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webauthnChallengeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testWebauthnChallengesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebauthnChallengesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(webauthnChallengePrimaryKeyColumns, webauthnChallengeColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebauthnChallengeToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WebauthnChallenge
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WebauthnChallengeSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*WebauthnChallenge)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testWebauthnChallengeToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebauthnChallenge
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webauthnChallengeDBTypes, false, strmangle.SetComplement(webauthnChallengePrimaryKeyColumns, webauthnChallengeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WebauthnChallenges[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testWebauthnChallengeToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebauthnChallenge
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webauthnChallengeDBTypes, false, strmangle.SetComplement(webauthnChallengePrimaryKeyColumns, webauthnChallengeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.WebauthnChallenges) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testWebauthnChallengesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebauthnChallengesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebauthnChallengeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebauthnChallengesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebauthnChallenges().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webauthnChallengeDBTypes = map[string]string{`Challenge`: `text`, `Ceremony`: `enum.webauthn_ceremony('registration','login')`, `UserID`: `uuid`, `ValidUntil`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                        = bytes.MinRead
+)
+
+func testWebauthnChallengesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webauthnChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webauthnChallengeAllColumns) == len(webauthnChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebauthnChallengesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webauthnChallengeAllColumns) == len(webauthnChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnChallenge{}
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webauthnChallengeDBTypes, true, webauthnChallengePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webauthnChallengeAllColumns, webauthnChallengePrimaryKeyColumns) {
+		fields = webauthnChallengeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webauthnChallengeAllColumns,
+			webauthnChallengePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebauthnChallengeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebauthnChallengesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(webauthnChallengeAllColumns) == len(webauthnChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WebauthnChallenge{}
+	if err = randomize.Struct(seed, &o, webauthnChallengeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebauthnChallenge: %s", err)
+	}
+
+	count, err := WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webauthnChallengeDBTypes, false, webauthnChallengePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebauthnChallenge struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebauthnChallenge: %s", err)
+	}
+
+	count, err = WebauthnChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
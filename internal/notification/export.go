@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// PreferenceExporter adds the notification preferences and quiet hours of the user to data exports, see export.Exporter.
+type PreferenceExporter struct{}
+
+type exportedPreferences struct {
+	// time zone and quiet hours are stored on the app user profile, which not every user has (e.g. CMS users)
+	TimeZone        null.String          `json:"time_zone"`
+	QuietHoursStart null.Int             `json:"quiet_hours_start"`
+	QuietHoursEnd   null.Int             `json:"quiet_hours_end"`
+	Preferences     []exportedPreference `json:"preferences"`
+}
+
+type exportedPreference struct {
+	Category  string    `json:"category"`
+	Channel   string    `json:"channel"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (PreferenceExporter) Name() string {
+	return "notification_preferences"
+}
+
+func (PreferenceExporter) Export(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	var result exportedPreferences
+
+	profile, err := models.FindAppUserProfile(ctx, exec, userID)
+	switch {
+	case err == nil:
+		result.TimeZone = null.StringFrom(profile.TimeZone)
+		result.QuietHoursStart = profile.QuietHoursStart
+		result.QuietHoursEnd = profile.QuietHoursEnd
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+
+	notificationPreferences, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.UserID.EQ(userID),
+		qm.OrderBy(models.NotificationPreferenceColumns.Category+" ASC, "+models.NotificationPreferenceColumns.Channel+" ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Preferences = make([]exportedPreference, 0, len(notificationPreferences))
+	for _, preference := range notificationPreferences {
+		result.Preferences = append(result.Preferences, exportedPreference{
+			Category:  preference.Category,
+			Channel:   preference.Channel,
+			Enabled:   preference.Enabled,
+			UpdatedAt: preference.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"errors"
+	"slices"
+)
+
+// Category groups notifications users can opt in to or out of per channel.
+type Category string
+
+const (
+	// CategoryAccount notifies about security relevant changes of the account, it cannot be disabled
+	CategoryAccount Category = "account"
+	// CategoryUpdates notifies about activity and news concerning the user
+	CategoryUpdates Category = "updates"
+	// CategoryMarketing notifies about offers and promotions, users have to opt in
+	CategoryMarketing Category = "marketing"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// Channel notifications are delivered through.
+type Channel string
+
+const (
+	ChannelPush  Channel = "push"
+	ChannelEmail Channel = "email"
+)
+
+func (c Channel) String() string {
+	return string(c)
+}
+
+var ErrUnknownCategory = errors.New("unknown notification category")
+
+type categoryConfig struct {
+	// mandatory categories are always delivered right away, ignoring preferences and quiet hours
+	mandatory bool
+	// defaultEnabled applies to channels the user has not set a preference for
+	defaultEnabled bool
+}
+
+var categories = map[Category]categoryConfig{
+	CategoryAccount:   {mandatory: true, defaultEnabled: true},
+	CategoryUpdates:   {defaultEnabled: true},
+	CategoryMarketing: {defaultEnabled: false},
+}
+
+// Categories returns all known categories, sorted by name.
+func Categories() []Category {
+	result := make([]Category, 0, len(categories))
+	for category := range categories {
+		result = append(result, category)
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+// Channels returns all channels notifications are delivered through.
+func Channels() []Channel {
+	return []Channel{ChannelPush, ChannelEmail}
+}
+
+func (c Category) IsValid() bool {
+	_, ok := categories[c]
+	return ok
+}
+
+// IsMandatory reports whether the category cannot be disabled by users.
+func (c Category) IsMandatory() bool {
+	return categories[c].mandatory
+}
+
+// DefaultEnabled reports whether the category is delivered to users without a preference for a channel.
+func (c Category) DefaultEnabled() bool {
+	return categories[c].defaultEnabled
+}
@@ -0,0 +1,120 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+// Decision on whether and when a notification is delivered to a user.
+type Decision struct {
+	// Allowed is false if the user has not enabled the category on the channel
+	Allowed bool
+	// DeferredUntil is set to the end of the quiet hours of the user if the notification has to be deferred
+	DeferredUntil null.Time
+}
+
+// Decide decides how a notification of the category is delivered to the user on the channel, see DecideForUsers.
+func Decide(ctx context.Context, exec boil.ContextExecutor, userID string, category Category, channel Channel, now time.Time) (Decision, error) {
+	decisions, err := DecideForUsers(ctx, exec, []string{userID}, category, channel, now)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	return decisions[userID], nil
+}
+
+// DecideForUsers decides how a notification of the category is delivered to each of the users on the channel at the
+// time now. Quiet hours only defer push notifications, emails are sent right away.
+func DecideForUsers(ctx context.Context, exec boil.ContextExecutor, userIDs []string, category Category, channel Channel, now time.Time) (map[string]Decision, error) {
+	log := util.LogFromContext(ctx).With().Str("category", category.String()).Str("channel", channel.String()).Logger()
+
+	if !category.IsValid() {
+		log.Debug().Msg("Unknown notification category")
+		return nil, ErrUnknownCategory
+	}
+
+	decisions := make(map[string]Decision, len(userIDs))
+	for _, userID := range userIDs {
+		decisions[userID] = Decision{Allowed: category.DefaultEnabled()}
+	}
+
+	if category.IsMandatory() || len(userIDs) == 0 {
+		return decisions, nil
+	}
+
+	preferences, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.UserID.IN(userIDs),
+		models.NotificationPreferenceWhere.Category.EQ(category.String()),
+		models.NotificationPreferenceWhere.Channel.EQ(channel.String()),
+	).All(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to load notification preferences")
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	for _, preference := range preferences {
+		decision := decisions[preference.UserID]
+		decision.Allowed = preference.Enabled
+		decisions[preference.UserID] = decision
+	}
+
+	if channel != ChannelPush {
+		return decisions, nil
+	}
+
+	profiles, err := models.AppUserProfiles(
+		models.AppUserProfileWhere.UserID.IN(userIDs),
+		models.AppUserProfileWhere.QuietHoursStart.IsNotNull(),
+		models.AppUserProfileWhere.QuietHoursEnd.IsNotNull(),
+	).All(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to load app user profiles with quiet hours")
+		return nil, fmt.Errorf("failed to get quiet hours: %w", err)
+	}
+
+	for _, profile := range profiles {
+		decision := decisions[profile.UserID]
+		if !decision.Allowed {
+			continue
+		}
+
+		loc, err := LoadTimeZone(profile.TimeZone)
+		if err != nil {
+			// time zones are validated when set, but might be removed from the time zone database later on
+			log.Warn().Err(err).Str("userID", profile.UserID).Str("timeZone", profile.TimeZone).Msg("Failed to load time zone of user, using UTC")
+			loc = time.UTC
+		}
+
+		quietHours := QuietHours{Start: profile.QuietHoursStart.Int, End: profile.QuietHoursEnd.Int}
+		if until, ok := quietHours.DeferUntil(now, loc); ok {
+			decision.DeferredUntil = null.TimeFrom(until)
+			decisions[profile.UserID] = decision
+		}
+	}
+
+	return decisions, nil
+}
+
+// LoadTimeZone loads the IANA time zone, e.g. Europe/Vienna. Unlike time.LoadLocation, the empty name and the time
+// zone of the server ("Local") are rejected.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if len(name) == 0 || name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTimeZone, err)
+	}
+
+	return loc, nil
+}
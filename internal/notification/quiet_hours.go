@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+var ErrInvalidQuietHours = errors.New("invalid quiet hours")
+
+// QuietHours during which push notifications are deferred, as minutes after midnight in the time zone of the user.
+// Quiet hours starting after they end span midnight, e.g. 22:00 to 07:00.
+type QuietHours struct {
+	Start int
+	End   int
+}
+
+func (q QuietHours) Validate() error {
+	if q.Start < 0 || q.Start >= minutesPerDay || q.End < 0 || q.End >= minutesPerDay {
+		return fmt.Errorf("%w: minutes must be between 0 and %d", ErrInvalidQuietHours, minutesPerDay-1)
+	}
+
+	if q.Start == q.End {
+		return fmt.Errorf("%w: start and end must differ", ErrInvalidQuietHours)
+	}
+
+	return nil
+}
+
+// DeferUntil returns the end of the quiet hours if t is within them, evaluated in the time zone loc.
+func (q QuietHours) DeferUntil(t time.Time, loc *time.Location) (time.Time, bool) {
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+
+	// time.Date normalizes minutes exceeding the day and resolves DST transitions
+	endOfDay := func(days int) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, 0, q.End, 0, 0, loc)
+	}
+
+	switch {
+	case q.Start < q.End && minute >= q.Start && minute < q.End:
+		return endOfDay(0), true
+	case q.Start > q.End && minute >= q.Start:
+		return endOfDay(1), true
+	case q.Start > q.End && minute < q.End:
+		return endOfDay(0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// ParseClock parses a time of day formatted as HH:MM to minutes after midnight.
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not formatted as HH:MM", ErrInvalidQuietHours, s)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClock formats minutes after midnight as HH:MM.
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
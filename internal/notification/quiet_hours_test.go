@@ -0,0 +1,76 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHoursDeferUntil(t *testing.T) {
+	vienna, err := notification.LoadTimeZone("Europe/Vienna")
+	require.NoError(t, err)
+
+	overnight := notification.QuietHours{Start: 22 * 60, End: 7 * 60}
+	lunch := notification.QuietHours{Start: 12 * 60, End: 13*60 + 30}
+
+	tests := []struct {
+		name       string
+		quietHours notification.QuietHours
+		now        time.Time
+		want       time.Time
+		deferred   bool
+	}{
+		{"before overnight", overnight, time.Date(2026, 10, 18, 21, 59, 0, 0, vienna), time.Time{}, false},
+		{"start of overnight", overnight, time.Date(2026, 10, 18, 22, 0, 0, 0, vienna), time.Date(2026, 10, 19, 7, 0, 0, 0, vienna), true},
+		{"after midnight", overnight, time.Date(2026, 10, 19, 3, 15, 0, 0, vienna), time.Date(2026, 10, 19, 7, 0, 0, 0, vienna), true},
+		{"end of overnight", overnight, time.Date(2026, 10, 19, 7, 0, 0, 0, vienna), time.Time{}, false},
+		{"evaluated in time zone", overnight, time.Date(2026, 10, 18, 20, 30, 0, 0, time.UTC), time.Date(2026, 10, 19, 7, 0, 0, 0, vienna), true},
+		{"overnight across DST change", overnight, time.Date(2026, 10, 24, 23, 0, 0, 0, vienna), time.Date(2026, 10, 25, 6, 0, 0, 0, time.UTC), true},
+		{"within lunch", lunch, time.Date(2026, 10, 18, 12, 45, 0, 0, vienna), time.Date(2026, 10, 18, 13, 30, 0, 0, vienna), true},
+		{"after lunch", lunch, time.Date(2026, 10, 18, 13, 30, 0, 0, vienna), time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, deferred := tt.quietHours.DeferUntil(tt.now, vienna)
+			assert.Equal(t, tt.deferred, deferred)
+			assert.True(t, tt.want.Equal(until), "want %s, got %s", tt.want, until)
+		})
+	}
+}
+
+func TestQuietHoursValidate(t *testing.T) {
+	require.NoError(t, notification.QuietHours{Start: 22 * 60, End: 7 * 60}.Validate())
+	require.NoError(t, notification.QuietHours{Start: 0, End: 24*60 - 1}.Validate())
+
+	require.ErrorIs(t, notification.QuietHours{Start: 60, End: 60}.Validate(), notification.ErrInvalidQuietHours)
+	require.ErrorIs(t, notification.QuietHours{Start: -1, End: 60}.Validate(), notification.ErrInvalidQuietHours)
+	require.ErrorIs(t, notification.QuietHours{Start: 60, End: 24 * 60}.Validate(), notification.ErrInvalidQuietHours)
+}
+
+func TestParseClock(t *testing.T) {
+	minutes, err := notification.ParseClock("07:30")
+	require.NoError(t, err)
+	assert.Equal(t, 7*60+30, minutes)
+	assert.Equal(t, "07:30", notification.FormatClock(minutes))
+	assert.Equal(t, "23:59", notification.FormatClock(24*60-1))
+
+	_, err = notification.ParseClock("24:00")
+	require.ErrorIs(t, err, notification.ErrInvalidQuietHours)
+	_, err = notification.ParseClock("7:30pm")
+	require.ErrorIs(t, err, notification.ErrInvalidQuietHours)
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	loc, err := notification.LoadTimeZone("America/New_York")
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", loc.String())
+
+	for _, name := range []string{"", "Local", "Europe/Atlantis"} {
+		_, err := notification.LoadTimeZone(name)
+		require.ErrorIs(t, err, notification.ErrInvalidTimeZone, name)
+	}
+}
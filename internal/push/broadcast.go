@@ -0,0 +1,391 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// BroadcastResult counts the devices a broadcast was sent to.
+type BroadcastResult struct {
+	Sent   int
+	Failed int
+	// Deferred counts the devices of users within their quiet hours, queued in the outbox until they end
+	Deferred int
+}
+
+// Broadcast sends the message to the devices of all active users matching the segment, paging through the push
+// tokens in batches of config.BatchSize passed to SendMulticast. Invalid tokens are deleted. Unlike SendToUser,
+// broadcasts are not queued in the outbox and failed deliveries are not retried. The result counts the devices
+// processed before an error occurred.
+// Users who disabled push notifications of the category are skipped, messages to users within their quiet hours are
+// queued in the outbox and sent once they end.
+func (s *Service) Broadcast(ctx context.Context, segment dto.PushSegment, category notification.Category, msg Message) (BroadcastResult, error) {
+	log := util.LogFromContext(ctx)
+
+	var result BroadcastResult
+	if s.GetProviderCount() < 1 {
+		log.Debug().Msg("No push provider registered, discarding broadcast")
+		return result, ErrNoProvider
+	}
+
+	for providerType, provider := range s.provider {
+		var lastID string
+		for {
+			mods := append(segmentQueryMods(segment),
+				models.PushTokenWhere.Provider.EQ(string(providerType)),
+				qm.OrderBy(models.PushTokenTableColumns.ID),
+				qm.Limit(s.config.BatchSize),
+			)
+			if len(lastID) > 0 {
+				mods = append(mods, models.PushTokenWhere.ID.GT(lastID))
+			}
+
+			pushTokens, err := models.PushTokens(mods...).All(ctx, s.DB)
+			if err != nil {
+				log.Err(err).Str("provider", string(providerType)).Msg("Failed to load push tokens for broadcast")
+				return result, fmt.Errorf("failed to get push tokens: %w", err)
+			}
+
+			if len(pushTokens) == 0 {
+				break
+			}
+
+			lastID = pushTokens[len(pushTokens)-1].ID
+
+			tokens, deferred, err := s.applyPreferences(ctx, pushTokens, category, msg)
+			if err != nil {
+				log.Err(err).Str("provider", string(providerType)).Msg("Failed to apply notification preferences to broadcast")
+				return result, err
+			}
+
+			result.Deferred += deferred
+			if err := s.multicast(ctx, provider, tokens, msg, &result); err != nil {
+				return result, err
+			}
+
+			if len(pushTokens) < s.config.BatchSize {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CreateBroadcast schedules the broadcast, which is sent by ProcessBroadcasts once due.
+func (s *Service) CreateBroadcast(ctx context.Context, request dto.CreatePushBroadcastRequest) (dto.PushBroadcast, error) {
+	log := util.LogFromContext(ctx)
+
+	scheduledAt := s.clock.Now()
+	if request.ScheduledAt.Valid {
+		if request.ScheduledAt.Time.Before(scheduledAt) {
+			log.Debug().Time("scheduledAt", request.ScheduledAt.Time).Msg("Push broadcast is scheduled in the past")
+			return dto.PushBroadcast{}, httperrors.ErrBadRequestInvalidPushBroadcastSchedule
+		}
+
+		scheduledAt = request.ScheduledAt.Time
+	}
+
+	category := request.Category
+	if len(category) == 0 {
+		category = notification.CategoryUpdates
+	}
+
+	if !category.IsValid() {
+		log.Debug().Str("category", category.String()).Msg("Unknown push broadcast category")
+		return dto.PushBroadcast{}, notification.ErrUnknownCategory
+	}
+
+	data := request.Data
+	if data == nil {
+		data = make(map[string]string)
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return dto.PushBroadcast{}, fmt.Errorf("failed to marshal push broadcast data: %w", err)
+	}
+
+	broadcast := models.PushBroadcast{
+		Title:       request.Title,
+		Body:        request.Body,
+		DeepLink:    request.DeepLink,
+		ImageURL:    request.ImageURL,
+		Data:        dataJSON,
+		Category:    category.String(),
+		Topic:       request.Segment.Topic,
+		Scope:       request.Segment.Scope,
+		Locale:      request.Segment.Locale,
+		Status:      models.PushBroadcastStatusScheduled,
+		ScheduledAt: scheduledAt,
+		CreatedByID: request.CreatedByID,
+	}
+
+	if err := broadcast.Insert(ctx, s.DB, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert push broadcast")
+		return dto.PushBroadcast{}, err
+	}
+
+	return mapper.LocalPushBroadcastToDTO(&broadcast), nil
+}
+
+// GetBroadcasts returns all broadcasts, the most recently scheduled first.
+func (s *Service) GetBroadcasts(ctx context.Context) (dto.PushBroadcasts, error) {
+	broadcasts, err := models.PushBroadcasts(
+		qm.OrderBy(models.PushBroadcastColumns.ScheduledAt+" DESC, "+models.PushBroadcastColumns.ID),
+	).All(ctx, s.DB)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to load push broadcasts")
+		return nil, err
+	}
+
+	result := make(dto.PushBroadcasts, 0, len(broadcasts))
+	for _, broadcast := range broadcasts {
+		result = append(result, mapper.LocalPushBroadcastToDTO(broadcast))
+	}
+
+	return result, nil
+}
+
+// DeleteBroadcast cancels the broadcast if it has not been sent yet.
+func (s *Service) DeleteBroadcast(ctx context.Context, broadcastID string) error {
+	log := util.LogFromContext(ctx).With().Str("broadcastID", broadcastID).Logger()
+
+	rowsAffected, err := models.PushBroadcasts(
+		models.PushBroadcastWhere.ID.EQ(broadcastID),
+		models.PushBroadcastWhere.Status.EQ(models.PushBroadcastStatusScheduled),
+	).DeleteAll(ctx, s.DB)
+	if err != nil {
+		log.Err(err).Msg("Failed to delete push broadcast")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Msg("Push broadcast not found")
+		return httperrors.ErrNotFoundPushBroadcastNotFound
+	}
+
+	return nil
+}
+
+// ProcessBroadcasts sends all due broadcasts, returning the number of broadcasts sent. Broadcasts are claimed before
+// being sent, so each broadcast is sent at most once even with multiple instances processing broadcasts.
+func (s *Service) ProcessBroadcasts(ctx context.Context) (int, error) {
+	var processed int
+	for ctx.Err() == nil {
+		broadcast, err := s.claimBroadcast(ctx)
+		if err != nil {
+			return processed, err
+		}
+
+		if broadcast == nil {
+			break
+		}
+
+		if err := s.sendBroadcast(ctx, broadcast); err != nil {
+			return processed, err
+		}
+
+		processed++
+	}
+
+	return processed, nil
+}
+
+// claimBroadcast marks the next due broadcast as sending, returning nil if no broadcast is due.
+func (s *Service) claimBroadcast(ctx context.Context) (*models.PushBroadcast, error) {
+	var broadcast *models.PushBroadcast
+	if err := db.WithTransaction(ctx, s.DB, func(exec boil.ContextExecutor) error {
+		broadcasts, err := models.PushBroadcasts(
+			models.PushBroadcastWhere.Status.EQ(models.PushBroadcastStatusScheduled),
+			models.PushBroadcastWhere.ScheduledAt.LTE(s.clock.Now()),
+			qm.OrderBy(models.PushBroadcastColumns.ScheduledAt),
+			qm.Limit(1),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		if len(broadcasts) == 0 {
+			return nil
+		}
+
+		broadcast = broadcasts[0]
+		broadcast.Status = models.PushBroadcastStatusSending
+		_, err = broadcast.Update(ctx, exec, boil.Whitelist(models.PushBroadcastColumns.Status, models.PushBroadcastColumns.UpdatedAt))
+
+		return err
+	}); err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to claim push broadcast")
+		return nil, fmt.Errorf("failed to claim push broadcast: %w", err)
+	}
+
+	return broadcast, nil
+}
+
+func (s *Service) sendBroadcast(ctx context.Context, broadcast *models.PushBroadcast) error {
+	log := util.LogFromContext(ctx).With().Str("broadcastID", broadcast.ID).Logger()
+
+	b := mapper.LocalPushBroadcastToDTO(broadcast)
+	result, err := s.Broadcast(ctx, b.Segment, b.Category, Message{
+		Title:    b.Title,
+		Body:     b.Body,
+		Data:     b.Data,
+		DeepLink: b.DeepLink.String,
+		ImageURL: b.ImageURL.String,
+	})
+	if err != nil {
+		log.Error().Err(err).Int("sentCount", result.Sent).Int("failedCount", result.Failed).Int("deferredCount", result.Deferred).Msg("Failed to send push broadcast")
+	}
+
+	// broadcasts failing before reaching any device are retried, partially sent ones are not to avoid duplicates
+	broadcast.Status = models.PushBroadcastStatusSent
+	broadcast.SentAt = null.TimeFrom(s.clock.Now())
+	if err != nil && result.Sent+result.Failed+result.Deferred == 0 {
+		broadcast.Status = models.PushBroadcastStatusScheduled
+		broadcast.SentAt = null.Time{}
+	}
+
+	broadcast.SentCount = result.Sent
+	broadcast.FailedCount = result.Failed
+	if _, updateErr := broadcast.Update(ctx, s.DB, boil.Whitelist(
+		models.PushBroadcastColumns.Status,
+		models.PushBroadcastColumns.SentAt,
+		models.PushBroadcastColumns.SentCount,
+		models.PushBroadcastColumns.FailedCount,
+		models.PushBroadcastColumns.UpdatedAt,
+	)); updateErr != nil {
+		log.Err(updateErr).Msg("Failed to update push broadcast")
+		return updateErr
+	}
+
+	return err
+}
+
+// multicast sends the message to the tokens, adding the responses to result and deleting invalid tokens.
+func (s *Service) multicast(ctx context.Context, provider Provider, tokens []string, msg Message, result *BroadcastResult) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	invalidTokens := make([]string, 0)
+	for _, res := range provider.SendMulticast(tokens, msg) {
+		if !res.Valid {
+			invalidTokens = append(invalidTokens, res.Token)
+		}
+
+		if res.Err != nil {
+			result.Failed++
+		} else {
+			result.Sent++
+		}
+	}
+
+	if len(invalidTokens) > 0 {
+		if _, err := models.PushTokens(models.PushTokenWhere.Token.IN(invalidTokens)).DeleteAll(ctx, s.DB); err != nil {
+			util.LogFromContext(ctx).Err(err).Str("provider", string(provider.GetProviderType())).Msg("Failed to delete invalid push tokens")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyPreferences returns the tokens the broadcast is sent to right away, skipping users who disabled the category
+// for push notifications. Messages to users within their quiet hours are queued in the outbox instead, returning the
+// number of deliveries deferred.
+func (s *Service) applyPreferences(ctx context.Context, pushTokens models.PushTokenSlice, category notification.Category, msg Message) ([]string, int, error) {
+	userIDs := make([]string, 0, len(pushTokens))
+	for _, pushToken := range pushTokens {
+		userIDs = append(userIDs, pushToken.UserID)
+	}
+
+	now := s.clock.Now()
+	decisions, err := notification.DecideForUsers(ctx, s.DB, userIDs, category, notification.ChannelPush, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var payload []byte
+	tokens := make([]string, 0, len(pushTokens))
+	deferred := 0
+	for _, pushToken := range pushTokens {
+		decision := decisions[pushToken.UserID]
+		if !decision.Allowed {
+			continue
+		}
+
+		if !decision.DeferredUntil.Valid {
+			tokens = append(tokens, pushToken.Token)
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = json.Marshal(msg); err != nil {
+				return nil, 0, fmt.Errorf("failed to marshal push message: %w", err)
+			}
+		}
+
+		delivery := models.PushDelivery{
+			UserID:        pushToken.UserID,
+			Provider:      pushToken.Provider,
+			Token:         pushToken.Token,
+			Message:       payload,
+			Status:        models.PushDeliveryStatusQueued,
+			NextAttemptAt: decision.DeferredUntil.Time,
+		}
+
+		if err := delivery.Insert(ctx, s.DB, boil.Infer()); err != nil {
+			return nil, 0, fmt.Errorf("failed to queue push message: %w", err)
+		}
+
+		deferred++
+	}
+
+	return tokens, deferred, nil
+}
+
+// segmentQueryMods restricts push tokens to the ones of active users matching the segment.
+func segmentQueryMods(segment dto.PushSegment) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		qm.InnerJoin(fmt.Sprintf("%s ON %s = %s", models.TableNames.Users, models.UserTableColumns.ID, models.PushTokenTableColumns.UserID)),
+		models.UserWhere.IsActive.EQ(true),
+	}
+
+	if segment.Topic.Valid {
+		mods = append(mods, qm.Where(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s = ?)",
+			models.TableNames.PushTopicSubscriptions,
+			models.PushTopicSubscriptionTableColumns.UserID,
+			models.PushTokenTableColumns.UserID,
+			models.PushTopicSubscriptionTableColumns.Topic,
+		), segment.Topic.String))
+	}
+
+	if segment.Scope.Valid {
+		mods = append(mods, qm.Where(fmt.Sprintf("? = ANY(%s)", models.UserTableColumns.Scopes), segment.Scope.String))
+	}
+
+	if segment.Locale.Valid {
+		mods = append(mods, qm.Where(
+			fmt.Sprintf("(lower(%[1]s) = lower(?) OR lower(%[1]s) LIKE lower(?))", models.PushTokenTableColumns.Locale),
+			segment.Locale.String,
+			db.EscapeLike(segment.Locale.String)+"-%",
+		))
+	}
+
+	return mods
+}
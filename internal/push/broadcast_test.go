@@ -0,0 +1,190 @@
+package push_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastSegments(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// a batch size of 1 pages through the tokens one by one
+		service := push.New(db, time2.NewMockClock(time.Now()), push.OutboxConfig{BatchSize: 1})
+		service.RegisterProvider(provider.NewMock(push.ProviderTypeFCM))
+
+		fix.User1PushToken.Locale = null.StringFrom("de-AT")
+		_, err := fix.User1PushToken.Update(ctx, db, boil.Whitelist(models.PushTokenColumns.Locale))
+		require.NoError(t, err)
+
+		fix.User2.Scopes = []string{"app", "cms"}
+		_, err = fix.User2.Update(ctx, db, boil.Whitelist(models.UserColumns.Scopes))
+		require.NoError(t, err)
+
+		for _, pushToken := range []*models.PushToken{
+			{UserID: fix.User2.ID, Token: strings.Repeat("a", 40), Provider: models.ProviderTypeFCM, Locale: null.StringFrom("en")},
+			// rejected as invalid by the mock provider
+			{UserID: fix.User2.ID, Token: "invalid-token", Provider: models.ProviderTypeFCM, Locale: null.StringFrom("en")},
+			// inactive users are never notified
+			{UserID: fix.UserRequiresConfirmation.ID, Token: strings.Repeat("b", 40), Provider: models.ProviderTypeFCM},
+		} {
+			require.NoError(t, pushToken.Insert(ctx, db, boil.Infer()))
+		}
+
+		subscription := models.PushTopicSubscription{UserID: fix.User2.ID, Topic: "news"}
+		require.NoError(t, subscription.Insert(ctx, db, boil.Infer()))
+
+		msg := push.NewNotification("Hello", "World")
+
+		tests := []struct {
+			name     string
+			segment  dto.PushSegment
+			expected push.BroadcastResult
+		}{
+			{"LocaleLanguage", dto.PushSegment{Locale: null.StringFrom("de")}, push.BroadcastResult{Sent: 1}},
+			{"LocaleCaseInsensitive", dto.PushSegment{Locale: null.StringFrom("DE-at")}, push.BroadcastResult{Sent: 1}},
+			{"LocaleRegionNoMatch", dto.PushSegment{Locale: null.StringFrom("en-US")}, push.BroadcastResult{}},
+			{"Scope", dto.PushSegment{Scope: null.StringFrom("cms")}, push.BroadcastResult{Sent: 1, Failed: 1}},
+			// the invalid token has been deleted by the previous broadcast
+			{"Topic", dto.PushSegment{Topic: null.StringFrom("news")}, push.BroadcastResult{Sent: 1}},
+			{"TopicAndLocale", dto.PushSegment{Topic: null.StringFrom("news"), Locale: null.StringFrom("de")}, push.BroadcastResult{}},
+			{"All", dto.PushSegment{}, push.BroadcastResult{Sent: 2}},
+		}
+
+		for _, tt := range tests {
+			result, err := service.Broadcast(ctx, tt.segment, notification.CategoryUpdates, msg)
+			require.NoError(t, err, tt.name)
+			assert.Equal(t, tt.expected, result, tt.name)
+		}
+
+		exists, err := models.PushTokens(models.PushTokenWhere.Token.EQ("invalid-token")).Exists(ctx, db)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestBroadcastNotificationPreferences(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// 23:30 in Vienna
+		now := time.Date(2026, 10, 18, 21, 30, 0, 0, time.UTC)
+		service := push.New(db, time2.NewMockClock(now), push.OutboxConfig{BatchSize: 10})
+		service.RegisterProvider(provider.NewMock(push.ProviderTypeFCM))
+
+		pushToken := models.PushToken{UserID: fix.User2.ID, Token: strings.Repeat("a", 40), Provider: models.ProviderTypeFCM}
+		require.NoError(t, pushToken.Insert(ctx, db, boil.Infer()))
+
+		msg := push.NewNotification("Hello", "World")
+
+		// marketing is opt-in
+		result, err := service.Broadcast(ctx, dto.PushSegment{}, notification.CategoryMarketing, msg)
+		require.NoError(t, err)
+		assert.Equal(t, push.BroadcastResult{}, result)
+
+		for _, userID := range []string{fix.User1.ID, fix.User2.ID} {
+			preference := models.NotificationPreference{
+				UserID:   userID,
+				Category: notification.CategoryMarketing.String(),
+				Channel:  notification.ChannelPush.String(),
+				Enabled:  true,
+			}
+			require.NoError(t, preference.Insert(ctx, db, boil.Infer()))
+		}
+
+		// users within their quiet hours are notified once they end
+		fix.User1AppUserProfile.TimeZone = "Europe/Vienna"
+		fix.User1AppUserProfile.QuietHoursStart = null.IntFrom(22 * 60)
+		fix.User1AppUserProfile.QuietHoursEnd = null.IntFrom(7 * 60)
+		_, err = fix.User1AppUserProfile.Update(ctx, db, boil.Whitelist(
+			models.AppUserProfileColumns.TimeZone,
+			models.AppUserProfileColumns.QuietHoursStart,
+			models.AppUserProfileColumns.QuietHoursEnd,
+		))
+		require.NoError(t, err)
+
+		result, err = service.Broadcast(ctx, dto.PushSegment{}, notification.CategoryMarketing, msg)
+		require.NoError(t, err)
+		assert.Equal(t, push.BroadcastResult{Sent: 1, Deferred: 1}, result)
+
+		deliveries, err := models.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, fix.User1PushToken.Token, deliveries[0].Token)
+		assert.Equal(t, models.PushDeliveryStatusQueued, deliveries[0].Status)
+		assert.True(t, time.Date(2026, 10, 19, 5, 0, 0, 0, time.UTC).Equal(deliveries[0].NextAttemptAt))
+	})
+}
+
+func TestProcessBroadcasts(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		clock := time2.NewMockClock(now)
+		service := push.New(db, clock, push.OutboxConfig{BatchSize: 10})
+
+		broadcast, err := service.CreateBroadcast(ctx, dto.CreatePushBroadcastRequest{
+			Title:       "Hello",
+			Body:        "World",
+			Data:        map[string]string{"newsId": "42"},
+			ScheduledAt: null.TimeFrom(now.Add(time.Hour)),
+			CreatedByID: null.StringFrom(fix.User1.ID),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.PushBroadcastStatusScheduled, broadcast.Status)
+		assert.Equal(t, notification.CategoryUpdates, broadcast.Category)
+
+		clock.Set(broadcast.ScheduledAt)
+
+		// broadcasts failing before reaching any device are retried
+		processed, err := service.ProcessBroadcasts(ctx)
+		require.ErrorIs(t, err, push.ErrNoProvider)
+		assert.Equal(t, 0, processed)
+
+		broadcasts, err := service.GetBroadcasts(ctx)
+		require.NoError(t, err)
+		require.Len(t, broadcasts, 1)
+		assert.Equal(t, models.PushBroadcastStatusScheduled, broadcasts[0].Status)
+
+		service.RegisterProvider(provider.NewMock(push.ProviderTypeFCM))
+
+		processed, err = service.ProcessBroadcasts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		broadcasts, err = service.GetBroadcasts(ctx)
+		require.NoError(t, err)
+		require.Len(t, broadcasts, 1)
+		assert.Equal(t, models.PushBroadcastStatusSent, broadcasts[0].Status)
+		assert.True(t, now.Add(time.Hour).Equal(broadcasts[0].SentAt.Time))
+		assert.Equal(t, 1, broadcasts[0].SentCount)
+		assert.Equal(t, map[string]string{"newsId": "42"}, broadcasts[0].Data)
+
+		// sent broadcasts are neither sent again nor cancellable
+		processed, err = service.ProcessBroadcasts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+
+		err = service.DeleteBroadcast(ctx, broadcast.ID)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,42 @@
+package push
+
+import (
+	"context"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// TopicSubscriptionExporter adds the push topics subscribed to by the user to data exports, see export.Exporter.
+type TopicSubscriptionExporter struct{}
+
+type exportedTopicSubscription struct {
+	Topic     string    `json:"topic"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (TopicSubscriptionExporter) Name() string {
+	return "push_topic_subscriptions"
+}
+
+func (TopicSubscriptionExporter) Export(ctx context.Context, exec boil.ContextExecutor, userID string) (any, error) {
+	pushTopicSubscriptions, err := models.PushTopicSubscriptions(
+		models.PushTopicSubscriptionWhere.UserID.EQ(userID),
+		qm.OrderBy(models.PushTopicSubscriptionColumns.Topic+" ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]exportedTopicSubscription, 0, len(pushTopicSubscriptions))
+	for _, subscription := range pushTopicSubscriptions {
+		subscriptions = append(subscriptions, exportedTopicSubscription{
+			Topic:     subscription.Topic,
+			CreatedAt: subscription.CreatedAt,
+		})
+	}
+
+	return subscriptions, nil
+}
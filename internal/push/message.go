@@ -0,0 +1,121 @@
+package push
+
+import (
+	"maps"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// Priority of the message delivery, PriorityDefault leaves the choice to the provider.
+type Priority string
+
+const (
+	PriorityDefault Priority = ""
+	PriorityNormal  Priority = "normal"
+	PriorityHigh    Priority = "high"
+)
+
+// Keys of the data entries the DeepLink and ImageURL of a message are passed to the app with.
+const (
+	DataKeyDeepLink = "deepLink"
+	DataKeyImageURL = "imageURL"
+)
+
+// Message is sent to the devices of a user. Messages without Title and Body are delivered as silent data messages,
+// which are not displayed but passed to the app in the background.
+type Message struct {
+	Title string
+	Body  string
+	// Data is passed to the app as key-value pairs
+	Data map[string]string
+	// DeepLink is opened by the app once the notification is tapped
+	DeepLink string
+	ImageURL string
+	// Badge sets the count shown on the app icon, 0 clears the badge and null leaves it unchanged
+	Badge null.Int
+	// Sound to play, "default" for the default system sound
+	Sound    string
+	Delivery DeliveryOptions
+	Android  AndroidOptions
+	APNS     APNSOptions
+}
+
+// DeliveryOptions control the delivery of a message, zero values use the provider defaults.
+type DeliveryOptions struct {
+	Priority Priority
+	// CollapseKey identifies messages replacing each other if they have not been delivered yet
+	CollapseKey string
+	// TTL discards the message if it could not be delivered within the duration
+	TTL time.Duration
+}
+
+type AndroidOptions struct {
+	ChannelID string
+	// Delivery overrides the delivery options of the message for Android devices
+	Delivery DeliveryOptions
+}
+
+type APNSOptions struct {
+	Category string
+	ThreadID string
+	// Delivery overrides the delivery options of the message for Apple devices
+	Delivery DeliveryOptions
+}
+
+// NewNotification returns a message displaying the notification with the given title and body.
+func NewNotification(title string, body string) Message {
+	return Message{
+		Title: title,
+		Body:  body,
+	}
+}
+
+// IsSilent reports whether the message is a data message without a notification to display.
+func (m Message) IsSilent() bool {
+	return len(m.Title) == 0 && len(m.Body) == 0
+}
+
+// DataWithLinks returns the data of the message, including the DeepLink and ImageURL if set.
+func (m Message) DataWithLinks() map[string]string {
+	data := maps.Clone(m.Data)
+	if data == nil {
+		data = make(map[string]string)
+	}
+
+	if len(m.DeepLink) > 0 {
+		data[DataKeyDeepLink] = m.DeepLink
+	}
+
+	if len(m.ImageURL) > 0 {
+		data[DataKeyImageURL] = m.ImageURL
+	}
+
+	return data
+}
+
+// AndroidDelivery returns the delivery options for Android devices, falling back to the message's options.
+func (m Message) AndroidDelivery() DeliveryOptions {
+	return m.Android.Delivery.withFallback(m.Delivery)
+}
+
+// APNSDelivery returns the delivery options for Apple devices, falling back to the message's options.
+func (m Message) APNSDelivery() DeliveryOptions {
+	return m.APNS.Delivery.withFallback(m.Delivery)
+}
+
+func (o DeliveryOptions) withFallback(fallback DeliveryOptions) DeliveryOptions {
+	if o.Priority == PriorityDefault {
+		o.Priority = fallback.Priority
+	}
+
+	if len(o.CollapseKey) == 0 {
+		o.CollapseKey = fallback.CollapseKey
+	}
+
+	if o.TTL == 0 {
+		o.TTL = fallback.TTL
+	}
+
+	return o
+}
@@ -0,0 +1,63 @@
+package push_test
+
+import (
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageDataWithLinks(t *testing.T) {
+	msg := push.NewNotification("Hello", "World")
+	assert.False(t, msg.IsSilent())
+	assert.Empty(t, msg.DataWithLinks())
+
+	msg = push.Message{
+		Data:     map[string]string{"orderId": "42"},
+		DeepLink: "gostarter://orders/42",
+		ImageURL: "https://example.com/image.png",
+	}
+	assert.True(t, msg.IsSilent())
+	assert.Equal(t, map[string]string{
+		"orderId":            "42",
+		push.DataKeyDeepLink: "gostarter://orders/42",
+		push.DataKeyImageURL: "https://example.com/image.png",
+	}, msg.DataWithLinks())
+
+	// the data of the message itself is not modified
+	assert.Equal(t, map[string]string{"orderId": "42"}, msg.Data)
+}
+
+func TestMessageDelivery(t *testing.T) {
+	msg := push.Message{
+		Delivery: push.DeliveryOptions{
+			Priority:    push.PriorityHigh,
+			CollapseKey: "orders",
+			TTL:         time.Hour,
+		},
+		Android: push.AndroidOptions{
+			Delivery: push.DeliveryOptions{
+				Priority: push.PriorityNormal,
+			},
+		},
+		APNS: push.APNSOptions{
+			Delivery: push.DeliveryOptions{
+				CollapseKey: "apns-orders",
+				TTL:         time.Minute,
+			},
+		},
+	}
+
+	assert.Equal(t, push.DeliveryOptions{
+		Priority:    push.PriorityNormal,
+		CollapseKey: "orders",
+		TTL:         time.Hour,
+	}, msg.AndroidDelivery())
+
+	assert.Equal(t, push.DeliveryOptions{
+		Priority:    push.PriorityHigh,
+		CollapseKey: "apns-orders",
+		TTL:         time.Minute,
+	}, msg.APNSDelivery())
+}
@@ -0,0 +1,392 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/data/dto"
+	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// SendToUser queues the message of the category for all devices of the user, see EnqueueToUser.
+func (s *Service) SendToUser(ctx context.Context, user *dto.User, category notification.Category, msg Message) error {
+	_, err := s.EnqueueToUser(ctx, s.DB, user.ID, category, msg)
+	return err
+}
+
+// EnqueueToUser queues the message for all devices of the user registered with a configured provider, returning the
+// IDs of the queued deliveries. Pass the transaction triggering the message as exec, so the message is only sent once
+// the transaction has been committed and never lost if it was.
+// Messages of a category the user has disabled for push notifications are discarded, returning no IDs. Messages
+// enqueued during the quiet hours of the user are deferred until they end.
+func (s *Service) EnqueueToUser(ctx context.Context, exec boil.ContextExecutor, userID string, category notification.Category, msg Message) ([]string, error) {
+	log := util.LogFromContext(ctx).With().Str("userID", userID).Str("category", category.String()).Logger()
+
+	if s.GetProviderCount() < 1 {
+		log.Debug().Msg("No push provider registered, discarding message")
+		return nil, ErrNoProvider
+	}
+
+	now := s.clock.Now()
+	decision, err := notification.Decide(ctx, exec, userID, category, notification.ChannelPush, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !decision.Allowed {
+		log.Debug().Msg("User disabled push notifications of category, discarding message")
+		return []string{}, nil
+	}
+
+	nextAttemptAt := now
+	if decision.DeferredUntil.Valid {
+		log.Debug().Time("deferredUntil", decision.DeferredUntil.Time).Msg("User has quiet hours, deferring message")
+		nextAttemptAt = decision.DeferredUntil.Time
+	}
+
+	providers := make([]string, 0, len(s.provider))
+	for providerType := range s.provider {
+		providers = append(providers, string(providerType))
+	}
+
+	pushTokens, err := models.PushTokens(
+		models.PushTokenWhere.UserID.EQ(userID),
+		models.PushTokenWhere.Provider.IN(providers),
+	).All(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to load push tokens")
+		return nil, fmt.Errorf("failed to get push tokens: %w", err)
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal push message: %w", err)
+	}
+
+	ids := make([]string, 0, len(pushTokens))
+	for _, pushToken := range pushTokens {
+		delivery := models.PushDelivery{
+			UserID:        userID,
+			Provider:      pushToken.Provider,
+			Token:         pushToken.Token,
+			Message:       payload,
+			Status:        models.PushDeliveryStatusQueued,
+			NextAttemptAt: nextAttemptAt,
+		}
+
+		if err := delivery.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Err(err).Msg("Failed to insert push delivery")
+			return nil, fmt.Errorf("failed to queue push message: %w", err)
+		}
+
+		ids = append(ids, delivery.ID)
+	}
+
+	return ids, nil
+}
+
+// GetDeliveries returns the deliveries with the given IDs, e.g. as returned by EnqueueToUser, in the order they were queued.
+func (s *Service) GetDeliveries(ctx context.Context, ids []string) ([]dto.PushDelivery, error) {
+	deliveries, err := models.PushDeliveries(
+		models.PushDeliveryWhere.ID.IN(ids),
+		qm.OrderBy(models.PushDeliveryColumns.CreatedAt+", "+models.PushDeliveryColumns.ID),
+	).All(ctx, s.DB)
+	if err != nil {
+		util.LogFromContext(ctx).Err(err).Msg("Failed to load push deliveries")
+		return nil, err
+	}
+
+	result := make([]dto.PushDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, mapper.LocalPushDeliveryToDTO(delivery))
+	}
+
+	return result, nil
+}
+
+// ProcessOutbox sends the next batch of due deliveries, returning the number of deliveries processed. Deliveries are
+// claimed by leasing them for config.LeaseDuration before being sent, allowing multiple instances to drain the outbox
+// concurrently without holding locks during provider requests. Messages are delivered at least once, a delivery failing
+// to update is sent again once its lease has expired.
+func (s *Service) ProcessOutbox(ctx context.Context) (int, error) {
+	deliveries, err := s.claimDeliveries(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to process push outbox: %w", err)
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if err := s.deliver(ctx, s.DB, delivery); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return len(deliveries), fmt.Errorf("failed to process push outbox: %w", errors.Join(errs...))
+	}
+
+	return len(deliveries), nil
+}
+
+// Purge deletes all deliveries completed (sent, failed or rejected due to an invalid token) more than
+// config.Retention ago, returning the number of deliveries deleted. Queued deliveries are never deleted.
+func (s *Service) Purge(ctx context.Context) (int64, error) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Retention <= 0 {
+		log.Debug().Msg("Push delivery retention is disabled, skipping purge")
+		return 0, nil
+	}
+
+	purged, err := models.PushDeliveries(
+		models.PushDeliveryWhere.Status.NEQ(models.PushDeliveryStatusQueued),
+		models.PushDeliveryWhere.UpdatedAt.LT(s.clock.Now().Add(-s.config.Retention)),
+	).DeleteAll(ctx, s.DB)
+	if err != nil {
+		log.Err(err).Msg("Failed to purge push deliveries")
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// DrainOutbox processes batches until no due deliveries are left, returning the total number of deliveries processed.
+func (s *Service) DrainOutbox(ctx context.Context) (int, error) {
+	var total int
+	for {
+		processed, err := s.ProcessOutbox(ctx)
+		total += processed
+		if err != nil {
+			return total, err
+		}
+
+		if processed < s.config.BatchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+// StartWorker drains the outbox and sends due broadcasts every config.PollInterval in the background until
+// StopWorker is called.
+func (s *Service) StartWorker(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.stopWorker = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		log := util.LogFromContext(ctx)
+		log.Info().Dur("pollInterval", s.config.PollInterval).Msg("Started push outbox worker")
+
+		ticker := time.NewTicker(s.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := s.DrainOutbox(ctx); err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to drain push outbox")
+			}
+
+			if _, err := s.ProcessBroadcasts(ctx); err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("Failed to process push broadcasts")
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Stopped push outbox worker")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// StopWorker stops the worker started by StartWorker, waiting for the batch currently being sent to complete.
+func (s *Service) StopWorker(ctx context.Context) error {
+	if s.stopWorker == nil {
+		return nil
+	}
+
+	s.stopWorker()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for push outbox worker: %w", ctx.Err())
+	}
+}
+
+// claimDeliveries loads the next batch of due deliveries and leases them by moving their next attempt to the end of
+// config.LeaseDuration, so they are not picked up by other instances while being sent.
+func (s *Service) claimDeliveries(ctx context.Context) (models.PushDeliverySlice, error) {
+	log := util.LogFromContext(ctx)
+
+	var deliveries models.PushDeliverySlice
+	if err := db.WithTransaction(ctx, s.DB, func(exec boil.ContextExecutor) error {
+		now := s.clock.Now()
+
+		var err error
+		deliveries, err = models.PushDeliveries(
+			models.PushDeliveryWhere.Status.EQ(models.PushDeliveryStatusQueued),
+			models.PushDeliveryWhere.NextAttemptAt.LTE(now),
+			qm.OrderBy(models.PushDeliveryColumns.NextAttemptAt),
+			qm.Limit(s.config.BatchSize),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, exec)
+		if err != nil {
+			log.Err(err).Msg("Failed to load queued push deliveries")
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		leasedUntil := now.Add(s.config.LeaseDuration)
+		if _, err := deliveries.UpdateAll(ctx, exec, models.M{
+			models.PushDeliveryColumns.NextAttemptAt: leasedUntil,
+			models.PushDeliveryColumns.UpdatedAt:     now,
+		}); err != nil {
+			log.Err(err).Msg("Failed to lease queued push deliveries")
+			return err
+		}
+
+		for _, delivery := range deliveries {
+			delivery.NextAttemptAt = leasedUntil
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// deliver sends the delivery and updates its status. Deliveries rejected due to an invalid token are not retried and
+// the token is deleted, other errors are retried until config.MaxAttempts is reached. Deliveries to tokens deleted in
+// the meantime (e.g. by signing out the device) are discarded without being sent.
+func (s *Service) deliver(ctx context.Context, exec boil.ContextExecutor, delivery *models.PushDelivery) error {
+	log := util.LogFromContext(ctx).With().
+		Str("deliveryID", delivery.ID).
+		Str("userID", delivery.UserID).
+		Str("provider", delivery.Provider).
+		Logger()
+
+	tokenExists, err := models.PushTokens(
+		models.PushTokenWhere.Token.EQ(delivery.Token),
+		models.PushTokenWhere.UserID.EQ(delivery.UserID),
+	).Exists(ctx, exec)
+	if err != nil {
+		log.Err(err).Msg("Failed to check push token of delivery")
+		return err
+	}
+
+	var res ProviderSendResponse
+	var permanent bool
+	if tokenExists {
+		res, permanent = s.send(delivery)
+	}
+
+	now := s.clock.Now()
+	delivery.Attempts++
+
+	switch {
+	case !tokenExists:
+		log.Debug().Msg("Push token was revoked, discarding message")
+		delivery.Status = models.PushDeliveryStatusInvalidToken
+		delivery.LastError = errorString(ErrTokenRevoked)
+	case permanent:
+		log.Warn().Err(res.Err).Msg("Push message cannot be delivered, giving up")
+		delivery.Status = models.PushDeliveryStatusFailed
+		delivery.LastError = errorString(res.Err)
+	case !res.Valid:
+		log.Debug().Err(res.Err).Msg("Push token is invalid, deleting token")
+		delivery.Status = models.PushDeliveryStatusInvalidToken
+		delivery.LastError = errorString(res.Err)
+
+		if _, err := models.PushTokens(
+			models.PushTokenWhere.Token.EQ(delivery.Token),
+			models.PushTokenWhere.UserID.EQ(delivery.UserID),
+		).DeleteAll(ctx, exec); err != nil {
+			log.Err(err).Msg("Failed to delete invalid push token")
+			return err
+		}
+	case res.Err == nil:
+		delivery.Status = models.PushDeliveryStatusSent
+		delivery.SentAt = null.TimeFrom(now)
+		delivery.LastError = null.String{}
+	case delivery.Attempts >= s.config.MaxAttempts:
+		log.Warn().Err(res.Err).Int("attempts", delivery.Attempts).Msg("Failed to send push message, giving up")
+		delivery.Status = models.PushDeliveryStatusFailed
+		delivery.LastError = errorString(res.Err)
+	default:
+		delivery.NextAttemptAt = now.Add(s.retryDelay(delivery.Attempts))
+		delivery.LastError = errorString(res.Err)
+		log.Debug().Err(res.Err).Int("attempts", delivery.Attempts).Time("nextAttemptAt", delivery.NextAttemptAt).Msg("Failed to send push message, retrying")
+	}
+
+	if _, err := delivery.Update(ctx, exec, boil.Whitelist(
+		models.PushDeliveryColumns.Status,
+		models.PushDeliveryColumns.Attempts,
+		models.PushDeliveryColumns.NextAttemptAt,
+		models.PushDeliveryColumns.LastError,
+		models.PushDeliveryColumns.SentAt,
+		models.PushDeliveryColumns.UpdatedAt,
+	)); err != nil {
+		log.Err(err).Msg("Failed to update push delivery")
+		return err
+	}
+
+	return nil
+}
+
+// send passes the delivery to its provider, reporting whether it failed permanently and must not be retried.
+func (s *Service) send(delivery *models.PushDelivery) (ProviderSendResponse, bool) {
+	var msg Message
+	if err := json.Unmarshal(delivery.Message, &msg); err != nil {
+		return ProviderSendResponse{Token: delivery.Token, Valid: true, Err: fmt.Errorf("failed to unmarshal push message: %w", err)}, true
+	}
+
+	provider, ok := s.provider[ProviderType(delivery.Provider)]
+	if !ok {
+		// the provider might be enabled again by the time the delivery is retried
+		return ProviderSendResponse{Token: delivery.Token, Valid: true, Err: ErrProviderNotRegistered}, false
+	}
+
+	return provider.Send(delivery.Token, msg), false
+}
+
+func (s *Service) retryDelay(attempts int) time.Duration {
+	d := s.config.RetryBaseDelay
+	for i := 1; i < attempts && d < s.config.RetryMaxDelay; i++ {
+		d *= 2
+	}
+
+	return min(d, s.config.RetryMaxDelay)
+}
+
+func errorString(err error) null.String {
+	if err == nil {
+		return null.String{}
+	}
+
+	return null.StringFrom(err.Error())
+}
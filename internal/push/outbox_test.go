@@ -0,0 +1,395 @@
+package push_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/test/fixtures"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRollback = errors.New("rollback")
+
+func newTestOutboxPusher(db *sql.DB, clock time2.Clock) *push.Service {
+	service := push.New(db, clock, push.OutboxConfig{
+		PollInterval:   10 * time.Millisecond,
+		BatchSize:      10,
+		MaxAttempts:    3,
+		RetryBaseDelay: time.Minute,
+		RetryMaxDelay:  90 * time.Second,
+		LeaseDuration:  5 * time.Minute,
+	})
+	service.RegisterProvider(provider.NewMock(push.ProviderTypeFCM))
+
+	return service
+}
+
+func TestEnqueueToUserWithinTransaction(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(now))
+
+		// messages of rolled back transactions are never sent
+		err := db.WithTransaction(ctx, sqlDB, func(exec boil.ContextExecutor) error {
+			ids, err := service.EnqueueToUser(ctx, exec, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+			require.NoError(t, err)
+			require.Len(t, ids, 1)
+
+			return errRollback
+		})
+		require.ErrorIs(t, err, errRollback)
+
+		count, err := models.PushDeliveries().Count(ctx, sqlDB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+
+		var ids []string
+		err = db.WithTransaction(ctx, sqlDB, func(exec boil.ContextExecutor) error {
+			ids, err = service.EnqueueToUser(ctx, exec, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+			return err
+		})
+		require.NoError(t, err)
+
+		deliveries, err := service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, models.PushDeliveryStatusQueued, deliveries[0].Status)
+		assert.Equal(t, fix.User1PushToken.Token, deliveries[0].Token)
+		assert.Equal(t, models.ProviderTypeFCM, deliveries[0].Provider)
+
+		processed, err := service.DrainOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err = service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, models.PushDeliveryStatusSent, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.True(t, now.Equal(deliveries[0].SentAt.Time))
+		assert.False(t, deliveries[0].LastError.Valid)
+
+		// sent deliveries are not processed again
+		processed, err = service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+}
+
+func TestProcessOutboxRetryAndDeadLetter(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		clock := time2.NewMockClock(now)
+		service := newTestOutboxPusher(sqlDB, clock)
+
+		// provoke error from mock provider
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("other error", "World"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		processed, err := service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err := service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusQueued, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.Equal(t, null.StringFrom("other error"), deliveries[0].LastError)
+		assert.True(t, now.Add(time.Minute).Equal(deliveries[0].NextAttemptAt))
+
+		// not due yet
+		processed, err = service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+
+		// the delay doubles, capped at the max delay
+		clock.Set(deliveries[0].NextAttemptAt)
+		processed, err = service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err = service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusQueued, deliveries[0].Status)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.True(t, clock.Now().Add(90*time.Second).Equal(deliveries[0].NextAttemptAt))
+
+		// the last attempt dead-letters the delivery
+		clock.Set(deliveries[0].NextAttemptAt)
+		processed, err = service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err = service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusFailed, deliveries[0].Status)
+		assert.Equal(t, 3, deliveries[0].Attempts)
+		assert.False(t, deliveries[0].SentAt.Valid)
+
+		clock.Advance(time.Hour)
+		processed, err = service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+
+		// the token is kept as the error was not caused by it
+		tokenCount, err := fix.User1.PushTokens().Count(ctx, sqlDB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), tokenCount)
+	})
+}
+
+func TestProcessOutboxInvalidToken(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(time.Now()))
+		service.RegisterProvider(provider.NewMock(push.ProviderTypeAPN))
+
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+
+		processed, err := service.DrainOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, processed)
+
+		deliveries, err := service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		statuses := make(map[string]string)
+		for _, delivery := range deliveries {
+			statuses[delivery.Token] = delivery.Status
+		}
+
+		assert.Equal(t, map[string]string{
+			fix.User1PushToken.Token:    models.PushDeliveryStatusSent,
+			fix.User1PushTokenAPN.Token: models.PushDeliveryStatusInvalidToken,
+		}, statuses)
+
+		exists, err := models.PushTokenExists(ctx, sqlDB, fix.User1PushTokenAPN.ID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestProcessOutboxProviderNotRegistered(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(time.Now()))
+
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		service.ResetProviders()
+		service.RegisterProvider(provider.NewMock(push.ProviderTypeAPN))
+
+		processed, err := service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		// the delivery is retried in case the provider is enabled again
+		deliveries, err := service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusQueued, deliveries[0].Status)
+		assert.Equal(t, null.StringFrom(push.ErrProviderNotRegistered.Error()), deliveries[0].LastError)
+	})
+}
+
+func TestProcessOutboxTokenRevoked(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(time.Now()))
+
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		// e.g. the device was signed out before the message was due
+		_, err = fix.User1PushToken.Delete(ctx, sqlDB)
+		require.NoError(t, err)
+
+		processed, err := service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err := service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusInvalidToken, deliveries[0].Status)
+		assert.Equal(t, null.StringFrom(push.ErrTokenRevoked.Error()), deliveries[0].LastError)
+		assert.False(t, deliveries[0].SentAt.Valid)
+	})
+}
+
+func TestOutboxWorker(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(time.Now()))
+
+		// stopping a worker never started is a no-op
+		require.NoError(t, service.StopWorker(ctx))
+
+		service.StartWorker(ctx)
+
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		require.Eventually(t, func() bool {
+			deliveries, err := service.GetDeliveries(ctx, ids)
+			return err == nil && len(deliveries) == 1 && deliveries[0].Status == models.PushDeliveryStatusSent
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.NoError(t, service.StopWorker(ctx))
+	})
+}
+
+func TestEnqueueToUserNotificationPreferences(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// 23:30 in Vienna
+		now := time.Date(2026, 10, 18, 21, 30, 0, 0, time.UTC)
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(now))
+
+		// marketing is opt-in
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryMarketing, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		preference := models.NotificationPreference{
+			UserID:   fix.User1.ID,
+			Category: notification.CategoryUpdates.String(),
+			Channel:  notification.ChannelPush.String(),
+			Enabled:  false,
+		}
+		require.NoError(t, preference.Insert(ctx, sqlDB, boil.Infer()))
+
+		ids, err = service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		_, err = service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.Category("unknown"), push.NewNotification("Hello", "World"))
+		require.ErrorIs(t, err, notification.ErrUnknownCategory)
+
+		// messages during quiet hours are deferred until they end, mandatory categories are sent right away
+		fix.User1AppUserProfile.TimeZone = "Europe/Vienna"
+		fix.User1AppUserProfile.QuietHoursStart = null.IntFrom(22 * 60)
+		fix.User1AppUserProfile.QuietHoursEnd = null.IntFrom(7 * 60)
+		_, err = fix.User1AppUserProfile.Update(ctx, sqlDB, boil.Whitelist(
+			models.AppUserProfileColumns.TimeZone,
+			models.AppUserProfileColumns.QuietHoursStart,
+			models.AppUserProfileColumns.QuietHoursEnd,
+		))
+		require.NoError(t, err)
+
+		preference.Category = notification.CategoryMarketing.String()
+		preference.Enabled = true
+		require.NoError(t, preference.Insert(ctx, sqlDB, boil.Infer()))
+
+		deferredIDs, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryMarketing, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, deferredIDs, 1)
+
+		accountIDs, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryAccount, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, accountIDs, 1)
+
+		deliveries, err := service.GetDeliveries(ctx, append(deferredIDs, accountIDs...))
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		nextAttemptAt := make(map[string]time.Time)
+		for _, delivery := range deliveries {
+			nextAttemptAt[delivery.ID] = delivery.NextAttemptAt
+		}
+
+		// 07:00 in Vienna
+		assert.True(t, time.Date(2026, 10, 19, 5, 0, 0, 0, time.UTC).Equal(nextAttemptAt[deferredIDs[0]]))
+		assert.True(t, now.Equal(nextAttemptAt[accountIDs[0]]))
+
+		processed, err := service.DrainOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+}
+
+type sendHookProvider struct {
+	push.Provider
+	onSend func(token string)
+}
+
+func (p *sendHookProvider) Send(token string, msg push.Message) push.ProviderSendResponse {
+	p.onSend(token)
+	return p.Provider.Send(token, msg)
+}
+
+func TestProcessOutboxLeasesDeliveriesWhileSending(t *testing.T) {
+	test.WithTestDatabase(t, func(sqlDB *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		service := newTestOutboxPusher(sqlDB, time2.NewMockClock(now))
+
+		var leased []*models.PushDelivery
+		service.RegisterProvider(&sendHookProvider{
+			Provider: provider.NewMock(push.ProviderTypeFCM),
+			onSend: func(_ string) {
+				// the delivery is neither locked nor claimable by other instances while being sent
+				processed, err := service.ProcessOutbox(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, 0, processed)
+
+				delivery, err := models.PushDeliveries(qm.For("UPDATE NOWAIT")).One(ctx, sqlDB)
+				require.NoError(t, err)
+				leased = append(leased, delivery)
+			},
+		})
+
+		ids, err := service.EnqueueToUser(ctx, sqlDB, fix.User1.ID, notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		processed, err := service.ProcessOutbox(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		require.Len(t, leased, 1)
+		assert.Equal(t, models.PushDeliveryStatusQueued, leased[0].Status)
+		assert.True(t, now.Add(5*time.Minute).Equal(leased[0].NextAttemptAt))
+
+		deliveries, err := service.GetDeliveries(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusSent, deliveries[0].Status)
+	})
+}
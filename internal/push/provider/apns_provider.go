@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	APNSEndpointProduction = "https://api.push.apple.com"
+	APNSEndpointSandbox    = "https://api.sandbox.push.apple.com"
+
+	// APNs rejects provider tokens older than one hour and refreshing them more often than every 20 minutes.
+	apnsProviderTokenTTL = 50 * time.Minute
+	apnsRequestTimeout   = 30 * time.Second
+
+	apnsReasonBadDeviceToken       = "BadDeviceToken"
+	apnsReasonUnregistered         = "Unregistered"
+	apnsReasonExpiredProviderToken = "ExpiredProviderToken"
+)
+
+var ErrAPNSRequestFailed = errors.New("APNs request failed")
+
+type APNS struct {
+	Config APNSConfig
+	client *http.Client
+	key    *ecdsa.PrivateKey
+
+	mu            sync.Mutex
+	token         string
+	tokenIssuedAt time.Time
+}
+
+type APNSConfig struct {
+	// AuthKeyPath is the path to the .p8 token signing key downloaded from the Apple developer account
+	AuthKeyPath string `json:"-"` // sensitive
+	KeyID       string
+	TeamID      string
+	// Topic is the bundle ID of the app
+	Topic      string
+	Production bool
+	// Endpoint overrides the endpoint selected by Production if set, e.g. for testing against a local server
+	Endpoint string
+}
+
+type apnsAPS struct {
+	Alert            *apnsAlert `json:"alert,omitempty"`
+	Badge            *int       `json:"badge,omitempty"`
+	Sound            string     `json:"sound,omitempty"`
+	Category         string     `json:"category,omitempty"`
+	ThreadID         string     `json:"thread-id,omitempty"`
+	ContentAvailable int        `json:"content-available,omitempty"`
+	MutableContent   int        `json:"mutable-content,omitempty"`
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type apnsErrorResponse struct {
+	Reason string `json:"reason"`
+}
+
+// NewAPNS creates the APNs provider authenticating using the .p8 signing key. If client is nil, a HTTP/2 capable
+// default client is used.
+func NewAPNS(config APNSConfig, client *http.Client) (*APNS, error) {
+	keyPEM, err := os.ReadFile(config.AuthKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read APNs auth key: %w", err)
+	}
+
+	key, err := jwt.ParseECPrivateKeyFromPEM(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse APNs auth key: %w", err)
+	}
+
+	if client == nil {
+		client = &http.Client{
+			Timeout: apnsRequestTimeout,
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				ForceAttemptHTTP2:   true,
+				TLSHandshakeTimeout: 10 * time.Second,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		}
+	}
+
+	return &APNS{
+		Config: config,
+		client: client,
+		key:    key,
+	}, nil
+}
+
+func (p *APNS) GetProviderType() push.ProviderType {
+	return push.ProviderTypeAPN
+}
+
+func (p *APNS) Send(token string, msg push.Message) push.ProviderSendResponse {
+	reason, err := p.send(token, msg)
+	if err != nil && reason == apnsReasonExpiredProviderToken {
+		// the cached provider token was rejected, retry once with a freshly signed one
+		p.resetProviderToken()
+		reason, err = p.send(token, msg)
+	}
+
+	return push.ProviderSendResponse{
+		Token: token,
+		Valid: reason != apnsReasonBadDeviceToken && reason != apnsReasonUnregistered,
+		Err:   err,
+	}
+}
+
+func (p *APNS) SendMulticast(tokens []string, msg push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, msg)
+}
+
+// send delivers the message to the device, returning the reason reported by APNs if it was rejected.
+func (p *APNS) send(token string, msg push.Message) (string, error) {
+	providerToken, err := p.providerToken()
+	if err != nil {
+		return "", err
+	}
+
+	// custom data is passed next to the reserved aps dictionary
+	payload := make(map[string]any)
+	for key, value := range msg.DataWithLinks() {
+		payload[key] = value
+	}
+	payload["aps"] = newAPNSAPS(msg, true)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal APNs payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, p.endpoint()+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create APNs request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Apns-Topic", p.Config.Topic)
+	for key, value := range newAPNSHeaders(msg) {
+		req.Header.Set(key, value)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send APNs request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return "", nil
+	}
+
+	var errRes apnsErrorResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, 4096)).Decode(&errRes); err != nil {
+		return "", fmt.Errorf("%w: status %d", ErrAPNSRequestFailed, res.StatusCode)
+	}
+
+	return errRes.Reason, fmt.Errorf("%w: status %d, reason %s", ErrAPNSRequestFailed, res.StatusCode, errRes.Reason)
+}
+
+// providerToken returns the cached JWT provider token, signing a new one once it is about to expire.
+func (p *APNS) providerToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if len(p.token) > 0 && now.Sub(p.tokenIssuedAt) < apnsProviderTokenTTL {
+		return p.token, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": p.Config.TeamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = p.Config.KeyID
+
+	signed, err := token.SignedString(p.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs provider token: %w", err)
+	}
+
+	p.token = signed
+	p.tokenIssuedAt = now
+
+	return signed, nil
+}
+
+func (p *APNS) resetProviderToken() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.token = ""
+}
+
+func (p *APNS) endpoint() string {
+	if len(p.Config.Endpoint) > 0 {
+		return p.Config.Endpoint
+	}
+
+	if p.Config.Production {
+		return APNSEndpointProduction
+	}
+
+	return APNSEndpointSandbox
+}
+
+// newAPNSAPS returns the aps dictionary of the message. The alert is omitted if requested, as FCM derives it from
+// the notification of the message itself.
+func newAPNSAPS(msg push.Message, includeAlert bool) apnsAPS {
+	aps := apnsAPS{
+		Sound:    msg.Sound,
+		Category: msg.APNS.Category,
+		ThreadID: msg.APNS.ThreadID,
+	}
+
+	if msg.Badge.Valid {
+		badge := msg.Badge.Int
+		aps.Badge = &badge
+	}
+
+	if msg.IsSilent() {
+		aps.ContentAvailable = 1
+	} else if includeAlert {
+		aps.Alert = &apnsAlert{
+			Title: msg.Title,
+			Body:  msg.Body,
+		}
+	}
+
+	// allows a notification service extension to attach the image
+	if len(msg.ImageURL) > 0 {
+		aps.MutableContent = 1
+	}
+
+	return aps
+}
+
+// newAPNSHeaders returns the request headers controlling the delivery of the message.
+func newAPNSHeaders(msg push.Message) map[string]string {
+	delivery := msg.APNSDelivery()
+
+	headers := map[string]string{
+		"Apns-Push-Type": "alert",
+		"Apns-Priority":  "10",
+	}
+
+	// background pushes have to be sent with low priority, they are rejected otherwise
+	if msg.IsSilent() {
+		headers["Apns-Push-Type"] = "background"
+		headers["Apns-Priority"] = "5"
+	} else if delivery.Priority == push.PriorityNormal {
+		headers["Apns-Priority"] = "5"
+	}
+
+	if len(delivery.CollapseKey) > 0 {
+		headers["Apns-Collapse-Id"] = delivery.CollapseKey
+	}
+
+	if delivery.TTL > 0 {
+		headers["Apns-Expiration"] = strconv.FormatInt(time.Now().Add(delivery.TTL).Unix(), 10)
+	}
+
+	return headers
+}
@@ -0,0 +1,266 @@
+package provider_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"github.com/aarondl/null/v8"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apnsTestKeyID  = "ABC123DEFG"
+	apnsTestTeamID = "DEF123GHIJ"
+	apnsTestTopic  = "dev.allaboutapps.gostarter"
+)
+
+type apnsStubRequest struct {
+	Proto         int
+	Path          string
+	Authorization string
+	Topic         string
+	PushType      string
+	Priority      string
+	CollapseID    string
+	Expiration    string
+	Body          map[string]any
+}
+
+type apnsStub struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	requests []apnsStubRequest
+}
+
+// newAPNSStub starts a local HTTP/2 server responding to device tokens prefixed with "bad" or "unregistered" like APNs.
+func newAPNSStub(t *testing.T) *apnsStub {
+	t.Helper()
+
+	stub := &apnsStub{}
+	stub.server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		stub.mu.Lock()
+		stub.requests = append(stub.requests, apnsStubRequest{
+			Proto:         r.ProtoMajor,
+			Path:          r.URL.Path,
+			Authorization: r.Header.Get("Authorization"),
+			Topic:         r.Header.Get("Apns-Topic"),
+			PushType:      r.Header.Get("Apns-Push-Type"),
+			Priority:      r.Header.Get("Apns-Priority"),
+			CollapseID:    r.Header.Get("Apns-Collapse-Id"),
+			Expiration:    r.Header.Get("Apns-Expiration"),
+			Body:          body,
+		})
+		stub.mu.Unlock()
+
+		token := strings.TrimPrefix(r.URL.Path, "/3/device/")
+		switch {
+		case strings.HasPrefix(token, "bad"):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		case strings.HasPrefix(token, "unregistered"):
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered","timestamp":1760788800000}`))
+		case strings.HasPrefix(token, "throttled"):
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"reason":"TooManyRequests"}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	stub.server.EnableHTTP2 = true
+	stub.server.StartTLS()
+	t.Cleanup(stub.server.Close)
+
+	return stub
+}
+
+func (s *apnsStub) Requests() []apnsStubRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]apnsStubRequest{}, s.requests...)
+}
+
+func newTestAPNS(t *testing.T, stub *apnsStub) (*provider.APNS, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey_"+apnsTestKeyID+".p8")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	apns, err := provider.NewAPNS(provider.APNSConfig{
+		AuthKeyPath: keyPath,
+		KeyID:       apnsTestKeyID,
+		TeamID:      apnsTestTeamID,
+		Topic:       apnsTestTopic,
+		Endpoint:    stub.server.URL,
+	}, stub.server.Client())
+	require.NoError(t, err)
+
+	return apns, key
+}
+
+func TestAPNSSend(t *testing.T) {
+	stub := newAPNSStub(t)
+	apns, key := newTestAPNS(t, stub)
+
+	assert.Equal(t, push.ProviderTypeAPN, apns.GetProviderType())
+
+	res := apns.Send("validtoken", push.NewNotification("Hello", "World"))
+	require.NoError(t, res.Err)
+	assert.True(t, res.Valid)
+	assert.Equal(t, "validtoken", res.Token)
+
+	requests := stub.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, 2, requests[0].Proto)
+	assert.Equal(t, "/3/device/validtoken", requests[0].Path)
+	assert.Equal(t, apnsTestTopic, requests[0].Topic)
+	assert.Equal(t, "alert", requests[0].PushType)
+	assert.Equal(t, "10", requests[0].Priority)
+	assert.Empty(t, requests[0].CollapseID)
+	assert.Empty(t, requests[0].Expiration)
+	assert.Equal(t, map[string]any{
+		"aps": map[string]any{
+			"alert": map[string]any{
+				"title": "Hello",
+				"body":  "World",
+			},
+		},
+	}, requests[0].Body)
+
+	providerToken, err := jwt.Parse(strings.TrimPrefix(requests[0].Authorization, "bearer "), func(_ *jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}), jwt.WithIssuer(apnsTestTeamID), jwt.WithIssuedAt())
+	require.NoError(t, err)
+	assert.Equal(t, apnsTestKeyID, providerToken.Header["kid"])
+}
+
+func TestAPNSSendRichMessage(t *testing.T) {
+	stub := newAPNSStub(t)
+	apns, _ := newTestAPNS(t, stub)
+
+	res := apns.Send("validtoken", push.Message{
+		Title:    "Hello",
+		Body:     "World",
+		Data:     map[string]string{"orderId": "42"},
+		DeepLink: "gostarter://orders/42",
+		ImageURL: "https://example.com/image.png",
+		Badge:    null.IntFrom(3),
+		Sound:    "default",
+		Delivery: push.DeliveryOptions{
+			Priority:    push.PriorityHigh,
+			CollapseKey: "orders",
+		},
+		APNS: push.APNSOptions{
+			Category: "ORDER",
+			ThreadID: "orders",
+			Delivery: push.DeliveryOptions{
+				Priority: push.PriorityNormal,
+				TTL:      time.Hour,
+			},
+		},
+	})
+	require.NoError(t, res.Err)
+
+	// silent messages are delivered as background pushes
+	res = apns.Send("validtoken", push.Message{
+		Data: map[string]string{"sync": "true"},
+	})
+	require.NoError(t, res.Err)
+
+	requests := stub.Requests()
+	require.Len(t, requests, 2)
+
+	assert.Equal(t, "alert", requests[0].PushType)
+	assert.Equal(t, "5", requests[0].Priority)
+	assert.Equal(t, "orders", requests[0].CollapseID)
+	assert.NotEmpty(t, requests[0].Expiration)
+	assert.Equal(t, map[string]any{
+		"aps": map[string]any{
+			"alert": map[string]any{
+				"title": "Hello",
+				"body":  "World",
+			},
+			"badge":           float64(3),
+			"sound":           "default",
+			"category":        "ORDER",
+			"thread-id":       "orders",
+			"mutable-content": float64(1),
+		},
+		"orderId":            "42",
+		push.DataKeyDeepLink: "gostarter://orders/42",
+		push.DataKeyImageURL: "https://example.com/image.png",
+	}, requests[0].Body)
+
+	assert.Equal(t, "background", requests[1].PushType)
+	assert.Equal(t, "5", requests[1].Priority)
+	assert.Equal(t, map[string]any{
+		"aps": map[string]any{
+			"content-available": float64(1),
+		},
+		"sync": "true",
+	}, requests[1].Body)
+}
+
+func TestAPNSSendMulticast(t *testing.T) {
+	stub := newAPNSStub(t)
+	apns, _ := newTestAPNS(t, stub)
+
+	responses := apns.SendMulticast([]string{"validtoken", "badtoken", "unregisteredtoken", "throttledtoken"}, push.NewNotification("Hello", "World"))
+	require.Len(t, responses, 4)
+
+	assert.True(t, responses[0].Valid)
+	require.NoError(t, responses[0].Err)
+
+	// only errors caused by the device token invalidate it
+	assert.False(t, responses[1].Valid)
+	require.ErrorIs(t, responses[1].Err, provider.ErrAPNSRequestFailed)
+	assert.False(t, responses[2].Valid)
+	require.ErrorIs(t, responses[2].Err, provider.ErrAPNSRequestFailed)
+	assert.True(t, responses[3].Valid)
+	require.ErrorIs(t, responses[3].Err, provider.ErrAPNSRequestFailed)
+
+	// the provider token is signed once and reused for subsequent requests
+	requests := stub.Requests()
+	require.Len(t, requests, 4)
+	for _, req := range requests[1:] {
+		assert.Equal(t, requests[0].Authorization, req.Authorization)
+	}
+}
+
+func TestAPNSInvalidAuthKey(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	require.NoError(t, os.WriteFile(keyPath, []byte("not a key"), 0o600))
+
+	_, err := provider.NewAPNS(provider.APNSConfig{AuthKeyPath: keyPath}, nil)
+	require.Error(t, err)
+
+	_, err = provider.NewAPNS(provider.APNSConfig{AuthKeyPath: filepath.Join(t.TempDir(), "missing.p8")}, nil)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -40,21 +41,24 @@ func (p *FCM) GetProviderType() push.ProviderType {
 	return push.ProviderTypeFCM
 }
 
-func (p *FCM) Send(token string, title string, message string) push.ProviderSendResponse {
+func (p *FCM) Send(token string, msg push.Message) push.ProviderSendResponse {
+	message, err := newFCMMessage(token, msg)
+	if err != nil {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+			Err:   err,
+		}
+	}
+
 	// https: //godoc.org/google.golang.org/api/fcm/v1#SendMessageRequest
 	// https://firebase.google.com/docs/cloud-messaging/send-message#rest
 	messageRequest := &fcm.SendMessageRequest{
 		ValidateOnly: p.Config.ValidateOnly,
-		Message: &fcm.Message{
-			Token: token,
-			Notification: &fcm.Notification{
-				Title: title,
-				Body:  message,
-			},
-		},
+		Message:      message,
 	}
 
-	_, err := p.service.Projects.Messages.Send("projects/"+p.Config.ProjectID, messageRequest).Do()
+	_, err = p.service.Projects.Messages.Send("projects/"+p.Config.ProjectID, messageRequest).Do()
 	valid := true
 	if err != nil {
 		// convert to original error and determine if the token was at fault
@@ -71,6 +75,65 @@ func (p *FCM) Send(token string, title string, message string) push.ProviderSend
 	}
 }
 
-func (p *FCM) SendMulticast(tokens []string, title, message string) []push.ProviderSendResponse {
-	return sendMulticastWithProvider(p, tokens, title, message)
+func (p *FCM) SendMulticast(tokens []string, msg push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, msg)
+}
+
+// newFCMMessage maps the message to FCM, including the APNs options for Apple devices registered with FCM.
+func newFCMMessage(token string, msg push.Message) (*fcm.Message, error) {
+	androidDelivery := msg.AndroidDelivery()
+
+	message := &fcm.Message{
+		Token: token,
+		Data:  msg.DataWithLinks(),
+		Android: &fcm.AndroidConfig{
+			CollapseKey: androidDelivery.CollapseKey,
+			Priority:    fcmAndroidPriority(androidDelivery.Priority),
+		},
+		Apns: &fcm.ApnsConfig{
+			Headers: newAPNSHeaders(msg),
+		},
+	}
+
+	if androidDelivery.TTL > 0 {
+		message.Android.Ttl = fmt.Sprintf("%ds", int64(androidDelivery.TTL.Seconds()))
+	}
+
+	if !msg.IsSilent() {
+		message.Notification = &fcm.Notification{
+			Title: msg.Title,
+			Body:  msg.Body,
+			Image: msg.ImageURL,
+		}
+
+		message.Android.Notification = &fcm.AndroidNotification{
+			ChannelId: msg.Android.ChannelID,
+			Sound:     msg.Sound,
+		}
+
+		if msg.Badge.Valid {
+			message.Android.Notification.NotificationCount = int64(msg.Badge.Int)
+		}
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"aps": newAPNSAPS(msg, false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal APNs payload: %w", err)
+	}
+	message.Apns.Payload = payload
+
+	return message, nil
+}
+
+func fcmAndroidPriority(priority push.Priority) string {
+	switch priority {
+	case push.PriorityHigh:
+		return "HIGH"
+	case push.PriorityNormal:
+		return "NORMAL"
+	default:
+		return ""
+	}
 }
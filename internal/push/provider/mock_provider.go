@@ -25,7 +25,7 @@ const (
 	expectedTokenLength = 40
 )
 
-func (p *Mock) Send(token string, title string, message string) push.ProviderSendResponse {
+func (p *Mock) Send(token string, msg push.Message) push.ProviderSendResponse {
 	valid := true
 	var err error
 	if len(token) < expectedTokenLength {
@@ -33,11 +33,11 @@ func (p *Mock) Send(token string, title string, message string) push.ProviderSen
 		err = errors.New("invalid token")
 	}
 
-	if title == "other error" {
+	if msg.Title == "other error" {
 		err = errors.New("other error")
 	}
 
-	log.Info().Str("token", token).Str("title", title).Str("message", message).Msg("Mock Push Notification")
+	log.Info().Str("token", token).Str("title", msg.Title).Str("message", msg.Body).Interface("data", msg.DataWithLinks()).Bool("silent", msg.IsSilent()).Msg("Mock Push Notification")
 
 	return push.ProviderSendResponse{
 		Token: token,
@@ -46,6 +46,6 @@ func (p *Mock) Send(token string, title string, message string) push.ProviderSen
 	}
 }
 
-func (p *Mock) SendMulticast(tokens []string, title, message string) []push.ProviderSendResponse {
-	return sendMulticastWithProvider(p, tokens, title, message)
+func (p *Mock) SendMulticast(tokens []string, msg push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, msg)
 }
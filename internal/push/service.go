@@ -4,11 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
+	"sync"
+	"time"
 
-	"allaboutapps.dev/aw/go-starter/internal/data/dto"
-	"allaboutapps.dev/aw/go-starter/internal/models"
-	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/dropbox/godropbox/time2"
 )
 
 type ProviderType string
@@ -18,9 +17,38 @@ const (
 	ProviderTypeAPN ProviderType = "apn"
 )
 
+var (
+	ErrNoProvider            = errors.New("no provider found")
+	ErrProviderNotRegistered = errors.New("provider not registered")
+	ErrTokenRevoked          = errors.New("push token revoked")
+)
+
+// Service delivers push messages to the devices of users. Messages are queued in the push_deliveries outbox and sent
+// by ProcessOutbox, scheduled broadcasts are sent by ProcessBroadcasts. Both are either called by the worker started
+// with StartWorker or by a job.
 type Service struct {
 	DB       *sql.DB
+	config   OutboxConfig
+	clock    time2.Clock
 	provider map[ProviderType]Provider
+
+	// stopWorker cancels the worker started by StartWorker, wg tracks its completion
+	stopWorker context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// OutboxConfig controls the delivery of queued messages. Failed deliveries are retried with an exponentially growing
+// delay starting at RetryBaseDelay capped at RetryMaxDelay, until MaxAttempts have failed. Deliveries being sent are
+// leased for LeaseDuration, which must exceed the time needed to send a batch. Completed deliveries are deleted by
+// Purge once Retention has passed, 0 keeps them forever.
+type OutboxConfig struct {
+	PollInterval   time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	LeaseDuration  time.Duration
+	Retention      time.Duration
 }
 
 type ProviderSendResponse struct {
@@ -36,14 +64,16 @@ type ProviderSendResponse struct {
 }
 
 type Provider interface {
-	Send(token string, title string, message string) ProviderSendResponse
-	SendMulticast(tokens []string, title, message string) []ProviderSendResponse
+	Send(token string, msg Message) ProviderSendResponse
+	SendMulticast(tokens []string, msg Message) []ProviderSendResponse
 	GetProviderType() ProviderType
 }
 
-func New(db *sql.DB) *Service {
+func New(db *sql.DB, clock time2.Clock, config OutboxConfig) *Service {
 	return &Service{
 		DB:       db,
+		config:   config,
+		clock:    clock,
 		provider: make(map[ProviderType]Provider),
 	}
 }
@@ -59,49 +89,3 @@ func (s *Service) ResetProviders() {
 func (s *Service) GetProviderCount() int {
 	return len(s.provider)
 }
-
-func (s *Service) SendToUser(ctx context.Context, user *dto.User, title string, message string) error {
-	if s.GetProviderCount() < 1 {
-		return errors.New("no provider found")
-	}
-	log := util.LogFromContext(ctx)
-
-	for providerType, provider := range s.provider {
-		// get all registered tokens for provider
-		pushTokens, err := models.PushTokens(
-			models.PushTokenWhere.Provider.EQ(string(providerType)),
-			models.PushTokenWhere.UserID.EQ(user.ID),
-		).All(ctx, s.DB)
-		if err != nil {
-			return fmt.Errorf("failed to get push tokens: %w", err)
-		}
-
-		var tokens []string
-		for _, token := range pushTokens {
-			tokens = append(tokens, token.Token)
-		}
-
-		responseSlice := provider.SendMulticast(tokens, title, message)
-		tokenToDelete := make([]string, 0)
-		for _, res := range responseSlice {
-			if res.Err != nil && res.Valid {
-				log.Debug().Err(res.Err).Str("token", res.Token).Str("provider", string(provider.GetProviderType())).Msgf("Error while sending push message to provider with valid token.")
-			}
-
-			if !res.Valid {
-				tokenToDelete = append(tokenToDelete, res.Token)
-			}
-		}
-		// delete invalid tokens
-		_, err = models.PushTokens(
-			models.PushTokenWhere.Token.IN(tokenToDelete),
-			models.PushTokenWhere.UserID.EQ(user.ID),
-		).DeleteAll(ctx, s.DB)
-		if err != nil {
-			log.Debug().Err(err).Str("provider", string(provider.GetProviderType())).Msg("Could not delete invalid tokens for provider")
-			return err
-		}
-	}
-
-	return nil
-}
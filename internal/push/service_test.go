@@ -6,6 +6,7 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/internal/data/mapper"
 	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/notification"
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
 	"allaboutapps.dev/aw/go-starter/internal/test"
@@ -20,7 +21,10 @@ func TestSendMessageSuccess(t *testing.T) {
 		ctx := t.Context()
 		fix := fixtures.Fixtures()
 
-		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), "Hello", "World")
+		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+
+		_, err = service.DrainOutbox(ctx)
 		require.NoError(t, err)
 
 		tokenCount, err2 := fix.User1.PushTokens().Count(ctx, db)
@@ -35,7 +39,10 @@ func TestSendMessageSuccessWithGenericError(t *testing.T) {
 		fix := fixtures.Fixtures()
 
 		// provoke error from mock provider
-		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), "other error", "World")
+		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), notification.CategoryUpdates, push.NewNotification("other error", "World"))
+		require.NoError(t, err)
+
+		_, err = service.DrainOutbox(ctx)
 		require.NoError(t, err)
 
 		tokenCount, err2 := fix.User1.PushTokens().Count(ctx, db)
@@ -62,7 +69,10 @@ func TestSendMessageWithInvalidToken(t *testing.T) {
 		require.NoError(t, err2)
 		require.Equal(t, int64(3), tokenCount)
 
-		err = service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), "Hello", "World")
+		err = service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+
+		_, err = service.DrainOutbox(ctx)
 		require.NoError(t, err)
 
 		tokenCount, err2 = fix.User1.PushTokens().Count(ctx, db)
@@ -79,7 +89,7 @@ func TestSendMessageWithNoProvider(t *testing.T) {
 		service.ResetProviders()
 		require.Equal(t, 0, service.GetProviderCount())
 
-		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), "Hello", "World")
+		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), notification.CategoryUpdates, push.NewNotification("Hello", "World"))
 		require.Error(t, err)
 
 		tokenCount, err2 := fix.User1.PushTokens().Count(ctx, db)
@@ -101,7 +111,10 @@ func TestSendMessageWithMultipleProvider(t *testing.T) {
 		service.RegisterProvider(mockProviderAPN)
 		service.RegisterProvider(mockProviderFCM)
 
-		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), "Hello", "World")
+		err := service.SendToUser(ctx, mapper.LocalUserToDTO(fix.User1).Ptr(), notification.CategoryUpdates, push.NewNotification("Hello", "World"))
+		require.NoError(t, err)
+
+		_, err = service.DrainOutbox(ctx)
 		require.NoError(t, err)
 
 		tokenCount, err2 := fix.User1.PushTokens().Count(ctx, db)
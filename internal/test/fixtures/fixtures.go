@@ -66,11 +66,15 @@ func Fixtures() FixtureMap {
 		Token:      "1cfc27d7-a178-4051-802b-f3ff3967c95c",
 		ValidUntil: now.Add(10 * 365 * 24 * time.Hour),
 		UserID:     f.User1.ID,
+		SessionID:  null.StringFrom("4c3c8e8d-5a0e-4a8b-9a53-7f0f5d3b1c21"),
 	}
 
 	f.User1RefreshToken1 = &models.RefreshToken{
-		Token:  "66412eaf-2b89-404d-bbb5-46c3b8bf1a53",
-		UserID: f.User1.ID,
+		Token:            "66412eaf-2b89-404d-bbb5-46c3b8bf1a53",
+		UserID:           f.User1.ID,
+		SessionID:        "4c3c8e8d-5a0e-4a8b-9a53-7f0f5d3b1c21",
+		LastUsedAt:       now,
+		SessionStartedAt: now,
 	}
 
 	f.User2 = &models.User{
@@ -90,11 +94,15 @@ func Fixtures() FixtureMap {
 		Token:      "115d28c5-f585-4fb5-9656-fb321739fee5",
 		ValidUntil: now.Add(10 * 365 * 24 * time.Hour),
 		UserID:     f.User2.ID,
+		SessionID:  null.StringFrom("0b5f7f3e-3f6a-4d2c-8e0b-2a9d6c4e7f12"),
 	}
 
 	f.User2RefreshToken1 = &models.RefreshToken{
-		Token:  "ea909c75-63d1-4348-a63c-4bcf8ab334a2",
-		UserID: f.User2.ID,
+		Token:            "ea909c75-63d1-4348-a63c-4bcf8ab334a2",
+		UserID:           f.User2.ID,
+		SessionID:        "0b5f7f3e-3f6a-4d2c-8e0b-2a9d6c4e7f12",
+		LastUsedAt:       now,
+		SessionStartedAt: now,
 	}
 
 	f.UserRequiresConfirmation = &models.User{
@@ -134,18 +142,23 @@ func Fixtures() FixtureMap {
 		Token:      "24d0b38d-387c-400c-80fc-a71d85031d4c",
 		ValidUntil: now.Add(10 * 365 * 24 * time.Hour),
 		UserID:     f.UserDeactivated.ID,
+		SessionID:  null.StringFrom("e6a2d1c4-9b7f-4e35-a1d8-5c3f0b2e9a47"),
 	}
 
 	f.UserDeactivatedRefreshToken1 = &models.RefreshToken{
-		Token:  "b6e13a88-7b18-4f17-b819-71b196be2444",
-		UserID: f.UserDeactivated.ID,
+		Token:            "b6e13a88-7b18-4f17-b819-71b196be2444",
+		UserID:           f.UserDeactivated.ID,
+		SessionID:        "e6a2d1c4-9b7f-4e35-a1d8-5c3f0b2e9a47",
+		LastUsedAt:       now,
+		SessionStartedAt: now,
 	}
 
 	f.User1PushToken = &models.PushToken{
-		ID:       "98ad176b-af90-44b7-b991-d9ebfc5dd9a0",
-		Token:    "cQ_Qk3ZCCZelUZ_K_Yn2BV:APA91bG4jst5srGYZqBAn_wRfiJUzAOQ4k8tV0sDcV4uas2ln5wNwkE_ebneR5Fqk7GvndZ-h3mWnjWaI8yZ4sVwo8qu_Aztotqup4mlEPNYgFGqTlJ5ltQrJG5oKp4RoYQ_0CeFaymn",
-		UserID:   f.User1.ID,
-		Provider: models.ProviderTypeFCM,
+		ID:        "98ad176b-af90-44b7-b991-d9ebfc5dd9a0",
+		Token:     "cQ_Qk3ZCCZelUZ_K_Yn2BV:APA91bG4jst5srGYZqBAn_wRfiJUzAOQ4k8tV0sDcV4uas2ln5wNwkE_ebneR5Fqk7GvndZ-h3mWnjWaI8yZ4sVwo8qu_Aztotqup4mlEPNYgFGqTlJ5ltQrJG5oKp4RoYQ_0CeFaymn",
+		UserID:    f.User1.ID,
+		Provider:  models.ProviderTypeFCM,
+		SessionID: f.User1AccessToken1.SessionID,
 	}
 
 	f.User1PushTokenAPN = &models.PushToken{
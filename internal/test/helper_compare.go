@@ -86,3 +86,18 @@ func RequireHTTPError(t *testing.T, res *httptest.ResponseRecorder, httpError *h
 
 	return response
 }
+
+func RequireHTTPValidationError(t *testing.T, res *httptest.ResponseRecorder, httpError *httperrors.HTTPValidationError) httperrors.HTTPValidationError {
+	t.Helper()
+
+	if httpError.Code != nil {
+		require.Equal(t, int(*httpError.Code), res.Result().StatusCode)
+	}
+
+	var response httperrors.HTTPValidationError
+	ParseResponseAndValidate(t, res, &response)
+
+	require.Equal(t, httpError, &response)
+
+	return response
+}
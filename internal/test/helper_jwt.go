@@ -0,0 +1,24 @@
+package test
+
+import (
+	"path/filepath"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+const (
+	JWTTestKeyIDEd25519 = "test-ed25519"
+	JWTTestKeyIDRSA     = "test-rsa"
+)
+
+// DefaultServiceConfigWithJWTAccessTokens returns the default service config issuing JWT access tokens, signed by
+// the key signingKeyID out of the test keys in /test/testdata/jwt.
+func DefaultServiceConfigWithJWTAccessTokens(signingKeyID string) config.Server {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Auth.AccessTokenFormat = config.AccessTokenFormatJWT
+	cfg.Auth.JWTKeysDirAbs = filepath.Join(util.GetProjectRootDir(), "test", "testdata", "jwt")
+	cfg.Auth.JWTSigningKeyID = signingKeyID
+
+	return cfg
+}
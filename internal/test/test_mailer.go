@@ -2,10 +2,13 @@ package test
 
 import (
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/i18n"
 	"allaboutapps.dev/aw/go-starter/internal/mailer"
 	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
+	"github.com/dropbox/godropbox/time2"
 	"github.com/jordan-wright/email"
 )
 
@@ -39,10 +42,16 @@ func GetTestMailerMockTransport(t *testing.T, m *mailer.Mailer) *transport.MockM
 func newMailerWithTransporter(t *testing.T, transporter transport.MailTransporter) *mailer.Mailer {
 	t.Helper()
 
-	config := config.DefaultServiceConfigFromEnv().Mailer
-	config.DefaultSender = TestMailerDefaultSender
+	serverConfig := config.DefaultServiceConfigFromEnv()
+	serverConfig.Mailer.DefaultSender = TestMailerDefaultSender
 
-	mailer := mailer.New(config, transporter)
+	i18n, err := i18n.New(serverConfig.I18n)
+	if err != nil {
+		t.Fatal("Failed to load i18n bundle", err)
+	}
+
+	// tests relying on notification preferences have to set the DB of the mailer
+	mailer := mailer.New(serverConfig.Mailer, transporter, nil, time2.NewMockClock(time.Now()), i18n)
 
 	if err := mailer.ParseTemplates(); err != nil {
 		t.Fatal("Failed to parse mailer templates", err)
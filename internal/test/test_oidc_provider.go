@@ -0,0 +1,263 @@
+package test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	TestOIDCProviderName         = "fake"
+	TestOIDCProviderClientID     = "go-starter-test"
+	TestOIDCProviderClientSecret = "go-starter-test-secret"
+	TestOIDCProviderRedirectURL  = "http://localhost/oidc/callback"
+
+	testOIDCProviderKeyID = "fake-key-1"
+)
+
+// TestOIDCIdentity is the user authenticated by the fake OIDC provider.
+type TestOIDCIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type testOIDCAuthorization struct {
+	identity      TestOIDCIdentity
+	nonce         string
+	codeChallenge string
+	redirectURI   string
+}
+
+// TestOIDCProvider is a minimal OpenID Connect provider supporting the authorization code flow
+// with PKCE, serving its discovery document, JWKS, authorization and token endpoints.
+type TestOIDCProvider struct {
+	Server *httptest.Server
+	// Clock is used to issue ID tokens, set it to the test server's clock when mocking the time.
+	Clock time2.Clock
+	// ModifyIDTokenClaims allows tampering with the claims of issued ID tokens.
+	ModifyIDTokenClaims func(claims jwt.MapClaims)
+
+	key            *rsa.PrivateKey
+	mu             sync.Mutex
+	authorizations map[string]testOIDCAuthorization
+	codeCount      int
+}
+
+// NewTestOIDCProvider starts a fake OIDC provider, which is closed once the test finishes.
+func NewTestOIDCProvider(t *testing.T) *TestOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &TestOIDCProvider{
+		Clock:          time2.DefaultClock,
+		key:            key,
+		authorizations: make(map[string]testOIDCAuthorization),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("GET /jwks", p.handleJWKS)
+	mux.HandleFunc("GET /authorize", p.handleAuthorize)
+	mux.HandleFunc("POST /token", p.handleToken)
+
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Server.Close)
+
+	return p
+}
+
+// Config returns the provider config to add to config.Server.Auth.OIDCProviders.
+func (p *TestOIDCProvider) Config() oauth2.OIDCProviderConfig {
+	return oauth2.OIDCProviderConfig{
+		Name:         TestOIDCProviderName,
+		IssuerURL:    p.Server.URL,
+		ClientID:     TestOIDCProviderClientID,
+		ClientSecret: TestOIDCProviderClientSecret,
+		RedirectURL:  TestOIDCProviderRedirectURL,
+		Scopes:       []string{"openid", "email"},
+	}
+}
+
+// Authorize simulates the user logging in at the provider by following the authorization URL,
+// returning the code and state passed to the redirect URL.
+func (p *TestOIDCProvider) Authorize(t *testing.T, authorizationURL string, identity TestOIDCIdentity) (code string, state string) {
+	t.Helper()
+
+	u, err := url.Parse(authorizationURL)
+	require.NoError(t, err)
+
+	q := u.Query()
+	q.Set("sub", identity.Subject)
+	q.Set("email", identity.Email)
+	q.Set("email_verified", strconv.FormatBool(identity.EmailVerified))
+	u.RawQuery = q.Encode()
+
+	client := &http.Client{
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Get(u.String())
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	redirect, err := res.Location()
+	require.NoError(t, err)
+
+	return redirect.Query().Get("code"), redirect.Query().Get("state")
+}
+
+func (p *TestOIDCProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeTestOIDCJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.Server.URL,
+		"authorization_endpoint":                p.Server.URL + "/authorize",
+		"token_endpoint":                        p.Server.URL + "/token",
+		"jwks_uri":                              p.Server.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+	})
+}
+
+func (p *TestOIDCProvider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	writeTestOIDCJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": testOIDCProviderKeyID,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+			},
+		},
+	})
+}
+
+func (p *TestOIDCProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	if q.Get("response_type") != "code" || q.Get("client_id") != TestOIDCProviderClientID ||
+		q.Get("code_challenge_method") != "S256" || len(q.Get("code_challenge")) == 0 || len(q.Get("state")) == 0 {
+		writeTestOIDCJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	p.mu.Lock()
+	p.codeCount++
+	code := "code-" + strconv.Itoa(p.codeCount)
+	p.authorizations[code] = testOIDCAuthorization{
+		identity: TestOIDCIdentity{
+			Subject:       q.Get("sub"),
+			Email:         q.Get("email"),
+			EmailVerified: q.Get("email_verified") == "true",
+		},
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		redirectURI:   q.Get("redirect_uri"),
+	}
+	p.mu.Unlock()
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		writeTestOIDCJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	rq := redirect.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	redirect.RawQuery = rq.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *TestOIDCProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeTestOIDCJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if clientID != TestOIDCProviderClientID || clientSecret != TestOIDCProviderClientSecret {
+		writeTestOIDCJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	// authorization codes may only be redeemed once
+	p.mu.Lock()
+	code := r.PostForm.Get("code")
+	authorization, ok := p.authorizations[code]
+	delete(p.authorizations, code)
+	p.mu.Unlock()
+
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("redirect_uri") != authorization.redirectURI ||
+		oauth2.GetPKCECodeChallengeS256(r.PostForm.Get("code_verifier")) != authorization.codeChallenge {
+		writeTestOIDCJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := p.Clock.Now()
+	claims := jwt.MapClaims{
+		"iss":            p.Server.URL,
+		"sub":            authorization.identity.Subject,
+		"aud":            TestOIDCProviderClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          authorization.nonce,
+		"email":          authorization.identity.Email,
+		"email_verified": authorization.identity.EmailVerified,
+	}
+
+	if p.ModifyIDTokenClaims != nil {
+		p.ModifyIDTokenClaims(claims)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testOIDCProviderKeyID
+
+	idToken, err := token.SignedString(p.key)
+	if err != nil {
+		writeTestOIDCJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeTestOIDCJSON(w, http.StatusOK, map[string]any{
+		"access_token": "access-" + code,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeTestOIDCJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
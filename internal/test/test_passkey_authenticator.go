@@ -0,0 +1,222 @@
+package test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	TestPasskeyRPID   = "localhost"
+	TestPasskeyOrigin = "http://localhost:3000"
+)
+
+// TestPasskeyAuthenticator is a minimal WebAuthn authenticator holding a single ES256 (P-256) passkey,
+// creating credentials with attestation "none" and signing assertions as platform authenticators do.
+type TestPasskeyAuthenticator struct {
+	RPID   string
+	Origin string
+	// SignCount is incremented with every assertion, set to 0 to mimic authenticators without counters.
+	SignCount uint32
+	// Flags overrides the authenticator data flags if set, e.g. to omit user verification.
+	Flags        byte
+	CredentialID []byte
+	// UserHandle is the user ID provided during registration, returned with assertions.
+	UserHandle []byte
+
+	key *ecdsa.PrivateKey
+}
+
+// NewTestPasskeyAuthenticator creates an authenticator for TestPasskeyRPID used from TestPasskeyOrigin.
+func NewTestPasskeyAuthenticator(t *testing.T) *TestPasskeyAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 32)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &TestPasskeyAuthenticator{
+		RPID:         TestPasskeyRPID,
+		Origin:       TestPasskeyOrigin,
+		CredentialID: credentialID,
+		key:          key,
+	}
+}
+
+// CreateCredential answers the registration options returned by the server using their challenge and
+// base64url encoded user ID, returning the credential payload as sent by clients.
+func (a *TestPasskeyAuthenticator) CreateCredential(t *testing.T, challenge string, userID string) GenericPayload {
+	t.Helper()
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(userID)
+	require.NoError(t, err)
+	a.UserHandle = userHandle
+
+	clientDataJSON := a.clientDataJSON(t, "webauthn.create", challenge)
+
+	pub, err := a.key.PublicKey.ECDH()
+	require.NoError(t, err)
+
+	// uncompressed point encoding 0x04 || x || y
+	point := pub.Bytes()
+	x, y := point[1:33], point[33:]
+
+	publicKey := encodeTestCBOR(map[any]any{
+		int64(1):  int64(2),  // kty: EC2
+		int64(3):  int64(-7), // alg: ES256
+		int64(-1): int64(1),  // crv: P-256
+		int64(-2): x,
+		int64(-3): y,
+	})
+
+	authData := a.authenticatorData(0x40)
+	authData = append(authData, make([]byte, 16)...)                                // AAGUID, zero for attestation "none"
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.CredentialID))) //nolint:gosec
+	authData = append(authData, a.CredentialID...)
+	authData = append(authData, publicKey...)
+
+	attestationObject := encodeTestCBOR(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": authData,
+	})
+
+	return GenericPayload{
+		"id":    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"type":  "public-key",
+		"response": GenericPayload{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestationObject),
+			"transports":        []string{"internal", "hybrid"},
+		},
+	}
+}
+
+// GetAssertion answers the login options returned by the server using their challenge,
+// returning the credential payload as sent by clients.
+func (a *TestPasskeyAuthenticator) GetAssertion(t *testing.T, challenge string) GenericPayload {
+	t.Helper()
+
+	if a.SignCount > 0 {
+		a.SignCount++
+	}
+
+	clientDataJSON := a.clientDataJSON(t, "webauthn.get", challenge)
+	authData := a.authenticatorData(0)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return GenericPayload{
+		"id":    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"type":  "public-key",
+		"response": GenericPayload{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"signature":         base64.RawURLEncoding.EncodeToString(signature),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(a.UserHandle),
+		},
+	}
+}
+
+func (a *TestPasskeyAuthenticator) clientDataJSON(t *testing.T, ceremonyType string, challenge string) []byte {
+	t.Helper()
+
+	clientDataJSON, err := json.Marshal(map[string]any{
+		"type":        ceremonyType,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	require.NoError(t, err)
+
+	return clientDataJSON
+}
+
+// authenticatorData returns the authenticator data without attested credential data, setting the
+// user present, user verified, backup eligible and backup state flags unless overridden by Flags.
+func (a *TestPasskeyAuthenticator) authenticatorData(additionalFlags byte) []byte {
+	flags := a.Flags
+	if flags == 0 {
+		flags = 0x01 | 0x04 | 0x08 | 0x10
+	}
+
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+
+	authData := append([]byte(nil), rpIDHash[:]...)
+	authData = append(authData, flags|additionalFlags)
+	authData = binary.BigEndian.AppendUint32(authData, a.SignCount)
+
+	return authData
+}
+
+// encodeTestCBOR encodes the subset of CBOR used by authenticators, supporting int64, string,
+// []byte and maps thereof. Map keys are sorted by their encoding as required by CTAP2.
+func encodeTestCBOR(val any) []byte {
+	switch v := val.(type) {
+	case int64:
+		if v < 0 {
+			return encodeTestCBORHead(1, uint64(-1-v))
+		}
+
+		return encodeTestCBORHead(0, uint64(v))
+	case string:
+		return append(encodeTestCBORHead(3, uint64(len(v))), v...)
+	case []byte:
+		return append(encodeTestCBORHead(2, uint64(len(v))), v...)
+	case map[any]any:
+		entries := make([][2][]byte, 0, len(v))
+		for key, val := range v {
+			entries = append(entries, [2][]byte{encodeTestCBOR(key), encodeTestCBOR(val)})
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			if len(entries[i][0]) != len(entries[j][0]) {
+				return len(entries[i][0]) < len(entries[j][0])
+			}
+
+			return string(entries[i][0]) < string(entries[j][0])
+		})
+
+		b := encodeTestCBORHead(5, uint64(len(v)))
+		for _, entry := range entries {
+			b = append(b, entry[0]...)
+			b = append(b, entry[1]...)
+		}
+
+		return b
+	}
+
+	panic("unsupported CBOR type")
+}
+
+func encodeTestCBORHead(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+	}
+
+	return binary.BigEndian.AppendUint64([]byte{major<<5 | 27}, arg)
+}
@@ -3,9 +3,12 @@ package test
 import (
 	"database/sql"
 	"testing"
+	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"github.com/dropbox/godropbox/time2"
 )
 
 func WithTestPusher(t *testing.T, closure func(p *push.Service, db *sql.DB)) {
@@ -20,7 +23,7 @@ func WithTestPusher(t *testing.T, closure func(p *push.Service, db *sql.DB)) {
 func NewTestPusher(t *testing.T, db *sql.DB) *push.Service {
 	t.Helper()
 
-	pushService := push.New(db)
+	pushService := push.New(db, time2.NewMockClock(time.Now()), config.DefaultServiceConfigFromEnv().Push.Outbox)
 	mockProvider := provider.NewMock(push.ProviderTypeFCM)
 	pushService.RegisterProvider(mockProvider)
 
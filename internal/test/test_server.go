@@ -68,6 +68,7 @@ func execClosureNewTestServer(ctx context.Context, t *testing.T, config config.S
 
 	// always use the mock pusher in tests
 	config.Push.UseFCMProvider = false
+	config.Push.UseAPNSProvider = false
 	config.Push.UseMockProvider = true
 
 	s, err := api.InitNewServerWithDB(config, db, t)
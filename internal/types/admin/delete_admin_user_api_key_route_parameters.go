@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteAdminUserAPIKeyRouteParams creates a new DeleteAdminUserAPIKeyRouteParams object
+// no default values defined in spec.
+func NewDeleteAdminUserAPIKeyRouteParams() DeleteAdminUserAPIKeyRouteParams {
+
+	return DeleteAdminUserAPIKeyRouteParams{}
+}
+
+// DeleteAdminUserAPIKeyRouteParams contains all the bound params for the delete admin user API key route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters DeleteAdminUserAPIKeyRoute
+type DeleteAdminUserAPIKeyRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*ID of the API key
+	  Required: true
+	  In: path
+	*/
+	APIKeyID strfmt.UUID4 `param:"apiKeyId"`
+	/*ID of the user
+	  Required: true
+	  In: path
+	*/
+	ID strfmt.UUID4 `param:"id"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteAdminUserAPIKeyRouteParams() beforehand.
+func (o *DeleteAdminUserAPIKeyRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rAPIKeyID, rhkAPIKeyID, _ := route.Params.GetOK("apiKeyId")
+	if err := o.bindAPIKeyID(rAPIKeyID, rhkAPIKeyID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *DeleteAdminUserAPIKeyRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// apiKeyId
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	if err := o.validateAPIKeyID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// id
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAPIKeyID binds and validates parameter APIKeyID from path.
+func (o *DeleteAdminUserAPIKeyRouteParams) bindAPIKeyID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("apiKeyId", "path", "strfmt.UUID4", raw)
+	}
+	o.APIKeyID = *(value.(*strfmt.UUID4))
+
+	if err := o.validateAPIKeyID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAPIKeyID carries on validations for parameter APIKeyID
+func (o *DeleteAdminUserAPIKeyRouteParams) validateAPIKeyID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("apiKeyId", "path", "uuid4", o.APIKeyID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *DeleteAdminUserAPIKeyRouteParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("id", "path", "strfmt.UUID4", raw)
+	}
+	o.ID = *(value.(*strfmt.UUID4))
+
+	if err := o.validateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateID carries on validations for parameter ID
+func (o *DeleteAdminUserAPIKeyRouteParams) validateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("id", "path", "uuid4", o.ID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
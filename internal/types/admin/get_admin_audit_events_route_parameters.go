@@ -0,0 +1,477 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetAdminAuditEventsRouteParams creates a new GetAdminAuditEventsRouteParams object
+// with the default values initialized.
+func NewGetAdminAuditEventsRouteParams() GetAdminAuditEventsRouteParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int64(50)
+	)
+
+	return GetAdminAuditEventsRouteParams{
+		Limit: &limitDefault,
+	}
+}
+
+// GetAdminAuditEventsRouteParams contains all the bound params for the get admin audit events route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAdminAuditEventsRoute
+type GetAdminAuditEventsRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only return events performed by the given user
+	  In: query
+	*/
+	ActorID *strfmt.UUID4 `query:"actorId"`
+	/*Cursor returned as `nextCursor` by the previous page
+	  Max Length: 255
+	  In: query
+	*/
+	Cursor *string `query:"cursor"`
+	/*Only return events of the given type
+	  Max Length: 255
+	  In: query
+	*/
+	EventType *string `query:"eventType"`
+	/*Only return events recorded at or after the given time
+	  In: query
+	*/
+	From *strfmt.DateTime `query:"from"`
+	/*Limit used for pagination, number of records to retrieve
+	  Maximum: 500
+	  Minimum: 1
+	  In: query
+	  Default: 50
+	*/
+	Limit *int64 `query:"limit"`
+	/*Only return events affecting the given user
+	  In: query
+	*/
+	SubjectID *strfmt.UUID4 `query:"subjectId"`
+	/*Only return events recorded before the given time
+	  In: query
+	*/
+	To *strfmt.DateTime `query:"to"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAdminAuditEventsRouteParams() beforehand.
+func (o *GetAdminAuditEventsRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qActorID, qhkActorID, _ := qs.GetOK("actorId")
+	if err := o.bindActorID(qActorID, qhkActorID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qEventType, qhkEventType, _ := qs.GetOK("eventType")
+	if err := o.bindEventType(qEventType, qhkEventType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFrom, qhkFrom, _ := qs.GetOK("from")
+	if err := o.bindFrom(qFrom, qhkFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSubjectID, qhkSubjectID, _ := qs.GetOK("subjectId")
+	if err := o.bindSubjectID(qSubjectID, qhkSubjectID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTo, qhkTo, _ := qs.GetOK("to")
+	if err := o.bindTo(qTo, qhkTo, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAdminAuditEventsRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// actorId
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateActorID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// cursor
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateCursor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// eventType
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateEventType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// from
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateFrom(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// limit
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// subjectId
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateSubjectID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// to
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateTo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindActorID binds and validates parameter ActorID from query.
+func (o *GetAdminAuditEventsRouteParams) bindActorID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("actorId", "query", "strfmt.UUID4", raw)
+	}
+	o.ActorID = (value.(*strfmt.UUID4))
+
+	if err := o.validateActorID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateActorID carries on validations for parameter ActorID
+func (o *GetAdminAuditEventsRouteParams) validateActorID(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.ActorID == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("actorId", "query", "uuid4", (*o.ActorID).String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetAdminAuditEventsRouteParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	if err := o.validateCursor(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCursor carries on validations for parameter Cursor
+func (o *GetAdminAuditEventsRouteParams) validateCursor(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Cursor == nil {
+		return nil
+	}
+
+	if err := validate.MaxLength("cursor", "query", *o.Cursor, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindEventType binds and validates parameter EventType from query.
+func (o *GetAdminAuditEventsRouteParams) bindEventType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.EventType = &raw
+
+	if err := o.validateEventType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEventType carries on validations for parameter EventType
+func (o *GetAdminAuditEventsRouteParams) validateEventType(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.EventType == nil {
+		return nil
+	}
+
+	if err := validate.MaxLength("eventType", "query", *o.EventType, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindFrom binds and validates parameter From from query.
+func (o *GetAdminAuditEventsRouteParams) bindFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("from", "query", "strfmt.DateTime", raw)
+	}
+	o.From = (value.(*strfmt.DateTime))
+
+	if err := o.validateFrom(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFrom carries on validations for parameter From
+func (o *GetAdminAuditEventsRouteParams) validateFrom(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.From == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("from", "query", "date-time", (*o.From).String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetAdminAuditEventsRouteParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminAuditEventsRouteParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetAdminAuditEventsRouteParams) validateLimit(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Limit == nil {
+		return nil
+	}
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 500, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSubjectID binds and validates parameter SubjectID from query.
+func (o *GetAdminAuditEventsRouteParams) bindSubjectID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("subjectId", "query", "strfmt.UUID4", raw)
+	}
+	o.SubjectID = (value.(*strfmt.UUID4))
+
+	if err := o.validateSubjectID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSubjectID carries on validations for parameter SubjectID
+func (o *GetAdminAuditEventsRouteParams) validateSubjectID(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.SubjectID == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("subjectId", "query", "uuid4", (*o.SubjectID).String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindTo binds and validates parameter To from query.
+func (o *GetAdminAuditEventsRouteParams) bindTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("to", "query", "strfmt.DateTime", raw)
+	}
+	o.To = (value.(*strfmt.DateTime))
+
+	if err := o.validateTo(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTo carries on validations for parameter To
+func (o *GetAdminAuditEventsRouteParams) validateTo(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.To == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("to", "query", "date-time", (*o.To).String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetAdminInvitesRouteParams creates a new GetAdminInvitesRouteParams object
+// no default values defined in spec.
+func NewGetAdminInvitesRouteParams() GetAdminInvitesRouteParams {
+
+	return GetAdminInvitesRouteParams{}
+}
+
+// GetAdminInvitesRouteParams contains all the bound params for the get admin invites route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAdminInvitesRoute
+type GetAdminInvitesRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAdminInvitesRouteParams() beforehand.
+func (o *GetAdminInvitesRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAdminInvitesRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,462 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetAdminUsersRouteParams creates a new GetAdminUsersRouteParams object
+// with the default values initialized.
+func NewGetAdminUsersRouteParams() GetAdminUsersRouteParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault    = int64(50)
+		offsetDefault   = int64(0)
+		orderByDefault  = string("username")
+		orderDirDefault = string("asc")
+	)
+
+	return GetAdminUsersRouteParams{
+		Limit: &limitDefault,
+
+		Offset: &offsetDefault,
+
+		OrderBy: &orderByDefault,
+
+		OrderDir: &orderDirDefault,
+	}
+}
+
+// GetAdminUsersRouteParams contains all the bound params for the get admin users route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAdminUsersRoute
+type GetAdminUsersRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only return activated or deactivated users
+	  In: query
+	*/
+	IsActive *bool `query:"isActive"`
+	/*Limit used for pagination, number of records to retrieve
+	  Maximum: 500
+	  Minimum: 1
+	  In: query
+	  Default: 50
+	*/
+	Limit *int64 `query:"limit"`
+	/*Offset used for pagination, number of records to skip
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	Offset *int64 `query:"offset"`
+	/*Field to order users by, defaults to `username` if omitted
+	  In: query
+	  Default: "username"
+	*/
+	OrderBy *string `query:"orderBy"`
+	/*Direction of order applied, defaults to `asc` if omitted. `asc` will sort `NULL` values at the end of the list.
+	  In: query
+	  Default: "asc"
+	*/
+	OrderDir *string `query:"orderDir"`
+	/*Search term matched against the username, every whitespace separated word has to match
+	  Max Length: 255
+	  In: query
+	*/
+	Q *string `query:"q"`
+	/*Only return users with the given scope (role) assigned
+	  Max Length: 255
+	  In: query
+	*/
+	Scope *string `query:"scope"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAdminUsersRouteParams() beforehand.
+func (o *GetAdminUsersRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qIsActive, qhkIsActive, _ := qs.GetOK("isActive")
+	if err := o.bindIsActive(qIsActive, qhkIsActive, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrderBy, qhkOrderBy, _ := qs.GetOK("orderBy")
+	if err := o.bindOrderBy(qOrderBy, qhkOrderBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrderDir, qhkOrderDir, _ := qs.GetOK("orderDir")
+	if err := o.bindOrderDir(qOrderDir, qhkOrderDir, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qQ, qhkQ, _ := qs.GetOK("q")
+	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qScope, qhkScope, _ := qs.GetOK("scope")
+	if err := o.bindScope(qScope, qhkScope, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAdminUsersRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// isActive
+	// Required: false
+	// AllowEmptyValue: false
+
+	// limit
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// offset
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOffset(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// orderBy
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOrderBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// orderDir
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOrderDir(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// q
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateQ(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// scope
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateScope(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIsActive binds and validates parameter IsActive from query.
+func (o *GetAdminUsersRouteParams) bindIsActive(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("isActive", "query", "bool", raw)
+	}
+	o.IsActive = &value
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetAdminUsersRouteParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetAdminUsersRouteParams) validateLimit(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Limit == nil {
+		return nil
+	}
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 500, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *GetAdminUsersRouteParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *GetAdminUsersRouteParams) validateOffset(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Offset == nil {
+		return nil
+	}
+
+	if err := validate.MinimumInt("offset", "query", *o.Offset, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrderBy binds and validates parameter OrderBy from query.
+func (o *GetAdminUsersRouteParams) bindOrderBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	o.OrderBy = &raw
+
+	if err := o.validateOrderBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrderBy carries on validations for parameter OrderBy
+func (o *GetAdminUsersRouteParams) validateOrderBy(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.OrderBy == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("orderBy", "query", *o.OrderBy, []interface{}{"username", "createdAt", "lastAuthenticatedAt"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrderDir binds and validates parameter OrderDir from query.
+func (o *GetAdminUsersRouteParams) bindOrderDir(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	o.OrderDir = &raw
+
+	if err := o.validateOrderDir(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrderDir carries on validations for parameter OrderDir
+func (o *GetAdminUsersRouteParams) validateOrderDir(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.OrderDir == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("orderDir", "query", *o.OrderDir, []interface{}{"asc", "desc"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindQ binds and validates parameter Q from query.
+func (o *GetAdminUsersRouteParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Q = &raw
+
+	if err := o.validateQ(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQ carries on validations for parameter Q
+func (o *GetAdminUsersRouteParams) validateQ(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Q == nil {
+		return nil
+	}
+
+	if err := validate.MaxLength("q", "query", *o.Q, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindScope binds and validates parameter Scope from query.
+func (o *GetAdminUsersRouteParams) bindScope(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Scope = &raw
+
+	if err := o.validateScope(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateScope carries on validations for parameter Scope
+func (o *GetAdminUsersRouteParams) validateScope(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Scope == nil {
+		return nil
+	}
+
+	if err := validate.MaxLength("scope", "query", *o.Scope, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AdminUser admin user
+//
+// swagger:model adminUser
+type AdminUser struct {
+
+	// Time the user was created
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// Number of failed login attempts since the last successful login or lockout
+	// Example: 2
+	FailedLoginAttempts int64 `json:"failedLoginAttempts,omitempty"`
+
+	// ID of the user
+	// Example: 891d37d3-c74f-493e-aea8-af73efd92016
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Whether the user is allowed to authenticate
+	// Example: true
+	// Required: true
+	IsActive *bool `json:"isActive"`
+
+	// Time the user last authenticated
+	// Example: 2026-10-18T12:00:00.000Z
+	// Format: date-time
+	LastAuthenticatedAt strfmt.DateTime `json:"lastAuthenticatedAt,omitempty"`
+
+	// Time until which logins of the user are locked due to too many failed attempts, only set if currently locked
+	// Example: 2026-10-18T12:15:00.000Z
+	// Format: date-time
+	LockedUntil strfmt.DateTime `json:"lockedUntil,omitempty"`
+
+	// Whether the user has to reset the password before logging in again
+	// Example: false
+	// Required: true
+	PasswordResetRequired *bool `json:"passwordResetRequired"`
+
+	// Whether the user has yet to confirm the registration
+	// Example: false
+	// Required: true
+	RequiresConfirmation *bool `json:"requiresConfirmation"`
+
+	// Scopes (roles) assigned to the user
+	// Example: ["app"]
+	// Required: true
+	Scopes []string `json:"scopes"`
+
+	// Time the user was last updated
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	UpdatedAt *strfmt.DateTime `json:"updatedAt"`
+
+	// Username of the user, empty for users authenticated by external providers only
+	// Example: user@example.com
+	Username string `json:"username,omitempty"`
+}
+
+// Validate validates this admin user
+func (m *AdminUser) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIsActive(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastAuthenticatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLockedUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePasswordResetRequired(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequiresConfirmation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AdminUser) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateIsActive(formats strfmt.Registry) error {
+
+	if err := validate.Required("isActive", "body", m.IsActive); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateLastAuthenticatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastAuthenticatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastAuthenticatedAt", "body", "date-time", m.LastAuthenticatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateLockedUntil(formats strfmt.Registry) error {
+	if swag.IsZero(m.LockedUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lockedUntil", "body", "date-time", m.LockedUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validatePasswordResetRequired(formats strfmt.Registry) error {
+
+	if err := validate.Required("passwordResetRequired", "body", m.PasswordResetRequired); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateRequiresConfirmation(formats strfmt.Registry) error {
+
+	if err := validate.Required("requiresConfirmation", "body", m.RequiresConfirmation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("updatedAt", "body", m.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("updatedAt", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this admin user based on context it is used
+func (m *AdminUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AdminUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AdminUser) UnmarshalBinary(b []byte) error {
+	var res AdminUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
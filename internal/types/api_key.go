@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIKey api key
+//
+// swagger:model apiKey
+type APIKey struct {
+
+	// Time the API key was created
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// ID of the API key
+	// Example: 7a4f0f3e-1c2b-4d5e-8f9a-0b1c2d3e4f5a
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Time the API key was last used to authenticate, updated at most once per minute
+	// Example: 2026-10-18T12:00:00.000Z
+	// Format: date-time
+	LastUsedAt strfmt.DateTime `json:"last_used_at,omitempty"`
+
+	// Name of the API key, used to tell keys apart
+	// Example: CI deployment
+	// Required: true
+	Name *string `json:"name"`
+
+	// Public prefix of the API key, the first part of the key before the `.`
+	// Example: 3f9c1a2b4d5e6f70
+	// Required: true
+	Prefix *string `json:"prefix"`
+
+	// Scopes granted to the API key, limited to the scopes of the user at the time of the request
+	// Example: ["app"]
+	// Required: true
+	Scopes []string `json:"scopes"`
+
+	// Time the API key expires, never expires if omitted
+	// Example: 2027-10-18T12:00:00.000Z
+	// Format: date-time
+	ValidUntil strfmt.DateTime `json:"valid_until,omitempty"`
+}
+
+// Validate validates this api key
+func (m *APIKey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastUsedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIKey) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateLastUsedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastUsedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_used_at", "body", "date-time", m.LastUsedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validatePrefix(formats strfmt.Registry) error {
+
+	if err := validate.Required("prefix", "body", m.Prefix); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIKey) validateValidUntil(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValidUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("valid_until", "body", "date-time", m.ValidUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api key based on context it is used
+func (m *APIKey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIKey) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIKey) UnmarshalBinary(b []byte) error {
+	var res APIKey
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AuditEvent audit event
+//
+// swagger:model auditEvent
+type AuditEvent struct {
+
+	// ID of the user performing the action, empty if unknown (e.g. failed logins)
+	// Example: 891d37d3-c74f-493e-aea8-af73efd92016
+	// Format: uuid4
+	ActorID strfmt.UUID4 `json:"actorId,omitempty"`
+
+	// Time the event was recorded
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// Type of the recorded event
+	// Example: login
+	// Required: true
+	EventType *string `json:"eventType"`
+
+	// ID of the audit event
+	// Example: 0e6c2b8f-3f4a-4d6e-9b1a-2c7d8e9f0a1b
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// IP address of the client performing the request
+	// Example: 203.0.113.10
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// Additional details depending on the event type
+	// Example: {"method":"password"}
+	// Required: true
+	Metadata interface{} `json:"metadata"`
+
+	// ID of the request the event was recorded in
+	// Example: 3kz5Yd9bW1cQe8XfLr2TnA6mPj0sHv4u
+	RequestID string `json:"requestId,omitempty"`
+
+	// ID of the user affected by the action, empty if unknown
+	// Example: 891d37d3-c74f-493e-aea8-af73efd92016
+	// Format: uuid4
+	SubjectID strfmt.UUID4 `json:"subjectId,omitempty"`
+
+	// User agent of the client performing the request
+	// Example: Mozilla/5.0
+	UserAgent string `json:"userAgent,omitempty"`
+}
+
+// Validate validates this audit event
+func (m *AuditEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActorID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEventType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMetadata(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubjectID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AuditEvent) validateActorID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ActorID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("actorId", "body", "uuid4", m.ActorID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateEventType(formats strfmt.Registry) error {
+
+	if err := validate.Required("eventType", "body", m.EventType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateMetadata(formats strfmt.Registry) error {
+
+	if m.Metadata == nil {
+		return errors.Required("metadata", "body", nil)
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateSubjectID(formats strfmt.Registry) error {
+	if swag.IsZero(m.SubjectID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("subjectId", "body", "uuid4", m.SubjectID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this audit event based on context it is used
+func (m *AuditEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditEvent) UnmarshalBinary(b []byte) error {
+	var res AuditEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
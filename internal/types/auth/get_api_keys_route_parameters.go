@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetAPIKeysRouteParams creates a new GetAPIKeysRouteParams object
+// no default values defined in spec.
+func NewGetAPIKeysRouteParams() GetAPIKeysRouteParams {
+
+	return GetAPIKeysRouteParams{}
+}
+
+// GetAPIKeysRouteParams contains all the bound params for the get API keys route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAPIKeysRoute
+type GetAPIKeysRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAPIKeysRouteParams() beforehand.
+func (o *GetAPIKeysRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAPIKeysRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
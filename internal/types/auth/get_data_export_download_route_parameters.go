@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetDataExportDownloadRouteParams creates a new GetDataExportDownloadRouteParams object
+// no default values defined in spec.
+func NewGetDataExportDownloadRouteParams() GetDataExportDownloadRouteParams {
+
+	return GetDataExportDownloadRouteParams{}
+}
+
+// GetDataExportDownloadRouteParams contains all the bound params for the get data export download route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetDataExportDownloadRoute
+type GetDataExportDownloadRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Token of the data export sent by email
+	  Required: true
+	  In: query
+	*/
+	Token strfmt.UUID4 `query:"token"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetDataExportDownloadRouteParams() beforehand.
+func (o *GetDataExportDownloadRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qToken, qhkToken, _ := qs.GetOK("token")
+	if err := o.bindToken(qToken, qhkToken, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetDataExportDownloadRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// token
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.Required("token", "query", o.Token); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindToken binds and validates parameter Token from query.
+func (o *GetDataExportDownloadRouteParams) bindToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("token", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("token", "query", raw); err != nil {
+		return err
+	}
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("token", "query", "strfmt.UUID4", raw)
+	}
+	o.Token = *(value.(*strfmt.UUID4))
+
+	if err := o.validateToken(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateToken carries on validations for parameter Token
+func (o *GetDataExportDownloadRouteParams) validateToken(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("token", "query", "uuid4", o.Token.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
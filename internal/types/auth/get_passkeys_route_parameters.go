@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetPasskeysRouteParams creates a new GetPasskeysRouteParams object
+// no default values defined in spec.
+func NewGetPasskeysRouteParams() GetPasskeysRouteParams {
+
+	return GetPasskeysRouteParams{}
+}
+
+// GetPasskeysRouteParams contains all the bound params for the get passkeys route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetPasskeysRoute
+type GetPasskeysRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetPasskeysRouteParams() beforehand.
+func (o *GetPasskeysRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetPasskeysRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+)
+
+// NewPostOIDCCallbackRouteParams creates a new PostOIDCCallbackRouteParams object
+// no default values defined in spec.
+func NewPostOIDCCallbackRouteParams() PostOIDCCallbackRouteParams {
+
+	return PostOIDCCallbackRouteParams{}
+}
+
+// PostOIDCCallbackRouteParams contains all the bound params for the post o ID c callback route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostOIDCCallbackRoute
+type PostOIDCCallbackRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: body
+	*/
+	Payload *types.PostOIDCCallbackPayload
+	/*Name of the configured OpenID Connect provider, e.g. `google`
+	  Required: true
+	  In: path
+	*/
+	Provider string `param:"provider"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostOIDCCallbackRouteParams() beforehand.
+func (o *PostOIDCCallbackRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body types.PostOIDCCallbackPayload
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			res = append(res, errors.NewParseError("payload", "body", "", err))
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Payload = &body
+			}
+		}
+	}
+	rProvider, rhkProvider, _ := route.Params.GetOK("provider")
+	if err := o.bindProvider(rProvider, rhkProvider, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostOIDCCallbackRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// Payload
+	// Required: false
+
+	// body is validated in endpoint
+	//if err := o.Payload.Validate(formats); err != nil {
+	//  res = append(res, err)
+	//}
+
+	// provider
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindProvider binds and validates parameter Provider from path.
+func (o *PostOIDCCallbackRouteParams) bindProvider(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Provider = raw
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetAdminUsersResponse get admin users response
+//
+// swagger:model getAdminUsersResponse
+type GetAdminUsersResponse struct {
+	Paginatable
+
+	// Users matching the filters, limited by the pagination
+	// Required: true
+	Data []*AdminUser `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (m *GetAdminUsersResponse) UnmarshalJSON(raw []byte) error {
+	// AO0
+	var aO0 Paginatable
+	if err := swag.ReadJSON(raw, &aO0); err != nil {
+		return err
+	}
+	m.Paginatable = aO0
+
+	// now for regular properties
+	var propsGetAdminUsersResponse struct {
+		Data []*AdminUser `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &propsGetAdminUsersResponse); err != nil {
+		return err
+	}
+	m.Data = propsGetAdminUsersResponse.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (m GetAdminUsersResponse) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 1)
+
+	aO0, err := swag.WriteJSON(m.Paginatable)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, aO0)
+
+	// now for regular properties
+	var propsGetAdminUsersResponse struct {
+		Data []*AdminUser `json:"data"`
+	}
+	propsGetAdminUsersResponse.Data = m.Data
+
+	jsonDataPropsGetAdminUsersResponse, errGetAdminUsersResponse := swag.WriteJSON(propsGetAdminUsersResponse)
+	if errGetAdminUsersResponse != nil {
+		return nil, errGetAdminUsersResponse
+	}
+	_parts = append(_parts, jsonDataPropsGetAdminUsersResponse)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this get admin users response
+func (m *GetAdminUsersResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with Paginatable
+	if err := m.Paginatable.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetAdminUsersResponse) validateData(formats strfmt.Registry) error {
+
+	if err := validate.Required("data", "body", m.Data); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Data); i++ {
+		if swag.IsZero(m.Data[i]) { // not required
+			continue
+		}
+
+		if m.Data[i] != nil {
+			if err := m.Data[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("data" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("data" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get admin users response based on the context it is used
+func (m *GetAdminUsersResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with Paginatable
+	if err := m.Paginatable.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetAdminUsersResponse) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Data); i++ {
+
+		if m.Data[i] != nil {
+			if err := m.Data[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("data" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("data" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetAdminUsersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetAdminUsersResponse) UnmarshalBinary(b []byte) error {
+	var res GetAdminUsersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetPushTopicsResponse get push topics response
+//
+// swagger:model getPushTopicsResponse
+type GetPushTopicsResponse struct {
+
+	// Names of the topics the user is subscribed to, sorted alphabetically
+	// Example: ["news","offers"]
+	// Required: true
+	Topics []string `json:"topics"`
+}
+
+// Validate validates this get push topics response
+func (m *GetPushTopicsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTopics(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetPushTopicsResponse) validateTopics(formats strfmt.Registry) error {
+
+	if err := validate.Required("topics", "body", m.Topics); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this get push topics response based on context it is used
+func (m *GetPushTopicsResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetPushTopicsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetPushTopicsResponse) UnmarshalBinary(b []byte) error {
+	var res GetPushTopicsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
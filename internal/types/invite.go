@@ -0,0 +1,185 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Invite invite
+//
+// swagger:model invite
+type Invite struct {
+
+	// Time the invite was created
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// ID of the user who created the invite, empty if the user has been deleted
+	// Example: 891d37d3-c74f-493e-aea8-af73efd92016
+	// Format: uuid4
+	CreatedByID strfmt.UUID4 `json:"createdById,omitempty"`
+
+	// Email address the invite was sent to
+	// Example: user@example.com
+	// Required: true
+	// Format: email
+	Email *strfmt.Email `json:"email"`
+
+	// ID of the invite
+	// Example: 5c1d9e2f-7a3b-4c8d-9e0f-1a2b3c4d5e6f
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Scopes (roles) assigned to the user registering with the invite
+	// Example: ["app"]
+	// Required: true
+	Scopes []string `json:"scopes"`
+
+	// Time the invite expires
+	// Example: 2026-10-25T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	ValidUntil *strfmt.DateTime `json:"validUntil"`
+}
+
+// Validate validates this invite
+func (m *Invite) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedByID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Invite) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invite) validateCreatedByID(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedByID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdById", "body", "uuid4", m.CreatedByID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invite) validateEmail(formats strfmt.Registry) error {
+
+	if err := validate.Required("email", "body", m.Email); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("email", "body", "email", m.Email.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invite) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invite) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invite) validateValidUntil(formats strfmt.Registry) error {
+
+	if err := validate.Required("validUntil", "body", m.ValidUntil); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("validUntil", "body", "date-time", m.ValidUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this invite based on context it is used
+func (m *Invite) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Invite) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Invite) UnmarshalBinary(b []byte) error {
+	var res Invite
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
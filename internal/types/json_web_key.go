@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// JSONWebKey json web key
+//
+// swagger:model jsonWebKey
+type JSONWebKey struct {
+
+	// Signing algorithm
+	// Example: EdDSA
+	// Required: true
+	// Enum: [EdDSA RS256]
+	Alg *string `json:"alg"`
+
+	// Curve of OKP keys
+	// Example: Ed25519
+	Crv string `json:"crv,omitempty"`
+
+	// Base64url encoded exponent of RSA keys
+	// Example: AQAB
+	E string `json:"e,omitempty"`
+
+	// Key ID, matching the kid header of signed tokens
+	// Example: 2026-10
+	// Required: true
+	Kid *string `json:"kid"`
+
+	// Key type
+	// Example: OKP
+	// Required: true
+	// Enum: [OKP RSA]
+	Kty *string `json:"kty"`
+
+	// Base64url encoded modulus of RSA keys
+	N string `json:"n,omitempty"`
+
+	// Intended use of the key
+	// Example: sig
+	// Required: true
+	Use *string `json:"use"`
+
+	// Base64url encoded public key of OKP keys
+	// Example: 11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo
+	X string `json:"x,omitempty"`
+}
+
+// Validate validates this json web key
+func (m *JSONWebKey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAlg(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKid(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKty(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUse(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var jsonWebKeyTypeAlgPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["EdDSA","RS256"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		jsonWebKeyTypeAlgPropEnum = append(jsonWebKeyTypeAlgPropEnum, v)
+	}
+}
+
+const (
+
+	// JSONWebKeyAlgEdDSA captures enum value "EdDSA"
+	JSONWebKeyAlgEdDSA string = "EdDSA"
+
+	// JSONWebKeyAlgRS256 captures enum value "RS256"
+	JSONWebKeyAlgRS256 string = "RS256"
+)
+
+// prop value enum
+func (m *JSONWebKey) validateAlgEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, jsonWebKeyTypeAlgPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *JSONWebKey) validateAlg(formats strfmt.Registry) error {
+
+	if err := validate.Required("alg", "body", m.Alg); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateAlgEnum("alg", "body", *m.Alg); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JSONWebKey) validateKid(formats strfmt.Registry) error {
+
+	if err := validate.Required("kid", "body", m.Kid); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var jsonWebKeyTypeKtyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OKP","RSA"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		jsonWebKeyTypeKtyPropEnum = append(jsonWebKeyTypeKtyPropEnum, v)
+	}
+}
+
+const (
+
+	// JSONWebKeyKtyOKP captures enum value "OKP"
+	JSONWebKeyKtyOKP string = "OKP"
+
+	// JSONWebKeyKtyRSA captures enum value "RSA"
+	JSONWebKeyKtyRSA string = "RSA"
+)
+
+// prop value enum
+func (m *JSONWebKey) validateKtyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, jsonWebKeyTypeKtyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *JSONWebKey) validateKty(formats strfmt.Registry) error {
+
+	if err := validate.Required("kty", "body", m.Kty); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateKtyEnum("kty", "body", *m.Kty); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JSONWebKey) validateUse(formats strfmt.Registry) error {
+
+	if err := validate.Required("use", "body", m.Use); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this json web key based on context it is used
+func (m *JSONWebKey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *JSONWebKey) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *JSONWebKey) UnmarshalBinary(b []byte) error {
+	var res JSONWebKey
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationCategory Category of notifications:
+// * `account` - security relevant changes of the account, mandatory
+// * `updates` - activity and news concerning the user
+// * `marketing` - offers and promotions, disabled unless opted in
+//
+// swagger:model notificationCategory
+type NotificationCategory string
+
+func NewNotificationCategory(value NotificationCategory) *NotificationCategory {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated NotificationCategory.
+func (m NotificationCategory) Pointer() *NotificationCategory {
+	return &m
+}
+
+const (
+
+	// NotificationCategoryAccount captures enum value "account"
+	NotificationCategoryAccount NotificationCategory = "account"
+
+	// NotificationCategoryUpdates captures enum value "updates"
+	NotificationCategoryUpdates NotificationCategory = "updates"
+
+	// NotificationCategoryMarketing captures enum value "marketing"
+	NotificationCategoryMarketing NotificationCategory = "marketing"
+)
+
+// for schema
+var notificationCategoryEnum []interface{}
+
+func init() {
+	var res []NotificationCategory
+	if err := json.Unmarshal([]byte(`["account","updates","marketing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		notificationCategoryEnum = append(notificationCategoryEnum, v)
+	}
+}
+
+func (m NotificationCategory) validateNotificationCategoryEnum(path, location string, value NotificationCategory) error {
+	if err := validate.EnumCase(path, location, value, notificationCategoryEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this notification category
+func (m NotificationCategory) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateNotificationCategoryEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this notification category based on context it is used
+func (m NotificationCategory) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationCategoryPreference notification category preference
+//
+// swagger:model notificationCategoryPreference
+type NotificationCategoryPreference struct {
+
+	// category
+	// Required: true
+	Category *NotificationCategory `json:"category"`
+
+	// Emails of the category are enabled
+	// Example: false
+	// Required: true
+	Email *bool `json:"email"`
+
+	// Mandatory categories cannot be disabled and ignore quiet hours
+	// Example: false
+	// Required: true
+	Mandatory *bool `json:"mandatory"`
+
+	// Push notifications of the category are enabled
+	// Example: true
+	// Required: true
+	Push *bool `json:"push"`
+}
+
+// Validate validates this notification category preference
+func (m *NotificationCategoryPreference) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCategory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMandatory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePush(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationCategoryPreference) validateCategory(formats strfmt.Registry) error {
+
+	if err := validate.Required("category", "body", m.Category); err != nil {
+		return err
+	}
+
+	if err := validate.Required("category", "body", m.Category); err != nil {
+		return err
+	}
+
+	if m.Category != nil {
+		if err := m.Category.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("category")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("category")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NotificationCategoryPreference) validateEmail(formats strfmt.Registry) error {
+
+	if err := validate.Required("email", "body", m.Email); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationCategoryPreference) validateMandatory(formats strfmt.Registry) error {
+
+	if err := validate.Required("mandatory", "body", m.Mandatory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationCategoryPreference) validatePush(formats strfmt.Registry) error {
+
+	if err := validate.Required("push", "body", m.Push); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this notification category preference based on the context it is used
+func (m *NotificationCategoryPreference) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCategory(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationCategoryPreference) contextValidateCategory(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Category != nil {
+		if err := m.Category.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("category")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("category")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationCategoryPreference) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationCategoryPreference) UnmarshalBinary(b []byte) error {
+	var res NotificationCategoryPreference
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,183 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationPreferences notification preferences
+//
+// swagger:model notificationPreferences
+type NotificationPreferences struct {
+
+	// categories
+	// Required: true
+	Categories []*NotificationCategoryPreference `json:"categories"`
+
+	// quiet hours
+	QuietHours *QuietHours `json:"quietHours,omitempty"`
+
+	// IANA time zone of the user, quiet hours are evaluated in it
+	// Example: Europe/Vienna
+	// Required: true
+	TimeZone *string `json:"timeZone"`
+}
+
+// Validate validates this notification preferences
+func (m *NotificationPreferences) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCategories(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateQuietHours(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeZone(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationPreferences) validateCategories(formats strfmt.Registry) error {
+
+	if err := validate.Required("categories", "body", m.Categories); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Categories); i++ {
+		if swag.IsZero(m.Categories[i]) { // not required
+			continue
+		}
+
+		if m.Categories[i] != nil {
+			if err := m.Categories[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("categories" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("categories" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NotificationPreferences) validateQuietHours(formats strfmt.Registry) error {
+	if swag.IsZero(m.QuietHours) { // not required
+		return nil
+	}
+
+	if m.QuietHours != nil {
+		if err := m.QuietHours.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quietHours")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quietHours")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NotificationPreferences) validateTimeZone(formats strfmt.Registry) error {
+
+	if err := validate.Required("timeZone", "body", m.TimeZone); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this notification preferences based on the context it is used
+func (m *NotificationPreferences) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCategories(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateQuietHours(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationPreferences) contextValidateCategories(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Categories); i++ {
+
+		if m.Categories[i] != nil {
+			if err := m.Categories[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("categories" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("categories" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NotificationPreferences) contextValidateQuietHours(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.QuietHours != nil {
+		if err := m.QuietHours.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quietHours")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quietHours")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationPreferences) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationPreferences) UnmarshalBinary(b []byte) error {
+	var res NotificationPreferences
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
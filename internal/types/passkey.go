@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Passkey passkey
+//
+// swagger:model passkey
+type Passkey struct {
+
+	// AAGUID identifying the authenticator model, all zeros if unknown
+	// Example: fbfc3007-154e-4ecc-8c0b-6e020557d7bd
+	// Required: true
+	// Format: uuid
+	Aaguid *strfmt.UUID `json:"aaguid"`
+
+	// Whether the passkey is synced to other devices of the user, e.g. via iCloud Keychain
+	// Example: true
+	// Required: true
+	BackedUp *bool `json:"backed_up"`
+
+	// Time the passkey was registered
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// ID of the passkey
+	// Example: 5c1f2e3d-4b5a-4697-8a8b-9c0d1e2f3a4b
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Time the passkey was last used to log in
+	// Example: 2026-10-18T12:00:00.000Z
+	// Format: date-time
+	LastUsedAt strfmt.DateTime `json:"last_used_at,omitempty"`
+
+	// Name of the passkey as provided during registration
+	// Example: iPhone
+	Name string `json:"name,omitempty"`
+
+	// Transports supported by the authenticator as reported during registration
+	// Example: ["internal","hybrid"]
+	// Required: true
+	Transports []string `json:"transports"`
+}
+
+// Validate validates this passkey
+func (m *Passkey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAaguid(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBackedUp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastUsedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransports(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Passkey) validateAaguid(formats strfmt.Registry) error {
+
+	if err := validate.Required("aaguid", "body", m.Aaguid); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("aaguid", "body", "uuid", m.Aaguid.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Passkey) validateBackedUp(formats strfmt.Registry) error {
+
+	if err := validate.Required("backed_up", "body", m.BackedUp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Passkey) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Passkey) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Passkey) validateLastUsedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastUsedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_used_at", "body", "date-time", m.LastUsedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Passkey) validateTransports(formats strfmt.Registry) error {
+
+	if err := validate.Required("transports", "body", m.Transports); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey based on context it is used
+func (m *Passkey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Passkey) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Passkey) UnmarshalBinary(b []byte) error {
+	var res Passkey
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,359 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PasskeyAuthenticationCredential PublicKeyCredential returned by `navigator.credentials.get()` in its JSON representation,
+// see https://www.w3.org/TR/webauthn-3/#dictdef-authenticationresponsejson
+//
+// swagger:model passkeyAuthenticationCredential
+type PasskeyAuthenticationCredential struct {
+
+	// Base64url encoded credential ID
+	// Example: 3q2-7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWc
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	ID *string `json:"id"`
+
+	// Base64url encoded credential ID
+	// Example: 3q2-7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWc
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	RawID *string `json:"rawId"`
+
+	// response
+	// Required: true
+	Response *PasskeyAuthenticationCredentialResponse `json:"response"`
+
+	// type
+	// Example: public-key
+	// Required: true
+	// Enum: [public-key]
+	Type *string `json:"type"`
+}
+
+// Validate validates this passkey authentication credential
+func (m *PasskeyAuthenticationCredential) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRawID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResponse(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredential) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("id", "body", *m.ID, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("id", "body", *m.ID, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredential) validateRawID(formats strfmt.Registry) error {
+
+	if err := validate.Required("rawId", "body", m.RawID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("rawId", "body", *m.RawID, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("rawId", "body", *m.RawID, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredential) validateResponse(formats strfmt.Registry) error {
+
+	if err := validate.Required("response", "body", m.Response); err != nil {
+		return err
+	}
+
+	if m.Response != nil {
+		if err := m.Response.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("response")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("response")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var passkeyAuthenticationCredentialTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["public-key"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		passkeyAuthenticationCredentialTypeTypePropEnum = append(passkeyAuthenticationCredentialTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// PasskeyAuthenticationCredentialTypePublicDashKey captures enum value "public-key"
+	PasskeyAuthenticationCredentialTypePublicDashKey string = "public-key"
+)
+
+// prop value enum
+func (m *PasskeyAuthenticationCredential) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, passkeyAuthenticationCredentialTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredential) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this passkey authentication credential based on the context it is used
+func (m *PasskeyAuthenticationCredential) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateResponse(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredential) contextValidateResponse(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Response != nil {
+		if err := m.Response.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("response")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("response")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyAuthenticationCredential) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyAuthenticationCredential) UnmarshalBinary(b []byte) error {
+	var res PasskeyAuthenticationCredential
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// PasskeyAuthenticationCredentialResponse passkey authentication credential response
+//
+// swagger:model PasskeyAuthenticationCredentialResponse
+type PasskeyAuthenticationCredentialResponse struct {
+
+	// Base64url encoded authenticator data
+	// Example: SZYN5YgOjGh0NBcPZHZgW4_krrmihjLHmVzzuoMdl2MdAAAAAA
+	// Required: true
+	// Max Length: 16384
+	// Min Length: 1
+	AuthenticatorData *string `json:"authenticatorData"`
+
+	// Base64url encoded client data
+	// Example: eyJ0eXBlIjoid2ViYXV0aG4uZ2V0In0
+	// Required: true
+	// Max Length: 16384
+	// Min Length: 1
+	ClientDataJSON *string `json:"clientDataJSON"`
+
+	// Base64url encoded signature over the authenticator data and the hash of the client data
+	// Example: MEUCIQDg
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	Signature *string `json:"signature"`
+
+	// Base64url encoded user handle as provided during registration
+	// Example: ODkxZDM3ZDMtYzc0Zi00OTNlLWFlYTgtYWY3M2VmZDkyMDE2
+	// Max Length: 2048
+	UserHandle string `json:"userHandle,omitempty"`
+}
+
+// Validate validates this passkey authentication credential response
+func (m *PasskeyAuthenticationCredentialResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuthenticatorData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClientDataJSON(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSignature(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserHandle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredentialResponse) validateAuthenticatorData(formats strfmt.Registry) error {
+
+	if err := validate.Required("response"+"."+"authenticatorData", "body", m.AuthenticatorData); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("response"+"."+"authenticatorData", "body", *m.AuthenticatorData, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("response"+"."+"authenticatorData", "body", *m.AuthenticatorData, 16384); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredentialResponse) validateClientDataJSON(formats strfmt.Registry) error {
+
+	if err := validate.Required("response"+"."+"clientDataJSON", "body", m.ClientDataJSON); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("response"+"."+"clientDataJSON", "body", *m.ClientDataJSON, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("response"+"."+"clientDataJSON", "body", *m.ClientDataJSON, 16384); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredentialResponse) validateSignature(formats strfmt.Registry) error {
+
+	if err := validate.Required("response"+"."+"signature", "body", m.Signature); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("response"+"."+"signature", "body", *m.Signature, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("response"+"."+"signature", "body", *m.Signature, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyAuthenticationCredentialResponse) validateUserHandle(formats strfmt.Registry) error {
+	if swag.IsZero(m.UserHandle) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("response"+"."+"userHandle", "body", m.UserHandle, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey authentication credential response based on context it is used
+func (m *PasskeyAuthenticationCredentialResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyAuthenticationCredentialResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyAuthenticationCredentialResponse) UnmarshalBinary(b []byte) error {
+	var res PasskeyAuthenticationCredentialResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
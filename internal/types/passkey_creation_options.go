@@ -0,0 +1,714 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PasskeyCreationOptions PublicKeyCredentialCreationOptions in their JSON representation, to be passed to
+// `navigator.credentials.create()` or the platform's passkey API.
+// See https://www.w3.org/TR/webauthn-3/#dictdef-publickeycredentialcreationoptionsjson
+//
+// swagger:model passkeyCreationOptions
+type PasskeyCreationOptions struct {
+
+	// attestation
+	// Example: none
+	// Required: true
+	Attestation *string `json:"attestation"`
+
+	// authenticator selection
+	// Required: true
+	AuthenticatorSelection *PasskeyCreationOptionsAuthenticatorSelection `json:"authenticatorSelection"`
+
+	// Base64url encoded challenge to be signed by the authenticator
+	// Example: mF2Zq6m1oQKkY7cR8T3fGvXJ0n8b4Yp2sLhW9dE5aUc
+	// Required: true
+	Challenge *string `json:"challenge"`
+
+	// Passkeys already registered for the user, preventing duplicate registrations on the same authenticator
+	// Required: true
+	ExcludeCredentials []*PasskeyCredentialDescriptor `json:"excludeCredentials"`
+
+	// Supported public key algorithms in order of preference
+	// Required: true
+	PubKeyCredParams []*PasskeyCreationOptionsPubKeyCredParamsItems0 `json:"pubKeyCredParams"`
+
+	// rp
+	// Required: true
+	Rp *PasskeyCreationOptionsRp `json:"rp"`
+
+	// Time in milliseconds to complete the registration
+	// Example: 300000
+	// Required: true
+	Timeout *int64 `json:"timeout"`
+
+	// user
+	// Required: true
+	User *PasskeyCreationOptionsUser `json:"user"`
+}
+
+// Validate validates this passkey creation options
+func (m *PasskeyCreationOptions) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttestation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAuthenticatorSelection(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChallenge(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExcludeCredentials(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePubKeyCredParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUser(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateAttestation(formats strfmt.Registry) error {
+
+	if err := validate.Required("attestation", "body", m.Attestation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateAuthenticatorSelection(formats strfmt.Registry) error {
+
+	if err := validate.Required("authenticatorSelection", "body", m.AuthenticatorSelection); err != nil {
+		return err
+	}
+
+	if m.AuthenticatorSelection != nil {
+		if err := m.AuthenticatorSelection.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("authenticatorSelection")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("authenticatorSelection")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateChallenge(formats strfmt.Registry) error {
+
+	if err := validate.Required("challenge", "body", m.Challenge); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateExcludeCredentials(formats strfmt.Registry) error {
+
+	if err := validate.Required("excludeCredentials", "body", m.ExcludeCredentials); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.ExcludeCredentials); i++ {
+		if swag.IsZero(m.ExcludeCredentials[i]) { // not required
+			continue
+		}
+
+		if m.ExcludeCredentials[i] != nil {
+			if err := m.ExcludeCredentials[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("excludeCredentials" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("excludeCredentials" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validatePubKeyCredParams(formats strfmt.Registry) error {
+
+	if err := validate.Required("pubKeyCredParams", "body", m.PubKeyCredParams); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.PubKeyCredParams); i++ {
+		if swag.IsZero(m.PubKeyCredParams[i]) { // not required
+			continue
+		}
+
+		if m.PubKeyCredParams[i] != nil {
+			if err := m.PubKeyCredParams[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pubKeyCredParams" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pubKeyCredParams" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateRp(formats strfmt.Registry) error {
+
+	if err := validate.Required("rp", "body", m.Rp); err != nil {
+		return err
+	}
+
+	if m.Rp != nil {
+		if err := m.Rp.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rp")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateTimeout(formats strfmt.Registry) error {
+
+	if err := validate.Required("timeout", "body", m.Timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) validateUser(formats strfmt.Registry) error {
+
+	if err := validate.Required("user", "body", m.User); err != nil {
+		return err
+	}
+
+	if m.User != nil {
+		if err := m.User.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("user")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("user")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this passkey creation options based on the context it is used
+func (m *PasskeyCreationOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAuthenticatorSelection(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateExcludeCredentials(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePubKeyCredParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRp(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUser(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCreationOptions) contextValidateAuthenticatorSelection(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AuthenticatorSelection != nil {
+		if err := m.AuthenticatorSelection.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("authenticatorSelection")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("authenticatorSelection")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) contextValidateExcludeCredentials(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ExcludeCredentials); i++ {
+
+		if m.ExcludeCredentials[i] != nil {
+			if err := m.ExcludeCredentials[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("excludeCredentials" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("excludeCredentials" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) contextValidatePubKeyCredParams(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.PubKeyCredParams); i++ {
+
+		if m.PubKeyCredParams[i] != nil {
+			if err := m.PubKeyCredParams[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pubKeyCredParams" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pubKeyCredParams" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) contextValidateRp(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Rp != nil {
+		if err := m.Rp.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rp")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptions) contextValidateUser(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.User != nil {
+		if err := m.User.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("user")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("user")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyCreationOptions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyCreationOptions) UnmarshalBinary(b []byte) error {
+	var res PasskeyCreationOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// PasskeyCreationOptionsAuthenticatorSelection passkey creation options authenticator selection
+//
+// swagger:model PasskeyCreationOptionsAuthenticatorSelection
+type PasskeyCreationOptionsAuthenticatorSelection struct {
+
+	// require resident key
+	// Example: true
+	// Required: true
+	RequireResidentKey *bool `json:"requireResidentKey"`
+
+	// resident key
+	// Example: required
+	// Required: true
+	ResidentKey *string `json:"residentKey"`
+
+	// user verification
+	// Example: required
+	// Required: true
+	UserVerification *string `json:"userVerification"`
+}
+
+// Validate validates this passkey creation options authenticator selection
+func (m *PasskeyCreationOptionsAuthenticatorSelection) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRequireResidentKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResidentKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserVerification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCreationOptionsAuthenticatorSelection) validateRequireResidentKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("authenticatorSelection"+"."+"requireResidentKey", "body", m.RequireResidentKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptionsAuthenticatorSelection) validateResidentKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("authenticatorSelection"+"."+"residentKey", "body", m.ResidentKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptionsAuthenticatorSelection) validateUserVerification(formats strfmt.Registry) error {
+
+	if err := validate.Required("authenticatorSelection"+"."+"userVerification", "body", m.UserVerification); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey creation options authenticator selection based on context it is used
+func (m *PasskeyCreationOptionsAuthenticatorSelection) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyCreationOptionsAuthenticatorSelection) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyCreationOptionsAuthenticatorSelection) UnmarshalBinary(b []byte) error {
+	var res PasskeyCreationOptionsAuthenticatorSelection
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// PasskeyCreationOptionsPubKeyCredParamsItems0 passkey creation options pub key cred params items0
+//
+// swagger:model PasskeyCreationOptionsPubKeyCredParamsItems0
+type PasskeyCreationOptionsPubKeyCredParamsItems0 struct {
+
+	// COSE algorithm identifier
+	// Example: -7
+	// Required: true
+	Alg *int64 `json:"alg"`
+
+	// type
+	// Example: public-key
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this passkey creation options pub key cred params items0
+func (m *PasskeyCreationOptionsPubKeyCredParamsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAlg(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCreationOptionsPubKeyCredParamsItems0) validateAlg(formats strfmt.Registry) error {
+
+	if err := validate.Required("alg", "body", m.Alg); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptionsPubKeyCredParamsItems0) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey creation options pub key cred params items0 based on context it is used
+func (m *PasskeyCreationOptionsPubKeyCredParamsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyCreationOptionsPubKeyCredParamsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyCreationOptionsPubKeyCredParamsItems0) UnmarshalBinary(b []byte) error {
+	var res PasskeyCreationOptionsPubKeyCredParamsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// PasskeyCreationOptionsRp passkey creation options rp
+//
+// swagger:model PasskeyCreationOptionsRp
+type PasskeyCreationOptionsRp struct {
+
+	// Relying party ID, the domain the passkey is bound to
+	// Example: example.com
+	// Required: true
+	ID *string `json:"id"`
+
+	// Name of the relying party shown by the authenticator
+	// Example: go-starter
+	// Required: true
+	Name *string `json:"name"`
+}
+
+// Validate validates this passkey creation options rp
+func (m *PasskeyCreationOptionsRp) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCreationOptionsRp) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("rp"+"."+"id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptionsRp) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("rp"+"."+"name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey creation options rp based on context it is used
+func (m *PasskeyCreationOptionsRp) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyCreationOptionsRp) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyCreationOptionsRp) UnmarshalBinary(b []byte) error {
+	var res PasskeyCreationOptionsRp
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// PasskeyCreationOptionsUser passkey creation options user
+//
+// swagger:model PasskeyCreationOptionsUser
+type PasskeyCreationOptionsUser struct {
+
+	// Display name of the account shown by the authenticator
+	// Example: user@example.com
+	// Required: true
+	DisplayName *string `json:"displayName"`
+
+	// Base64url encoded user handle, returned by the authenticator on login
+	// Example: ODkxZDM3ZDMtYzc0Zi00OTNlLWFlYTgtYWY3M2VmZDkyMDE2
+	// Required: true
+	ID *string `json:"id"`
+
+	// Name of the account shown by the authenticator
+	// Example: user@example.com
+	// Required: true
+	Name *string `json:"name"`
+}
+
+// Validate validates this passkey creation options user
+func (m *PasskeyCreationOptionsUser) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDisplayName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCreationOptionsUser) validateDisplayName(formats strfmt.Registry) error {
+
+	if err := validate.Required("user"+"."+"displayName", "body", m.DisplayName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptionsUser) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("user"+"."+"id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCreationOptionsUser) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("user"+"."+"name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey creation options user based on context it is used
+func (m *PasskeyCreationOptionsUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyCreationOptionsUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyCreationOptionsUser) UnmarshalBinary(b []byte) error {
+	var res PasskeyCreationOptionsUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PasskeyCredentialDescriptor passkey credential descriptor
+//
+// swagger:model passkeyCredentialDescriptor
+type PasskeyCredentialDescriptor struct {
+
+	// Base64url encoded credential ID
+	// Example: 3q2-7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWc
+	// Required: true
+	ID *string `json:"id"`
+
+	// Transports supported by the authenticator
+	// Example: ["internal","hybrid"]
+	Transports []string `json:"transports"`
+
+	// Type of the credential, will always be `public-key`
+	// Example: public-key
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this passkey credential descriptor
+func (m *PasskeyCredentialDescriptor) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyCredentialDescriptor) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyCredentialDescriptor) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey credential descriptor based on context it is used
+func (m *PasskeyCredentialDescriptor) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyCredentialDescriptor) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyCredentialDescriptor) UnmarshalBinary(b []byte) error {
+	var res PasskeyCredentialDescriptor
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,342 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PasskeyRegistrationCredential PublicKeyCredential returned by `navigator.credentials.create()` in its JSON representation,
+// see https://www.w3.org/TR/webauthn-3/#dictdef-registrationresponsejson
+//
+// swagger:model passkeyRegistrationCredential
+type PasskeyRegistrationCredential struct {
+
+	// Base64url encoded credential ID
+	// Example: 3q2-7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWc
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	ID *string `json:"id"`
+
+	// Base64url encoded credential ID
+	// Example: 3q2-7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWc
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	RawID *string `json:"rawId"`
+
+	// response
+	// Required: true
+	Response *PasskeyRegistrationCredentialResponse `json:"response"`
+
+	// type
+	// Example: public-key
+	// Required: true
+	// Enum: [public-key]
+	Type *string `json:"type"`
+}
+
+// Validate validates this passkey registration credential
+func (m *PasskeyRegistrationCredential) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRawID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResponse(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyRegistrationCredential) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("id", "body", *m.ID, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("id", "body", *m.ID, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRegistrationCredential) validateRawID(formats strfmt.Registry) error {
+
+	if err := validate.Required("rawId", "body", m.RawID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("rawId", "body", *m.RawID, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("rawId", "body", *m.RawID, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRegistrationCredential) validateResponse(formats strfmt.Registry) error {
+
+	if err := validate.Required("response", "body", m.Response); err != nil {
+		return err
+	}
+
+	if m.Response != nil {
+		if err := m.Response.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("response")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("response")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var passkeyRegistrationCredentialTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["public-key"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		passkeyRegistrationCredentialTypeTypePropEnum = append(passkeyRegistrationCredentialTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// PasskeyRegistrationCredentialTypePublicDashKey captures enum value "public-key"
+	PasskeyRegistrationCredentialTypePublicDashKey string = "public-key"
+)
+
+// prop value enum
+func (m *PasskeyRegistrationCredential) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, passkeyRegistrationCredentialTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PasskeyRegistrationCredential) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this passkey registration credential based on the context it is used
+func (m *PasskeyRegistrationCredential) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateResponse(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyRegistrationCredential) contextValidateResponse(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Response != nil {
+		if err := m.Response.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("response")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("response")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyRegistrationCredential) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyRegistrationCredential) UnmarshalBinary(b []byte) error {
+	var res PasskeyRegistrationCredential
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// PasskeyRegistrationCredentialResponse passkey registration credential response
+//
+// swagger:model PasskeyRegistrationCredentialResponse
+type PasskeyRegistrationCredentialResponse struct {
+
+	// Base64url encoded CBOR attestation object
+	// Example: o2NmbXRkbm9uZWdhdHRTdG10oGhhdXRoRGF0YVjF
+	// Required: true
+	// Max Length: 65536
+	// Min Length: 1
+	AttestationObject *string `json:"attestationObject"`
+
+	// Base64url encoded client data
+	// Example: eyJ0eXBlIjoid2ViYXV0aG4uY3JlYXRlIn0
+	// Required: true
+	// Max Length: 16384
+	// Min Length: 1
+	ClientDataJSON *string `json:"clientDataJSON"`
+
+	// Transports supported by the authenticator
+	// Example: ["internal","hybrid"]
+	// Max Items: 10
+	Transports []string `json:"transports"`
+}
+
+// Validate validates this passkey registration credential response
+func (m *PasskeyRegistrationCredentialResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttestationObject(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClientDataJSON(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransports(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyRegistrationCredentialResponse) validateAttestationObject(formats strfmt.Registry) error {
+
+	if err := validate.Required("response"+"."+"attestationObject", "body", m.AttestationObject); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("response"+"."+"attestationObject", "body", *m.AttestationObject, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("response"+"."+"attestationObject", "body", *m.AttestationObject, 65536); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRegistrationCredentialResponse) validateClientDataJSON(formats strfmt.Registry) error {
+
+	if err := validate.Required("response"+"."+"clientDataJSON", "body", m.ClientDataJSON); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("response"+"."+"clientDataJSON", "body", *m.ClientDataJSON, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("response"+"."+"clientDataJSON", "body", *m.ClientDataJSON, 16384); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRegistrationCredentialResponse) validateTransports(formats strfmt.Registry) error {
+	if swag.IsZero(m.Transports) { // not required
+		return nil
+	}
+
+	iTransportsSize := int64(len(m.Transports))
+
+	if err := validate.MaxItems("response"+"."+"transports", "body", iTransportsSize, 10); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Transports); i++ {
+
+		if err := validate.MaxLength("response"+"."+"transports"+"."+strconv.Itoa(i), "body", m.Transports[i], 32); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey registration credential response based on context it is used
+func (m *PasskeyRegistrationCredentialResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyRegistrationCredentialResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyRegistrationCredentialResponse) UnmarshalBinary(b []byte) error {
+	var res PasskeyRegistrationCredentialResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
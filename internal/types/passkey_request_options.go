@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PasskeyRequestOptions PublicKeyCredentialRequestOptions in their JSON representation, to be passed to
+// `navigator.credentials.get()` or the platform's passkey API.
+// See https://www.w3.org/TR/webauthn-3/#dictdef-publickeycredentialrequestoptionsjson
+//
+// swagger:model passkeyRequestOptions
+type PasskeyRequestOptions struct {
+
+	// Base64url encoded challenge to be signed by the authenticator
+	// Example: mF2Zq6m1oQKkY7cR8T3fGvXJ0n8b4Yp2sLhW9dE5aUc
+	// Required: true
+	Challenge *string `json:"challenge"`
+
+	// Relying party ID, the domain the passkey is bound to
+	// Example: example.com
+	// Required: true
+	RpID *string `json:"rpId"`
+
+	// Time in milliseconds to complete the login
+	// Example: 300000
+	// Required: true
+	Timeout *int64 `json:"timeout"`
+
+	// user verification
+	// Example: required
+	// Required: true
+	UserVerification *string `json:"userVerification"`
+}
+
+// Validate validates this passkey request options
+func (m *PasskeyRequestOptions) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChallenge(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRpID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserVerification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PasskeyRequestOptions) validateChallenge(formats strfmt.Registry) error {
+
+	if err := validate.Required("challenge", "body", m.Challenge); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRequestOptions) validateRpID(formats strfmt.Registry) error {
+
+	if err := validate.Required("rpId", "body", m.RpID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRequestOptions) validateTimeout(formats strfmt.Registry) error {
+
+	if err := validate.Required("timeout", "body", m.Timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PasskeyRequestOptions) validateUserVerification(formats strfmt.Registry) error {
+
+	if err := validate.Required("userVerification", "body", m.UserVerification); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this passkey request options based on context it is used
+func (m *PasskeyRequestOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PasskeyRequestOptions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PasskeyRequestOptions) UnmarshalBinary(b []byte) error {
+	var res PasskeyRequestOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
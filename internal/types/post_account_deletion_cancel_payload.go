@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAccountDeletionCancelPayload post account deletion cancel payload
+//
+// swagger:model postAccountDeletionCancelPayload
+type PostAccountDeletionCancelPayload struct {
+
+	// Cancel token sent by email when scheduling the account deletion
+	// Example: 5e3b2f0a-8c3d-4b8e-a1c2-7f6d9e0b4c21
+	// Required: true
+	// Format: uuid4
+	Token *strfmt.UUID4 `json:"token"`
+}
+
+// Validate validates this post account deletion cancel payload
+func (m *PostAccountDeletionCancelPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAccountDeletionCancelPayload) validateToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("token", "body", m.Token); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("token", "body", "uuid4", m.Token.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post account deletion cancel payload based on context it is used
+func (m *PostAccountDeletionCancelPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAccountDeletionCancelPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAccountDeletionCancelPayload) UnmarshalBinary(b []byte) error {
+	var res PostAccountDeletionCancelPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
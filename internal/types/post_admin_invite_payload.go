@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAdminInvitePayload post admin invite payload
+//
+// swagger:model postAdminInvitePayload
+type PostAdminInvitePayload struct {
+
+	// Email address to send the invite to, replaces any pending invite of the same address
+	// Example: user@example.com
+	// Required: true
+	// Max Length: 255
+	// Min Length: 1
+	// Format: email
+	Email *strfmt.Email `json:"email"`
+
+	// Names of the roles to assign to the invited user, defaults to the default user scopes if omitted. Requires the `roles:assign` permission
+	// Example: ["app"]
+	// Max Items: 50
+	// Unique: true
+	Scopes []string `json:"scopes"`
+
+	// Time the invite expires, defaults to the configured invite validity if omitted
+	// Example: 2026-10-25T12:00:00.000Z
+	// Format: date-time
+	ValidUntil strfmt.DateTime `json:"validUntil,omitempty"`
+}
+
+// Validate validates this post admin invite payload
+func (m *PostAdminInvitePayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAdminInvitePayload) validateEmail(formats strfmt.Registry) error {
+
+	if err := validate.Required("email", "body", m.Email); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("email", "body", m.Email.String(), 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("email", "body", m.Email.String(), 255); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("email", "body", "email", m.Email.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminInvitePayload) validateScopes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Scopes) { // not required
+		return nil
+	}
+
+	iScopesSize := int64(len(m.Scopes))
+
+	if err := validate.MaxItems("scopes", "body", iScopesSize, 50); err != nil {
+		return err
+	}
+
+	if err := validate.UniqueItems("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		if err := validate.MinLength("scopes"+"."+strconv.Itoa(i), "body", m.Scopes[i], 1); err != nil {
+			return err
+		}
+
+		if err := validate.MaxLength("scopes"+"."+strconv.Itoa(i), "body", m.Scopes[i], 255); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PostAdminInvitePayload) validateValidUntil(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValidUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("validUntil", "body", "date-time", m.ValidUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post admin invite payload based on context it is used
+func (m *PostAdminInvitePayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAdminInvitePayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAdminInvitePayload) UnmarshalBinary(b []byte) error {
+	var res PostAdminInvitePayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,327 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAdminPushBroadcastPayload post admin push broadcast payload
+//
+// swagger:model postAdminPushBroadcastPayload
+type PostAdminPushBroadcastPayload struct {
+
+	// Body of the notification
+	// Example: Check out what's new in the app.
+	// Required: true
+	// Max Length: 2000
+	// Min Length: 1
+	Body *string `json:"body"`
+
+	// Notification category, users who disabled push notifications of the category are not notified.
+	// Users within their quiet hours are notified once they end.
+	// Enum: [updates marketing]
+	Category *string `json:"category,omitempty"`
+
+	// Custom data passed to the app
+	// Example: {"newsId":"42"}
+	Data map[string]string `json:"data,omitempty"`
+
+	// Link opened by the app once the notification is tapped
+	// Example: gostarter://news/42
+	// Max Length: 2000
+	DeepLink string `json:"deepLink,omitempty"`
+
+	// URL of the image attached to the notification
+	// Example: https://example.com/image.png
+	// Max Length: 2000
+	// Format: uri
+	ImageURL strfmt.URI `json:"imageUrl,omitempty"`
+
+	// Only notify devices with the locale, matching regional variants of a language (e.g. "de" matches "de-AT")
+	// Example: de
+	// Max Length: 35
+	// Pattern: ^[a-zA-Z]{2,8}(-[a-zA-Z0-9]{1,8})*$
+	Locale string `json:"locale,omitempty"`
+
+	// Time to send the push broadcast at, sent immediately if omitted
+	// Example: 2026-10-18T12:00:00.000Z
+	// Format: date-time
+	ScheduledAt strfmt.DateTime `json:"scheduledAt,omitempty"`
+
+	// Only notify users with the scope
+	// Example: app
+	// Max Length: 255
+	Scope string `json:"scope,omitempty"`
+
+	// Title of the notification
+	// Example: New features available
+	// Required: true
+	// Max Length: 255
+	// Min Length: 1
+	Title *string `json:"title"`
+
+	// Only notify users subscribed to the topic
+	// Example: news
+	// Max Length: 100
+	// Pattern: ^[a-zA-Z0-9_.-]+$
+	Topic string `json:"topic,omitempty"`
+}
+
+// Validate validates this post admin push broadcast payload
+func (m *PostAdminPushBroadcastPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBody(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCategory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeepLink(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateImageURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLocale(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScheduledAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScope(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTopic(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateBody(formats strfmt.Registry) error {
+
+	if err := validate.Required("body", "body", m.Body); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("body", "body", *m.Body, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("body", "body", *m.Body, 2000); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var postAdminPushBroadcastPayloadTypeCategoryPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["updates","marketing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postAdminPushBroadcastPayloadTypeCategoryPropEnum = append(postAdminPushBroadcastPayloadTypeCategoryPropEnum, v)
+	}
+}
+
+const (
+
+	// PostAdminPushBroadcastPayloadCategoryUpdates captures enum value "updates"
+	PostAdminPushBroadcastPayloadCategoryUpdates string = "updates"
+
+	// PostAdminPushBroadcastPayloadCategoryMarketing captures enum value "marketing"
+	PostAdminPushBroadcastPayloadCategoryMarketing string = "marketing"
+)
+
+// prop value enum
+func (m *PostAdminPushBroadcastPayload) validateCategoryEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postAdminPushBroadcastPayloadTypeCategoryPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateCategory(formats strfmt.Registry) error {
+	if swag.IsZero(m.Category) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCategoryEnum("category", "body", *m.Category); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateData(formats strfmt.Registry) error {
+	if swag.IsZero(m.Data) { // not required
+		return nil
+	}
+
+	for k := range m.Data {
+
+		if err := validate.MaxLength("data"+"."+k, "body", m.Data[k], 1000); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateDeepLink(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeepLink) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("deepLink", "body", m.DeepLink, 2000); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateImageURL(formats strfmt.Registry) error {
+	if swag.IsZero(m.ImageURL) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("imageUrl", "body", m.ImageURL.String(), 2000); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("imageUrl", "body", "uri", m.ImageURL.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateLocale(formats strfmt.Registry) error {
+	if swag.IsZero(m.Locale) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("locale", "body", m.Locale, 35); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("locale", "body", m.Locale, `^[a-zA-Z]{2,8}(-[a-zA-Z0-9]{1,8})*$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateScheduledAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScheduledAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scheduledAt", "body", "date-time", m.ScheduledAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateScope(formats strfmt.Registry) error {
+	if swag.IsZero(m.Scope) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("scope", "body", m.Scope, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("title", "body", *m.Title, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("title", "body", *m.Title, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminPushBroadcastPayload) validateTopic(formats strfmt.Registry) error {
+	if swag.IsZero(m.Topic) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("topic", "body", m.Topic, 100); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("topic", "body", m.Topic, `^[a-zA-Z0-9_.-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post admin push broadcast payload based on context it is used
+func (m *PostAdminPushBroadcastPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAdminPushBroadcastPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAdminPushBroadcastPayload) UnmarshalBinary(b []byte) error {
+	var res PostAdminPushBroadcastPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,109 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAdminUserImpersonateResponse post admin user impersonate response
+//
+// swagger:model postAdminUserImpersonateResponse
+type PostAdminUserImpersonateResponse struct {
+
+	// Access token authenticating requests as the impersonated user, either an opaque UUID or a signed JWT depending on the
+	// server configuration. It cannot be refreshed and is rejected by sensitive endpoints (e.g. password change or account deletion).
+	// Example: c1247d8d-0d65-41c4-bc86-ec041d2ac437
+	// Required: true
+	AccessToken *string `json:"access_token"`
+
+	// Access token expiry in seconds
+	// Example: 900
+	// Required: true
+	ExpiresIn *int64 `json:"expires_in"`
+
+	// Type of access token, will always be `bearer`
+	// Example: bearer
+	// Required: true
+	TokenType *string `json:"token_type"`
+}
+
+// Validate validates this post admin user impersonate response
+func (m *PostAdminUserImpersonateResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAccessToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAdminUserImpersonateResponse) validateAccessToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("access_token", "body", m.AccessToken); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminUserImpersonateResponse) validateExpiresIn(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires_in", "body", m.ExpiresIn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAdminUserImpersonateResponse) validateTokenType(formats strfmt.Registry) error {
+
+	if err := validate.Required("token_type", "body", m.TokenType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post admin user impersonate response based on context it is used
+func (m *PostAdminUserImpersonateResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAdminUserImpersonateResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAdminUserImpersonateResponse) UnmarshalBinary(b []byte) error {
+	var res PostAdminUserImpersonateResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
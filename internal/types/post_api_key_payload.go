@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAPIKeyPayload post Api key payload
+//
+// swagger:model postApiKeyPayload
+type PostAPIKeyPayload struct {
+
+	// Name of the API key, used to tell keys apart
+	// Example: CI deployment
+	// Required: true
+	// Max Length: 255
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// Scopes granted to the API key, must be assigned to the user
+	// Example: ["app"]
+	// Required: true
+	// Max Items: 50
+	// Min Items: 1
+	// Unique: true
+	Scopes []string `json:"scopes"`
+
+	// Time the API key expires, never expires if omitted
+	// Example: 2027-10-18T12:00:00.000Z
+	// Format: date-time
+	ValidUntil strfmt.DateTime `json:"valid_until,omitempty"`
+}
+
+// Validate validates this post Api key payload
+func (m *PostAPIKeyPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAPIKeyPayload) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("name", "body", *m.Name, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostAPIKeyPayload) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	iScopesSize := int64(len(m.Scopes))
+
+	if err := validate.MinItems("scopes", "body", iScopesSize, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxItems("scopes", "body", iScopesSize, 50); err != nil {
+		return err
+	}
+
+	if err := validate.UniqueItems("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		if err := validate.MinLength("scopes"+"."+strconv.Itoa(i), "body", m.Scopes[i], 1); err != nil {
+			return err
+		}
+
+		if err := validate.MaxLength("scopes"+"."+strconv.Itoa(i), "body", m.Scopes[i], 255); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PostAPIKeyPayload) validateValidUntil(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValidUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("valid_until", "body", "date-time", m.ValidUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post Api key payload based on context it is used
+func (m *PostAPIKeyPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAPIKeyPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAPIKeyPayload) UnmarshalBinary(b []byte) error {
+	var res PostAPIKeyPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAPIKeyResponse post Api key response
+//
+// swagger:model postApiKeyResponse
+type PostAPIKeyResponse struct {
+	APIKey
+
+	// The API key, only returned once on creation. Must be provided using the `Authorization` header
+	// with the "APIKey " prefix.
+	// Example: 3f9c1a2b4d5e6f70.9b8a7c6d5e4f30211f2e3d4c5b6a79880a1b2c3d4e5f60718293a4b5c6d7e8f9
+	// Required: true
+	Key *string `json:"key"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (m *PostAPIKeyResponse) UnmarshalJSON(raw []byte) error {
+	// AO0
+	var aO0 APIKey
+	if err := swag.ReadJSON(raw, &aO0); err != nil {
+		return err
+	}
+	m.APIKey = aO0
+
+	// now for regular properties
+	var propsPostAPIKeyResponse struct {
+		Key *string `json:"key"`
+	}
+	if err := swag.ReadJSON(raw, &propsPostAPIKeyResponse); err != nil {
+		return err
+	}
+	m.Key = propsPostAPIKeyResponse.Key
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (m PostAPIKeyResponse) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 1)
+
+	aO0, err := swag.WriteJSON(m.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, aO0)
+
+	// now for regular properties
+	var propsPostAPIKeyResponse struct {
+		Key *string `json:"key"`
+	}
+	propsPostAPIKeyResponse.Key = m.Key
+
+	jsonDataPropsPostAPIKeyResponse, errPostAPIKeyResponse := swag.WriteJSON(propsPostAPIKeyResponse)
+	if errPostAPIKeyResponse != nil {
+		return nil, errPostAPIKeyResponse
+	}
+	_parts = append(_parts, jsonDataPropsPostAPIKeyResponse)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this post Api key response
+func (m *PostAPIKeyResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with APIKey
+	if err := m.APIKey.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAPIKeyResponse) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("key", "body", m.Key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post Api key response based on the context it is used
+func (m *PostAPIKeyResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with APIKey
+	if err := m.APIKey.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAPIKeyResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAPIKeyResponse) UnmarshalBinary(b []byte) error {
+	var res PostAPIKeyResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
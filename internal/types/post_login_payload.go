@@ -19,6 +19,11 @@ import (
 // swagger:model postLoginPayload
 type PostLoginPayload struct {
 
+	// Optional name of the client's device, shown in the list of the user's sessions
+	// Example: iPhone 15 Pro
+	// Max Length: 255
+	DeviceName string `json:"device_name,omitempty"`
+
 	// Password of user to authenticate as
 	// Example: correct horse battery staple
 	// Required: true
@@ -39,6 +44,10 @@ type PostLoginPayload struct {
 func (m *PostLoginPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDeviceName(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePassword(formats); err != nil {
 		res = append(res, err)
 	}
@@ -53,6 +62,18 @@ func (m *PostLoginPayload) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PostLoginPayload) validateDeviceName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeviceName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("device_name", "body", m.DeviceName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PostLoginPayload) validatePassword(formats strfmt.Registry) error {
 
 	if err := validate.Required("password", "body", m.Password); err != nil {
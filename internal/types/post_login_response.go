@@ -19,11 +19,10 @@ import (
 // swagger:model postLoginResponse
 type PostLoginResponse struct {
 
-	// Access token required for accessing protected API endpoints
+	// Access token required for accessing protected API endpoints, either an opaque UUID or a signed JWT depending on the server configuration
 	// Example: c1247d8d-0d65-41c4-bc86-ec041d2ac437
 	// Required: true
-	// Format: uuid4
-	AccessToken *strfmt.UUID4 `json:"access_token"`
+	AccessToken *string `json:"access_token"`
 
 	// Access token expiry in seconds
 	// Example: 86400
@@ -74,10 +73,6 @@ func (m *PostLoginResponse) validateAccessToken(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := validate.FormatOf("access_token", "body", "uuid4", m.AccessToken.String(), formats); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostLoginTwoFactorChallengeResponse post login two factor challenge response
+//
+// swagger:model postLoginTwoFactorChallengeResponse
+type PostLoginTwoFactorChallengeResponse struct {
+
+	// Short-lived token to complete the login using `POST /api/v1/auth/2fa/verify`
+	// Example: 0f3b1c1e-8e4b-4a51-9e3a-5b1d2d0e4f6a
+	// Required: true
+	// Format: uuid4
+	ChallengeToken *strfmt.UUID4 `json:"challenge_token"`
+
+	// Challenge token expiry in seconds
+	// Example: 300
+	// Required: true
+	ExpiresIn *int64 `json:"expires_in"`
+}
+
+// Validate validates this post login two factor challenge response
+func (m *PostLoginTwoFactorChallengeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChallengeToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostLoginTwoFactorChallengeResponse) validateChallengeToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("challenge_token", "body", m.ChallengeToken); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("challenge_token", "body", "uuid4", m.ChallengeToken.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostLoginTwoFactorChallengeResponse) validateExpiresIn(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires_in", "body", m.ExpiresIn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post login two factor challenge response based on context it is used
+func (m *PostLoginTwoFactorChallengeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostLoginTwoFactorChallengeResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostLoginTwoFactorChallengeResponse) UnmarshalBinary(b []byte) error {
+	var res PostLoginTwoFactorChallengeResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostMagicLinkCompletePayload post magic link complete payload
+//
+// swagger:model postMagicLinkCompletePayload
+type PostMagicLinkCompletePayload struct {
+
+	// Optional name of the client's device, shown in the list of the user's sessions
+	// Example: iPhone 15 Pro
+	// Max Length: 255
+	DeviceName string `json:"device_name,omitempty"`
+
+	// Magic link token sent via email
+	// Example: ec16f032-3c44-4148-bbcc-45557466fa74
+	// Required: true
+	// Format: uuid4
+	Token *strfmt.UUID4 `json:"token"`
+}
+
+// Validate validates this post magic link complete payload
+func (m *PostMagicLinkCompletePayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeviceName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostMagicLinkCompletePayload) validateDeviceName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeviceName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("device_name", "body", m.DeviceName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostMagicLinkCompletePayload) validateToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("token", "body", m.Token); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("token", "body", "uuid4", m.Token.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post magic link complete payload based on context it is used
+func (m *PostMagicLinkCompletePayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostMagicLinkCompletePayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostMagicLinkCompletePayload) UnmarshalBinary(b []byte) error {
+	var res PostMagicLinkCompletePayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostOIDCCallbackPayload post o Id c callback payload
+//
+// swagger:model postOIdCCallbackPayload
+type PostOIDCCallbackPayload struct {
+
+	// Authorization code returned by the provider
+	// Example: 4/0AfJohXk3q
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	Code *string `json:"code"`
+
+	// Optional name of the client's device, shown in the list of the user's sessions
+	// Example: iPhone 15 Pro
+	// Max Length: 255
+	DeviceName string `json:"device_name,omitempty"`
+
+	// State returned by the provider, as set by `GET /api/v1/auth/oidc/{provider}/authorize`
+	// Example: 7a5b2b1c-3d4e-4f60-8a9b-0c1d2e3f4a5b
+	// Required: true
+	// Format: uuid4
+	State *strfmt.UUID4 `json:"state"`
+}
+
+// Validate validates this post o Id c callback payload
+func (m *PostOIDCCallbackPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeviceName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostOIDCCallbackPayload) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("code", "body", *m.Code, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("code", "body", *m.Code, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOIDCCallbackPayload) validateDeviceName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeviceName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("device_name", "body", m.DeviceName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOIDCCallbackPayload) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("state", "body", "uuid4", m.State.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post o Id c callback payload based on context it is used
+func (m *PostOIDCCallbackPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostOIDCCallbackPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostOIDCCallbackPayload) UnmarshalBinary(b []byte) error {
+	var res PostOIDCCallbackPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
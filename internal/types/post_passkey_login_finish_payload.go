@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostPasskeyLoginFinishPayload post passkey login finish payload
+//
+// swagger:model postPasskeyLoginFinishPayload
+type PostPasskeyLoginFinishPayload struct {
+
+	// credential
+	// Required: true
+	Credential *PasskeyAuthenticationCredential `json:"credential"`
+
+	// Optional name of the client's device, shown in the list of the user's sessions
+	// Example: iPhone 15 Pro
+	// Max Length: 255
+	DeviceName string `json:"device_name,omitempty"`
+}
+
+// Validate validates this post passkey login finish payload
+func (m *PostPasskeyLoginFinishPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCredential(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeviceName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostPasskeyLoginFinishPayload) validateCredential(formats strfmt.Registry) error {
+
+	if err := validate.Required("credential", "body", m.Credential); err != nil {
+		return err
+	}
+
+	if m.Credential != nil {
+		if err := m.Credential.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("credential")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("credential")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PostPasskeyLoginFinishPayload) validateDeviceName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeviceName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("device_name", "body", m.DeviceName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post passkey login finish payload based on the context it is used
+func (m *PostPasskeyLoginFinishPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCredential(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostPasskeyLoginFinishPayload) contextValidateCredential(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Credential != nil {
+		if err := m.Credential.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("credential")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("credential")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostPasskeyLoginFinishPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostPasskeyLoginFinishPayload) UnmarshalBinary(b []byte) error {
+	var res PostPasskeyLoginFinishPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,17 @@ import (
 // swagger:model postRegisterPayload
 type PostRegisterPayload struct {
 
+	// Optional name of the client's device, shown in the list of the user's sessions
+	// Example: iPhone 15 Pro
+	// Max Length: 255
+	DeviceName string `json:"device_name,omitempty"`
+
+	// Optional token of the invite received by email. Users registering with a valid invite are activated immediately and
+	// assigned the scopes of the invite. Required if self-signup is disabled.
+	// Example: 7b4f2d1e-9c3a-4e8b-a5d6-0f1e2d3c4b5a
+	// Format: uuid4
+	InviteToken strfmt.UUID4 `json:"invite_token,omitempty"`
+
 	// Password to register with
 	// Example: correct horse battery staple
 	// Required: true
@@ -39,6 +50,14 @@ type PostRegisterPayload struct {
 func (m *PostRegisterPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDeviceName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInviteToken(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePassword(formats); err != nil {
 		res = append(res, err)
 	}
@@ -53,6 +72,30 @@ func (m *PostRegisterPayload) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PostRegisterPayload) validateDeviceName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeviceName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("device_name", "body", m.DeviceName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostRegisterPayload) validateInviteToken(formats strfmt.Registry) error {
+	if swag.IsZero(m.InviteToken) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("invite_token", "body", "uuid4", m.InviteToken.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PostRegisterPayload) validatePassword(formats strfmt.Registry) error {
 
 	if err := validate.Required("password", "body", m.Password); err != nil {
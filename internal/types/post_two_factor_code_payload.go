@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostTwoFactorCodePayload post two factor code payload
+//
+// swagger:model postTwoFactorCodePayload
+type PostTwoFactorCodePayload struct {
+
+	// Current TOTP code of the user's authenticator app or, if permitted, one of the user's recovery codes
+	// Example: 123456
+	// Required: true
+	// Max Length: 32
+	// Min Length: 1
+	Code *string `json:"code"`
+}
+
+// Validate validates this post two factor code payload
+func (m *PostTwoFactorCodePayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostTwoFactorCodePayload) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("code", "body", *m.Code, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("code", "body", *m.Code, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post two factor code payload based on context it is used
+func (m *PostTwoFactorCodePayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostTwoFactorCodePayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostTwoFactorCodePayload) UnmarshalBinary(b []byte) error {
+	var res PostTwoFactorCodePayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostTwoFactorEnrollResponse post two factor enroll response
+//
+// swagger:model postTwoFactorEnrollResponse
+type PostTwoFactorEnrollResponse struct {
+
+	// otpauth:// URI of the TOTP secret, usually rendered as QR code
+	// Example: otpauth://totp/go-starter:user@example.com?algorithm=SHA1\u0026digits=6\u0026issuer=go-starter\u0026period=30\u0026secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	// Required: true
+	OtpauthURI *string `json:"otpauthUri"`
+
+	// One-time recovery codes, only returned once and usable instead of a TOTP code
+	// Example: ["k7d2m-9xq4p","a3v8n-2hz6w"]
+	// Required: true
+	RecoveryCodes []string `json:"recoveryCodes"`
+
+	// Base32 encoded TOTP secret for manual entry into authenticator apps
+	// Example: JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	// Required: true
+	Secret *string `json:"secret"`
+}
+
+// Validate validates this post two factor enroll response
+func (m *PostTwoFactorEnrollResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOtpauthURI(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRecoveryCodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostTwoFactorEnrollResponse) validateOtpauthURI(formats strfmt.Registry) error {
+
+	if err := validate.Required("otpauthUri", "body", m.OtpauthURI); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostTwoFactorEnrollResponse) validateRecoveryCodes(formats strfmt.Registry) error {
+
+	if err := validate.Required("recoveryCodes", "body", m.RecoveryCodes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostTwoFactorEnrollResponse) validateSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("secret", "body", m.Secret); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post two factor enroll response based on context it is used
+func (m *PostTwoFactorEnrollResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostTwoFactorEnrollResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostTwoFactorEnrollResponse) UnmarshalBinary(b []byte) error {
+	var res PostTwoFactorEnrollResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
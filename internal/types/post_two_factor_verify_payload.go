@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostTwoFactorVerifyPayload post two factor verify payload
+//
+// swagger:model postTwoFactorVerifyPayload
+type PostTwoFactorVerifyPayload struct {
+
+	// Challenge token returned by `POST /api/v1/auth/login`
+	// Example: 0f3b1c1e-8e4b-4a51-9e3a-5b1d2d0e4f6a
+	// Required: true
+	// Format: uuid4
+	ChallengeToken *strfmt.UUID4 `json:"challenge_token"`
+
+	// Current TOTP code of the user's authenticator app or one of the user's recovery codes
+	// Example: 123456
+	// Required: true
+	// Max Length: 32
+	// Min Length: 1
+	Code *string `json:"code"`
+
+	// Optional name of the client's device, shown in the list of the user's sessions
+	// Example: iPhone 15 Pro
+	// Max Length: 255
+	DeviceName string `json:"device_name,omitempty"`
+}
+
+// Validate validates this post two factor verify payload
+func (m *PostTwoFactorVerifyPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChallengeToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeviceName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostTwoFactorVerifyPayload) validateChallengeToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("challenge_token", "body", m.ChallengeToken); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("challenge_token", "body", "uuid4", m.ChallengeToken.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostTwoFactorVerifyPayload) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("code", "body", *m.Code, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("code", "body", *m.Code, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostTwoFactorVerifyPayload) validateDeviceName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeviceName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("device_name", "body", m.DeviceName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post two factor verify payload based on context it is used
+func (m *PostTwoFactorVerifyPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostTwoFactorVerifyPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostTwoFactorVerifyPayload) UnmarshalBinary(b []byte) error {
+	var res PostTwoFactorVerifyPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
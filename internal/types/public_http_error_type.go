@@ -39,6 +39,21 @@ const (
 	// PublicHTTPErrorTypeOLDPUSHTOKENNOTFOUND captures enum value "OLD_PUSH_TOKEN_NOT_FOUND"
 	PublicHTTPErrorTypeOLDPUSHTOKENNOTFOUND PublicHTTPErrorType = "OLD_PUSH_TOKEN_NOT_FOUND"
 
+	// PublicHTTPErrorTypePUSHBROADCASTNOTFOUND captures enum value "PUSH_BROADCAST_NOT_FOUND"
+	PublicHTTPErrorTypePUSHBROADCASTNOTFOUND PublicHTTPErrorType = "PUSH_BROADCAST_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDPUSHBROADCASTSCHEDULE captures enum value "INVALID_PUSH_BROADCAST_SCHEDULE"
+	PublicHTTPErrorTypeINVALIDPUSHBROADCASTSCHEDULE PublicHTTPErrorType = "INVALID_PUSH_BROADCAST_SCHEDULE"
+
+	// PublicHTTPErrorTypeINVALIDTIMEZONE captures enum value "INVALID_TIME_ZONE"
+	PublicHTTPErrorTypeINVALIDTIMEZONE PublicHTTPErrorType = "INVALID_TIME_ZONE"
+
+	// PublicHTTPErrorTypeINVALIDQUIETHOURS captures enum value "INVALID_QUIET_HOURS"
+	PublicHTTPErrorTypeINVALIDQUIETHOURS PublicHTTPErrorType = "INVALID_QUIET_HOURS"
+
+	// PublicHTTPErrorTypeNOTIFICATIONCATEGORYMANDATORY captures enum value "NOTIFICATION_CATEGORY_MANDATORY"
+	PublicHTTPErrorTypeNOTIFICATIONCATEGORYMANDATORY PublicHTTPErrorType = "NOTIFICATION_CATEGORY_MANDATORY"
+
 	// PublicHTTPErrorTypeZEROFILESIZE captures enum value "ZERO_FILE_SIZE"
 	PublicHTTPErrorTypeZEROFILESIZE PublicHTTPErrorType = "ZERO_FILE_SIZE"
 
@@ -68,6 +83,84 @@ const (
 
 	// PublicHTTPErrorTypeMISSINGSCOPES captures enum value "MISSING_SCOPES"
 	PublicHTTPErrorTypeMISSINGSCOPES PublicHTTPErrorType = "MISSING_SCOPES"
+
+	// PublicHTTPErrorTypeTOTPALREADYENABLED captures enum value "TOTP_ALREADY_ENABLED"
+	PublicHTTPErrorTypeTOTPALREADYENABLED PublicHTTPErrorType = "TOTP_ALREADY_ENABLED"
+
+	// PublicHTTPErrorTypeTOTPNOTENABLED captures enum value "TOTP_NOT_ENABLED"
+	PublicHTTPErrorTypeTOTPNOTENABLED PublicHTTPErrorType = "TOTP_NOT_ENABLED"
+
+	// PublicHTTPErrorTypeINVALIDTOTPCODE captures enum value "INVALID_TOTP_CODE"
+	PublicHTTPErrorTypeINVALIDTOTPCODE PublicHTTPErrorType = "INVALID_TOTP_CODE"
+
+	// PublicHTTPErrorTypeTOOMANYATTEMPTS captures enum value "TOO_MANY_ATTEMPTS"
+	PublicHTTPErrorTypeTOOMANYATTEMPTS PublicHTTPErrorType = "TOO_MANY_ATTEMPTS"
+
+	// PublicHTTPErrorTypeRATELIMITEXCEEDED captures enum value "RATE_LIMIT_EXCEEDED"
+	PublicHTTPErrorTypeRATELIMITEXCEEDED PublicHTTPErrorType = "RATE_LIMIT_EXCEEDED"
+
+	// PublicHTTPErrorTypeOIDCPROVIDERNOTFOUND captures enum value "OIDC_PROVIDER_NOT_FOUND"
+	PublicHTTPErrorTypeOIDCPROVIDERNOTFOUND PublicHTTPErrorType = "OIDC_PROVIDER_NOT_FOUND"
+
+	// PublicHTTPErrorTypeOIDCAUTHENTICATIONFAILED captures enum value "OIDC_AUTHENTICATION_FAILED"
+	PublicHTTPErrorTypeOIDCAUTHENTICATIONFAILED PublicHTTPErrorType = "OIDC_AUTHENTICATION_FAILED"
+
+	// PublicHTTPErrorTypeSESSIONNOTFOUND captures enum value "SESSION_NOT_FOUND"
+	PublicHTTPErrorTypeSESSIONNOTFOUND PublicHTTPErrorType = "SESSION_NOT_FOUND"
+
+	// PublicHTTPErrorTypeMISSINGPERMISSION captures enum value "MISSING_PERMISSION"
+	PublicHTTPErrorTypeMISSINGPERMISSION PublicHTTPErrorType = "MISSING_PERMISSION"
+
+	// PublicHTTPErrorTypePASSWORDRESETREQUIRED captures enum value "PASSWORD_RESET_REQUIRED"
+	PublicHTTPErrorTypePASSWORDRESETREQUIRED PublicHTTPErrorType = "PASSWORD_RESET_REQUIRED"
+
+	// PublicHTTPErrorTypeAPIKEYNOTFOUND captures enum value "API_KEY_NOT_FOUND"
+	PublicHTTPErrorTypeAPIKEYNOTFOUND PublicHTTPErrorType = "API_KEY_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDAPIKEYSCOPES captures enum value "INVALID_API_KEY_SCOPES"
+	PublicHTTPErrorTypeINVALIDAPIKEYSCOPES PublicHTTPErrorType = "INVALID_API_KEY_SCOPES"
+
+	// PublicHTTPErrorTypeINVALIDAPIKEYEXPIRY captures enum value "INVALID_API_KEY_EXPIRY"
+	PublicHTTPErrorTypeINVALIDAPIKEYEXPIRY PublicHTTPErrorType = "INVALID_API_KEY_EXPIRY"
+
+	// PublicHTTPErrorTypePASSKEYNOTFOUND captures enum value "PASSKEY_NOT_FOUND"
+	PublicHTTPErrorTypePASSKEYNOTFOUND PublicHTTPErrorType = "PASSKEY_NOT_FOUND"
+
+	// PublicHTTPErrorTypePASSKEYALREADYREGISTERED captures enum value "PASSKEY_ALREADY_REGISTERED"
+	PublicHTTPErrorTypePASSKEYALREADYREGISTERED PublicHTTPErrorType = "PASSKEY_ALREADY_REGISTERED"
+
+	// PublicHTTPErrorTypeINVALIDPASSKEY captures enum value "INVALID_PASSKEY"
+	PublicHTTPErrorTypeINVALIDPASSKEY PublicHTTPErrorType = "INVALID_PASSKEY"
+
+	// PublicHTTPErrorTypeUSERMISSINGEMAIL captures enum value "USER_MISSING_EMAIL"
+	PublicHTTPErrorTypeUSERMISSINGEMAIL PublicHTTPErrorType = "USER_MISSING_EMAIL"
+
+	// PublicHTTPErrorTypeIMPERSONATIONFORBIDDEN captures enum value "IMPERSONATION_FORBIDDEN"
+	PublicHTTPErrorTypeIMPERSONATIONFORBIDDEN PublicHTTPErrorType = "IMPERSONATION_FORBIDDEN"
+
+	// PublicHTTPErrorTypeINVITEREQUIRED captures enum value "INVITE_REQUIRED"
+	PublicHTTPErrorTypeINVITEREQUIRED PublicHTTPErrorType = "INVITE_REQUIRED"
+
+	// PublicHTTPErrorTypeINVITEEMAILMISMATCH captures enum value "INVITE_EMAIL_MISMATCH"
+	PublicHTTPErrorTypeINVITEEMAILMISMATCH PublicHTTPErrorType = "INVITE_EMAIL_MISMATCH"
+
+	// PublicHTTPErrorTypeUSERNOTFOUND captures enum value "USER_NOT_FOUND"
+	PublicHTTPErrorTypeUSERNOTFOUND PublicHTTPErrorType = "USER_NOT_FOUND"
+
+	// PublicHTTPErrorTypeROLENOTFOUND captures enum value "ROLE_NOT_FOUND"
+	PublicHTTPErrorTypeROLENOTFOUND PublicHTTPErrorType = "ROLE_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDCURSOR captures enum value "INVALID_CURSOR"
+	PublicHTTPErrorTypeINVALIDCURSOR PublicHTTPErrorType = "INVALID_CURSOR"
+
+	// PublicHTTPErrorTypeUSERNOTIMPERSONATABLE captures enum value "USER_NOT_IMPERSONATABLE"
+	PublicHTTPErrorTypeUSERNOTIMPERSONATABLE PublicHTTPErrorType = "USER_NOT_IMPERSONATABLE"
+
+	// PublicHTTPErrorTypeINVITENOTFOUND captures enum value "INVITE_NOT_FOUND"
+	PublicHTTPErrorTypeINVITENOTFOUND PublicHTTPErrorType = "INVITE_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDINVITEEXPIRY captures enum value "INVALID_INVITE_EXPIRY"
+	PublicHTTPErrorTypeINVALIDINVITEEXPIRY PublicHTTPErrorType = "INVALID_INVITE_EXPIRY"
 )
 
 // for schema
@@ -75,7 +168,7 @@ var publicHttpErrorTypeEnum []interface{}
 
 func init() {
 	var res []PublicHTTPErrorType
-	if err := json.Unmarshal([]byte(`["generic","PUSH_TOKEN_ALREADY_EXISTS","OLD_PUSH_TOKEN_NOT_FOUND","ZERO_FILE_SIZE","USER_DEACTIVATED","INVALID_PASSWORD","NOT_LOCAL_USER","TOKEN_NOT_FOUND","TOKEN_EXPIRED","USER_ALREADY_EXISTS","MALFORMED_TOKEN","LAST_AUTHENTICATED_AT_EXCEEDED","MISSING_SCOPES"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["generic","PUSH_TOKEN_ALREADY_EXISTS","OLD_PUSH_TOKEN_NOT_FOUND","PUSH_BROADCAST_NOT_FOUND","INVALID_PUSH_BROADCAST_SCHEDULE","INVALID_TIME_ZONE","INVALID_QUIET_HOURS","NOTIFICATION_CATEGORY_MANDATORY","ZERO_FILE_SIZE","USER_DEACTIVATED","INVALID_PASSWORD","NOT_LOCAL_USER","TOKEN_NOT_FOUND","TOKEN_EXPIRED","USER_ALREADY_EXISTS","MALFORMED_TOKEN","LAST_AUTHENTICATED_AT_EXCEEDED","MISSING_SCOPES","TOTP_ALREADY_ENABLED","TOTP_NOT_ENABLED","INVALID_TOTP_CODE","TOO_MANY_ATTEMPTS","RATE_LIMIT_EXCEEDED","OIDC_PROVIDER_NOT_FOUND","OIDC_AUTHENTICATION_FAILED","SESSION_NOT_FOUND","MISSING_PERMISSION","PASSWORD_RESET_REQUIRED","API_KEY_NOT_FOUND","INVALID_API_KEY_SCOPES","INVALID_API_KEY_EXPIRY","PASSKEY_NOT_FOUND","PASSKEY_ALREADY_REGISTERED","INVALID_PASSKEY","USER_MISSING_EMAIL","IMPERSONATION_FORBIDDEN","INVITE_REQUIRED","INVITE_EMAIL_MISMATCH","USER_NOT_FOUND","ROLE_NOT_FOUND","INVALID_CURSOR","USER_NOT_IMPERSONATABLE","INVITE_NOT_FOUND","INVALID_INVITE_EXPIRY"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
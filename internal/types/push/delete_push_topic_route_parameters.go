@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package push
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeletePushTopicRouteParams creates a new DeletePushTopicRouteParams object
+// no default values defined in spec.
+func NewDeletePushTopicRouteParams() DeletePushTopicRouteParams {
+
+	return DeletePushTopicRouteParams{}
+}
+
+// DeletePushTopicRouteParams contains all the bound params for the delete push topic route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters DeletePushTopicRoute
+type DeletePushTopicRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Name of the topic
+	  Required: true
+	  Max Length: 100
+	  Pattern: ^[a-zA-Z0-9_.-]+$
+	  In: path
+	*/
+	Topic string `param:"topic"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeletePushTopicRouteParams() beforehand.
+func (o *DeletePushTopicRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rTopic, rhkTopic, _ := route.Params.GetOK("topic")
+	if err := o.bindTopic(rTopic, rhkTopic, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *DeletePushTopicRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// topic
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	if err := o.validateTopic(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTopic binds and validates parameter Topic from path.
+func (o *DeletePushTopicRouteParams) bindTopic(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Topic = raw
+
+	if err := o.validateTopic(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTopic carries on validations for parameter Topic
+func (o *DeletePushTopicRouteParams) validateTopic(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("topic", "path", o.Topic, 100); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("topic", "path", o.Topic, `^[a-zA-Z0-9_.-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
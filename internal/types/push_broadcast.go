@@ -0,0 +1,383 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PushBroadcast push broadcast
+//
+// swagger:model pushBroadcast
+type PushBroadcast struct {
+
+	// Body of the notification
+	// Example: Check out what's new in the app.
+	// Required: true
+	Body *string `json:"body"`
+
+	// Notification category, users who disabled push notifications of the category are not notified
+	// Required: true
+	// Enum: [updates marketing]
+	Category *string `json:"category"`
+
+	// Time the push broadcast was created
+	// Example: 2026-10-18T11:00:00.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// ID of the user who created the push broadcast, empty if the user has been deleted
+	// Example: 891d37d3-c74f-493e-aea8-af73efd92016
+	// Format: uuid4
+	CreatedByID strfmt.UUID4 `json:"createdById,omitempty"`
+
+	// Custom data passed to the app
+	// Example: {"newsId":"42"}
+	// Required: true
+	Data map[string]string `json:"data"`
+
+	// Link opened by the app once the notification is tapped
+	// Example: gostarter://news/42
+	DeepLink string `json:"deepLink,omitempty"`
+
+	// Number of devices the notification could not be delivered to
+	// Example: 3
+	// Required: true
+	FailedCount *int64 `json:"failedCount"`
+
+	// ID of the push broadcast
+	// Example: 0f6c2a8e-5b1d-4e3f-9a7c-2d4e6f8a0b1c
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// URL of the image attached to the notification
+	// Example: https://example.com/image.png
+	ImageURL string `json:"imageUrl,omitempty"`
+
+	// Only devices with the locale, or a regional variant of it, are notified
+	// Example: de
+	Locale string `json:"locale,omitempty"`
+
+	// Time the push broadcast is sent at
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	ScheduledAt *strfmt.DateTime `json:"scheduledAt"`
+
+	// Only users with the scope are notified
+	// Example: app
+	Scope string `json:"scope,omitempty"`
+
+	// Time the push broadcast was sent
+	// Example: 2026-10-18T12:00:05.000Z
+	// Format: date-time
+	SentAt strfmt.DateTime `json:"sentAt,omitempty"`
+
+	// Number of devices the notification was delivered to
+	// Example: 1250
+	// Required: true
+	SentCount *int64 `json:"sentCount"`
+
+	// Status of the push broadcast
+	// Required: true
+	// Enum: [scheduled sending sent]
+	Status *string `json:"status"`
+
+	// Title of the notification
+	// Example: New features available
+	// Required: true
+	Title *string `json:"title"`
+
+	// Only users subscribed to the topic are notified
+	// Example: news
+	Topic string `json:"topic,omitempty"`
+}
+
+// Validate validates this push broadcast
+func (m *PushBroadcast) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBody(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCategory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedByID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailedCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScheduledAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSentAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSentCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PushBroadcast) validateBody(formats strfmt.Registry) error {
+
+	if err := validate.Required("body", "body", m.Body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var pushBroadcastTypeCategoryPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["updates","marketing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		pushBroadcastTypeCategoryPropEnum = append(pushBroadcastTypeCategoryPropEnum, v)
+	}
+}
+
+const (
+
+	// PushBroadcastCategoryUpdates captures enum value "updates"
+	PushBroadcastCategoryUpdates string = "updates"
+
+	// PushBroadcastCategoryMarketing captures enum value "marketing"
+	PushBroadcastCategoryMarketing string = "marketing"
+)
+
+// prop value enum
+func (m *PushBroadcast) validateCategoryEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, pushBroadcastTypeCategoryPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PushBroadcast) validateCategory(formats strfmt.Registry) error {
+
+	if err := validate.Required("category", "body", m.Category); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCategoryEnum("category", "body", *m.Category); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateCreatedByID(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedByID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdById", "body", "uuid4", m.CreatedByID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateData(formats strfmt.Registry) error {
+
+	if err := validate.Required("data", "body", m.Data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateFailedCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("failedCount", "body", m.FailedCount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateScheduledAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("scheduledAt", "body", m.ScheduledAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("scheduledAt", "body", "date-time", m.ScheduledAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateSentAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.SentAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("sentAt", "body", "date-time", m.SentAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateSentCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("sentCount", "body", m.SentCount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var pushBroadcastTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["scheduled","sending","sent"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		pushBroadcastTypeStatusPropEnum = append(pushBroadcastTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// PushBroadcastStatusScheduled captures enum value "scheduled"
+	PushBroadcastStatusScheduled string = "scheduled"
+
+	// PushBroadcastStatusSending captures enum value "sending"
+	PushBroadcastStatusSending string = "sending"
+
+	// PushBroadcastStatusSent captures enum value "sent"
+	PushBroadcastStatusSent string = "sent"
+)
+
+// prop value enum
+func (m *PushBroadcast) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, pushBroadcastTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PushBroadcast) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushBroadcast) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this push broadcast based on context it is used
+func (m *PushBroadcast) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PushBroadcast) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PushBroadcast) UnmarshalBinary(b []byte) error {
+	var res PushBroadcast
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,147 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PutNotificationCategoryPreference put notification category preference
+//
+// swagger:model putNotificationCategoryPreference
+type PutNotificationCategoryPreference struct {
+
+	// category
+	// Required: true
+	Category *NotificationCategory `json:"category"`
+
+	// Enables emails of the category
+	// Example: false
+	// Required: true
+	Email *bool `json:"email"`
+
+	// Enables push notifications of the category
+	// Example: true
+	// Required: true
+	Push *bool `json:"push"`
+}
+
+// Validate validates this put notification category preference
+func (m *PutNotificationCategoryPreference) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCategory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePush(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PutNotificationCategoryPreference) validateCategory(formats strfmt.Registry) error {
+
+	if err := validate.Required("category", "body", m.Category); err != nil {
+		return err
+	}
+
+	if err := validate.Required("category", "body", m.Category); err != nil {
+		return err
+	}
+
+	if m.Category != nil {
+		if err := m.Category.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("category")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("category")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PutNotificationCategoryPreference) validateEmail(formats strfmt.Registry) error {
+
+	if err := validate.Required("email", "body", m.Email); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PutNotificationCategoryPreference) validatePush(formats strfmt.Registry) error {
+
+	if err := validate.Required("push", "body", m.Push); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this put notification category preference based on the context it is used
+func (m *PutNotificationCategoryPreference) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCategory(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PutNotificationCategoryPreference) contextValidateCategory(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Category != nil {
+		if err := m.Category.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("category")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("category")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PutNotificationCategoryPreference) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PutNotificationCategoryPreference) UnmarshalBinary(b []byte) error {
+	var res PutNotificationCategoryPreference
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,193 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PutNotificationPreferencesPayload put notification preferences payload
+//
+// swagger:model putNotificationPreferencesPayload
+type PutNotificationPreferencesPayload struct {
+
+	// categories
+	// Max Items: 20
+	Categories []*PutNotificationCategoryPreference `json:"categories"`
+
+	// quiet hours
+	QuietHours *QuietHours `json:"quietHours,omitempty"`
+
+	// IANA time zone of the user, quiet hours are evaluated in it
+	// Example: Europe/Vienna
+	// Required: true
+	// Max Length: 64
+	TimeZone *string `json:"timeZone"`
+}
+
+// Validate validates this put notification preferences payload
+func (m *PutNotificationPreferencesPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCategories(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateQuietHours(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeZone(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PutNotificationPreferencesPayload) validateCategories(formats strfmt.Registry) error {
+	if swag.IsZero(m.Categories) { // not required
+		return nil
+	}
+
+	iCategoriesSize := int64(len(m.Categories))
+
+	if err := validate.MaxItems("categories", "body", iCategoriesSize, 20); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Categories); i++ {
+		if swag.IsZero(m.Categories[i]) { // not required
+			continue
+		}
+
+		if m.Categories[i] != nil {
+			if err := m.Categories[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("categories" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("categories" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PutNotificationPreferencesPayload) validateQuietHours(formats strfmt.Registry) error {
+	if swag.IsZero(m.QuietHours) { // not required
+		return nil
+	}
+
+	if m.QuietHours != nil {
+		if err := m.QuietHours.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quietHours")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quietHours")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PutNotificationPreferencesPayload) validateTimeZone(formats strfmt.Registry) error {
+
+	if err := validate.Required("timeZone", "body", m.TimeZone); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("timeZone", "body", *m.TimeZone, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this put notification preferences payload based on the context it is used
+func (m *PutNotificationPreferencesPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCategories(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateQuietHours(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PutNotificationPreferencesPayload) contextValidateCategories(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Categories); i++ {
+
+		if m.Categories[i] != nil {
+			if err := m.Categories[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("categories" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("categories" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PutNotificationPreferencesPayload) contextValidateQuietHours(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.QuietHours != nil {
+		if err := m.QuietHours.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quietHours")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quietHours")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PutNotificationPreferencesPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PutNotificationPreferencesPayload) UnmarshalBinary(b []byte) error {
+	var res PutNotificationPreferencesPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,12 @@ import (
 // swagger:model putUpdatePushTokenPayload
 type PutUpdatePushTokenPayload struct {
 
+	// BCP 47 language tag of the device locale, used to target broadcasts.
+	// Example: de-AT
+	// Max Length: 35
+	// Pattern: ^[a-zA-Z]{2,8}(-[a-zA-Z0-9]{1,8})*$
+	Locale *string `json:"locale,omitempty"`
+
 	// New push token for given provider.
 	// Example: 1c91e550-8167-439c-8021-dee7de2f7e96
 	// Required: true
@@ -41,6 +47,10 @@ type PutUpdatePushTokenPayload struct {
 func (m *PutUpdatePushTokenPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateLocale(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNewToken(formats); err != nil {
 		res = append(res, err)
 	}
@@ -59,6 +69,22 @@ func (m *PutUpdatePushTokenPayload) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutUpdatePushTokenPayload) validateLocale(formats strfmt.Registry) error {
+	if swag.IsZero(m.Locale) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("locale", "body", *m.Locale, 35); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("locale", "body", *m.Locale, `^[a-zA-Z]{2,8}(-[a-zA-Z0-9]{1,8})*$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PutUpdatePushTokenPayload) validateNewToken(formats strfmt.Registry) error {
 
 	if err := validate.Required("newToken", "body", m.NewToken); err != nil {
@@ -0,0 +1,97 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PutUserRolesPayload put user roles payload
+//
+// swagger:model putUserRolesPayload
+type PutUserRolesPayload struct {
+
+	// Names of the roles to assign to the user, replacing all currently assigned roles
+	// Example: ["app","cms"]
+	// Required: true
+	// Max Items: 50
+	// Unique: true
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this put user roles payload
+func (m *PutUserRolesPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PutUserRolesPayload) validateRoles(formats strfmt.Registry) error {
+
+	if err := validate.Required("roles", "body", m.Roles); err != nil {
+		return err
+	}
+
+	iRolesSize := int64(len(m.Roles))
+
+	if err := validate.MaxItems("roles", "body", iRolesSize, 50); err != nil {
+		return err
+	}
+
+	if err := validate.UniqueItems("roles", "body", m.Roles); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Roles); i++ {
+
+		if err := validate.MinLength("roles"+"."+strconv.Itoa(i), "body", m.Roles[i], 1); err != nil {
+			return err
+		}
+
+		if err := validate.MaxLength("roles"+"."+strconv.Itoa(i), "body", m.Roles[i], 255); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this put user roles payload based on context it is used
+func (m *PutUserRolesPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PutUserRolesPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PutUserRolesPayload) UnmarshalBinary(b []byte) error {
+	var res PutUserRolesPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,100 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// QuietHours quiet hours
+//
+// swagger:model quietHours
+type QuietHours struct {
+
+	// End of the quiet hours in the time zone of the user (HH:MM), quiet hours ending before they start span midnight
+	// Example: 07:00
+	// Required: true
+	// Pattern: ^([01][0-9]|2[0-3]):[0-5][0-9]$
+	End *string `json:"end"`
+
+	// Start of the quiet hours in the time zone of the user (HH:MM)
+	// Example: 22:00
+	// Required: true
+	// Pattern: ^([01][0-9]|2[0-3]):[0-5][0-9]$
+	Start *string `json:"start"`
+}
+
+// Validate validates this quiet hours
+func (m *QuietHours) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEnd(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStart(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *QuietHours) validateEnd(formats strfmt.Registry) error {
+
+	if err := validate.Required("end", "body", m.End); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("end", "body", *m.End, `^([01][0-9]|2[0-3]):[0-5][0-9]$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *QuietHours) validateStart(formats strfmt.Registry) error {
+
+	if err := validate.Required("start", "body", m.Start); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("start", "body", *m.Start, `^([01][0-9]|2[0-3]):[0-5][0-9]$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this quiet hours based on context it is used
+func (m *QuietHours) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *QuietHours) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *QuietHours) UnmarshalBinary(b []byte) error {
+	var res QuietHours
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
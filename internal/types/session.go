@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Session session
+//
+// swagger:model session
+type Session struct {
+
+	// Whether the session is the one of the access token used for the request
+	// Example: true
+	// Required: true
+	Current *bool `json:"current"`
+
+	// Name of the device as provided during login
+	// Example: iPhone 15 Pro
+	DeviceName string `json:"device_name,omitempty"`
+
+	// ID of the session, stays the same when refreshing tokens
+	// Example: 2b0ee7c1-6a1b-4b4e-9d43-6d3a4c1b2f70
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// IP address of the client during the last login or token refresh
+	// Example: 203.0.113.42
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// Time of the last login or token refresh of the session
+	// Example: 2026-10-18T12:00:00.000Z
+	// Required: true
+	// Format: date-time
+	LastUsedAt *strfmt.DateTime `json:"last_used_at"`
+
+	// User agent of the client during the last login or token refresh
+	// Example: go-starter/1.0 (iOS 18.0)
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+// Validate validates this session
+func (m *Session) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastUsedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Session) validateCurrent(formats strfmt.Registry) error {
+
+	if err := validate.Required("current", "body", m.Current); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Session) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Session) validateLastUsedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("last_used_at", "body", m.LastUsedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("last_used_at", "body", "date-time", m.LastUsedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this session based on context it is used
+func (m *Session) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Session) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Session) UnmarshalBinary(b []byte) error {
+	var res Session
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
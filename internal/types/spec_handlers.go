@@ -36,22 +36,73 @@ func (o *SwaggerSpec) initHandlerCache() {
 	o.Handlers["HEAD"] = make(map[string]bool)
 	o.Handlers["PATCH"] = make(map[string]bool)
 
+	o.Handlers["DELETE"]["/api/v1/auth/api-keys/{id}"] = true
+	o.Handlers["DELETE"]["/api/v1/admin/invites/{id}"] = true
+	o.Handlers["DELETE"]["/api/v1/admin/push/broadcasts/{id}"] = true
+	o.Handlers["DELETE"]["/api/v1/admin/users/{id}/api-keys/{apiKeyId}"] = true
+	o.Handlers["DELETE"]["/api/v1/auth/passkeys/{id}"] = true
+	o.Handlers["DELETE"]["/api/v1/push/topics/{topic}"] = true
+	o.Handlers["DELETE"]["/api/v1/auth/sessions/{id}"] = true
 	o.Handlers["DELETE"]["/api/v1/auth/account"] = true
+	o.Handlers["GET"]["/api/v1/auth/api-keys"] = true
+	o.Handlers["GET"]["/api/v1/admin/audit-events"] = true
+	o.Handlers["GET"]["/api/v1/admin/invites"] = true
+	o.Handlers["GET"]["/api/v1/admin/push/broadcasts"] = true
+	o.Handlers["GET"]["/api/v1/admin/users/{id}/api-keys"] = true
+	o.Handlers["GET"]["/api/v1/admin/users/{id}"] = true
+	o.Handlers["GET"]["/api/v1/admin/users"] = true
 	o.Handlers["GET"]["/.well-known/assetlinks.json"] = true
 	o.Handlers["GET"]["/.well-known/apple-app-site-association"] = true
 	o.Handlers["GET"]["/api/v1/auth/register"] = true
+	o.Handlers["GET"]["/api/v1/auth/account/export/download"] = true
 	o.Handlers["GET"]["/-/healthy"] = true
+	o.Handlers["GET"]["/.well-known/jwks.json"] = true
+	o.Handlers["GET"]["/api/v1/notifications/preferences"] = true
+	o.Handlers["GET"]["/api/v1/auth/oidc/{provider}/authorize"] = true
+	o.Handlers["GET"]["/api/v1/auth/passkeys"] = true
+	o.Handlers["GET"]["/api/v1/push/topics"] = true
 	o.Handlers["GET"]["/-/ready"] = true
+	o.Handlers["GET"]["/api/v1/admin/roles"] = true
+	o.Handlers["GET"]["/api/v1/auth/sessions"] = true
 	o.Handlers["GET"]["/swagger.yml"] = true
 	o.Handlers["GET"]["/api/v1/auth/userinfo"] = true
 	o.Handlers["GET"]["/-/version"] = true
+	o.Handlers["POST"]["/api/v1/auth/api-keys"] = true
+	o.Handlers["POST"]["/api/v1/auth/account/deletion/cancel"] = true
+	o.Handlers["POST"]["/api/v1/admin/invites"] = true
+	o.Handlers["POST"]["/api/v1/admin/push/broadcasts"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/api-keys"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/activate"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/deactivate"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/impersonate"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/logout"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/password-reset"] = true
+	o.Handlers["POST"]["/api/v1/auth/change-email/confirm"] = true
+	o.Handlers["POST"]["/api/v1/auth/change-email/revert"] = true
+	o.Handlers["POST"]["/api/v1/auth/change-email"] = true
 	o.Handlers["POST"]["/api/v1/auth/change-password"] = true
 	o.Handlers["POST"]["/api/v1/auth/register/{registrationToken}"] = true
+	o.Handlers["POST"]["/api/v1/auth/account/export"] = true
 	o.Handlers["POST"]["/api/v1/auth/forgot-password/complete"] = true
 	o.Handlers["POST"]["/api/v1/auth/forgot-password"] = true
 	o.Handlers["POST"]["/api/v1/auth/login"] = true
 	o.Handlers["POST"]["/api/v1/auth/logout"] = true
+	o.Handlers["POST"]["/api/v1/auth/magic-link/complete"] = true
+	o.Handlers["POST"]["/api/v1/auth/magic-link"] = true
+	o.Handlers["POST"]["/api/v1/auth/oidc/{provider}/callback"] = true
+	o.Handlers["POST"]["/api/v1/auth/passkeys/login/begin"] = true
+	o.Handlers["POST"]["/api/v1/auth/passkeys/login/finish"] = true
+	o.Handlers["POST"]["/api/v1/auth/passkeys/register/begin"] = true
+	o.Handlers["POST"]["/api/v1/auth/passkeys/register/finish"] = true
 	o.Handlers["POST"]["/api/v1/auth/refresh"] = true
 	o.Handlers["POST"]["/api/v1/auth/register"] = true
+	o.Handlers["POST"]["/api/v1/auth/sessions/revoke-others"] = true
+	o.Handlers["POST"]["/api/v1/auth/2fa/confirm"] = true
+	o.Handlers["POST"]["/api/v1/auth/2fa/disable"] = true
+	o.Handlers["POST"]["/api/v1/auth/2fa/enroll"] = true
+	o.Handlers["POST"]["/api/v1/auth/2fa/verify"] = true
+	o.Handlers["PUT"]["/api/v1/notifications/preferences"] = true
+	o.Handlers["PUT"]["/api/v1/push/topics/{topic}"] = true
 	o.Handlers["PUT"]["/api/v1/push/token"] = true
+	o.Handlers["PUT"]["/api/v1/admin/users/{id}/roles"] = true
 }
@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UserRolesResponse user roles response
+//
+// swagger:model userRolesResponse
+type UserRolesResponse struct {
+
+	// Permissions granted to the user by the assigned roles
+	// Example: ["roles:read","roles:assign"]
+	// Required: true
+	Permissions []string `json:"permissions"`
+
+	// Names of the roles assigned to the user
+	// Example: ["app","cms"]
+	// Required: true
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this user roles response
+func (m *UserRolesResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePermissions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UserRolesResponse) validatePermissions(formats strfmt.Registry) error {
+
+	if err := validate.Required("permissions", "body", m.Permissions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserRolesResponse) validateRoles(formats strfmt.Registry) error {
+
+	if err := validate.Required("roles", "body", m.Roles); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this user roles response based on context it is used
+func (m *UserRolesResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserRolesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserRolesResponse) UnmarshalBinary(b []byte) error {
+	var res UserRolesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
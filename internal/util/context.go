@@ -12,11 +12,20 @@ type contextKey string
 const (
 	CTXKeyUser          contextKey = "user"
 	CTXKeyAccessToken   contextKey = "access_token"
+	CTXKeyPermissions   contextKey = "permissions"
+	CTXKeyImpersonator  contextKey = "impersonator"
 	CTXKeyCacheControl  contextKey = "cache_control"
 	CTXKeyRequestID     contextKey = "request_id"
 	CTXKeyDisableLogger contextKey = "disable_logger"
+	CTXKeyClientInfo    contextKey = "client_info"
 )
 
+// ClientInfo describes the client performing the (HTTP) request.
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
 //nolint:containedctx
 type detachedContext struct {
 	parent context.Context
@@ -49,6 +58,26 @@ func RequestIDFromContext(ctx context.Context) (string, error) {
 	return id, nil
 }
 
+// WithClientInfo stores the info of the requesting client in the context, see ClientInfoFromContext.
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, CTXKeyClientInfo, info)
+}
+
+// ClientInfoFromContext returns the info of the client performing the (HTTP) request, returning an error if it is not present.
+func ClientInfoFromContext(ctx context.Context) (ClientInfo, error) {
+	val := ctx.Value(CTXKeyClientInfo)
+	if val == nil {
+		return ClientInfo{}, errors.New("no client info present in context")
+	}
+
+	info, ok := val.(ClientInfo)
+	if !ok {
+		return ClientInfo{}, errors.New("client info in context is not of type ClientInfo")
+	}
+
+	return info, nil
+}
+
 // ShouldDisableLogger checks whether the logger instance should be disabled for the provided context.
 // `util.LogFromContext` will use this function to check whether it should return a default logger if
 // none has been set by our logging middleware before, or fall back to the disabled logger, suppressing
@@ -0,0 +1,66 @@
+package hashing
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// MaxCalibratedTime caps the time cost chosen by Calibrate, preventing excessive costs on slow hardware.
+	MaxCalibratedTime uint32 = 16
+
+	// calibrationRuns is the number of hashes measured per time cost, using the fastest one as noise
+	// (e.g. other processes) only ever slows hashing down.
+	calibrationRuns = 3
+	// calibrationPassword is hashed during calibration, its value does not influence the duration.
+	calibrationPassword = "calibration"
+)
+
+// Calibrate benchmarks hashing with params on the current hardware and returns a copy of params with the time
+// cost raised until a hash takes at least target, along with the measured duration. Memory and threads are kept
+// as configured, as the memory cost should be set to the maximum affordable (see Hasher) and threads to the
+// available cores. The returned params are never weaker than the provided ones.
+func Calibrate(params *Argon2Params, target time.Duration) (*Argon2Params, time.Duration, error) {
+	calibrated := *params
+	if calibrated.Time < 1 {
+		calibrated.Time = 1
+	}
+
+	elapsed, err := measureHashPassword(&calibrated)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for elapsed < target && calibrated.Time < MaxCalibratedTime {
+		// the duration grows linearly with the time cost, estimate the required one to converge quickly
+		estimated := math.Ceil(float64(calibrated.Time) * float64(target) / float64(max(elapsed, time.Microsecond)))
+		next := uint32(min(estimated, float64(MaxCalibratedTime)))
+		if next <= calibrated.Time {
+			next = calibrated.Time + 1
+		}
+
+		calibrated.Time = next
+
+		elapsed, err = measureHashPassword(&calibrated)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return &calibrated, elapsed, nil
+}
+
+func measureHashPassword(params *Argon2Params) (time.Duration, error) {
+	fastest := time.Duration(math.MaxInt64)
+
+	for range calibrationRuns {
+		start := time.Now()
+		if _, err := HashPassword(calibrationPassword, params); err != nil {
+			return 0, err
+		}
+
+		fastest = min(fastest, time.Since(start))
+	}
+
+	return fastest, nil
+}
@@ -0,0 +1,77 @@
+package hashing
+
+import (
+	"context"
+	"fmt"
+)
+
+// Hasher hashes passwords using Params and verifies them against stored hashes, allowing at most
+// maxConcurrency argon2 computations at the same time. Every computation allocates the memory cost
+// of its params (Memory KiB), so without limit bursts of logins could exhaust the available memory.
+type Hasher struct {
+	Params *Argon2Params
+
+	sem chan struct{}
+}
+
+func NewHasher(params *Argon2Params, maxConcurrency int) *Hasher {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	return &Hasher{
+		Params: params,
+		sem:    make(chan struct{}, maxConcurrency),
+	}
+}
+
+// HashPassword hashes the password using the hasher's params, waiting for a free slot until ctx is done.
+func (h *Hasher) HashPassword(ctx context.Context, password string) (string, error) {
+	if err := h.acquire(ctx); err != nil {
+		return "", err
+	}
+	defer h.release()
+
+	return HashPassword(password, h.Params)
+}
+
+// ComparePasswordAndHash verifies the password against the hash, waiting for a free slot until ctx is done.
+func (h *Hasher) ComparePasswordAndHash(ctx context.Context, password string, hash string) (bool, error) {
+	if err := h.acquire(ctx); err != nil {
+		return false, err
+	}
+	defer h.release()
+
+	return ComparePasswordAndHash(password, hash)
+}
+
+// NeedsRehash reports whether the hash was created using weaker params than the hasher's ones.
+func (h *Hasher) NeedsRehash(hash string) (bool, error) {
+	params, salt, _, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	return params.Memory < h.Params.Memory ||
+		params.Time < h.Params.Time ||
+		params.KeyLength < h.Params.KeyLength ||
+		uint64(len(salt)) < uint64(h.Params.SaltLength), nil
+}
+
+func (h *Hasher) acquire(ctx context.Context) error {
+	// canceled requests must not start hashing, even if a slot is available
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to wait for password hashing: %w", err)
+	}
+
+	select {
+	case h.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for password hashing: %w", ctx.Err())
+	}
+}
+
+func (h *Hasher) release() {
+	<-h.sem
+}
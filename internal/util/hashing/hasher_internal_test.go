@@ -0,0 +1,42 @@
+package hashing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasherLimitsConcurrency(t *testing.T) {
+	hasher := NewHasher(&Argon2Params{Time: 1, Memory: 1024, Threads: 1, KeyLength: 32, SaltLength: 16}, 2)
+
+	// occupy all slots, as if two hashes were being computed
+	hasher.sem <- struct{}{}
+	hasher.sem <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := hasher.HashPassword(ctx, "t3stp4ssw0rd")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// waiting calls continue once a slot is released
+	done := make(chan error)
+	go func() {
+		_, err := hasher.HashPassword(t.Context(), "t3stp4ssw0rd")
+		done <- err
+	}()
+
+	hasher.release()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("hashing did not continue after releasing a slot")
+	}
+
+	assert.Len(t, hasher.sem, 1)
+}
@@ -0,0 +1,127 @@
+package hashing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testArgon2Params keeps hashing cheap, as the tests do not depend on the costs
+var testArgon2Params = &hashing.Argon2Params{
+	Time:       1,
+	Memory:     1024,
+	Threads:    1,
+	KeyLength:  32,
+	SaltLength: 16,
+}
+
+func TestHasherHashAndCompare(t *testing.T) {
+	hasher := hashing.NewHasher(testArgon2Params, 2)
+
+	hash, err := hasher.HashPassword(t.Context(), "t3stp4ssw0rd")
+	require.NoError(t, err)
+	assert.Contains(t, hash, "$m=1024,t=1,p=1$")
+
+	match, err := hasher.ComparePasswordAndHash(t.Context(), "t3stp4ssw0rd", hash)
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = hasher.ComparePasswordAndHash(t.Context(), "wr0ngt3stp4ssw0rd", hash)
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestHasherNeedsRehash(t *testing.T) {
+	hasher := hashing.NewHasher(hashing.DefaultArgon2Params, 1)
+
+	tests := []struct {
+		name     string
+		params   hashing.Argon2Params
+		expected bool
+	}{
+		{
+			name:     "SameParams",
+			params:   *hashing.DefaultArgon2Params,
+			expected: false,
+		},
+		{
+			name:     "LowerMemory",
+			params:   hashing.Argon2Params{Time: 1, Memory: 32 * 1024, Threads: 4, KeyLength: 32, SaltLength: 16},
+			expected: true,
+		},
+		{
+			name:     "HigherTime",
+			params:   hashing.Argon2Params{Time: 2, Memory: 64 * 1024, Threads: 4, KeyLength: 32, SaltLength: 16},
+			expected: false,
+		},
+		{
+			name:     "ShorterKey",
+			params:   hashing.Argon2Params{Time: 1, Memory: 64 * 1024, Threads: 4, KeyLength: 16, SaltLength: 16},
+			expected: true,
+		},
+		{
+			name:     "ShorterSalt",
+			params:   hashing.Argon2Params{Time: 1, Memory: 64 * 1024, Threads: 4, KeyLength: 32, SaltLength: 8},
+			expected: true,
+		},
+		{
+			name:     "DifferentThreads",
+			params:   hashing.Argon2Params{Time: 1, Memory: 64 * 1024, Threads: 1, KeyLength: 32, SaltLength: 16},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := hashing.HashPassword("t3stp4ssw0rd", &tt.params)
+			require.NoError(t, err)
+
+			needsRehash, err := hasher.NeedsRehash(hash)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, needsRehash)
+		})
+	}
+
+	_, err := hasher.NeedsRehash("$argon2i$v=19$m=65536,t=1,p=4$c8FqPHMT83tyxE2v0xDAFw$s2qmbRoRRbfyLIVFUzRwzE7F8PLjchpLKaV7Wf7tHgk")
+	require.ErrorIs(t, err, hashing.ErrInvalidArgon2Hash)
+}
+
+func TestHasherCanceledContext(t *testing.T) {
+	hasher := hashing.NewHasher(testArgon2Params, 1)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := hasher.HashPassword(ctx, "t3stp4ssw0rd")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = hasher.ComparePasswordAndHash(ctx, "t3stp4ssw0rd", hash)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCalibrate(t *testing.T) {
+	params, elapsed, err := hashing.Calibrate(testArgon2Params, 20*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.GreaterOrEqual(t, params.Time, testArgon2Params.Time)
+	assert.True(t, elapsed >= 20*time.Millisecond || params.Time == hashing.MaxCalibratedTime, "elapsed %s with time %d", elapsed, params.Time)
+
+	// all other params are kept and the provided ones are not modified
+	assert.Equal(t, testArgon2Params.Memory, params.Memory)
+	assert.Equal(t, testArgon2Params.Threads, params.Threads)
+	assert.Equal(t, testArgon2Params.KeyLength, params.KeyLength)
+	assert.Equal(t, testArgon2Params.SaltLength, params.SaltLength)
+	assert.Equal(t, uint32(1), testArgon2Params.Time)
+
+	// params already exceeding the target are never weakened
+	stronger := *testArgon2Params
+	stronger.Time = 3
+
+	params, _, err = hashing.Calibrate(&stronger, time.Nanosecond)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), params.Time)
+}
@@ -31,7 +31,7 @@ func TestBindAndValidateSuccess(t *testing.T) {
 	//nolint:gosec
 	testToken := "a546daf5-c845-46a7-8fa6-3d94ae7e1424"
 	testResponse := &types.PostLoginResponse{
-		AccessToken:  conv.UUID4(strfmt.UUID4("afbcbc30-4794-48bd-93f1-08373a031fe3")),
+		AccessToken:  swag.String("afbcbc30-4794-48bd-93f1-08373a031fe3"),
 		RefreshToken: conv.UUID4(strfmt.UUID4("1dd1228c-fa9a-4755-b995-30e24dd6247d")),
 		ExpiresIn:    swag.Int64(3600),
 		TokenType:    swag.String("Bearer"),
@@ -0,0 +1,415 @@
+package oauth2
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	oidcDiscoveryPath          = "/.well-known/openid-configuration"
+	oidcJWKSMinRefreshInterval = time.Minute
+	oidcDefaultHTTPTimeout     = 10 * time.Second
+	oidcMaxResponseBytes       = 1 << 20
+	oidcAuthMethodPost         = "client_secret_post"
+)
+
+var (
+	ErrOIDCInvalidIDToken = errors.New("invalid ID token")
+)
+
+// OIDCProviderConfig configures the client of a single OpenID Connect provider.
+type OIDCProviderConfig struct {
+	// Name identifies the provider in API routes and linked user identities, e.g. "google".
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string `json:"-"` // sensitive
+	// RedirectURL is the URL the provider redirects to after authorization, handing the code and
+	// state to the app or frontend, which completes the login using our callback endpoint.
+	RedirectURL string
+	Scopes      []string
+}
+
+// OIDCDiscoveryDocument contains the subset of the provider metadata used by OIDCClient,
+// see https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OIDCDiscoveryDocument struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+type OIDCTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+}
+
+// OIDCBool is a bool claim, which some providers (e.g. Apple) encode as string.
+type OIDCBool bool
+
+func (b *OIDCBool) UnmarshalJSON(data []byte) error {
+	var val any
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	switch v := val.(type) {
+	case bool:
+		*b = OIDCBool(v)
+	case string:
+		*b = OIDCBool(strings.EqualFold(v, "true"))
+	default:
+		*b = false
+	}
+
+	return nil
+}
+
+// OIDCIDTokenClaims are the verified claims of an ID token.
+type OIDCIDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string   `json:"nonce,omitempty"`
+	Email         string   `json:"email,omitempty"`
+	EmailVerified OIDCBool `json:"email_verified,omitempty"`
+}
+
+// OIDCClient implements the authorization code flow with PKCE of an OpenID Connect provider.
+// The discovery document is loaded on first use and the provider's keys are reloaded if an
+// ID token is signed by an unknown key.
+type OIDCClient struct {
+	Config     OIDCProviderConfig
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *OIDCDiscoveryDocument
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+func NewOIDCClient(config OIDCProviderConfig, httpClient *http.Client) *OIDCClient {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: oidcDefaultHTTPTimeout}
+	}
+
+	return &OIDCClient{
+		Config:     config,
+		httpClient: httpClient,
+	}
+}
+
+// AuthCodeURL returns the URL of the provider's authorization endpoint to redirect the user to.
+func (c *OIDCClient) AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	discovery, err := c.Discovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse authorization endpoint: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.Config.ClientID)
+	q.Set("redirect_uri", c.Config.RedirectURL)
+	q.Set("scope", strings.Join(c.Config.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange redeems the authorization code for the provider's tokens.
+func (c *OIDCClient) Exchange(ctx context.Context, code string, codeVerifier string) (OIDCTokenResponse, error) {
+	discovery, err := c.Discovery(ctx)
+	if err != nil {
+		return OIDCTokenResponse{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.Config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", c.Config.ClientID)
+
+	// client_secret_basic is the default if the provider does not state otherwise
+	useBasicAuth := !slices.Contains(discovery.TokenEndpointAuthMethodsSupported, oidcAuthMethodPost)
+	if !useBasicAuth {
+		form.Set("client_secret", c.Config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return OIDCTokenResponse{}, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if useBasicAuth {
+		req.SetBasicAuth(url.QueryEscape(c.Config.ClientID), url.QueryEscape(c.Config.ClientSecret))
+	}
+
+	var result OIDCTokenResponse
+	if err := c.do(req, &result); err != nil {
+		return OIDCTokenResponse{}, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	if len(result.IDToken) == 0 {
+		return OIDCTokenResponse{}, errors.New("token response is missing id_token")
+	}
+
+	return result, nil
+}
+
+// VerifyIDToken verifies the signature, issuer, audience, expiry and nonce of the ID token.
+// Errors caused by the token itself wrap ErrOIDCInvalidIDToken.
+func (c *OIDCClient) VerifyIDToken(ctx context.Context, rawIDToken string, nonce string, now time.Time) (OIDCIDTokenClaims, error) {
+	discovery, err := c.Discovery(ctx)
+	if err != nil {
+		return OIDCIDTokenClaims{}, err
+	}
+
+	// unknown key IDs trigger a reload of the provider's keys, whose errors must not be masked as invalid token
+	var keyErr error
+	keyFunc := func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		key, err := c.publicKey(ctx, kid, now)
+		if err != nil {
+			keyErr = err
+		}
+
+		return key, err
+	}
+
+	var claims OIDCIDTokenClaims
+	if _, err := jwt.ParseWithClaims(rawIDToken, &claims, keyFunc,
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(c.Config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	); err != nil {
+		if keyErr != nil && !errors.Is(keyErr, ErrOIDCInvalidIDToken) {
+			return OIDCIDTokenClaims{}, keyErr
+		}
+
+		return OIDCIDTokenClaims{}, fmt.Errorf("%w: %w", ErrOIDCInvalidIDToken, err)
+	}
+
+	if len(claims.Subject) == 0 {
+		return OIDCIDTokenClaims{}, fmt.Errorf("%w: missing sub claim", ErrOIDCInvalidIDToken)
+	}
+
+	if claims.Nonce != nonce {
+		return OIDCIDTokenClaims{}, fmt.Errorf("%w: nonce mismatch", ErrOIDCInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// Discovery returns the provider's discovery document, loading it on first use.
+func (c *OIDCClient) Discovery(ctx context.Context) (*OIDCDiscoveryDocument, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.Config.IssuerURL, "/")+oidcDiscoveryPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	var discovery OIDCDiscoveryDocument
+	if err := c.do(req, &discovery); err != nil {
+		return nil, fmt.Errorf("failed to load discovery document: %w", err)
+	}
+
+	// see https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationValidation
+	if discovery.Issuer != c.Config.IssuerURL {
+		return nil, fmt.Errorf("discovery document issuer %q does not match configured issuer %q", discovery.Issuer, c.Config.IssuerURL)
+	}
+
+	if len(discovery.AuthorizationEndpoint) == 0 || len(discovery.TokenEndpoint) == 0 || len(discovery.JWKSURI) == 0 {
+		return nil, errors.New("discovery document is missing required endpoints")
+	}
+
+	c.discovery = &discovery
+
+	return c.discovery, nil
+}
+
+func (c *OIDCClient) publicKey(ctx context.Context, kid string, now time.Time) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+
+	if !c.keysFetchedAt.IsZero() && now.Sub(c.keysFetchedAt) < oidcJWKSMinRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown kid %q", ErrOIDCInvalidIDToken, kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.discovery.JWKSURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	var jwks struct {
+		Keys []oidcJSONWebKey `json:"keys"`
+	}
+	if err := c.do(req, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to load JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if len(jwk.Use) > 0 && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			// skip keys of unsupported types instead of failing all logins
+			continue
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	c.keys = keys
+	c.keysFetchedAt = now
+
+	key, ok := c.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown kid %q", ErrOIDCInvalidIDToken, kid)
+	}
+
+	return key, nil
+}
+
+func (c *OIDCClient) do(req *http.Request, result any) error {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, oidcMaxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", res.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+type oidcJSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k oidcJSONWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key parameter: %w", err)
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,109 @@
+package oauth2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/util/oauth2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCClient(t *testing.T) {
+	ctx := context.Background()
+	provider := test.NewTestOIDCProvider(t)
+	client := oauth2.NewOIDCClient(provider.Config(), nil)
+
+	verifier, err := oauth2.GetPKCECodeVerifier()
+	require.NoError(t, err)
+
+	authorize := func(t *testing.T, identity test.TestOIDCIdentity) string {
+		t.Helper()
+
+		authorizationURL, err := client.AuthCodeURL(ctx, "state1", "nonce1", oauth2.GetPKCECodeChallengeS256(verifier))
+		require.NoError(t, err)
+
+		u, err := url.Parse(authorizationURL)
+		require.NoError(t, err)
+		assert.Equal(t, provider.Server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+		assert.Equal(t, test.TestOIDCProviderClientID, u.Query().Get("client_id"))
+		assert.Equal(t, test.TestOIDCProviderRedirectURL, u.Query().Get("redirect_uri"))
+		assert.Equal(t, "openid email", u.Query().Get("scope"))
+		assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+
+		code, state := provider.Authorize(t, authorizationURL, identity)
+		assert.Equal(t, "state1", state)
+
+		return code
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		code := authorize(t, test.TestOIDCIdentity{Subject: "sub1", Email: "user@example.com", EmailVerified: true})
+
+		tokens, err := client.Exchange(ctx, code, verifier)
+		require.NoError(t, err)
+
+		claims, err := client.VerifyIDToken(ctx, tokens.IDToken, "nonce1", time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, "sub1", claims.Subject)
+		assert.Equal(t, "user@example.com", claims.Email)
+		assert.True(t, bool(claims.EmailVerified))
+
+		// codes can only be redeemed once
+		_, err = client.Exchange(ctx, code, verifier)
+		require.Error(t, err)
+	})
+
+	t.Run("InvalidCodeVerifier", func(t *testing.T) {
+		code := authorize(t, test.TestOIDCIdentity{Subject: "sub1"})
+
+		_, err := client.Exchange(ctx, code, verifier+"x")
+		require.Error(t, err)
+	})
+
+	t.Run("InvalidIDToken", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			nonce  string
+			now    time.Time
+			modify func(claims jwt.MapClaims)
+		}{
+			{name: "NonceMismatch", nonce: "nonce2", now: time.Now()},
+			{name: "Expired", nonce: "nonce1", now: time.Now().Add(2 * time.Hour)},
+			{name: "WrongAudience", nonce: "nonce1", now: time.Now(), modify: func(claims jwt.MapClaims) { claims["aud"] = "other-client" }},
+			{name: "WrongIssuer", nonce: "nonce1", now: time.Now(), modify: func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" }},
+			{name: "MissingSubject", nonce: "nonce1", now: time.Now(), modify: func(claims jwt.MapClaims) { delete(claims, "sub") }},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				provider.ModifyIDTokenClaims = tt.modify
+				defer func() { provider.ModifyIDTokenClaims = nil }()
+
+				code := authorize(t, test.TestOIDCIdentity{Subject: "sub1"})
+
+				tokens, err := client.Exchange(ctx, code, verifier)
+				require.NoError(t, err)
+
+				_, err = client.VerifyIDToken(ctx, tokens.IDToken, tt.nonce, tt.now)
+				require.ErrorIs(t, err, oauth2.ErrOIDCInvalidIDToken)
+			})
+		}
+	})
+}
+
+func TestOIDCBool(t *testing.T) {
+	var claims oauth2.OIDCIDTokenClaims
+
+	// Apple encodes email_verified as string
+	require.NoError(t, json.Unmarshal([]byte(`{"email_verified":"true"}`), &claims))
+	assert.True(t, bool(claims.EmailVerified))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"email_verified":false}`), &claims))
+	assert.False(t, bool(claims.EmailVerified))
+}
@@ -0,0 +1,69 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// hashPrefixLength is the length of the hex encoded SHA-1 prefix identifying a range, as used by the Pwned Passwords API.
+const hashPrefixLength = 5
+
+// BreachedList checks passwords against a local copy of breached password hashes, split into ranges by the
+// first 5 characters of their uppercase hex encoded SHA-1 hash (k-anonymity model of the Pwned Passwords API).
+// Each range is stored as <PREFIX>.txt in the directory, containing one "<SUFFIX>:<COUNT>" line per hash,
+// e.g. as downloaded by the PwnedPasswordsDownloader. Missing ranges are treated as not breached, allowing
+// to only load parts of the list.
+type BreachedList struct {
+	dir string
+}
+
+func NewBreachedList(dir string) (*BreachedList, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read breached passwords directory: %w", err)
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("breached passwords path %q is not a directory", dir)
+	}
+
+	return &BreachedList{dir: dir}, nil
+}
+
+// Contains reports whether the password is part of the breached passwords, only loading its hash range.
+func (l *BreachedList) Contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	f, err := os.Open(filepath.Join(l.dir, prefix+".txt"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to open breached passwords range %s: %w", prefix, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(entry, suffix) {
+			return true, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read breached passwords range %s: %w", prefix, err)
+	}
+
+	return false, nil
+}
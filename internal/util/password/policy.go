@@ -0,0 +1,133 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rule identifies a requirement of the password policy.
+type Rule string
+
+const (
+	RuleMinLength        Rule = "min_length"
+	RuleLowercase        Rule = "lowercase"
+	RuleUppercase        Rule = "uppercase"
+	RuleDigit            Rule = "digit"
+	RuleSpecialCharacter Rule = "special_character"
+	RuleUsername         Rule = "username"
+	RuleBreached         Rule = "breached"
+	RuleHistory          Rule = "history"
+)
+
+// minUsernamePartLength is the minimum length of the local part of a username (before "@") to be
+// rejected as part of passwords, shorter ones would match too many passwords by chance.
+const minUsernamePartLength = 3
+
+// Violation describes a rule of the policy not met by a password.
+type Violation struct {
+	Rule    Rule
+	Message string
+}
+
+// Policy defines the requirements for passwords chosen by users.
+type Policy struct {
+	MinLength        int
+	RequireLowercase bool
+	RequireUppercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+	// DisallowUsername rejects passwords containing the username or its local part.
+	DisallowUsername bool
+	// Breached rejects passwords contained in the list of breached passwords, if set.
+	Breached *BreachedList
+}
+
+// Validate returns all violations of the policy by the password chosen by the user with the given username,
+// which is empty if unknown. The password history has to be checked separately, see HistoryViolation.
+func (p Policy) Validate(password string, username string) ([]Violation, error) {
+	var violations []Violation
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMinLength,
+			Message: fmt.Sprintf("must be at least %d characters long", p.MinLength),
+		})
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSpecial = true
+		}
+	}
+
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, Violation{Rule: RuleLowercase, Message: "must contain a lowercase letter"})
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, Violation{Rule: RuleUppercase, Message: "must contain an uppercase letter"})
+	}
+
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, Violation{Rule: RuleDigit, Message: "must contain a digit"})
+	}
+
+	if p.RequireSpecial && !hasSpecial {
+		violations = append(violations, Violation{Rule: RuleSpecialCharacter, Message: "must contain a special character"})
+	}
+
+	if p.DisallowUsername && containsUsername(password, username) {
+		violations = append(violations, Violation{Rule: RuleUsername, Message: "must not contain the username"})
+	}
+
+	if p.Breached != nil {
+		breached, err := p.Breached.Contains(password)
+		if err != nil {
+			return nil, err
+		}
+
+		if breached {
+			violations = append(violations, Violation{Rule: RuleBreached, Message: "has appeared in a data breach and must not be used"})
+		}
+	}
+
+	return violations, nil
+}
+
+// HistoryViolation returns the violation of passwords matching one of the last historySize passwords of the user.
+func HistoryViolation(historySize int) Violation {
+	return Violation{
+		Rule:    RuleHistory,
+		Message: fmt.Sprintf("must not match any of the last %d passwords", historySize),
+	}
+}
+
+func containsUsername(password string, username string) bool {
+	password = strings.ToLower(password)
+	username = strings.ToLower(strings.TrimSpace(username))
+
+	if len(username) == 0 {
+		return false
+	}
+
+	if strings.Contains(password, username) {
+		return true
+	}
+
+	local, _, found := strings.Cut(username, "@")
+	if found && utf8.RuneCountInString(local) >= minUsernamePartLength {
+		return strings.Contains(password, local)
+	}
+
+	return false
+}
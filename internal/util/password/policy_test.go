@@ -0,0 +1,136 @@
+package password_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/password"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rules(violations []password.Violation) []password.Rule {
+	res := make([]password.Rule, 0, len(violations))
+	for _, v := range violations {
+		res = append(res, v.Rule)
+	}
+
+	return res
+}
+
+func TestPolicyValidate(t *testing.T) {
+	policy := password.Policy{
+		MinLength:        10,
+		RequireLowercase: true,
+		RequireUppercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+		DisallowUsername: true,
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		username string
+		expected []password.Rule
+	}{
+		{
+			name:     "Valid",
+			password: "Correct-Horse-42",
+			username: "user1@example.com",
+			expected: []password.Rule{},
+		},
+		{
+			name:     "Empty",
+			password: "",
+			expected: []password.Rule{password.RuleMinLength, password.RuleLowercase, password.RuleUppercase, password.RuleDigit, password.RuleSpecialCharacter},
+		},
+		{
+			name:     "MultibyteCharacters",
+			password: "Ünïcødé-Pässwörd-1",
+			expected: []password.Rule{},
+		},
+		{
+			name:     "TooShort",
+			password: "Ab1-cdef",
+			expected: []password.Rule{password.RuleMinLength},
+		},
+		{
+			name:     "OnlyLetters",
+			password: "CorrectHorseBattery",
+			expected: []password.Rule{password.RuleDigit, password.RuleSpecialCharacter},
+		},
+		{
+			name:     "Username",
+			password: "My-USER1@example.com-Password",
+			username: "user1@example.com",
+			expected: []password.Rule{password.RuleUsername},
+		},
+		{
+			name:     "UsernameLocalPart",
+			password: "Password-User1-2024",
+			username: "user1@example.com",
+			expected: []password.Rule{password.RuleUsername},
+		},
+		{
+			name:     "ShortUsernameLocalPart",
+			password: "Password-Ab-2024",
+			username: "ab@example.com",
+			expected: []password.Rule{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := policy.Validate(tt.password, tt.username)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rules(violations))
+		})
+	}
+}
+
+func TestPolicyValidateDefaults(t *testing.T) {
+	violations, err := password.Policy{}.Validate("a", "")
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = password.Policy{MinLength: 8}.Validate("passwor", "")
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "must be at least 8 characters long", violations[0].Message)
+}
+
+func TestPolicyValidateBreached(t *testing.T) {
+	breached, err := password.NewBreachedList(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "breached-passwords"))
+	require.NoError(t, err)
+
+	policy := password.Policy{Breached: breached}
+
+	for _, pw := range []string{"password", "qwertyuiop"} {
+		violations, err := policy.Validate(pw, "")
+		require.NoError(t, err)
+		assert.Equal(t, []password.Rule{password.RuleBreached}, rules(violations), pw)
+	}
+
+	// same range as "password", but not listed
+	for _, pw := range []string{"correct horse battery staple", "Password"} {
+		violations, err := policy.Validate(pw, "")
+		require.NoError(t, err)
+		assert.Empty(t, violations, pw)
+	}
+}
+
+func TestNewBreachedListInvalid(t *testing.T) {
+	_, err := password.NewBreachedList(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "does-not-exist"))
+	require.Error(t, err)
+
+	_, err = password.NewBreachedList(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "breached-passwords", "5BAA6.txt"))
+	require.Error(t, err)
+}
+
+func TestHistoryViolation(t *testing.T) {
+	violation := password.HistoryViolation(5)
+	assert.Equal(t, password.RuleHistory, violation.Rule)
+	assert.Equal(t, "must not match any of the last 5 passwords", violation.Message)
+}
@@ -0,0 +1,126 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+const (
+	// DefaultPeriod is the time step (in seconds) as recommended by RFC 6238, supported by all common authenticator apps
+	DefaultPeriod = 30
+	// DefaultDigits is the number of digits of a generated code, supported by all common authenticator apps
+	DefaultDigits = 6
+	// DefaultSkew is the number of time steps before and after the current one still accepted during validation
+	DefaultSkew = 1
+
+	defaultSecretLength = 20
+)
+
+var (
+	b32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+	digitsPower  = [...]uint32{1, 10, 100, 1000, 10000, 100000, 1000000, 10000000, 100000000}
+)
+
+// GenerateSecret returns a new random secret in unpadded base32 encoding, suitable for
+// use with GenerateCode, Validate and KeyURI.
+func GenerateSecret() (string, error) {
+	b, err := util.GenerateRandomBytes(defaultSecretLength)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+
+	return b32NoPadding.EncodeToString(b), nil
+}
+
+// TimeStep returns the RFC 6238 time step counter for the given time.
+func TimeStep(t time.Time) int64 {
+	return t.Unix() / DefaultPeriod
+}
+
+// GenerateCode returns the code for the given base32 encoded secret at time step counter.
+func GenerateCode(secret string, counter int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, uint64(counter)), nil //nolint:gosec
+}
+
+// Validate checks whether code is valid for the given base32 encoded secret at time t, allowing
+// for DefaultSkew time steps of clock drift. On success, the matched time step counter is returned
+// so callers are able to reject replays of already consumed codes.
+func Validate(secret string, code string, t time.Time) (bool, int64, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return false, 0, err
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != DefaultDigits {
+		return false, 0, nil
+	}
+
+	current := TimeStep(t)
+	for i := -DefaultSkew; i <= DefaultSkew; i++ {
+		counter := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(counter))), []byte(code)) == 1 { //nolint:gosec
+			return true, counter, nil
+		}
+	}
+
+	return false, 0, nil
+}
+
+// KeyURI returns the otpauth:// URI used for enrolling the secret in authenticator apps, see
+// https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func KeyURI(issuer string, accountName string, secret string) string {
+	label := url.PathEscape(accountName)
+	if len(issuer) > 0 {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	if len(issuer) > 0 {
+		params.Set("issuer", issuer)
+	}
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(DefaultDigits))
+	params.Set("period", fmt.Sprint(DefaultPeriod))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, params.Encode())
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := b32NoPadding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode totp secret: %w", err)
+	}
+
+	return key, nil
+}
+
+// hotp implements RFC 4226 section 5.3 using HMAC-SHA1 and dynamic truncation.
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", DefaultDigits, value%digitsPower[DefaultDigits])
+}
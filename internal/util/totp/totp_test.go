@@ -0,0 +1,85 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 appendix B test secret "12345678901234567890" (SHA1)
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCodeRFC6238Vectors(t *testing.T) {
+	// RFC 6238 lists 8 digit codes, we only use the last 6 digits
+	tests := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range tests {
+		code, err := totp.GenerateCode(rfcSecret, totp.TimeStep(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "unix %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	step := totp.TimeStep(now)
+
+	code, err := totp.GenerateCode(secret, step)
+	require.NoError(t, err)
+
+	valid, counter, err := totp.Validate(secret, code, now)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, step, counter)
+
+	// previous step is accepted due to skew
+	prev, err := totp.GenerateCode(secret, step-1)
+	require.NoError(t, err)
+	valid, counter, err = totp.Validate(secret, prev, now)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, step-1, counter)
+
+	// codes outside the skew window are rejected
+	old, err := totp.GenerateCode(secret, step-2)
+	require.NoError(t, err)
+	valid, _, err = totp.Validate(secret, old, now)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, _, err = totp.Validate(secret, "12345", now)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, _, err = totp.Validate("not base32!", code, now)
+	require.Error(t, err)
+}
+
+func TestKeyURI(t *testing.T) {
+	uri := totp.KeyURI("go-starter", "user1@example.com", rfcSecret)
+
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/go-starter:user1@example.com", u.Path)
+	assert.Equal(t, rfcSecret, u.Query().Get("secret"))
+	assert.Equal(t, "go-starter", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
@@ -11,15 +11,40 @@ import (
 const (
 	queryParamToken         = "token"
 	accountConfirmationPath = "/api/v1/auth/register"
+	dataExportDownloadPath  = "/api/v1/auth/account/export/download"
 )
 
 func PasswordResetDeeplinkURL(config config.Server, token string) (*url.URL, error) {
-	u, err := url.Parse(config.Frontend.BaseURL)
+	return frontendDeeplinkURL(config, config.Frontend.PasswordResetEndpoint, token)
+}
+
+func MagicLinkDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	return frontendDeeplinkURL(config, config.Frontend.MagicLinkEndpoint, token)
+}
+
+func EmailChangeConfirmationDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	return frontendDeeplinkURL(config, config.Frontend.EmailChangeConfirmEndpoint, token)
+}
+
+func EmailChangeRevertDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	return frontendDeeplinkURL(config, config.Frontend.EmailChangeRevertEndpoint, token)
+}
+
+func AccountDeletionCancelDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	return frontendDeeplinkURL(config, config.Frontend.AccountDeletionCancelEndpoint, token)
+}
+
+func InviteDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	return frontendDeeplinkURL(config, config.Frontend.InviteEndpoint, token)
+}
+
+func ConfirmationDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	u, err := url.Parse(config.Echo.BaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse the base URL: %w", err)
 	}
 
-	u.Path = path.Join(u.Path, config.Frontend.PasswordResetEndpoint)
+	u.Path = path.Join(u.Path, accountConfirmationPath)
 
 	q := u.Query()
 	q.Set(queryParamToken, token)
@@ -28,13 +53,24 @@ func PasswordResetDeeplinkURL(config config.Server, token string) (*url.URL, err
 	return u, nil
 }
 
-func ConfirmationDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+func ConfirmationRequestURL(config config.Server, token string) (*url.URL, error) {
 	u, err := url.Parse(config.Echo.BaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse the base URL: %w", err)
 	}
 
-	u.Path = path.Join(u.Path, accountConfirmationPath)
+	u.Path = path.Join(u.Path, accountConfirmationPath, token)
+
+	return u, nil
+}
+
+func DataExportDownloadURL(config config.Server, token string) (*url.URL, error) {
+	u, err := url.Parse(config.Echo.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the base URL: %w", err)
+	}
+
+	u.Path = path.Join(u.Path, dataExportDownloadPath)
 
 	q := u.Query()
 	q.Set(queryParamToken, token)
@@ -43,13 +79,17 @@ func ConfirmationDeeplinkURL(config config.Server, token string) (*url.URL, erro
 	return u, nil
 }
 
-func ConfirmationRequestURL(config config.Server, token string) (*url.URL, error) {
-	u, err := url.Parse(config.Echo.BaseURL)
+func frontendDeeplinkURL(config config.Server, endpoint string, token string) (*url.URL, error) {
+	u, err := url.Parse(config.Frontend.BaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse the base URL: %w", err)
 	}
 
-	u.Path = path.Join(u.Path, accountConfirmationPath, token)
+	u.Path = path.Join(u.Path, endpoint)
+
+	q := u.Query()
+	q.Set(queryParamToken, token)
+	u.RawQuery = q.Encode()
 
 	return u, nil
 }
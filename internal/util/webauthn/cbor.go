@@ -0,0 +1,227 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	cborMajorUnsigned = 0
+	cborMajorNegative = 1
+	cborMajorBytes    = 2
+	cborMajorText     = 3
+	cborMajorArray    = 4
+	cborMajorMap      = 5
+	cborMajorTag      = 6
+	cborMajorSimple   = 7
+
+	// cborMaxDepth limits the nesting of arrays and maps, authenticators never send deeply nested data
+	cborMaxDepth = 16
+)
+
+var (
+	errCBORTruncated = errors.New("cbor: unexpected end of data")
+)
+
+// decodeCBOR decodes the first CBOR data item of data (RFC 8949), returning the item and the number of bytes
+// consumed. Only the subset used by WebAuthn authenticators is supported, i.e. no indefinite lengths.
+// Integers are returned as int64, maps as map[any]any with int64 or string keys.
+func decodeCBOR(data []byte) (any, int, error) {
+	d := cborDecoder{data: data}
+
+	val, err := d.decode(0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return val, d.pos, nil
+}
+
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *cborDecoder) decode(depth int) (any, error) {
+	if depth > cborMaxDepth {
+		return nil, errors.New("cbor: maximum nesting depth exceeded")
+	}
+
+	if d.pos >= len(d.data) {
+		return nil, errCBORTruncated
+	}
+
+	initial := d.data[d.pos]
+	d.pos++
+
+	major := initial >> 5
+	info := initial & 0x1f
+
+	if major == cborMajorSimple {
+		return d.decodeSimple(info)
+	}
+
+	arg, err := d.readArgument(info)
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborMajorUnsigned:
+		if arg > math.MaxInt64 {
+			return nil, errors.New("cbor: integer overflows int64")
+		}
+
+		return int64(arg), nil
+	case cborMajorNegative:
+		if arg > math.MaxInt64 {
+			return nil, errors.New("cbor: integer overflows int64")
+		}
+
+		return -1 - int64(arg), nil
+	case cborMajorBytes:
+		b, err := d.readBytes(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte(nil), b...), nil
+	case cborMajorText:
+		b, err := d.readBytes(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		return string(b), nil
+	case cborMajorArray:
+		// every item takes at least one byte, so longer arrays are truncated anyway
+		if arg > uint64(len(d.data)-d.pos) {
+			return nil, errCBORTruncated
+		}
+
+		arr := make([]any, 0, arg)
+		for range arg {
+			item, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			arr = append(arr, item)
+		}
+
+		return arr, nil
+	case cborMajorMap:
+		if arg > uint64(len(d.data)-d.pos)/2 {
+			return nil, errCBORTruncated
+		}
+
+		m := make(map[any]any, arg)
+		for range arg {
+			key, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, fmt.Errorf("cbor: unsupported map key type %T", key)
+			}
+
+			if _, ok := m[key]; ok {
+				return nil, fmt.Errorf("cbor: duplicate map key %v", key)
+			}
+
+			val, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			m[key] = val
+		}
+
+		return m, nil
+	case cborMajorTag:
+		// tags carry no meaning for WebAuthn, only the tagged item is returned
+		return d.decode(depth + 1)
+	}
+
+	return nil, fmt.Errorf("cbor: unsupported major type %d", major)
+}
+
+func (d *cborDecoder) decodeSimple(info byte) (any, error) {
+	switch info {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 26:
+		b, err := d.readBytes(4)
+		if err != nil {
+			return nil, err
+		}
+
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 27:
+		b, err := d.readBytes(8)
+		if err != nil {
+			return nil, err
+		}
+
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	}
+
+	return nil, fmt.Errorf("cbor: unsupported simple value %d", info)
+}
+
+func (d *cborDecoder) readArgument(info byte) (uint64, error) {
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		b, err := d.readBytes(1)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint64(b[0]), nil
+	case info == 25:
+		b, err := d.readBytes(2)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case info == 26:
+		b, err := d.readBytes(4)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	case info == 27:
+		b, err := d.readBytes(8)
+		if err != nil {
+			return 0, err
+		}
+
+		return binary.BigEndian.Uint64(b), nil
+	}
+
+	return 0, errors.New("cbor: indefinite lengths are not supported")
+}
+
+func (d *cborDecoder) readBytes(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.pos) {
+		return nil, errCBORTruncated
+	}
+
+	b := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+
+	return b, nil
+}
@@ -0,0 +1,143 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// COSE algorithm identifiers of the supported credential public keys, see
+// https://www.iana.org/assignments/cose/cose.xhtml#algorithms
+const (
+	COSEAlgorithmES256 int64 = -7
+	COSEAlgorithmEdDSA int64 = -8
+	COSEAlgorithmRS256 int64 = -257
+)
+
+const (
+	coseKeyType      int64 = 1
+	coseKeyAlgorithm int64 = 3
+	coseKeyCurve     int64 = -1
+	coseKeyX         int64 = -2
+	coseKeyY         int64 = -3
+	coseKeyRSAN      int64 = -1
+	coseKeyRSAE      int64 = -2
+
+	coseKeyTypeOKP int64 = 1
+	coseKeyTypeEC2 int64 = 2
+	coseKeyTypeRSA int64 = 3
+
+	coseCurveP256    int64 = 1
+	coseCurveEd25519 int64 = 6
+
+	rsaMinKeyBits = 2048
+)
+
+// SupportedAlgorithms lists the COSE algorithms accepted for new credentials, in order of preference.
+var SupportedAlgorithms = []int64{COSEAlgorithmES256, COSEAlgorithmEdDSA, COSEAlgorithmRS256}
+
+// coseKey is a parsed COSE_Key (RFC 9052) credential public key.
+type coseKey struct {
+	algorithm int64
+	publicKey crypto.PublicKey
+}
+
+func parseCOSEKey(data []byte) (coseKey, error) {
+	val, n, err := decodeCBOR(data)
+	if err != nil {
+		return coseKey{}, err
+	}
+
+	if n != len(data) {
+		return coseKey{}, errors.New("trailing data after COSE key")
+	}
+
+	m, ok := val.(map[any]any)
+	if !ok {
+		return coseKey{}, errors.New("COSE key is not a map")
+	}
+
+	kty, _ := m[coseKeyType].(int64)
+	alg, _ := m[coseKeyAlgorithm].(int64)
+
+	switch {
+	case kty == coseKeyTypeEC2 && alg == COSEAlgorithmES256:
+		crv, _ := m[coseKeyCurve].(int64)
+		x, _ := m[coseKeyX].([]byte)
+		y, _ := m[coseKeyY].([]byte)
+		if crv != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return coseKey{}, errors.New("invalid ES256 COSE key")
+		}
+
+		// ecdh rejects points not on the curve, which ecdsa.PublicKey would not check
+		uncompressed := append(append([]byte{0x04}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(uncompressed); err != nil {
+			return coseKey{}, fmt.Errorf("invalid ES256 COSE key: %w", err)
+		}
+
+		return coseKey{
+			algorithm: alg,
+			publicKey: &ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(x),
+				Y:     new(big.Int).SetBytes(y),
+			},
+		}, nil
+	case kty == coseKeyTypeOKP && alg == COSEAlgorithmEdDSA:
+		crv, _ := m[coseKeyCurve].(int64)
+		x, _ := m[coseKeyX].([]byte)
+		if crv != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return coseKey{}, errors.New("invalid EdDSA COSE key")
+		}
+
+		return coseKey{
+			algorithm: alg,
+			publicKey: ed25519.PublicKey(x),
+		}, nil
+	case kty == coseKeyTypeRSA && alg == COSEAlgorithmRS256:
+		n, _ := m[coseKeyRSAN].([]byte)
+		e, _ := m[coseKeyRSAE].([]byte)
+		if len(e) == 0 || len(e) > 4 {
+			return coseKey{}, errors.New("invalid RS256 COSE key")
+		}
+
+		pub := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+
+		if pub.N.BitLen() < rsaMinKeyBits || pub.E < 3 {
+			return coseKey{}, errors.New("invalid RS256 COSE key")
+		}
+
+		return coseKey{
+			algorithm: alg,
+			publicKey: pub,
+		}, nil
+	}
+
+	return coseKey{}, fmt.Errorf("unsupported COSE key type %d with algorithm %d", kty, alg)
+}
+
+// verify checks the signature over the message, hashing the message as required by the algorithm.
+func (k coseKey) verify(message []byte, signature []byte) bool {
+	switch pub := k.publicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return ecdsa.VerifyASN1(pub, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, message, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) == nil
+	}
+
+	return false
+}
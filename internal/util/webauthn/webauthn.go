@@ -0,0 +1,358 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+const (
+	CeremonyTypeCreate = "webauthn.create"
+	CeremonyTypeGet    = "webauthn.get"
+
+	challengeLength = 32
+	// credentialIDMaxLength as defined by https://www.w3.org/TR/webauthn-3/#credential-id
+	credentialIDMaxLength = 1023
+
+	authDataMinLength          = 37
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagBackupEligible         = 0x08
+	flagBackupState            = 0x10
+	flagAttestedCredentialData = 0x40
+
+	attestationFormatNone   = "none"
+	attestationFormatPacked = "packed"
+
+	androidOriginPrefix       = "android:apk-key-hash:"
+	androidLoginCredsRelation = "delegate_permission/common.get_login_creds"
+	androidAppNamespace       = "android_app"
+)
+
+var (
+	ErrInvalidResponse   = errors.New("invalid webauthn response")
+	ErrSignCountRollback = errors.New("webauthn sign count did not increase, credential might be cloned")
+)
+
+// RelyingParty verifies the responses of WebAuthn ceremonies (passkeys) for the relying party ID, which
+// is the domain the credentials are bound to. Origins lists all accepted client origins, e.g. the web
+// frontend or Android apps (see AndroidOriginsFromAssetLinks). iOS apps use the origin https://<ID>.
+type RelyingParty struct {
+	ID      string
+	Name    string
+	Origins []string
+}
+
+// CollectedClientData is the client data signed by the authenticator,
+// see https://www.w3.org/TR/webauthn-3/#dictionary-client-data
+type CollectedClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// AttestationResponse is the response of an authenticator creating a new credential.
+type AttestationResponse struct {
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// AssertionResponse is the response of an authenticator signing a login challenge.
+type AssertionResponse struct {
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+// Credential is a verified credential, to be stored for verifying future assertions.
+type Credential struct {
+	ID []byte
+	// PublicKey is COSE encoded, as returned by the authenticator.
+	PublicKey      []byte
+	SignCount      uint32
+	AAGUID         [16]byte
+	BackupEligible bool
+	BackupState    bool
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	// only set if flagAttestedCredentialData is set
+	aaguid       [16]byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// GenerateChallenge returns a new random challenge in unpadded base64url encoding, the encoding used
+// by clients within the client data.
+func GenerateChallenge() (string, error) {
+	b, err := util.GenerateRandomBytes(challengeLength)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webauthn challenge: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeBase64URL decodes base64url encoded binary data of WebAuthn responses, accepting
+// both padded and unpadded encoding.
+func DecodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// ParseClientData parses the JSON encoded client data, returning the challenge to verify the response against.
+func ParseClientData(clientDataJSON []byte) (CollectedClientData, error) {
+	var clientData CollectedClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return CollectedClientData{}, fmt.Errorf("%w: failed to parse client data: %w", ErrInvalidResponse, err)
+	}
+
+	return clientData, nil
+}
+
+// VerifyRegistration verifies the response of a registration ceremony started with the given challenge,
+// requiring user verification. Attestation statements are not verified as attestation "none" is requested,
+// only self attestation of the "packed" format is checked as its signature is made by the credential itself.
+func (rp RelyingParty) VerifyRegistration(response AttestationResponse, challenge string) (Credential, error) {
+	if err := rp.verifyClientData(response.ClientDataJSON, CeremonyTypeCreate, challenge); err != nil {
+		return Credential{}, err
+	}
+
+	val, n, err := decodeCBOR(response.AttestationObject)
+	if err != nil || n != len(response.AttestationObject) {
+		return Credential{}, fmt.Errorf("%w: malformed attestation object", ErrInvalidResponse)
+	}
+
+	attestationObject, ok := val.(map[any]any)
+	if !ok {
+		return Credential{}, fmt.Errorf("%w: attestation object is not a map", ErrInvalidResponse)
+	}
+
+	format, _ := attestationObject["fmt"].(string)
+	attStmt, _ := attestationObject["attStmt"].(map[any]any)
+	rawAuthData, _ := attestationObject["authData"].([]byte)
+
+	authData, err := rp.parseAndVerifyAuthenticatorData(rawAuthData)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	if authData.flags&flagAttestedCredentialData == 0 {
+		return Credential{}, fmt.Errorf("%w: missing attested credential data", ErrInvalidResponse)
+	}
+
+	key, err := parseCOSEKey(authData.publicKey)
+	if err != nil {
+		return Credential{}, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	switch format {
+	case attestationFormatNone:
+		if len(attStmt) != 0 {
+			return Credential{}, fmt.Errorf("%w: attestation statement of format none is not empty", ErrInvalidResponse)
+		}
+	case attestationFormatPacked:
+		if _, hasX5C := attStmt["x5c"]; !hasX5C {
+			alg, _ := attStmt["alg"].(int64)
+			sig, _ := attStmt["sig"].([]byte)
+			if alg != key.algorithm || !key.verify(signedData(rawAuthData, response.ClientDataJSON), sig) {
+				return Credential{}, fmt.Errorf("%w: invalid packed self attestation", ErrInvalidResponse)
+			}
+		}
+	}
+
+	return Credential{
+		ID:             authData.credentialID,
+		PublicKey:      authData.publicKey,
+		SignCount:      authData.signCount,
+		AAGUID:         authData.aaguid,
+		BackupEligible: authData.flags&flagBackupEligible != 0,
+		BackupState:    authData.flags&flagBackupState != 0,
+	}, nil
+}
+
+// VerifyAssertion verifies the response of a login ceremony started with the given challenge, signed by the
+// stored credential and requiring user verification. Returns the updated credential to store. A sign count
+// not increasing indicates a cloned authenticator, unless the authenticator does not use sign counts at all.
+func (rp RelyingParty) VerifyAssertion(response AssertionResponse, challenge string, credential Credential) (Credential, error) {
+	if err := rp.verifyClientData(response.ClientDataJSON, CeremonyTypeGet, challenge); err != nil {
+		return Credential{}, err
+	}
+
+	authData, err := rp.parseAndVerifyAuthenticatorData(response.AuthenticatorData)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	key, err := parseCOSEKey(credential.PublicKey)
+	if err != nil {
+		return Credential{}, fmt.Errorf("failed to parse stored credential public key: %w", err)
+	}
+
+	if !key.verify(signedData(response.AuthenticatorData, response.ClientDataJSON), response.Signature) {
+		return Credential{}, fmt.Errorf("%w: invalid signature", ErrInvalidResponse)
+	}
+
+	if (authData.signCount != 0 || credential.SignCount != 0) && authData.signCount <= credential.SignCount {
+		return Credential{}, ErrSignCountRollback
+	}
+
+	credential.SignCount = authData.signCount
+	credential.BackupState = authData.flags&flagBackupState != 0
+
+	return credential, nil
+}
+
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, ceremonyType string, challenge string) error {
+	clientData, err := ParseClientData(clientDataJSON)
+	if err != nil {
+		return err
+	}
+
+	if clientData.Type != ceremonyType {
+		return fmt.Errorf("%w: unexpected client data type %q", ErrInvalidResponse, clientData.Type)
+	}
+
+	if len(challenge) == 0 || subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1 {
+		return fmt.Errorf("%w: challenge mismatch", ErrInvalidResponse)
+	}
+
+	if !slices.Contains(rp.Origins, clientData.Origin) {
+		return fmt.Errorf("%w: origin %q not allowed", ErrInvalidResponse, clientData.Origin)
+	}
+
+	if clientData.CrossOrigin {
+		return fmt.Errorf("%w: cross-origin ceremonies are not allowed", ErrInvalidResponse)
+	}
+
+	return nil
+}
+
+func (rp RelyingParty) parseAndVerifyAuthenticatorData(data []byte) (authenticatorData, error) {
+	authData, err := parseAuthenticatorData(data)
+	if err != nil {
+		return authenticatorData{}, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return authenticatorData{}, fmt.Errorf("%w: relying party ID mismatch", ErrInvalidResponse)
+	}
+
+	if authData.flags&flagUserPresent == 0 {
+		return authenticatorData{}, fmt.Errorf("%w: user not present", ErrInvalidResponse)
+	}
+
+	if authData.flags&flagUserVerified == 0 {
+		return authenticatorData{}, fmt.Errorf("%w: user not verified", ErrInvalidResponse)
+	}
+
+	return authData, nil
+}
+
+// parseAuthenticatorData parses the authenticator data, see https://www.w3.org/TR/webauthn-3/#sctn-authenticator-data
+func parseAuthenticatorData(data []byte) (authenticatorData, error) {
+	if len(data) < authDataMinLength {
+		return authenticatorData{}, errors.New("authenticator data too short")
+	}
+
+	authData := authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	if authData.flags&flagAttestedCredentialData == 0 {
+		return authData, nil
+	}
+
+	rest := data[authDataMinLength:]
+	if len(rest) < 18 {
+		return authenticatorData{}, errors.New("attested credential data too short")
+	}
+
+	copy(authData.aaguid[:], rest[:16])
+	credentialIDLength := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+
+	if credentialIDLength == 0 || credentialIDLength > credentialIDMaxLength || len(rest) < credentialIDLength {
+		return authenticatorData{}, errors.New("invalid credential ID length")
+	}
+
+	authData.credentialID = rest[:credentialIDLength]
+	rest = rest[credentialIDLength:]
+
+	// the public key is followed by optional extensions, only its CBOR encoding tells its length
+	_, n, err := decodeCBOR(rest)
+	if err != nil {
+		return authenticatorData{}, fmt.Errorf("malformed credential public key: %w", err)
+	}
+
+	authData.publicKey = rest[:n]
+
+	return authData, nil
+}
+
+// signedData returns the data signed by the authenticator for assertions and self attestations.
+func signedData(authData []byte, clientDataJSON []byte) []byte {
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	return append(append([]byte(nil), authData...), clientDataHash[:]...)
+}
+
+// FormatAAGUID formats the AAGUID identifying the authenticator model as UUID.
+func FormatAAGUID(aaguid [16]byte) string {
+	h := hex.EncodeToString(aaguid[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+type assetLinksStatement struct {
+	Relation []string `json:"relation"`
+	Target   struct {
+		Namespace              string   `json:"namespace"`
+		SHA256CertFingerprints []string `json:"sha256_cert_fingerprints"`
+	} `json:"target"`
+}
+
+// AndroidOriginsFromAssetLinks returns the WebAuthn origins of all Android apps permitted to use the
+// credentials of the domain by the Digital Asset Links file (relation "delegate_permission/common.get_login_creds"),
+// which Android clients report as "android:apk-key-hash:<base64url encoded SHA-256 fingerprint of the signing certificate>".
+func AndroidOriginsFromAssetLinks(data []byte) ([]string, error) {
+	var statements []assetLinksStatement
+	if err := json.Unmarshal(data, &statements); err != nil {
+		return nil, fmt.Errorf("failed to parse asset links: %w", err)
+	}
+
+	origins := []string{}
+	for _, statement := range statements {
+		if statement.Target.Namespace != androidAppNamespace || !slices.Contains(statement.Relation, androidLoginCredsRelation) {
+			continue
+		}
+
+		for _, fingerprint := range statement.Target.SHA256CertFingerprints {
+			b, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+			if err != nil || len(b) != sha256.Size {
+				return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint %q in asset links", fingerprint)
+			}
+
+			origin := androidOriginPrefix + base64.RawURLEncoding.EncodeToString(b)
+			if !slices.Contains(origins, origin) {
+				origins = append(origins, origin)
+			}
+		}
+	}
+
+	return origins, nil
+}
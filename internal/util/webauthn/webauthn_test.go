@@ -0,0 +1,232 @@
+package webauthn_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/webauthn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRelyingParty = webauthn.RelyingParty{
+	ID:      test.TestPasskeyRPID,
+	Name:    "go-starter",
+	Origins: []string{test.TestPasskeyOrigin},
+}
+
+var testUserID = base64.RawURLEncoding.EncodeToString([]byte("f6ede5d8-e22a-4ca5-aa12-67821865a3e5"))
+
+func decodeResponseField(t *testing.T, payload test.GenericPayload, field string) []byte {
+	t.Helper()
+
+	response, ok := payload["response"].(test.GenericPayload)
+	require.True(t, ok)
+
+	value, ok := response[field].(string)
+	require.True(t, ok, "missing response field %q", field)
+
+	b, err := webauthn.DecodeBase64URL(value)
+	require.NoError(t, err)
+
+	return b
+}
+
+func attestationResponse(t *testing.T, payload test.GenericPayload) webauthn.AttestationResponse {
+	t.Helper()
+
+	return webauthn.AttestationResponse{
+		ClientDataJSON:    decodeResponseField(t, payload, "clientDataJSON"),
+		AttestationObject: decodeResponseField(t, payload, "attestationObject"),
+	}
+}
+
+func assertionResponse(t *testing.T, payload test.GenericPayload) webauthn.AssertionResponse {
+	t.Helper()
+
+	return webauthn.AssertionResponse{
+		ClientDataJSON:    decodeResponseField(t, payload, "clientDataJSON"),
+		AuthenticatorData: decodeResponseField(t, payload, "authenticatorData"),
+		Signature:         decodeResponseField(t, payload, "signature"),
+	}
+}
+
+func registerCredential(t *testing.T, authenticator *test.TestPasskeyAuthenticator) webauthn.Credential {
+	t.Helper()
+
+	challenge, err := webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	credential, err := testRelyingParty.VerifyRegistration(attestationResponse(t, authenticator.CreateCredential(t, challenge, testUserID)), challenge)
+	require.NoError(t, err)
+
+	return credential
+}
+
+func TestVerifyRegistrationAndAssertion(t *testing.T) {
+	authenticator := test.NewTestPasskeyAuthenticator(t)
+	authenticator.SignCount = 1
+
+	credential := registerCredential(t, authenticator)
+	assert.Equal(t, authenticator.CredentialID, credential.ID)
+	assert.Equal(t, uint32(1), credential.SignCount)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", webauthn.FormatAAGUID(credential.AAGUID))
+	assert.True(t, credential.BackupEligible)
+	assert.True(t, credential.BackupState)
+
+	challenge, err := webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	updated, err := testRelyingParty.VerifyAssertion(assertionResponse(t, authenticator.GetAssertion(t, challenge)), challenge, credential)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), updated.SignCount)
+	assert.Equal(t, credential.PublicKey, updated.PublicKey)
+
+	// replaying an older sign count indicates a cloned authenticator
+	authenticator.SignCount = 0
+	challenge, err = webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	_, err = testRelyingParty.VerifyAssertion(assertionResponse(t, authenticator.GetAssertion(t, challenge)), challenge, updated)
+	require.ErrorIs(t, err, webauthn.ErrSignCountRollback)
+}
+
+func TestVerifyAssertionWithoutSignCount(t *testing.T) {
+	authenticator := test.NewTestPasskeyAuthenticator(t)
+	credential := registerCredential(t, authenticator)
+
+	for range 2 {
+		challenge, err := webauthn.GenerateChallenge()
+		require.NoError(t, err)
+
+		updated, err := testRelyingParty.VerifyAssertion(assertionResponse(t, authenticator.GetAssertion(t, challenge)), challenge, credential)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), updated.SignCount)
+	}
+}
+
+func TestVerifyRegistrationInvalid(t *testing.T) {
+	challenge, err := webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	otherChallenge, err := webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		modify    func(a *test.TestPasskeyAuthenticator)
+		challenge string
+	}{
+		{
+			name:      "ChallengeMismatch",
+			modify:    func(_ *test.TestPasskeyAuthenticator) {},
+			challenge: otherChallenge,
+		},
+		{
+			name: "OriginNotAllowed",
+			modify: func(a *test.TestPasskeyAuthenticator) {
+				a.Origin = "https://evil.example.com"
+			},
+			challenge: challenge,
+		},
+		{
+			name: "RelyingPartyMismatch",
+			modify: func(a *test.TestPasskeyAuthenticator) {
+				a.RPID = "evil.example.com"
+			},
+			challenge: challenge,
+		},
+		{
+			name: "UserNotVerified",
+			modify: func(a *test.TestPasskeyAuthenticator) {
+				a.Flags = 0x01
+			},
+			challenge: challenge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator := test.NewTestPasskeyAuthenticator(t)
+			tt.modify(authenticator)
+
+			_, err := testRelyingParty.VerifyRegistration(attestationResponse(t, authenticator.CreateCredential(t, challenge, testUserID)), tt.challenge)
+			require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+		})
+	}
+}
+
+func TestVerifyAssertionInvalid(t *testing.T) {
+	authenticator := test.NewTestPasskeyAuthenticator(t)
+	credential := registerCredential(t, authenticator)
+
+	challenge, err := webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	// assertions of other credentials do not match the stored public key
+	other := test.NewTestPasskeyAuthenticator(t)
+	_, err = testRelyingParty.VerifyAssertion(assertionResponse(t, other.GetAssertion(t, challenge)), challenge, credential)
+	require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+
+	// registration responses are not accepted as assertions
+	payload := authenticator.GetAssertion(t, challenge)
+	response := assertionResponse(t, payload)
+	response.ClientDataJSON = decodeResponseField(t, authenticator.CreateCredential(t, challenge, testUserID), "clientDataJSON")
+	_, err = testRelyingParty.VerifyAssertion(response, challenge, credential)
+	require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+
+	// tampered authenticator data invalidates the signature
+	response = assertionResponse(t, payload)
+	response.AuthenticatorData[36]++
+	_, err = testRelyingParty.VerifyAssertion(response, challenge, credential)
+	require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+
+	// unverified users are rejected
+	authenticator.Flags = 0x01
+	_, err = testRelyingParty.VerifyAssertion(assertionResponse(t, authenticator.GetAssertion(t, challenge)), challenge, credential)
+	require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+}
+
+func TestVerifyRegistrationMalformed(t *testing.T) {
+	challenge, err := webauthn.GenerateChallenge()
+	require.NoError(t, err)
+
+	authenticator := test.NewTestPasskeyAuthenticator(t)
+	response := attestationResponse(t, authenticator.CreateCredential(t, challenge, testUserID))
+
+	// every truncation of the attestation object must be rejected without panicking
+	for i := range len(response.AttestationObject) {
+		_, err := testRelyingParty.VerifyRegistration(webauthn.AttestationResponse{
+			ClientDataJSON:    response.ClientDataJSON,
+			AttestationObject: response.AttestationObject[:i],
+		}, challenge)
+		require.ErrorIs(t, err, webauthn.ErrInvalidResponse, "length %d", i)
+	}
+
+	_, err = testRelyingParty.VerifyRegistration(webauthn.AttestationResponse{
+		ClientDataJSON:    []byte("not json"),
+		AttestationObject: response.AttestationObject,
+	}, challenge)
+	require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+}
+
+func TestAndroidOriginsFromAssetLinks(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "android-assetlinks.json"))
+	require.NoError(t, err)
+
+	origins, err := webauthn.AndroidOriginsFromAssetLinks(data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"android:apk-key-hash:u6Y-p5H0BSxtR4saP8WeDXsvGujVbBBSi_S3qR3DV0M"}, origins)
+
+	// apps without the get_login_creds relation may not use the credentials
+	origins, err = webauthn.AndroidOriginsFromAssetLinks([]byte(`[{"relation": ["delegate_permission/common.handle_all_urls"], "target": {"namespace": "android_app", "package_name": "test", "sha256_cert_fingerprints": ["BB:A6"]}}]`))
+	require.NoError(t, err)
+	assert.Empty(t, origins)
+
+	_, err = webauthn.AndroidOriginsFromAssetLinks([]byte(`[{"relation": ["delegate_permission/common.get_login_creds"], "target": {"namespace": "android_app", "package_name": "test", "sha256_cert_fingerprints": ["BB:A6"]}}]`))
+	require.Error(t, err)
+}
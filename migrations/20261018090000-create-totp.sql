@@ -0,0 +1,49 @@
+-- +migrate Up
+CREATE TABLE totp_secrets (
+    user_id uuid NOT NULL,
+    secret text NOT NULL,
+    confirmed_at timestamptz,
+    last_used_time_step bigint,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT totp_secrets_pkey PRIMARY KEY (user_id)
+);
+
+ALTER TABLE totp_secrets
+    ADD CONSTRAINT totp_secrets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE totp_recovery_codes (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    code_hash text NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT totp_recovery_codes_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_totp_recovery_codes_fk_user_id ON totp_recovery_codes USING btree (user_id);
+
+ALTER TABLE totp_recovery_codes
+    ADD CONSTRAINT totp_recovery_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE two_factor_challenge_tokens (
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    user_id uuid NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT two_factor_challenge_tokens_pkey PRIMARY KEY (token)
+);
+
+CREATE INDEX idx_two_factor_challenge_tokens_fk_user_id ON two_factor_challenge_tokens USING btree (user_id);
+
+ALTER TABLE two_factor_challenge_tokens
+    ADD CONSTRAINT two_factor_challenge_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS two_factor_challenge_tokens;
+
+DROP TABLE IF EXISTS totp_recovery_codes;
+
+DROP TABLE IF EXISTS totp_secrets;
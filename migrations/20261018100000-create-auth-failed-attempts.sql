@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TYPE auth_attempt_scope AS ENUM (
+    'login_username',
+    'login_ip',
+    'password_reset_ip'
+);
+
+CREATE TABLE auth_failed_attempts (
+    scope auth_attempt_scope NOT NULL,
+    key text NOT NULL,
+    failed_count int NOT NULL DEFAULT 0,
+    lockout_count int NOT NULL DEFAULT 0,
+    last_failed_at timestamptz NOT NULL,
+    locked_until timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT auth_failed_attempts_pkey PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idx_auth_failed_attempts_last_failed_at ON auth_failed_attempts USING btree (last_failed_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS auth_failed_attempts;
+
+DROP TYPE IF EXISTS auth_attempt_scope;
@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE rate_limit_buckets (
+    key text NOT NULL,
+    tokens double precision NOT NULL,
+    refilled_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT rate_limit_buckets_pkey PRIMARY KEY (key)
+);
+
+CREATE INDEX idx_rate_limit_buckets_expires_at ON rate_limit_buckets USING btree (expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE access_token_denylist (
+    jti uuid NOT NULL,
+    valid_until timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT access_token_denylist_pkey PRIMARY KEY (jti)
+);
+
+CREATE INDEX idx_access_token_denylist_valid_until ON access_token_denylist USING btree (valid_until);
+
+-- +migrate Down
+DROP TABLE IF EXISTS access_token_denylist;
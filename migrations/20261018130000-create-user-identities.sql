@@ -0,0 +1,35 @@
+-- +migrate Up
+CREATE TABLE user_identities (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    provider text NOT NULL,
+    subject text NOT NULL,
+    email text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT user_identities_pkey PRIMARY KEY (id),
+    CONSTRAINT user_identities_provider_subject_key UNIQUE (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_fk_user_id ON user_identities USING btree (user_id);
+
+ALTER TABLE user_identities
+    ADD CONSTRAINT user_identities_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE oidc_auth_states (
+    state uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    provider text NOT NULL,
+    nonce text NOT NULL,
+    code_verifier text NOT NULL,
+    valid_until timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT oidc_auth_states_pkey PRIMARY KEY (state)
+);
+
+CREATE INDEX idx_oidc_auth_states_valid_until ON oidc_auth_states USING btree (valid_until);
+
+-- +migrate Down
+DROP TABLE IF EXISTS oidc_auth_states;
+
+DROP TABLE IF EXISTS user_identities;
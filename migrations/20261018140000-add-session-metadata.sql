@@ -0,0 +1,48 @@
+-- +migrate Up
+-- refresh tokens keep their session_id across rotation, identifying the session of a device
+ALTER TABLE refresh_tokens
+    ADD COLUMN session_id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    ADD COLUMN device_name text,
+    ADD COLUMN user_agent text,
+    ADD COLUMN ip_address text,
+    ADD COLUMN last_used_at timestamptz;
+
+UPDATE
+    refresh_tokens
+SET
+    last_used_at = updated_at;
+
+ALTER TABLE refresh_tokens
+    ALTER COLUMN last_used_at SET NOT NULL;
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens USING btree (session_id);
+
+ALTER TABLE access_tokens
+    ADD COLUMN session_id uuid;
+
+CREATE INDEX idx_access_tokens_session_id ON access_tokens USING btree (session_id);
+
+ALTER TABLE push_tokens
+    ADD COLUMN session_id uuid;
+
+CREATE INDEX idx_push_tokens_session_id ON push_tokens USING btree (session_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_push_tokens_session_id;
+
+ALTER TABLE push_tokens
+    DROP COLUMN IF EXISTS session_id;
+
+DROP INDEX IF EXISTS idx_access_tokens_session_id;
+
+ALTER TABLE access_tokens
+    DROP COLUMN IF EXISTS session_id;
+
+DROP INDEX IF EXISTS idx_refresh_tokens_session_id;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip_address,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS device_name,
+    DROP COLUMN IF EXISTS session_id;
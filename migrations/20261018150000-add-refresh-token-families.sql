@@ -0,0 +1,37 @@
+-- +migrate Up
+-- rotated refresh tokens are kept until their session ends, presenting one again revokes the whole session
+ALTER TABLE refresh_tokens
+    ADD COLUMN rotated_at timestamptz,
+    ADD COLUMN session_started_at timestamptz;
+
+UPDATE
+    refresh_tokens
+SET
+    session_started_at = created_at;
+
+ALTER TABLE refresh_tokens
+    ALTER COLUMN session_started_at SET NOT NULL;
+
+CREATE TABLE refresh_token_reuse_events (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    session_id uuid NOT NULL,
+    rotated_at timestamptz NOT NULL,
+    user_agent text,
+    ip_address text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT refresh_token_reuse_events_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_refresh_token_reuse_events_fk_user_id ON refresh_token_reuse_events USING btree (user_id);
+
+ALTER TABLE refresh_token_reuse_events
+    ADD CONSTRAINT refresh_token_reuse_events_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS refresh_token_reuse_events;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS session_started_at,
+    DROP COLUMN IF EXISTS rotated_at;
@@ -0,0 +1,70 @@
+-- +migrate Up
+-- roles are assigned to users by name through users.scopes, each scope is a role
+CREATE TABLE roles (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT roles_pkey PRIMARY KEY (id),
+    CONSTRAINT roles_name_key UNIQUE (name)
+);
+
+-- permissions follow the "resource:action" format
+CREATE TABLE permissions (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT permissions_pkey PRIMARY KEY (id),
+    CONSTRAINT permissions_name_key UNIQUE (name)
+);
+
+CREATE TABLE role_permissions (
+    role_id uuid NOT NULL,
+    permission_id uuid NOT NULL,
+    CONSTRAINT role_permissions_pkey PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE INDEX idx_role_permissions_fk_permission_id ON role_permissions USING btree (permission_id);
+
+ALTER TABLE role_permissions
+    ADD CONSTRAINT role_permissions_role_id_fkey FOREIGN KEY (role_id) REFERENCES roles (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE role_permissions
+    ADD CONSTRAINT role_permissions_permission_id_fkey FOREIGN KEY (permission_id) REFERENCES permissions (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+INSERT INTO roles (name, description, created_at, updated_at)
+    VALUES ('app', 'App user', now(), now()), ('cms', 'CMS user', now(), now());
+
+-- every scope already assigned to a user becomes a role without permissions
+INSERT INTO roles (name, created_at, updated_at)
+SELECT DISTINCT
+    unnest(scopes),
+    now(),
+    now()
+FROM
+    users
+ON CONFLICT (name)
+    DO NOTHING;
+
+INSERT INTO permissions (name, description, created_at, updated_at)
+    VALUES ('roles:read', 'List roles and their permissions', now(), now()), ('roles:assign', 'Assign roles to users', now(), now());
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT
+    roles.id,
+    permissions.id
+FROM
+    roles,
+    permissions
+WHERE
+    roles.name = 'cms';
+
+-- +migrate Down
+DROP TABLE IF EXISTS role_permissions;
+
+DROP TABLE IF EXISTS permissions;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,25 @@
+-- +migrate Up
+-- set by administrators to block password logins until the user completed a password reset
+ALTER TABLE users
+    ADD COLUMN password_reset_required boolean NOT NULL DEFAULT FALSE;
+
+INSERT INTO permissions (name, description, created_at, updated_at)
+    VALUES ('users:read', 'List and view users', now(), now()), ('users:write', 'Activate, deactivate and log out users and force password resets', now(), now());
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT
+    roles.id,
+    permissions.id
+FROM
+    roles,
+    permissions
+WHERE
+    roles.name = 'cms'
+    AND permissions.name IN ('users:read', 'users:write');
+
+-- +migrate Down
+DELETE FROM permissions
+WHERE name IN ('users:read', 'users:write');
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS password_reset_required;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- personal API keys are presented as "<prefix>.<secret>", only the SHA-256 digest of the random secret is stored
+CREATE TABLE api_keys (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    name text NOT NULL,
+    prefix text NOT NULL,
+    key_hash text NOT NULL,
+    scopes text[] NOT NULL,
+    valid_until timestamptz,
+    last_used_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT api_keys_pkey PRIMARY KEY (id),
+    CONSTRAINT api_keys_prefix_key UNIQUE (prefix)
+);
+
+CREATE INDEX idx_api_keys_fk_user_id ON api_keys USING btree (user_id);
+
+ALTER TABLE api_keys
+    ADD CONSTRAINT api_keys_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
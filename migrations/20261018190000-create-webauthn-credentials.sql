@@ -0,0 +1,52 @@
+-- +migrate Up
+CREATE TYPE webauthn_ceremony AS ENUM (
+    'registration',
+    'login'
+);
+
+CREATE TABLE webauthn_credentials (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    credential_id bytea NOT NULL,
+    name text,
+    public_key bytea NOT NULL,
+    sign_count bigint NOT NULL DEFAULT 0,
+    transports text[] NOT NULL DEFAULT '{}',
+    aaguid uuid NOT NULL,
+    backup_eligible boolean NOT NULL DEFAULT FALSE,
+    backup_state boolean NOT NULL DEFAULT FALSE,
+    last_used_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT webauthn_credentials_pkey PRIMARY KEY (id),
+    CONSTRAINT webauthn_credentials_credential_id_key UNIQUE (credential_id)
+);
+
+CREATE INDEX idx_webauthn_credentials_fk_user_id ON webauthn_credentials USING btree (user_id);
+
+ALTER TABLE webauthn_credentials
+    ADD CONSTRAINT webauthn_credentials_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE webauthn_challenges (
+    challenge text NOT NULL,
+    ceremony webauthn_ceremony NOT NULL,
+    user_id uuid,
+    valid_until timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT webauthn_challenges_pkey PRIMARY KEY (challenge)
+);
+
+CREATE INDEX idx_webauthn_challenges_fk_user_id ON webauthn_challenges USING btree (user_id);
+
+CREATE INDEX idx_webauthn_challenges_valid_until ON webauthn_challenges USING btree (valid_until);
+
+ALTER TABLE webauthn_challenges
+    ADD CONSTRAINT webauthn_challenges_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS webauthn_challenges;
+
+DROP TABLE IF EXISTS webauthn_credentials;
+
+DROP TYPE IF EXISTS webauthn_ceremony;
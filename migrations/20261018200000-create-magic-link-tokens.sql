@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE magic_link_tokens (
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    user_id uuid NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT magic_link_tokens_pkey PRIMARY KEY (token)
+);
+
+CREATE INDEX idx_magic_link_tokens_fk_user_uid ON magic_link_tokens USING btree (user_id);
+
+ALTER TABLE magic_link_tokens
+    ADD CONSTRAINT magic_link_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TYPE auth_attempt_scope
+    ADD VALUE 'magic_link_ip';
+
+-- +migrate Down
+DROP TABLE IF EXISTS magic_link_tokens;
+
+-- enum values cannot be dropped, rows still using it are removed instead
+DELETE FROM auth_failed_attempts
+WHERE scope = 'magic_link_ip';
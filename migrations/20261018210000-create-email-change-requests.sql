@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE email_change_requests (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    old_email text NOT NULL,
+    new_email text NOT NULL,
+    confirmation_token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    revert_token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    revert_valid_until timestamptz NOT NULL,
+    confirmed_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT email_change_requests_pkey PRIMARY KEY (id),
+    CONSTRAINT email_change_requests_confirmation_token_key UNIQUE (confirmation_token),
+    CONSTRAINT email_change_requests_revert_token_key UNIQUE (revert_token)
+);
+
+CREATE INDEX idx_email_change_requests_fk_user_uid ON email_change_requests USING btree (user_id);
+
+ALTER TABLE email_change_requests
+    ADD CONSTRAINT email_change_requests_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS email_change_requests;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE password_history_entries (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    password_hash text NOT NULL,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT password_history_entries_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_password_history_entries_fk_user_uid ON password_history_entries USING btree (user_id);
+
+ALTER TABLE password_history_entries
+    ADD CONSTRAINT password_history_entries_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_history_entries;
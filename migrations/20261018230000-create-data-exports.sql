@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE data_exports (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    completed_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT data_exports_pkey PRIMARY KEY (id),
+    CONSTRAINT data_exports_token_key UNIQUE (token)
+);
+
+CREATE INDEX idx_data_exports_fk_user_uid ON data_exports USING btree (user_id);
+
+ALTER TABLE data_exports
+    ADD CONSTRAINT data_exports_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS data_exports;
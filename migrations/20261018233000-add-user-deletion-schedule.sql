@@ -0,0 +1,19 @@
+-- +migrate Up
+-- set when users delete their account, which is deactivated and only purged once deletion_scheduled_at has passed
+ALTER TABLE users
+    ADD COLUMN deletion_scheduled_at timestamptz,
+    ADD COLUMN deletion_cancel_token uuid,
+    ADD COLUMN deletion_reminder_sent_at timestamptz,
+    ADD CONSTRAINT users_deletion_cancel_token_key UNIQUE (deletion_cancel_token);
+
+CREATE INDEX idx_users_deletion_scheduled_at ON users USING btree (deletion_scheduled_at)
+WHERE
+    deletion_scheduled_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_users_deletion_scheduled_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deletion_reminder_sent_at,
+    DROP COLUMN IF EXISTS deletion_cancel_token,
+    DROP COLUMN IF EXISTS deletion_scheduled_at;
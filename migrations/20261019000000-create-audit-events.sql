@@ -0,0 +1,43 @@
+-- +migrate Up
+-- append-only log of security relevant events, rows are never updated and only deleted by the retention purge.
+-- intentionally without foreign keys as events have to outlive the users they reference
+CREATE TABLE audit_events (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    event_type text NOT NULL,
+    actor_id uuid,
+    subject_id uuid,
+    ip_address text,
+    user_agent text,
+    request_id text,
+    metadata jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL,
+    CONSTRAINT audit_events_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_audit_events_created_at_id ON audit_events USING btree (created_at DESC, id DESC);
+
+CREATE INDEX idx_audit_events_actor_id ON audit_events USING btree (actor_id);
+
+CREATE INDEX idx_audit_events_subject_id ON audit_events USING btree (subject_id);
+
+CREATE INDEX idx_audit_events_event_type ON audit_events USING btree (event_type);
+
+INSERT INTO permissions (name, description, created_at, updated_at)
+    VALUES ('audit:read', 'List audit events', now(), now());
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT
+    roles.id,
+    permissions.id
+FROM
+    roles,
+    permissions
+WHERE
+    roles.name = 'cms'
+    AND permissions.name = 'audit:read';
+
+-- +migrate Down
+DELETE FROM permissions
+WHERE name = 'audit:read';
+
+DROP TABLE IF EXISTS audit_events;
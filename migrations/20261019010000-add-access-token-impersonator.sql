@@ -0,0 +1,34 @@
+-- +migrate Up
+-- set for access tokens issued to support staff impersonating the token's user, such tokens are never refreshed
+ALTER TABLE access_tokens
+    ADD COLUMN impersonator_id uuid;
+
+ALTER TABLE access_tokens
+    ADD CONSTRAINT access_tokens_impersonator_id_fkey FOREIGN KEY (impersonator_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX idx_access_tokens_impersonator_id ON access_tokens USING btree (impersonator_id)
+WHERE
+    impersonator_id IS NOT NULL;
+
+INSERT INTO permissions (name, description, created_at, updated_at)
+    VALUES ('users:impersonate', 'Impersonate users', now(), now());
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT
+    roles.id,
+    permissions.id
+FROM
+    roles,
+    permissions
+WHERE
+    roles.name = 'cms'
+    AND permissions.name = 'users:impersonate';
+
+-- +migrate Down
+DELETE FROM permissions
+WHERE name = 'users:impersonate';
+
+DROP INDEX IF EXISTS idx_access_tokens_impersonator_id;
+
+ALTER TABLE access_tokens
+    DROP COLUMN IF EXISTS impersonator_id;
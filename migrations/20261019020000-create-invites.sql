@@ -0,0 +1,49 @@
+-- +migrate Up
+-- invites allow registering without confirmation, assigning the scopes chosen by the inviting CMS user.
+-- Accepted invites are kept, linking the registered user.
+CREATE TABLE invites (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    email text NOT NULL,
+    scopes text[] NOT NULL DEFAULT '{}',
+    valid_until timestamptz NOT NULL,
+    created_by_id uuid,
+    accepted_at timestamptz,
+    user_id uuid,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT invites_pkey PRIMARY KEY (id),
+    CONSTRAINT invites_token_key UNIQUE (token)
+);
+
+CREATE INDEX idx_invites_email ON invites USING btree (email);
+
+CREATE INDEX idx_invites_fk_created_by_uid ON invites USING btree (created_by_id);
+
+CREATE INDEX idx_invites_fk_user_uid ON invites USING btree (user_id);
+
+ALTER TABLE invites
+    ADD CONSTRAINT invites_created_by_id_fkey FOREIGN KEY (created_by_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+ALTER TABLE invites
+    ADD CONSTRAINT invites_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+INSERT INTO permissions (name, description, created_at, updated_at)
+    VALUES ('invites:read', 'List pending invites', now(), now()), ('invites:write', 'Create and revoke invites', now(), now());
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT
+    roles.id,
+    permissions.id
+FROM
+    roles,
+    permissions
+WHERE
+    roles.name = 'cms'
+    AND permissions.name IN ('invites:read', 'invites:write');
+
+-- +migrate Down
+DELETE FROM permissions
+WHERE name IN ('invites:read', 'invites:write');
+
+DROP TABLE IF EXISTS invites;
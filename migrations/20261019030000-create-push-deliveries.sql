@@ -0,0 +1,42 @@
+-- +migrate Up
+CREATE TYPE push_delivery_status AS ENUM (
+    'queued',
+    'sent',
+    'failed',
+    'invalid_token'
+);
+
+-- outbox of push messages, rows are inserted within the transaction triggering the message and drained by the
+-- push outbox worker. the token is copied to record where the message was sent to, messages to push tokens
+-- deleted or replaced before delivery are discarded.
+CREATE TABLE push_deliveries (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    provider provider_type NOT NULL,
+    token text NOT NULL,
+    message jsonb NOT NULL,
+    status push_delivery_status NOT NULL DEFAULT 'queued',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error text,
+    sent_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT push_deliveries_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_push_deliveries_fk_user_id ON push_deliveries USING btree (user_id);
+
+CREATE INDEX idx_push_deliveries_queued_next_attempt_at ON push_deliveries USING btree (next_attempt_at)
+WHERE
+    status = 'queued';
+
+CREATE INDEX idx_push_deliveries_status ON push_deliveries USING btree (status);
+
+ALTER TABLE push_deliveries
+    ADD CONSTRAINT push_deliveries_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS push_deliveries;
+
+DROP TYPE IF EXISTS push_delivery_status;
@@ -0,0 +1,83 @@
+-- +migrate Up
+-- locale of the device the push token was registered on, used to target broadcasts
+ALTER TABLE push_tokens
+    ADD COLUMN locale text;
+
+CREATE INDEX idx_push_tokens_locale ON push_tokens USING btree (locale);
+
+CREATE TABLE push_topic_subscriptions (
+    user_id uuid NOT NULL,
+    topic text NOT NULL,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT push_topic_subscriptions_pkey PRIMARY KEY (user_id, topic)
+);
+
+CREATE INDEX idx_push_topic_subscriptions_topic ON push_topic_subscriptions USING btree (topic);
+
+ALTER TABLE push_topic_subscriptions
+    ADD CONSTRAINT push_topic_subscriptions_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TYPE push_broadcast_status AS ENUM (
+    'scheduled',
+    'sending',
+    'sent'
+);
+
+-- broadcasts composed in the CMS, sent to all push tokens matching the segment (topic, scope and locale) once due.
+-- segment columns left empty match all users.
+CREATE TABLE push_broadcasts (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    title text NOT NULL,
+    body text NOT NULL,
+    deep_link text,
+    image_url text,
+    data jsonb NOT NULL DEFAULT '{}',
+    topic text,
+    scope text,
+    locale text,
+    status push_broadcast_status NOT NULL DEFAULT 'scheduled',
+    scheduled_at timestamptz NOT NULL,
+    sent_at timestamptz,
+    sent_count int NOT NULL DEFAULT 0,
+    failed_count int NOT NULL DEFAULT 0,
+    created_by_id uuid,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT push_broadcasts_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_push_broadcasts_scheduled_scheduled_at ON push_broadcasts USING btree (scheduled_at)
+WHERE
+    status = 'scheduled';
+
+CREATE INDEX idx_push_broadcasts_fk_created_by_id ON push_broadcasts USING btree (created_by_id);
+
+ALTER TABLE push_broadcasts
+    ADD CONSTRAINT push_broadcasts_created_by_id_fkey FOREIGN KEY (created_by_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+INSERT INTO permissions (name, description, created_at, updated_at)
+    VALUES ('broadcasts:read', 'List push broadcasts', now(), now()), ('broadcasts:write', 'Compose, schedule and cancel push broadcasts', now(), now());
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT
+    roles.id,
+    permissions.id
+FROM
+    roles,
+    permissions
+WHERE
+    roles.name = 'cms'
+    AND permissions.name IN ('broadcasts:read', 'broadcasts:write');
+
+-- +migrate Down
+DELETE FROM permissions
+WHERE name IN ('broadcasts:read', 'broadcasts:write');
+
+DROP TABLE IF EXISTS push_broadcasts;
+
+DROP TYPE IF EXISTS push_broadcast_status;
+
+DROP TABLE IF EXISTS push_topic_subscriptions;
+
+ALTER TABLE push_tokens
+    DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,44 @@
+-- +migrate Up
+-- time zone of the user, quiet hours are evaluated in it.
+-- quiet hours are stored as minutes after midnight, quiet hours are disabled if either is null.
+-- start > end spans midnight, e.g. 22:00 (1320) to 07:00 (420).
+ALTER TABLE app_user_profiles
+    ADD COLUMN time_zone text NOT NULL DEFAULT 'UTC',
+    ADD COLUMN quiet_hours_start int,
+    ADD COLUMN quiet_hours_end int;
+
+CREATE TYPE notification_channel AS ENUM (
+    'push',
+    'email'
+);
+
+-- opt-ins and opt-outs of notification categories per channel, categories without a row use their default.
+CREATE TABLE notification_preferences (
+    user_id uuid NOT NULL,
+    category text NOT NULL,
+    channel notification_channel NOT NULL,
+    enabled boolean NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT notification_preferences_pkey PRIMARY KEY (user_id, category, channel)
+);
+
+ALTER TABLE notification_preferences
+    ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- broadcasts respect the notification preferences of their category
+ALTER TABLE push_broadcasts
+    ADD COLUMN category text NOT NULL DEFAULT 'updates';
+
+-- +migrate Down
+ALTER TABLE push_broadcasts
+    DROP COLUMN IF EXISTS category;
+
+DROP TABLE IF EXISTS notification_preferences;
+
+DROP TYPE IF EXISTS notification_channel;
+
+ALTER TABLE app_user_profiles
+    DROP COLUMN IF EXISTS time_zone,
+    DROP COLUMN IF EXISTS quiet_hours_start,
+    DROP COLUMN IF EXISTS quiet_hours_end;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- completed deliveries are purged once the retention has passed, see push.Service.Purge
+CREATE INDEX idx_push_deliveries_completed_updated_at ON push_deliveries USING btree (updated_at)
+WHERE
+    status <> 'queued';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_push_deliveries_completed_updated_at;